@@ -8,6 +8,7 @@ import (
 	"github.com/Soli0222/flow-sight/backend/internal/config"
 	"github.com/Soli0222/flow-sight/backend/internal/database"
 	"github.com/Soli0222/flow-sight/backend/internal/logger"
+	"github.com/Soli0222/flow-sight/backend/internal/tracing"
 	"github.com/Soli0222/flow-sight/backend/internal/version"
 
 	"github.com/joho/godotenv"
@@ -36,6 +37,18 @@ func main() {
 		"environment", cfg.Env,
 	)
 
+	// Initialize tracing before the database so queries are traced
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, version.Version)
+	if err != nil {
+		appLogger.ErrorContext(ctx, "Failed to set up tracing", "error", err.Error())
+		return
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			appLogger.ErrorContext(ctx, "Failed to flush traces", "error", err.Error())
+		}
+	}()
+
 	// Initialize database
 	db, err := database.Connect(cfg.Database)
 	if err != nil {
@@ -56,6 +69,14 @@ func main() {
 
 	// Initialize and start API server
 	server := api.NewServer(db, cfg, appLogger)
+
+	jobCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+	server.StartBackgroundJobs(jobCtx)
+
+	appLogger.InfoContext(ctx, "Serving metrics", "port", cfg.Metrics.Port)
+	server.StartMetrics(":" + cfg.Metrics.Port)
+
 	appLogger.InfoContext(ctx, "Starting server", "port", cfg.Port)
 
 	if err := server.Start(":" + cfg.Port); err != nil {
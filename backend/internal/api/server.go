@@ -1,26 +1,58 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/config"
 	"github.com/Soli0222/flow-sight/backend/internal/handlers"
+	"github.com/Soli0222/flow-sight/backend/internal/jobs"
 	"github.com/Soli0222/flow-sight/backend/internal/logger"
+	"github.com/Soli0222/flow-sight/backend/internal/metrics"
 	"github.com/Soli0222/flow-sight/backend/internal/middleware"
+	"github.com/Soli0222/flow-sight/backend/internal/netguard"
+	"github.com/Soli0222/flow-sight/backend/internal/notify"
+	"github.com/Soli0222/flow-sight/backend/internal/pdf"
 	"github.com/Soli0222/flow-sight/backend/internal/repositories"
 	"github.com/Soli0222/flow-sight/backend/internal/services"
 	"github.com/Soli0222/flow-sight/backend/internal/version"
+	"github.com/Soli0222/flow-sight/backend/internal/webhook"
+	"net/http"
+	"strconv"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
+// recurringPaymentProgressSchedule is how often remaining payment counts are brought up to date
+const recurringPaymentProgressSchedule = "@hourly"
+
+// netWorthSnapshotSchedule is when the day's net worth of every user is recorded
+const netWorthSnapshotSchedule = "55 23 * * *"
+
+// monthlyReportSchedule is when the report of the month just ended is produced
+const monthlyReportSchedule = "0 6 1 * *"
+
+// notificationSchedule is when the alerts and digests of the day are sent
+const notificationSchedule = "0 8 * * *"
+
+// webhookDeliverySchedule is how often queued and retried webhook deliveries are sent
+const webhookDeliverySchedule = "* * * * *"
+
+// healthPath is the path of the health check
+const healthPath = "/api/v1/health"
+
+// calendarFeedRoute is the route of calendar feeds, whose path holds the secret token of the feed
+const calendarFeedRoute = "/api/v1/calendar/:token"
+
 type Server struct {
-	router *gin.Engine
-	db     *sql.DB
-	config *config.Config
-	logger *logger.Logger
+	router    *gin.Engine
+	db        *sql.DB
+	config    *config.Config
+	logger    *logger.Logger
+	scheduler *jobs.Scheduler
 }
 
 func NewServer(db *sql.DB, cfg *config.Config, appLogger *logger.Logger) *Server {
@@ -31,6 +63,15 @@ func NewServer(db *sql.DB, cfg *config.Config, appLogger *logger.Logger) *Server
 
 	router := gin.New() // Use gin.New() instead of gin.Default() to avoid default middleware
 
+	// Keep the secret tokens of calendar feeds out of traces and logs
+	router.Use(middleware.RedactPaths(calendarFeedRoute))
+
+	// Start a span per request, continuing the trace of the caller. Health checks are left
+	// out so probes do not bury the requests of users.
+	router.Use(otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(func(r *http.Request) bool {
+		return r.URL.Path != healthPath
+	})))
+
 	// Add custom request logging middleware
 	router.Use(middleware.RequestLogger(appLogger))
 
@@ -63,17 +104,54 @@ func (s *Server) setupRoutes() {
 	recurringPaymentRepo := repositories.NewRecurringPaymentRepository(s.db)
 	cardMonthlyTotalRepo := repositories.NewCardMonthlyTotalRepository(s.db)
 	appSettingRepo := repositories.NewAppSettingRepository(s.db)
+	recurringTransferRepo := repositories.NewRecurringTransferRepository(s.db)
+	savingsGoalRepo := repositories.NewSavingsGoalRepository(s.db)
+	categoryRepo := repositories.NewCategoryRepository(s.db)
+	budgetRepo := repositories.NewBudgetRepository(s.db)
+	transactionRepo := repositories.NewTransactionRepository(s.db)
+	exchangeRateRepo := repositories.NewExchangeRateRepository(s.db)
+	assetRepo := repositories.NewAssetRepository(s.db)
+	netWorthSnapshotRepo := repositories.NewNetWorthSnapshotRepository(s.db)
+	calendarFeedRepo := repositories.NewCalendarFeedRepository(s.db)
+	monthlyReportRepo := repositories.NewMonthlyReportRepository(s.db)
+	notificationLogRepo := repositories.NewNotificationLogRepository(s.db)
+	webhookRepo := repositories.NewWebhookRepository(s.db)
+	webhookDeliveryRepo := repositories.NewWebhookDeliveryRepository(s.db)
+	jobRunRepo := repositories.NewJobRunRepository(s.db)
+	jobLockRepo := repositories.NewJobLockRepository(s.db)
+
+	// Requests to user-configured URLs stay off the server's network
+	outboundGuard := s.outboundGuard()
 
 	// Initialize services
 	authService := services.NewAuthService(userRepo, s.config)
-	creditCardService := services.NewCreditCardService(creditCardRepo)
-	bankAccountService := services.NewBankAccountService(bankAccountRepo)
-	incomeService := services.NewIncomeService(incomeSourceRepo, monthlyIncomeRepo)
-	recurringPaymentService := services.NewRecurringPaymentService(recurringPaymentRepo)
-	cardMonthlyTotalService := services.NewCardMonthlyTotalService(cardMonthlyTotalRepo)
-	appSettingService := services.NewAppSettingService(appSettingRepo)
-	cashflowService := services.NewCashflowService(bankAccountRepo, incomeSourceRepo, monthlyIncomeRepo, recurringPaymentRepo, cardMonthlyTotalRepo, creditCardRepo, appSettingRepo)
-	dashboardService := services.NewDashboardService(bankAccountRepo, creditCardRepo, incomeSourceRepo, monthlyIncomeRepo, recurringPaymentRepo, cashflowService)
+	webhookService := services.NewWebhookService(webhookRepo, webhookDeliveryRepo, webhook.NewClient(outboundGuard), outboundGuard)
+	creditCardService := services.NewCreditCardService(creditCardRepo, categoryRepo, webhookService)
+	bankAccountService := services.NewBankAccountService(bankAccountRepo, webhookService)
+	incomeService := services.NewIncomeService(incomeSourceRepo, monthlyIncomeRepo, categoryRepo, webhookService)
+	recurringPaymentService := services.NewRecurringPaymentService(recurringPaymentRepo, categoryRepo, webhookService)
+	cardMonthlyTotalService := services.NewCardMonthlyTotalService(cardMonthlyTotalRepo, creditCardRepo, webhookService)
+	appSettingService := services.NewAppSettingService(appSettingRepo, outboundGuard)
+	recurringTransferService := services.NewRecurringTransferService(recurringTransferRepo, bankAccountRepo)
+	exchangeRateService := services.NewExchangeRateService(exchangeRateRepo)
+	assetService := services.NewAssetService(assetRepo)
+	netWorthService := services.NewNetWorthService(assetRepo, bankAccountRepo, recurringPaymentRepo, netWorthSnapshotRepo, userRepo, appSettingRepo, exchangeRateRepo)
+	cashflowService := services.NewCashflowService(bankAccountRepo, incomeSourceRepo, monthlyIncomeRepo, recurringPaymentRepo, cardMonthlyTotalRepo, creditCardRepo, appSettingRepo, recurringTransferRepo, budgetRepo, exchangeRateRepo)
+	budgetService := services.NewBudgetService(categoryRepo, budgetRepo, transactionRepo, bankAccountRepo, creditCardRepo, cashflowService)
+	savingsGoalService := services.NewSavingsGoalService(savingsGoalRepo, bankAccountRepo, cashflowService)
+	dashboardService := services.NewDashboardService(bankAccountRepo, savingsGoalRepo, cashflowService, netWorthService)
+	calendarService := services.NewCalendarService(calendarFeedRepo, appSettingRepo, cashflowService)
+	reportService := services.NewReportService(monthlyReportRepo, userRepo, appSettingRepo, cashflowService, s.reportFont())
+	notificationService := services.NewNotificationService(notificationLogRepo, userRepo, appSettingRepo, creditCardRepo, cardMonthlyTotalRepo, cashflowService, s.notifiers(outboundGuard), webhookService, s.config.Host)
+	exportService := services.NewExportService(bankAccountRepo, creditCardRepo, cardMonthlyTotalRepo, incomeSourceRepo, recurringPaymentRepo)
+
+	// Initialize background jobs
+	s.scheduler = jobs.NewScheduler(jobs.NewRunner(jobRunRepo, s.logger), jobLockRepo, jobRunRepo, s.logger)
+	s.registerJob(recurringPaymentProgressSchedule, jobs.NewServiceJob(jobs.RecurringPaymentProgressJobName, recurringPaymentService.AdvanceProgress))
+	s.registerJob(netWorthSnapshotSchedule, jobs.NewServiceJob(jobs.NetWorthSnapshotJobName, netWorthService.RecordNetWorthSnapshots))
+	s.registerJob(monthlyReportSchedule, jobs.NewServiceJob(jobs.MonthlyReportJobName, reportService.GenerateMonthlyReports))
+	s.registerJob(notificationSchedule, jobs.NewServiceJob(jobs.NotificationJobName, notificationService.SendNotifications))
+	s.registerJob(webhookDeliverySchedule, jobs.NewServiceJob(jobs.WebhookDeliveryJobName, webhookService.DeliverWebhooks))
 
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(authService, s.config)
@@ -83,8 +161,20 @@ func (s *Server) setupRoutes() {
 	recurringPaymentHandler := handlers.NewRecurringPaymentHandler(recurringPaymentService)
 	cardMonthlyTotalHandler := handlers.NewCardMonthlyTotalHandler(cardMonthlyTotalService)
 	appSettingHandler := handlers.NewAppSettingHandler(appSettingService)
+	recurringTransferHandler := handlers.NewRecurringTransferHandler(recurringTransferService)
+	exchangeRateHandler := handlers.NewExchangeRateHandler(exchangeRateService)
+	assetHandler := handlers.NewAssetHandler(assetService)
+	netWorthHandler := handlers.NewNetWorthHandler(netWorthService)
+	savingsGoalHandler := handlers.NewSavingsGoalHandler(savingsGoalService)
+	budgetHandler := handlers.NewBudgetHandler(budgetService)
 	cashflowHandler := handlers.NewCashflowHandler(cashflowService)
 	dashboardHandler := handlers.NewDashboardHandler(dashboardService)
+	exportHandler := handlers.NewExportHandler(exportService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService, s.config.Host)
+	reportHandler := handlers.NewReportHandler(reportService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	jobHandler := handlers.NewJobHandler(s.scheduler)
 
 	// Public routes (no authentication required)
 	api := s.router.Group("/api/v1")
@@ -93,6 +183,9 @@ func (s *Server) setupRoutes() {
 	api.GET("/auth/google", authHandler.GoogleLogin)
 	api.GET("/auth/google/callback", authHandler.GoogleCallback)
 
+	// Calendar feed; the secret token in the address authenticates calendar apps
+	s.router.GET(calendarFeedRoute, calendarHandler.GetCalendarFeedFile)
+
 	// Protected routes (authentication required)
 	protected := api.Group("")
 	protected.Use(middleware.AuthMiddleware(authService))
@@ -103,6 +196,7 @@ func (s *Server) setupRoutes() {
 	// Credit Card routes
 	protected.GET("/credit-cards", creditCardHandler.GetCreditCards)
 	protected.POST("/credit-cards", creditCardHandler.CreateCreditCard)
+	protected.GET("/credit-cards/export", exportHandler.ExportCreditCards)
 	protected.GET("/credit-cards/:id", creditCardHandler.GetCreditCard)
 	protected.PUT("/credit-cards/:id", creditCardHandler.UpdateCreditCard)
 	protected.DELETE("/credit-cards/:id", creditCardHandler.DeleteCreditCard)
@@ -110,6 +204,7 @@ func (s *Server) setupRoutes() {
 	// Bank Account routes
 	protected.GET("/bank-accounts", bankAccountHandler.GetBankAccounts)
 	protected.POST("/bank-accounts", bankAccountHandler.CreateBankAccount)
+	protected.GET("/bank-accounts/export", exportHandler.ExportBankAccounts)
 	protected.GET("/bank-accounts/:id", bankAccountHandler.GetBankAccount)
 	protected.PUT("/bank-accounts/:id", bankAccountHandler.UpdateBankAccount)
 	protected.DELETE("/bank-accounts/:id", bankAccountHandler.DeleteBankAccount)
@@ -117,6 +212,7 @@ func (s *Server) setupRoutes() {
 	// Income routes
 	protected.GET("/income-sources", incomeHandler.GetIncomeSources)
 	protected.POST("/income-sources", incomeHandler.CreateIncomeSource)
+	protected.GET("/income-sources/export", exportHandler.ExportIncomeSources)
 	protected.GET("/income-sources/:id", incomeHandler.GetIncomeSource)
 	protected.PUT("/income-sources/:id", incomeHandler.UpdateIncomeSource)
 	protected.DELETE("/income-sources/:id", incomeHandler.DeleteIncomeSource)
@@ -130,26 +226,119 @@ func (s *Server) setupRoutes() {
 	// Recurring Payment routes
 	protected.GET("/recurring-payments", recurringPaymentHandler.GetRecurringPayments)
 	protected.POST("/recurring-payments", recurringPaymentHandler.CreateRecurringPayment)
+	protected.GET("/recurring-payments/export", exportHandler.ExportRecurringPayments)
 	protected.GET("/recurring-payments/:id", recurringPaymentHandler.GetRecurringPayment)
 	protected.PUT("/recurring-payments/:id", recurringPaymentHandler.UpdateRecurringPayment)
 	protected.DELETE("/recurring-payments/:id", recurringPaymentHandler.DeleteRecurringPayment)
+	protected.GET("/recurring-payments/:id/amortization", recurringPaymentHandler.GetAmortizationSchedule)
+
+	// Recurring Transfer routes
+	protected.GET("/recurring-transfers", recurringTransferHandler.GetRecurringTransfers)
+	protected.POST("/recurring-transfers", recurringTransferHandler.CreateRecurringTransfer)
+	protected.GET("/recurring-transfers/:id", recurringTransferHandler.GetRecurringTransfer)
+	protected.PUT("/recurring-transfers/:id", recurringTransferHandler.UpdateRecurringTransfer)
+	protected.DELETE("/recurring-transfers/:id", recurringTransferHandler.DeleteRecurringTransfer)
+
+	// Savings Goal routes
+	protected.GET("/savings-goals", savingsGoalHandler.GetSavingsGoals)
+	protected.POST("/savings-goals", savingsGoalHandler.CreateSavingsGoal)
+	protected.GET("/savings-goals/progress", savingsGoalHandler.GetSavingsGoalProgress)
+	protected.GET("/savings-goals/:id", savingsGoalHandler.GetSavingsGoal)
+	protected.PUT("/savings-goals/:id", savingsGoalHandler.UpdateSavingsGoal)
+	protected.DELETE("/savings-goals/:id", savingsGoalHandler.DeleteSavingsGoal)
+
+	// Category, Budget and Transaction routes
+	protected.GET("/categories", budgetHandler.GetCategories)
+	protected.POST("/categories", budgetHandler.CreateCategory)
+	protected.GET("/categories/breakdown", budgetHandler.GetCategoryBreakdown)
+	protected.GET("/categories/:id", budgetHandler.GetCategory)
+	protected.PUT("/categories/:id", budgetHandler.UpdateCategory)
+	protected.DELETE("/categories/:id", budgetHandler.DeleteCategory)
+
+	protected.GET("/budgets", budgetHandler.GetBudgets)
+	protected.POST("/budgets", budgetHandler.CreateBudget)
+	protected.GET("/budgets/report", budgetHandler.GetBudgetReport)
+	protected.GET("/budgets/:id", budgetHandler.GetBudget)
+	protected.PUT("/budgets/:id", budgetHandler.UpdateBudget)
+	protected.DELETE("/budgets/:id", budgetHandler.DeleteBudget)
+
+	protected.GET("/transactions", budgetHandler.GetTransactions)
+	protected.POST("/transactions", budgetHandler.CreateTransaction)
+	protected.GET("/transactions/:id", budgetHandler.GetTransaction)
+	protected.PUT("/transactions/:id", budgetHandler.UpdateTransaction)
+	protected.DELETE("/transactions/:id", budgetHandler.DeleteTransaction)
 
 	// Card Monthly Total routes
 	protected.GET("/card-monthly-totals", cardMonthlyTotalHandler.GetCardMonthlyTotals)
 	protected.POST("/card-monthly-totals", cardMonthlyTotalHandler.CreateCardMonthlyTotal)
+	protected.GET("/card-monthly-totals/export", exportHandler.ExportCardMonthlyTotals)
 	protected.GET("/card-monthly-totals/:id", cardMonthlyTotalHandler.GetCardMonthlyTotal)
 	protected.PUT("/card-monthly-totals/:id", cardMonthlyTotalHandler.UpdateCardMonthlyTotal)
 	protected.DELETE("/card-monthly-totals/:id", cardMonthlyTotalHandler.DeleteCardMonthlyTotal)
 
+	// Exchange Rate routes
+	protected.GET("/exchange-rates", exchangeRateHandler.GetExchangeRates)
+	protected.POST("/exchange-rates", exchangeRateHandler.CreateExchangeRate)
+	protected.POST("/exchange-rates/import", exchangeRateHandler.ImportExchangeRates)
+	protected.GET("/exchange-rates/:id", exchangeRateHandler.GetExchangeRate)
+	protected.PUT("/exchange-rates/:id", exchangeRateHandler.UpdateExchangeRate)
+	protected.DELETE("/exchange-rates/:id", exchangeRateHandler.DeleteExchangeRate)
+
+	// Asset routes
+	protected.GET("/assets", assetHandler.GetAssets)
+	protected.POST("/assets", assetHandler.CreateAsset)
+	protected.POST("/assets/valuations/import", assetHandler.ImportAssetValuations)
+	protected.GET("/assets/:id", assetHandler.GetAsset)
+	protected.PUT("/assets/:id", assetHandler.UpdateAsset)
+	protected.DELETE("/assets/:id", assetHandler.DeleteAsset)
+	protected.GET("/assets/:id/valuations", assetHandler.GetAssetValuations)
+	protected.POST("/assets/:id/valuations", assetHandler.CreateAssetValuation)
+	protected.DELETE("/assets/:id/valuations/:valuation_id", assetHandler.DeleteAssetValuation)
+
+	// Net Worth routes
+	protected.GET("/net-worth", netWorthHandler.GetNetWorth)
+	protected.GET("/net-worth/history", netWorthHandler.GetNetWorthHistory)
+
 	// App Setting routes
 	protected.GET("/settings", appSettingHandler.GetSettings)
+	protected.GET("/settings/schema", appSettingHandler.GetSettingsSchema)
 	protected.PUT("/settings", appSettingHandler.UpdateSettings)
 
 	// Cashflow Projection routes
 	protected.GET("/cashflow-projection", cashflowHandler.GetCashflowProjection)
+	protected.GET("/cashflow-projection/probabilistic", cashflowHandler.GetProbabilisticProjection)
+
+	// Calendar Feed routes
+	protected.GET("/calendar-feed", calendarHandler.GetCalendarFeed)
+	protected.POST("/calendar-feed", calendarHandler.CreateCalendarFeed)
+	protected.DELETE("/calendar-feed", calendarHandler.DeleteCalendarFeed)
+
+	// Report routes
+	protected.GET("/reports/monthly", reportHandler.GetMonthlyReport)
+	protected.GET("/reports/monthly/archive", reportHandler.GetStoredMonthlyReports)
+	protected.GET("/reports/monthly/archive/:year_month", reportHandler.GetStoredMonthlyReport)
+
+	// Notification routes
+	protected.GET("/notifications", notificationHandler.GetNotificationLogs)
+	protected.POST("/notifications/test", notificationHandler.SendTestNotification)
+
+	// Webhook routes
+	protected.GET("/webhooks", webhookHandler.GetWebhooks)
+	protected.POST("/webhooks", webhookHandler.CreateWebhook)
+	protected.GET("/webhooks/:id", webhookHandler.GetWebhook)
+	protected.PUT("/webhooks/:id", webhookHandler.UpdateWebhook)
+	protected.DELETE("/webhooks/:id", webhookHandler.DeleteWebhook)
+	protected.GET("/webhooks/:id/deliveries", webhookHandler.GetWebhookDeliveries)
+	protected.POST("/webhooks/:id/test", webhookHandler.SendTestEvent)
 
 	// Dashboard routes
 	protected.GET("/dashboard/summary", dashboardHandler.GetDashboardSummary)
+	protected.GET("/dashboard/overview", dashboardHandler.GetDashboardOverview)
+
+	// Admin routes
+	admin := protected.Group("/admin")
+	admin.Use(middleware.AdminMiddleware(s.config.AdminEmails))
+	admin.GET("/jobs", jobHandler.GetJobs)
 
 	// Health check
 	api.GET("/health", func(c *gin.Context) {
@@ -166,6 +355,91 @@ func (s *Server) setupRoutes() {
 	s.router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
 
+// registerJob adds a job to the scheduler. A job that cannot be registered is logged and skipped.
+func (s *Server) registerJob(spec string, job jobs.Job) {
+	if err := s.scheduler.Register(spec, job); err != nil {
+		s.logger.ErrorContext(context.Background(), "Failed to register job", "job", job.Name(), "error", err.Error())
+	}
+}
+
+// notifiers returns the transports of the notification channels. Chat services need no server
+// configuration and are reached through the guard; e-mail is left out when no mail server is
+// configured.
+func (s *Server) notifiers(guard *netguard.Guard) map[string]notify.Notifier {
+	notifiers := map[string]notify.Notifier{
+		services.NotificationChannelSlack:   notify.NewSlackNotifier(guard),
+		services.NotificationChannelDiscord: notify.NewDiscordNotifier(guard),
+		services.NotificationChannelLINE:    notify.NewLINENotifier(s.config.LINENotifyURL, guard),
+	}
+	if email := s.emailNotifier(); email != nil {
+		notifiers[services.NotificationChannelEmail] = email
+	}
+	return notifiers
+}
+
+// outboundGuard returns the guard of requests to user-configured URLs. When the allowed
+// networks are misconfigured, none are allowed.
+func (s *Server) outboundGuard() *netguard.Guard {
+	guard, err := netguard.New(s.config.OutboundAllowedNetworks)
+	if err != nil {
+		s.logger.ErrorContext(context.Background(), "Invalid outbound allowed networks, only public addresses are allowed", "error", err.Error())
+		guard, _ = netguard.New(nil)
+	}
+	return guard
+}
+
+// reportFont returns the font of monthly report PDFs, nil when it cannot be loaded, which leaves
+// the reports unavailable
+func (s *Server) reportFont() *pdf.Font {
+	font, err := pdf.LoadFont(s.config.ReportFontPath)
+	if err != nil {
+		s.logger.ErrorContext(context.Background(), "Failed to load the report font, monthly report PDFs are unavailable", "path", s.config.ReportFontPath, "error", err.Error())
+		return nil
+	}
+	return font
+}
+
+// emailNotifier returns the notifier of the configured mail server, nil when e-mail
+// notifications are off
+func (s *Server) emailNotifier() notify.Notifier {
+	if s.config.SMTP.Host == "" {
+		return nil
+	}
+	port, err := strconv.Atoi(s.config.SMTP.Port)
+	if err != nil {
+		s.logger.ErrorContext(context.Background(), "Invalid SMTP port, e-mail notifications are off", "port", s.config.SMTP.Port)
+		return nil
+	}
+	return notify.NewSMTPNotifier(notify.SMTPConfig{
+		Host:     s.config.SMTP.Host,
+		Port:     port,
+		Username: s.config.SMTP.Username,
+		Password: s.config.SMTP.Password,
+		From:     s.config.SMTP.From,
+	})
+}
+
+// StartMetrics serves the Prometheus metrics, including the database pool statistics, on their
+// own port. Failing to serve them is logged and does not stop the API.
+func (s *Server) StartMetrics(addr string) {
+	if err := metrics.RegisterDB(s.db, s.config.Database.Name); err != nil {
+		s.logger.ErrorContext(context.Background(), "Failed to register database metrics", "error", err.Error())
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler(s.config.Metrics.Token))
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			s.logger.ErrorContext(context.Background(), "Failed to serve metrics", "error", err.Error())
+		}
+	}()
+}
+
+// StartBackgroundJobs starts the job scheduler. Jobs run until ctx is cancelled.
+func (s *Server) StartBackgroundJobs(ctx context.Context) {
+	s.scheduler.Start(ctx)
+}
+
 func (s *Server) Start(addr string) error {
 	return s.router.Run(addr)
 }
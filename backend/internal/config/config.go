@@ -1,6 +1,9 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strings"
+)
 
 type Config struct {
 	Port     string
@@ -9,6 +12,22 @@ type Config struct {
 	JWT      JWTConfig
 	OAuth    OAuthConfig
 	Env      string
+	// AdminEmails are the users allowed to use the admin endpoints
+	AdminEmails []string
+	// SMTP is the mail server of e-mail notifications; e-mail is off without a host
+	SMTP SMTPConfig
+	// LINENotifyURL is the LINE Notify-compatible endpoint LINE notifications are posted to
+	LINENotifyURL string
+	// OutboundAllowedNetworks are the addresses or CIDR prefixes of the server's own network,
+	// e.g. a Home Assistant on the LAN, that webhooks and chat notifications may be sent to
+	OutboundAllowedNetworks []string
+	// ReportFontPath is the TrueType font monthly report PDFs are set in, e.g. IPAex Gothic or
+	// Noto Sans JP. The glyphs a report uses are embedded in it.
+	ReportFontPath string
+	// Metrics is where Prometheus scrapes the metrics; they are kept off the API port
+	Metrics MetricsConfig
+	// Tracing is where spans are exported over OTLP; spans are not exported without an endpoint
+	Tracing TracingConfig
 }
 
 type DatabaseConfig struct {
@@ -24,6 +43,29 @@ type JWTConfig struct {
 	Secret string
 }
 
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+type MetricsConfig struct {
+	Port string
+	// Token, when set, must be sent by scrapes as a bearer token
+	Token string
+}
+
+type TracingConfig struct {
+	// Endpoint is the OTLP/HTTP traces URL, e.g. http://otel-collector:4318/v1/traces
+	Endpoint    string
+	ServiceName string
+	// SampleRatio is the share of new traces that are sampled, from 0 to 1. Requests that
+	// arrive with a trace follow the sampling decision of their caller.
+	SampleRatio string
+}
+
 type OAuthConfig struct {
 	GoogleClientID     string
 	GoogleClientSecret string
@@ -50,7 +92,27 @@ func Load() *Config {
 			GoogleClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
 			RedirectURL:        getEnv("GOOGLE_REDIRECT_URL", "http://localhost:8080/api/v1/auth/google/callback"),
 		},
-		Env: getEnv("ENV", "development"),
+		Env:         getEnv("ENV", "development"),
+		AdminEmails: getEnvList("ADMIN_EMAILS"),
+		SMTP: SMTPConfig{
+			Host:     getEnv("SMTP_HOST", ""),
+			Port:     getEnv("SMTP_PORT", "587"),
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("SMTP_FROM", "Flow Sight <noreply@localhost>"),
+		},
+		LINENotifyURL:           getEnv("LINE_NOTIFY_URL", "https://notify-api.line.me/api/notify"),
+		OutboundAllowedNetworks: getEnvList("OUTBOUND_ALLOWED_NETWORKS"),
+		ReportFontPath:          getEnv("REPORT_FONT_PATH", "/usr/share/fonts/ipaex/ipaexg.ttf"),
+		Metrics: MetricsConfig{
+			Port:  getEnv("METRICS_PORT", "9090"),
+			Token: getEnv("METRICS_TOKEN", ""),
+		},
+		Tracing: TracingConfig{
+			Endpoint:    getEnv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", ""),
+			ServiceName: getEnv("OTEL_SERVICE_NAME", "flow-sight-backend"),
+			SampleRatio: getEnv("OTEL_TRACES_SAMPLER_ARG", "1"),
+		},
 	}
 }
 
@@ -60,3 +122,14 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// getEnvList reads a comma separated list, ignoring empty entries
+func getEnvList(key string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,123 @@
+// Package currency describes the currencies amounts can be held in and converts amounts between
+// them.
+//
+// Every stored amount is an integer with two decimals, i.e. hundredths of the major unit, in
+// whatever currency it is held. The minor unit of a currency (ISO 4217) decides how precise its
+// amounts are: yen have no decimals, so a converted yen amount is rounded to a multiple of 100.
+package currency
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Default is the currency of amounts stored without one and the default base currency
+const Default = "JPY"
+
+// scale is the number of decimals of every stored amount
+const scale = 2
+
+// Currency is a supported ISO 4217 currency
+type Currency struct {
+	Code   string
+	Digits int // Decimals of the minor unit, e.g. 0 for JPY and 2 for USD
+}
+
+var currencies = map[string]Currency{
+	"AUD": {Code: "AUD", Digits: 2},
+	"CAD": {Code: "CAD", Digits: 2},
+	"CHF": {Code: "CHF", Digits: 2},
+	"CNY": {Code: "CNY", Digits: 2},
+	"EUR": {Code: "EUR", Digits: 2},
+	"GBP": {Code: "GBP", Digits: 2},
+	"HKD": {Code: "HKD", Digits: 2},
+	"JPY": {Code: "JPY", Digits: 0},
+	"KRW": {Code: "KRW", Digits: 0},
+	"NZD": {Code: "NZD", Digits: 2},
+	"SGD": {Code: "SGD", Digits: 2},
+	"TWD": {Code: "TWD", Digits: 2},
+	"USD": {Code: "USD", Digits: 2},
+}
+
+// Lookup returns the currency with the given code. Codes are case insensitive.
+func Lookup(code string) (Currency, bool) {
+	c, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	return c, ok
+}
+
+// Codes returns the codes of every supported currency in alphabetical order
+func Codes() []string {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// MinorUnit returns the smallest amount of the currency in stored units, e.g. 100 for JPY and 1
+// for USD. It is 1 for an unknown currency.
+func MinorUnit(code string) int64 {
+	c, ok := Lookup(code)
+	if !ok || c.Digits >= scale {
+		return 1
+	}
+	return int64(math.Pow10(scale - c.Digits))
+}
+
+// Round rounds an amount half away from zero to the minor unit of the currency. Amounts of an
+// unknown currency are returned unchanged.
+func Round(amount int64, code string) int64 {
+	step := MinorUnit(code)
+	if step == 1 {
+		return amount
+	}
+
+	remainder := amount % step
+	switch {
+	case remainder*2 >= step:
+		return amount - remainder + step
+	case remainder*2 <= -step:
+		return amount - remainder - step
+	default:
+		return amount - remainder
+	}
+}
+
+// Convert converts an amount with an exchange rate, the number of units of the target currency
+// per unit of the source currency, and rounds the result to the minor unit of the target currency
+func Convert(amount int64, rate float64, to string) int64 {
+	return Round(int64(math.Round(float64(amount)*rate)), to)
+}
+
+// Format formats an amount for display with thousands separators: yen with a yen sign, e.g.
+// "¥1,234", other currencies with their code and decimals, e.g. "USD 1,234.50"
+func Format(amount int64, code string) string {
+	digits := scale
+	if c, ok := Lookup(code); ok {
+		digits = c.Digits
+	}
+	amount = Round(amount, code)
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	whole := strconv.FormatInt(amount/100, 10)
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	if digits > 0 {
+		whole += fmt.Sprintf(".%02d", amount%100)
+	}
+
+	if strings.ToUpper(code) == "JPY" {
+		return sign + "¥" + whole
+	}
+	return sign + strings.ToUpper(code) + " " + whole
+}
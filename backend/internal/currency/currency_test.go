@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	jpy, ok := Lookup(" jpy ")
+	assert.True(t, ok)
+	assert.Equal(t, Currency{Code: "JPY", Digits: 0}, jpy)
+
+	_, ok = Lookup("XXX")
+	assert.False(t, ok)
+}
+
+func TestCodes(t *testing.T) {
+	codes := Codes()
+	assert.Contains(t, codes, "JPY")
+	assert.Contains(t, codes, "USD")
+	assert.IsIncreasing(t, codes)
+}
+
+func TestMinorUnit(t *testing.T) {
+	assert.Equal(t, int64(100), MinorUnit("JPY"))
+	assert.Equal(t, int64(1), MinorUnit("USD"))
+	assert.Equal(t, int64(1), MinorUnit("XXX"))
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		code     string
+		expected int64
+	}{
+		{name: "yen rounded down", amount: 12349, code: "JPY", expected: 12300},
+		{name: "yen rounded half up", amount: 12350, code: "JPY", expected: 12400},
+		{name: "negative yen", amount: -12350, code: "JPY", expected: -12400},
+		{name: "negative yen rounded towards zero", amount: -12349, code: "JPY", expected: -12300},
+		{name: "cents unchanged", amount: 12349, code: "USD", expected: 12349},
+		{name: "unknown currency unchanged", amount: 12349, code: "XXX", expected: 12349},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Round(tt.amount, tt.code))
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	// $1,234.56 at 150.123 JPY/USD is ¥185,335.85, rounded to whole yen
+	assert.Equal(t, int64(18533600), Convert(123456, 150.123, "JPY"))
+	// ¥100,000 at 0.0066 USD/JPY is $660.00
+	assert.Equal(t, int64(66000), Convert(10000000, 0.0066, "USD"))
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "¥1,234,568", Format(123456750, "JPY"))
+	assert.Equal(t, "¥0", Format(0, "JPY"))
+	assert.Equal(t, "-¥300", Format(-30000, "JPY"))
+	assert.Equal(t, "USD 1,234.50", Format(123450, "usd"))
+	assert.Equal(t, "EUR 0.05", Format(5, "EUR"))
+}
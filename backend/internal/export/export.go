@@ -0,0 +1,318 @@
+// Package export writes tables of data as CSV or Excel (XLSX) files for spreadsheets.
+//
+// CSV files are UTF-8 with a byte order mark so Japanese Excel detects the encoding. Text that a
+// spreadsheet would read as a formula is prefixed with a single quote. XLSX files are minimal
+// single-sheet workbooks written with the standard library; numbers are written as numeric cells
+// and everything else as inline strings, which spreadsheets never evaluate.
+package export
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+)
+
+// Format is the file format of an export
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+// ParseFormat returns the format with the given name. Names are case insensitive.
+func ParseFormat(name string) (Format, bool) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case CSV, XLSX:
+		return format, true
+	}
+	return "", false
+}
+
+// ContentType returns the MIME type of files of the format
+func (f Format) ContentType() string {
+	if f == XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Filename returns the name of a file of the format with the given base name
+func (f Format) Filename(name string) string {
+	return name + "." + string(f)
+}
+
+// Unit is the unit amounts are exported in
+type Unit string
+
+const (
+	Yen   Unit = "yen"   // Whole currency units, e.g. 1234 for ¥1,234 or 12.50 for $12.50
+	Minor Unit = "minor" // Stored amounts, i.e. hundredths of the currency unit
+)
+
+// ParseUnit returns the unit with the given name. Names are case insensitive.
+func ParseUnit(name string) (Unit, bool) {
+	switch unit := Unit(strings.ToLower(strings.TrimSpace(name))); unit {
+	case Yen, Minor:
+		return unit, true
+	}
+	return "", false
+}
+
+// Number is a numeric cell value in its textual form
+type Number string
+
+// Amount formats a stored amount of the given currency in the unit. In whole units the amount is
+// rounded to the minor unit of the currency and has as many decimals as the currency.
+func Amount(amount int64, code string, unit Unit) Number {
+	if unit == Minor {
+		return Number(strconv.FormatInt(amount, 10))
+	}
+
+	digits := 2
+	if c, ok := currency.Lookup(code); ok {
+		digits = c.Digits
+	}
+	amount = currency.Round(amount, code)
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	if digits == 0 {
+		return Number(sign + strconv.FormatInt(amount/100, 10))
+	}
+	return Number(fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100))
+}
+
+// Table is the data of an export: a header row followed by rows of cells. A cell is a string, a
+// Number, an int, an int64 or nil for an empty cell.
+type Table struct {
+	Name    string // Sheet name of an XLSX file
+	Headers []string
+	Rows    [][]any
+}
+
+// AddRow appends a row of cells to the table
+func (t *Table) AddRow(cells ...any) {
+	t.Rows = append(t.Rows, cells)
+}
+
+// Write writes the table to w in the format
+func Write(w io.Writer, format Format, table *Table) error {
+	if format == XLSX {
+		return WriteXLSX(w, table)
+	}
+	return WriteCSV(w, table)
+}
+
+// byteOrderMark makes Excel read a CSV file as UTF-8
+const byteOrderMark = "\ufeff"
+
+// WriteCSV writes the table as a UTF-8 CSV file with a byte order mark and CRLF line endings
+func WriteCSV(w io.Writer, table *Table) error {
+	if _, err := io.WriteString(w, byteOrderMark); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	headers := make([]string, len(table.Headers))
+	for i, header := range table.Headers {
+		headers[i] = csvText(header)
+	}
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			text, numeric := cellText(cell)
+			if !numeric {
+				text = csvText(text)
+			}
+			record[i] = text
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// formulaPrefixes are the first characters that make a spreadsheet read a CSV field as a formula
+const formulaPrefixes = "=+-@\t\r"
+
+// csvText neutralises text a spreadsheet would evaluate as a formula by prefixing it with a single
+// quote, so user-entered names such as "=HYPERLINK(...)" are shown as typed
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune(formulaPrefixes, rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// cellText returns the text of a cell and whether it is numeric
+func cellText(cell any) (string, bool) {
+	switch value := cell.(type) {
+	case nil:
+		return "", false
+	case string:
+		return value, false
+	case Number:
+		return string(value), true
+	case int:
+		return strconv.Itoa(value), true
+	case int64:
+		return strconv.FormatInt(value, 10), true
+	default:
+		return fmt.Sprint(value), false
+	}
+}
+
+// Parts of an XLSX package besides the sheet
+var xlsxParts = []struct {
+	name    string
+	content string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`},
+	{"xl/styles.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+		`</styleSheet>`},
+}
+
+// headerStyle is the index of the bold cell format in styles.xml
+const headerStyle = 1
+
+// WriteXLSX writes the table as an Excel workbook with a single sheet. The header row is bold.
+func WriteXLSX(w io.Writer, table *Table) error {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		if err := writeZipFile(archive, part.name, part.content); err != nil {
+			return err
+		}
+	}
+	if err := writeZipFile(archive, "xl/workbook.xml", workbookXML(table.Name)); err != nil {
+		return err
+	}
+	if err := writeZipFile(archive, "xl/worksheets/sheet1.xml", sheetXML(table)); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+func writeZipFile(archive *zip.Writer, name, content string) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(file, content)
+	return err
+}
+
+func workbookXML(sheetName string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="` + escapeXML(sheetTitle(sheetName)) + `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+}
+
+// sheetTitle makes a name usable as a sheet name: at most 31 characters without []:*?/\
+func sheetTitle(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if runes := []rune(name); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+	if name == "" {
+		name = "Sheet1"
+	}
+	return name
+}
+
+func sheetXML(table *Table) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	headers := make([]any, len(table.Headers))
+	for i, header := range table.Headers {
+		headers[i] = header
+	}
+	writeRow(&b, 1, headers, headerStyle)
+	for i, row := range table.Rows {
+		writeRow(&b, i+2, row, 0)
+	}
+
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+func writeRow(b *strings.Builder, number int, cells []any, style int) {
+	fmt.Fprintf(b, `<row r="%d">`, number)
+	for i, cell := range cells {
+		if cell == nil {
+			continue
+		}
+		text, numeric := cellText(cell)
+
+		ref := columnName(i) + strconv.Itoa(number)
+		styleAttr := ""
+		if style != 0 {
+			styleAttr = fmt.Sprintf(` s="%d"`, style)
+		}
+		if numeric {
+			fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttr, escapeXML(text))
+		} else {
+			fmt.Fprintf(b, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, styleAttr, escapeXML(text))
+		}
+	}
+	b.WriteString(`</row>`)
+}
+
+// columnName returns the letters of a zero-based column index, e.g. "A", "Z" or "AA"
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,133 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	format, ok := ParseFormat(" XLSX ")
+	assert.True(t, ok)
+	assert.Equal(t, XLSX, format)
+
+	_, ok = ParseFormat("pdf")
+	assert.False(t, ok)
+}
+
+func TestAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		code     string
+		unit     Unit
+		expected Number
+	}{
+		{name: "yen", amount: 123400, code: "JPY", unit: Yen, expected: "1234"},
+		{name: "yen rounded", amount: 123450, code: "JPY", unit: Yen, expected: "1235"},
+		{name: "dollars with cents", amount: 1250, code: "USD", unit: Yen, expected: "12.50"},
+		{name: "negative dollars", amount: -5, code: "USD", unit: Yen, expected: "-0.05"},
+		{name: "minor unit", amount: 123450, code: "JPY", unit: Minor, expected: "123450"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Amount(tt.amount, tt.code, tt.unit))
+		})
+	}
+}
+
+func testTable() *Table {
+	table := &Table{Name: "口座", Headers: []string{"名前", "残高"}}
+	table.AddRow("メイン, 普通", Number("1234"))
+	table.AddRow("<サブ>", nil)
+	return table
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, CSV, testTable()))
+
+	assert.Equal(t, "\ufeff名前,残高\r\n\"メイン, 普通\",1234\r\n<サブ>,\r\n", buf.String())
+}
+
+func TestWriteCSV_formulas(t *testing.T) {
+	table := &Table{Headers: []string{"名前", "金額"}}
+	table.AddRow("=HYPERLINK(\"http://example.com\")", Number("-1234"))
+	table.AddRow("+81", nil)
+	table.AddRow("-2", nil)
+	table.AddRow("@SUM(A1)", nil)
+	table.AddRow("\tタブ", nil)
+	table.AddRow("\r改行", nil)
+	table.AddRow("a=b", nil)
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, CSV, table))
+
+	assert.Equal(t, "\ufeff名前,金額\r\n"+
+		"\"'=HYPERLINK(\"\"http://example.com\"\")\",-1234\r\n"+
+		"'+81,\r\n"+
+		"'-2,\r\n"+
+		"'@SUM(A1),\r\n"+
+		"'\tタブ,\r\n"+
+		"\"'改行\",\r\n"+ // the CRLF writer drops a lone CR inside a quoted field
+		"a=b,\r\n", buf.String())
+}
+
+func readXLSX(t *testing.T, table *Table) map[string]string {
+	t.Helper()
+	var buf bytes.Buffer
+
+	require.NoError(t, Write(&buf, XLSX, table))
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+func TestWriteXLSX(t *testing.T) {
+	files := readXLSX(t, testTable())
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files["xl/workbook.xml"], `<sheet name="口座"`)
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">名前</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>1234</v></c>`)
+	assert.Contains(t, sheet, `<t xml:space="preserve">&lt;サブ&gt;</t>`)
+	assert.NotContains(t, sheet, `r="B3"`, "empty cells are left out")
+}
+
+func TestWriteXLSX_formulas(t *testing.T) {
+	table := &Table{Headers: []string{"名前", "金額"}}
+	table.AddRow("=1+2", Number("-1234"))
+	table.AddRow("@SUM(A1)", nil)
+
+	sheet := readXLSX(t, table)["xl/worksheets/sheet1.xml"]
+
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">=1+2</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>-1234</v></c>`)
+	assert.Contains(t, sheet, `<c r="A3" t="inlineStr"><is><t xml:space="preserve">@SUM(A1)</t></is></c>`)
+	assert.NotContains(t, sheet, "<f>", "text is never written as a formula")
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "A", columnName(0))
+	assert.Equal(t, "Z", columnName(25))
+	assert.Equal(t, "AA", columnName(26))
+	assert.Equal(t, "AZ", columnName(51))
+	assert.Equal(t, "BA", columnName(52))
+}
@@ -39,7 +39,7 @@ func (h *AppSettingHandler) GetSettings(c *gin.Context) {
 		return
 	}
 
-	settings, err := h.appSettingService.GetSettings(userUUID)
+	settings, err := h.appSettingService.GetSettings(c.Request.Context(), userUUID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -53,13 +53,14 @@ type UpdateSettingsRequest struct {
 }
 
 // @Summary Update settings
-// @Description Update settings for a user
+// @Description Update settings for a user. All settings are validated against the settings schema and stored in one transaction.
 // @Tags settings
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param settings body UpdateSettingsRequest true "Settings data"
 // @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
 // @Router /settings [put]
 func (h *AppSettingHandler) UpdateSettings(c *gin.Context) {
 	userID, exists := c.Get("user_id")
@@ -80,12 +81,22 @@ func (h *AppSettingHandler) UpdateSettings(c *gin.Context) {
 		return
 	}
 
-	for key, value := range req.Settings {
-		if err := h.appSettingService.UpdateSetting(userUUID, key, value); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
+	if err := h.appSettingService.UpdateSettings(c.Request.Context(), userUUID, req.Settings); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Settings updated successfully"})
 }
+
+// @Summary Get settings schema
+// @Description Get the type, default, range and description of every known setting
+// @Tags settings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.SettingDefinition
+// @Router /settings/schema [get]
+func (h *AppSettingHandler) GetSettingsSchema(c *gin.Context) {
+	c.JSON(http.StatusOK, h.appSettingService.GetSchema())
+}
@@ -0,0 +1,405 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxAssetValuationImportSize limits the size of an imported CSV file
+const maxAssetValuationImportSize = 1 << 20
+
+type AssetHandler struct {
+	assetService AssetServiceInterface
+}
+
+func NewAssetHandler(assetService AssetServiceInterface) *AssetHandler {
+	return &AssetHandler{
+		assetService: assetService,
+	}
+}
+
+// @Summary Get all assets
+// @Description Get all investments and other non-cash assets of the user with their latest valuation
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Asset
+// @Router /assets [get]
+func (h *AssetHandler) GetAssets(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	assets, err := h.assetService.GetAssets(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, assets)
+}
+
+// @Summary Get asset by ID
+// @Description Get a specific asset by ID with its latest valuation
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} models.Asset
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id} [get]
+func (h *AssetHandler) GetAsset(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset id format"})
+		return
+	}
+
+	asset, err := h.assetService.GetAsset(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, asset)
+}
+
+// @Summary Create asset
+// @Description Create a securities, NISA, pension, property, crypto or other asset
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param asset body models.Asset true "Asset data"
+// @Success 201 {object} models.Asset
+// @Failure 400 {object} map[string]string
+// @Router /assets [post]
+func (h *AssetHandler) CreateAsset(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var asset models.Asset
+	if err := c.ShouldBindJSON(&asset); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	asset.UserID = userUUID
+
+	if err := h.assetService.CreateAsset(c.Request.Context(), &asset); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, asset)
+}
+
+// @Summary Update asset
+// @Description Update an existing asset. Valuations are recorded separately.
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param asset body models.Asset true "Asset data"
+// @Success 200 {object} models.Asset
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id} [put]
+func (h *AssetHandler) UpdateAsset(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset id format"})
+		return
+	}
+
+	var asset models.Asset
+	if err := c.ShouldBindJSON(&asset); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	asset.ID = id
+	if err := h.assetService.UpdateAsset(c.Request.Context(), userUUID, &asset); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, asset)
+}
+
+// @Summary Delete asset
+// @Description Delete an asset together with its valuations
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id} [delete]
+func (h *AssetHandler) DeleteAsset(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset id format"})
+		return
+	}
+
+	if err := h.assetService.DeleteAsset(c.Request.Context(), userUUID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get asset valuations
+// @Description Get the valuations of an asset, oldest date first
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {array} models.AssetValuation
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/valuations [get]
+func (h *AssetHandler) GetAssetValuations(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset id format"})
+		return
+	}
+
+	valuations, err := h.assetService.GetAssetValuations(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, valuations)
+}
+
+// @Summary Create asset valuation
+// @Description Record the value of an asset on a date. A valuation of the same date is replaced.
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param valuation body models.AssetValuation true "Asset Valuation data"
+// @Success 201 {object} models.AssetValuation
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/valuations [post]
+func (h *AssetHandler) CreateAssetValuation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset id format"})
+		return
+	}
+
+	var valuation models.AssetValuation
+	if err := c.ShouldBindJSON(&valuation); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	valuation.AssetID = id
+	if err := h.assetService.CreateAssetValuation(c.Request.Context(), userUUID, &valuation); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, valuation)
+}
+
+// @Summary Delete asset valuation
+// @Description Delete a valuation of an asset
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param valuation_id path string true "Asset Valuation ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/valuations/{valuation_id} [delete]
+func (h *AssetHandler) DeleteAssetValuation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	assetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset id format"})
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("valuation_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid asset valuation id format"})
+		return
+	}
+
+	if err := h.assetService.DeleteAssetValuation(c.Request.Context(), userUUID, assetID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Import asset valuations
+// @Description Import valuations from a CSV file with the columns date, asset (name or ID) and value. The file is sent as the "file" field of a multipart form or as the request body.
+// @Tags assets
+// @Accept multipart/form-data,text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file false "CSV file"
+// @Success 200 {object} models.AssetValuationImportResult
+// @Failure 400 {object} map[string]string
+// @Router /assets/valuations/import [post]
+func (h *AssetHandler) ImportAssetValuations(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAssetValuationImportSize)
+
+	var file io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+			return
+		}
+		upload, err := header.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer upload.Close()
+		file = upload
+	}
+
+	result, err := h.assetService.ImportAssetValuations(c.Request.Context(), userUUID, file)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+			return
+		}
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAssetHandler_GetAssets(t *testing.T) {
+	tests := []struct {
+		name           string
+		authenticated  bool
+		setupMock      func(*MockAssetServiceInterface, uuid.UUID)
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:          "successful retrieval",
+			authenticated: true,
+			setupMock: func(m *MockAssetServiceInterface, userID uuid.UUID) {
+				m.On("GetAssets", mock.Anything, userID).Return([]models.Asset{
+					{ID: uuid.New(), UserID: userID, Name: "NISA", AssetType: "nisa", Currency: "JPY", IsActive: true},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "unauthenticated user",
+			authenticated:  false,
+			setupMock:      func(m *MockAssetServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "service error",
+			authenticated: true,
+			setupMock: func(m *MockAssetServiceInterface, userID uuid.UUID) {
+				m.On("GetAssets", mock.Anything, userID).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockAssetServiceInterface(t)
+			handler := NewAssetHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			var c *gin.Context
+			var w *httptest.ResponseRecorder
+			if tt.authenticated {
+				c, w = helpers.CreateTestContextWithUserID(t, "GET", "/assets", nil, userID)
+			} else {
+				c, w = helpers.CreateTestContext(t, "GET", "/assets", nil, false)
+			}
+
+			handler.GetAssets(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var assets []models.Asset
+				helpers.ParseJSONResponse(t, w, &assets)
+				assert.Len(t, assets, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestAssetHandler_CreateAsset(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupMock      func(*MockAssetServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			requestBody: map[string]interface{}{
+				"name":       "NISA",
+				"asset_type": "nisa",
+				"currency":   "JPY",
+				"is_active":  true,
+			},
+			setupMock: func(m *MockAssetServiceInterface) {
+				m.On("CreateAsset", mock.Anything, mock.MatchedBy(func(asset *models.Asset) bool {
+					return asset.UserID == uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d") && asset.AssetType == "nisa"
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "invalid request body",
+			requestBody: map[string]interface{}{
+				"name": 42,
+			},
+			setupMock:      func(m *MockAssetServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "validation error",
+			requestBody: map[string]interface{}{
+				"name":       "Gold",
+				"asset_type": "metal",
+			},
+			setupMock: func(m *MockAssetServiceInterface) {
+				m.On("CreateAsset", mock.Anything, mock.AnythingOfType("*models.Asset")).
+					Return(services.NewValidationError("asset_type", "must be one of securities, nisa, pension, property, crypto, other"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockAssetServiceInterface(t)
+			handler := NewAssetHandler(mockService)
+			tt.setupMock(mockService)
+
+			userID := uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/assets", tt.requestBody, userID)
+
+			handler.CreateAsset(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestAssetHandler_CreateAssetValuation(t *testing.T) {
+	assetID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		assetID        string
+		requestBody    map[string]interface{}
+		setupMock      func(*MockAssetServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name:    "successful creation",
+			assetID: assetID.String(),
+			requestBody: map[string]interface{}{
+				"valuation_date": "2024-01-31",
+				"value":          123456700,
+			},
+			setupMock: func(m *MockAssetServiceInterface) {
+				m.On("CreateAssetValuation", mock.Anything, userID, mock.MatchedBy(func(valuation *models.AssetValuation) bool {
+					return valuation.AssetID == assetID && valuation.Value == 123456700
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "invalid asset id",
+			assetID:        "invalid",
+			requestBody:    map[string]interface{}{},
+			setupMock:      func(m *MockAssetServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "validation error",
+			assetID: assetID.String(),
+			requestBody: map[string]interface{}{
+				"valuation_date": "31/01/2024",
+				"value":          100,
+			},
+			setupMock: func(m *MockAssetServiceInterface) {
+				m.On("CreateAssetValuation", mock.Anything, userID, mock.AnythingOfType("*models.AssetValuation")).
+					Return(services.NewValidationError("valuation_date", "must be in YYYY-MM-DD format"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "another user's asset",
+			assetID: uuid.New().String(),
+			requestBody: map[string]interface{}{
+				"valuation_date": "2024-01-31",
+				"value":          100,
+			},
+			setupMock: func(m *MockAssetServiceInterface) {
+				m.On("CreateAssetValuation", mock.Anything, userID, mock.AnythingOfType("*models.AssetValuation")).Return(sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockAssetServiceInterface(t)
+			handler := NewAssetHandler(mockService)
+			tt.setupMock(mockService)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/assets/"+tt.assetID+"/valuations", tt.requestBody, userID)
+			c.Params = gin.Params{{Key: "id", Value: tt.assetID}}
+
+			handler.CreateAssetValuation(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestAssetHandler_DeleteAssetValuation(t *testing.T) {
+	tests := []struct {
+		name           string
+		assetID        string
+		err            error
+		expectedStatus int
+	}{
+		{name: "deleted", assetID: uuid.New().String(), expectedStatus: http.StatusNoContent},
+		{name: "another user's asset", assetID: uuid.New().String(), err: sql.ErrNoRows, expectedStatus: http.StatusNotFound},
+		{name: "invalid asset id", assetID: "invalid", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockAssetServiceInterface(t)
+			handler := NewAssetHandler(mockService)
+
+			userID, valuationID := uuid.New(), uuid.New()
+			if assetID, err := uuid.Parse(tt.assetID); err == nil {
+				mockService.On("DeleteAssetValuation", mock.Anything, userID, assetID, valuationID).Return(tt.err)
+			}
+
+			c, _ := helpers.CreateTestContextWithUserID(t, "DELETE", "/assets/"+tt.assetID+"/valuations/"+valuationID.String(), nil, userID)
+			c.Params = gin.Params{{Key: "id", Value: tt.assetID}, {Key: "valuation_id", Value: valuationID.String()}}
+
+			handler.DeleteAssetValuation(c)
+
+			assert.Equal(t, tt.expectedStatus, c.Writer.Status())
+		})
+	}
+}
+
+func TestAssetHandler_ImportAssetValuations(t *testing.T) {
+	const csvData = "date,asset,value\n2024-01-31,NISA,1234567\n"
+
+	readsCSV := mock.MatchedBy(func(r io.Reader) bool {
+		data, err := io.ReadAll(r)
+		return err == nil && string(data) == csvData
+	})
+
+	tests := []struct {
+		name           string
+		body           string
+		setupMock      func(*MockAssetServiceInterface, uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "raw CSV body",
+			body: csvData,
+			setupMock: func(m *MockAssetServiceInterface, userID uuid.UUID) {
+				m.On("ImportAssetValuations", mock.Anything, userID, readsCSV).Return(&models.AssetValuationImportResult{Imported: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "unknown asset",
+			body: "2024-01-31,Gold,100\n",
+			setupMock: func(m *MockAssetServiceInterface, userID uuid.UUID) {
+				m.On("ImportAssetValuations", mock.Anything, userID, mock.Anything).
+					Return(nil, services.NewValidationError("file", "line 1: unknown asset \"Gold\""))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockAssetServiceInterface(t)
+			handler := NewAssetHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/assets/valuations/import", nil, userID)
+			c.Request = httptest.NewRequest("POST", "/assets/valuations/import", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "text/csv")
+
+			handler.ImportAssetValuations(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var result models.AssetValuationImportResult
+				helpers.ParseJSONResponse(t, w, &result)
+				assert.Equal(t, 1, result.Imported)
+			}
+		})
+	}
+}
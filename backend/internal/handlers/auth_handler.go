@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
@@ -46,7 +45,7 @@ func (h *AuthHandler) normalizeHost() string {
 // @Router /auth/google [get]
 func (h *AuthHandler) GoogleLogin(c *gin.Context) {
 	logger := middleware.GetLogger(c)
-	ctx := context.Background()
+	ctx := c.Request.Context()
 
 	state := generateState()
 	http.SetCookie(c.Writer, &http.Cookie{
@@ -94,7 +93,7 @@ func generateState() string {
 // @Router /auth/google/callback [get]
 func (h *AuthHandler) GoogleCallback(c *gin.Context) {
 	logger := middleware.GetLogger(c)
-	ctx := context.Background()
+	ctx := c.Request.Context()
 
 	code := c.Query("code")
 	if code == "" {
@@ -106,7 +105,7 @@ func (h *AuthHandler) GoogleCallback(c *gin.Context) {
 		return
 	}
 
-	user, token, err := h.authService.HandleGoogleCallback(code)
+	user, token, err := h.authService.HandleGoogleCallback(ctx, code)
 	if err != nil {
 		logger.ErrorContext(ctx, "OAuth callback failed",
 			"error", err.Error(),
@@ -159,7 +158,7 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 		return
 	}
 
-	user, err := h.authService.GetUserByID(userUUID.String())
+	user, err := h.authService.GetUserByID(c.Request.Context(), userUUID.String())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -79,7 +79,7 @@ func TestAuthHandler_GoogleCallback(t *testing.T) {
 					Picture: "https://example.com/picture.jpg",
 				}
 				testToken := "test-jwt-token"
-				m.On("HandleGoogleCallback", "test-auth-code").Return(testUser, testToken, nil)
+				m.On("HandleGoogleCallback", mock.Anything, "test-auth-code").Return(testUser, testToken, nil)
 			},
 			expectedStatus:   http.StatusFound,
 			expectedRedirect: "http://localhost:3000/auth/callback",
@@ -97,7 +97,7 @@ func TestAuthHandler_GoogleCallback(t *testing.T) {
 			name: "callback service error",
 			code: "invalid-code",
 			setupMock: func(m *MockAuthServiceInterface) {
-				m.On("HandleGoogleCallback", "invalid-code").Return((*models.User)(nil), "", assert.AnError)
+				m.On("HandleGoogleCallback", mock.Anything, "invalid-code").Return((*models.User)(nil), "", assert.AnError)
 			},
 			expectedStatus:   http.StatusFound,
 			expectedRedirect: "http://localhost:3000/login?error=callback_failed",
@@ -156,7 +156,7 @@ func TestAuthHandler_GetMe(t *testing.T) {
 					Name:    "Test User",
 					Picture: "https://example.com/picture.jpg",
 				}
-				m.On("GetUserByID", "cbf3d545-d81d-450d-acb3-c5c49a597d6d").Return(testUser, nil)
+				m.On("GetUserByID", mock.Anything, "cbf3d545-d81d-450d-acb3-c5c49a597d6d").Return(testUser, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -172,7 +172,7 @@ func TestAuthHandler_GetMe(t *testing.T) {
 			name:          "user not found",
 			authenticated: true,
 			setupMock: func(m *MockAuthServiceInterface) {
-				m.On("GetUserByID", "cbf3d545-d81d-450d-acb3-c5c49a597d6d").Return((*models.User)(nil), assert.AnError)
+				m.On("GetUserByID", mock.Anything, "cbf3d545-d81d-450d-acb3-c5c49a597d6d").Return((*models.User)(nil), assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
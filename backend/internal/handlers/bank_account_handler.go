@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/middleware"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"net/http"
@@ -41,7 +40,7 @@ func (h *BankAccountHandler) GetBankAccounts(c *gin.Context) {
 		return
 	}
 
-	accounts, err := h.bankAccountService.GetBankAccounts(userUUID)
+	accounts, err := h.bankAccountService.GetBankAccounts(c.Request.Context(), userUUID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -66,7 +65,7 @@ func (h *BankAccountHandler) GetBankAccount(c *gin.Context) {
 		return
 	}
 
-	account, err := h.bankAccountService.GetBankAccount(id)
+	account, err := h.bankAccountService.GetBankAccount(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "bank account not found"})
 		return
@@ -86,7 +85,7 @@ func (h *BankAccountHandler) GetBankAccount(c *gin.Context) {
 // @Router /bank-accounts [post]
 func (h *BankAccountHandler) CreateBankAccount(c *gin.Context) {
 	logger := middleware.GetLogger(c)
-	ctx := context.Background()
+	ctx := c.Request.Context()
 
 	userID, exists := c.Get("user_id")
 	if !exists {
@@ -117,13 +116,13 @@ func (h *BankAccountHandler) CreateBankAccount(c *gin.Context) {
 	// Set the user_id from the authenticated user
 	account.UserID = userUUID
 
-	if err := h.bankAccountService.CreateBankAccount(&account); err != nil {
+	if err := h.bankAccountService.CreateBankAccount(ctx, &account); err != nil {
 		logger.ErrorContext(ctx, "Failed to create bank account",
 			"user_id", userUUID.String(),
 			"account_name", account.Name,
 			"error", err.Error(),
 		)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -168,8 +167,8 @@ func (h *BankAccountHandler) UpdateBankAccount(c *gin.Context) {
 
 	account.ID = id
 	account.UserID = userID.(uuid.UUID)
-	if err := h.bankAccountService.UpdateBankAccount(&account); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.bankAccountService.UpdateBankAccount(c.Request.Context(), &account); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -199,7 +198,7 @@ func (h *BankAccountHandler) DeleteBankAccount(c *gin.Context) {
 		return
 	}
 
-	if err := h.bankAccountService.DeleteBankAccount(id); err != nil {
+	if err := h.bankAccountService.DeleteBankAccount(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -28,7 +28,7 @@ func TestBankAccountHandler_GetBankAccounts_New(t *testing.T) {
 					*helpers.CreateTestBankAccount(userID),
 					*helpers.CreateTestBankAccount(userID),
 				}
-				m.On("GetBankAccounts", userID).Return(accounts, nil)
+				m.On("GetBankAccounts", mock.Anything, userID).Return(accounts, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  2,
@@ -46,7 +46,7 @@ func TestBankAccountHandler_GetBankAccounts_New(t *testing.T) {
 			name:          "service error",
 			authenticated: true,
 			setupMock: func(m *MockBankAccountServiceInterface, userID uuid.UUID) {
-				m.On("GetBankAccounts", userID).Return([]models.BankAccount{}, assert.AnError)
+				m.On("GetBankAccounts", mock.Anything, userID).Return([]models.BankAccount{}, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedCount:  0,
@@ -98,7 +98,7 @@ func TestBankAccountHandler_GetBankAccount_New(t *testing.T) {
 			setupMock: func(m *MockBankAccountServiceInterface, accountIDStr string) {
 				accountID, _ := uuid.Parse(accountIDStr)
 				testAccount := helpers.CreateTestBankAccount(uuid.New())
-				m.On("GetBankAccount", accountID).Return(testAccount, nil)
+				m.On("GetBankAccount", mock.Anything, accountID).Return(testAccount, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -117,7 +117,7 @@ func TestBankAccountHandler_GetBankAccount_New(t *testing.T) {
 			authenticated: true,
 			setupMock: func(m *MockBankAccountServiceInterface, accountIDStr string) {
 				accountID, _ := uuid.Parse(accountIDStr)
-				m.On("GetBankAccount", accountID).Return(nil, assert.AnError)
+				m.On("GetBankAccount", mock.Anything, accountID).Return(nil, assert.AnError)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -163,7 +163,7 @@ func TestBankAccountHandler_CreateBankAccount_New(t *testing.T) {
 				"balance": int64(100000), // 1000.00 in cents
 			},
 			setupMock: func(m *MockBankAccountServiceInterface) {
-				m.On("CreateBankAccount", mock.AnythingOfType("*models.BankAccount")).Return(nil)
+				m.On("CreateBankAccount", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -196,7 +196,7 @@ func TestBankAccountHandler_CreateBankAccount_New(t *testing.T) {
 				"balance": int64(100000),
 			},
 			setupMock: func(m *MockBankAccountServiceInterface) {
-				m.On("CreateBankAccount", mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
+				m.On("CreateBankAccount", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -243,7 +243,7 @@ func TestBankAccountHandler_UpdateBankAccount_New(t *testing.T) {
 				"balance": int64(200000), // 2000.00 in cents
 			},
 			setupMock: func(m *MockBankAccountServiceInterface) {
-				m.On("UpdateBankAccount", mock.AnythingOfType("*models.BankAccount")).Return(nil)
+				m.On("UpdateBankAccount", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -282,7 +282,7 @@ func TestBankAccountHandler_UpdateBankAccount_New(t *testing.T) {
 				"balance": int64(200000),
 			},
 			setupMock: func(m *MockBankAccountServiceInterface) {
-				m.On("UpdateBankAccount", mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
+				m.On("UpdateBankAccount", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -326,7 +326,7 @@ func TestBankAccountHandler_DeleteBankAccount_New(t *testing.T) {
 			authenticated: true,
 			setupMock: func(m *MockBankAccountServiceInterface) {
 				accountUUID, _ := uuid.Parse("354a4ccc-1ac2-44ea-9d52-a9b76b9a7518")
-				m.On("DeleteBankAccount", accountUUID).Return(nil)
+				m.On("DeleteBankAccount", mock.Anything, accountUUID).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
 		},
@@ -354,7 +354,7 @@ func TestBankAccountHandler_DeleteBankAccount_New(t *testing.T) {
 			authenticated: true,
 			setupMock: func(m *MockBankAccountServiceInterface) {
 				accountUUID, _ := uuid.Parse("e8149fec-e1be-4512-8acc-3437222b581a")
-				m.On("DeleteBankAccount", accountUUID).Return(assert.AnError)
+				m.On("DeleteBankAccount", mock.Anything, accountUUID).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -0,0 +1,689 @@
+package handlers
+
+import (
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type BudgetHandler struct {
+	budgetService BudgetServiceInterface
+}
+
+func NewBudgetHandler(budgetService BudgetServiceInterface) *BudgetHandler {
+	return &BudgetHandler{
+		budgetService: budgetService,
+	}
+}
+
+// Category handlers
+
+// @Summary Get all categories
+// @Description Get all categories of the user
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Category
+// @Router /categories [get]
+func (h *BudgetHandler) GetCategories(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	categorys, err := h.budgetService.GetCategories(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, categorys)
+}
+
+// @Summary Get category by ID
+// @Description Get a specific category by ID
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Success 200 {object} models.Category
+// @Failure 404 {object} map[string]string
+// @Router /categories/{id} [get]
+func (h *BudgetHandler) GetCategory(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id format"})
+		return
+	}
+
+	category, err := h.budgetService.GetCategory(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// @Summary Create category
+// @Description Create a spending category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param category body models.Category true "Category data"
+// @Success 201 {object} models.Category
+// @Failure 400 {object} map[string]string
+// @Router /categories [post]
+func (h *BudgetHandler) CreateCategory(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var category models.Category
+	if err := c.ShouldBindJSON(&category); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	category.UserID = userUUID
+
+	if err := h.budgetService.CreateCategory(c.Request.Context(), &category); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, category)
+}
+
+// @Summary Update category
+// @Description Update an existing category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Param category body models.Category true "Category data"
+// @Success 200 {object} models.Category
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /categories/{id} [put]
+func (h *BudgetHandler) UpdateCategory(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id format"})
+		return
+	}
+
+	var category models.Category
+	if err := c.ShouldBindJSON(&category); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	category.ID = id
+	if err := h.budgetService.UpdateCategory(c.Request.Context(), userUUID, &category); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
+// @Summary Delete category
+// @Description Delete a category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /categories/{id} [delete]
+func (h *BudgetHandler) DeleteCategory(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid category id format"})
+		return
+	}
+
+	if err := h.budgetService.DeleteCategory(c.Request.Context(), userUUID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get category breakdown
+// @Description Aggregate the cashflow projection per month and category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param months query int false "Number of months to aggregate" default(12)
+// @Param top_level query bool false "Roll subcategories up into their top-level category" default(false)
+// @Success 200 {array} models.CategoryBreakdown
+// @Failure 400 {object} map[string]string
+// @Router /categories/breakdown [get]
+func (h *BudgetHandler) GetCategoryBreakdown(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	months, err := strconv.Atoi(c.DefaultQuery("months", "12"))
+	if err != nil || months <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid months parameter"})
+		return
+	}
+	if months > 120 {
+		months = 120 // Limit to 120 months (10 years)
+	}
+
+	topLevel := c.DefaultQuery("top_level", "false") == "true"
+
+	breakdowns, err := h.budgetService.GetCategoryBreakdown(c.Request.Context(), userUUID, months, topLevel)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, breakdowns)
+}
+
+// Budget handlers
+
+// @Summary Get all budgets
+// @Description Get all budgets of the user
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Budget
+// @Router /budgets [get]
+func (h *BudgetHandler) GetBudgets(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	budgets, err := h.budgetService.GetBudgets(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, budgets)
+}
+
+// @Summary Get budget by ID
+// @Description Get a specific budget by ID
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Budget ID"
+// @Success 200 {object} models.Budget
+// @Failure 404 {object} map[string]string
+// @Router /budgets/{id} [get]
+func (h *BudgetHandler) GetBudget(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid budget id format"})
+		return
+	}
+
+	budget, err := h.budgetService.GetBudget(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, budget)
+}
+
+// @Summary Create budget
+// @Description Create the default monthly budget of a category, or an override for one month
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param budget body models.Budget true "Budget data"
+// @Success 201 {object} models.Budget
+// @Failure 400 {object} map[string]string
+// @Router /budgets [post]
+func (h *BudgetHandler) CreateBudget(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var budget models.Budget
+	if err := c.ShouldBindJSON(&budget); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	budget.UserID = userUUID
+
+	if err := h.budgetService.CreateBudget(c.Request.Context(), &budget); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, budget)
+}
+
+// @Summary Update budget
+// @Description Update an existing budget
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Budget ID"
+// @Param budget body models.Budget true "Budget data"
+// @Success 200 {object} models.Budget
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /budgets/{id} [put]
+func (h *BudgetHandler) UpdateBudget(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid budget id format"})
+		return
+	}
+
+	var budget models.Budget
+	if err := c.ShouldBindJSON(&budget); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	budget.ID = id
+	if err := h.budgetService.UpdateBudget(c.Request.Context(), userUUID, &budget); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, budget)
+}
+
+// @Summary Delete budget
+// @Description Delete a budget
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Budget ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /budgets/{id} [delete]
+func (h *BudgetHandler) DeleteBudget(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid budget id format"})
+		return
+	}
+
+	if err := h.budgetService.DeleteBudget(c.Request.Context(), userUUID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get budget report
+// @Description Compare each category's budget for a month with the actual transactions
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param year_month query string false "Month in YYYY-MM format (default: current month)"
+// @Success 200 {object} models.BudgetReport
+// @Failure 400 {object} map[string]string
+// @Router /budgets/report [get]
+func (h *BudgetHandler) GetBudgetReport(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	yearMonth := c.DefaultQuery("year_month", time.Now().Format("2006-01"))
+
+	report, err := h.budgetService.GetBudgetReport(c.Request.Context(), userUUID, yearMonth)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Transaction handlers
+
+// @Summary Get all transactions
+// @Description Get the transactions of the user, optionally only those of one month
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param year_month query string false "Month in YYYY-MM format"
+// @Success 200 {array} models.Transaction
+// @Failure 400 {object} map[string]string
+// @Router /transactions [get]
+func (h *BudgetHandler) GetTransactions(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	transactions, err := h.budgetService.GetTransactions(c.Request.Context(), userUUID, c.Query("year_month"))
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transactions)
+}
+
+// @Summary Get transaction by ID
+// @Description Get a specific transaction by ID
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transaction ID"
+// @Success 200 {object} models.Transaction
+// @Failure 404 {object} map[string]string
+// @Router /transactions/{id} [get]
+func (h *BudgetHandler) GetTransaction(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transaction id format"})
+		return
+	}
+
+	transaction, err := h.budgetService.GetTransaction(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transaction)
+}
+
+// @Summary Create transaction
+// @Description Record an actual spending transaction
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param transaction body models.Transaction true "Transaction data"
+// @Success 201 {object} models.Transaction
+// @Failure 400 {object} map[string]string
+// @Router /transactions [post]
+func (h *BudgetHandler) CreateTransaction(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var transaction models.Transaction
+	if err := c.ShouldBindJSON(&transaction); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	transaction.UserID = userUUID
+
+	if err := h.budgetService.CreateTransaction(c.Request.Context(), &transaction); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, transaction)
+}
+
+// @Summary Update transaction
+// @Description Update an existing transaction
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transaction ID"
+// @Param transaction body models.Transaction true "Transaction data"
+// @Success 200 {object} models.Transaction
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /transactions/{id} [put]
+func (h *BudgetHandler) UpdateTransaction(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transaction id format"})
+		return
+	}
+
+	var transaction models.Transaction
+	if err := c.ShouldBindJSON(&transaction); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	transaction.ID = id
+	if err := h.budgetService.UpdateTransaction(c.Request.Context(), userUUID, &transaction); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transaction)
+}
+
+// @Summary Delete transaction
+// @Description Delete a transaction
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transaction ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /transactions/{id} [delete]
+func (h *BudgetHandler) DeleteTransaction(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid transaction id format"})
+		return
+	}
+
+	if err := h.budgetService.DeleteTransaction(c.Request.Context(), userUUID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBudgetHandler_GetBudgetReport(t *testing.T) {
+	tests := []struct {
+		name              string
+		query             string
+		expectedYearMonth string
+		serviceError      error
+		expectedStatus    int
+	}{
+		{
+			name:              "report for a month",
+			query:             "?year_month=2024-12",
+			expectedYearMonth: "2024-12",
+			expectedStatus:    http.StatusOK,
+		},
+		{
+			name:              "defaults to the current month",
+			expectedYearMonth: time.Now().Format("2006-01"),
+			expectedStatus:    http.StatusOK,
+		},
+		{
+			name:              "invalid month",
+			query:             "?year_month=December",
+			expectedYearMonth: "December",
+			serviceError:      services.NewValidationError("year_month", "must be in YYYY-MM format"),
+			expectedStatus:    http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockBudgetServiceInterface(t)
+			handler := NewBudgetHandler(mockService)
+
+			userID := uuid.New()
+			if tt.serviceError != nil {
+				mockService.On("GetBudgetReport", mock.Anything, userID, tt.expectedYearMonth).Return(nil, tt.serviceError)
+			} else {
+				mockService.On("GetBudgetReport", mock.Anything, userID, tt.expectedYearMonth).Return(&models.BudgetReport{
+					YearMonth:  tt.expectedYearMonth,
+					Categories: []models.BudgetReportEntry{{CategoryID: uuid.New(), CategoryName: "Food", Budget: 50000, Actual: 30000, Remaining: 20000, UsageRate: 60}},
+				}, nil)
+			}
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/budgets/report"+tt.query, nil, userID)
+
+			handler.GetBudgetReport(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var report models.BudgetReport
+				helpers.ParseJSONResponse(t, w, &report)
+				assert.Equal(t, tt.expectedYearMonth, report.YearMonth)
+				assert.Len(t, report.Categories, 1)
+			}
+		})
+	}
+}
+
+func TestBudgetHandler_GetBudgetReport_Unauthenticated(t *testing.T) {
+	handler := NewBudgetHandler(NewMockBudgetServiceInterface(t))
+
+	c, w := helpers.CreateTestContext(t, "GET", "/budgets/report", nil, false)
+
+	handler.GetBudgetReport(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestBudgetHandler_GetCategoryBreakdown(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		expectedMonths   int
+		expectedTopLevel bool
+		expectedStatus   int
+	}{
+		{
+			name:           "defaults to twelve months",
+			expectedMonths: 12,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:             "top-level categories",
+			query:            "?months=6&top_level=true",
+			expectedMonths:   6,
+			expectedTopLevel: true,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:           "months are capped",
+			query:          "?months=500",
+			expectedMonths: 120,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid months",
+			query:          "?months=abc",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockBudgetServiceInterface(t)
+			handler := NewBudgetHandler(mockService)
+
+			userID := uuid.New()
+			if tt.expectedStatus == http.StatusOK {
+				mockService.On("GetCategoryBreakdown", mock.Anything, userID, tt.expectedMonths, tt.expectedTopLevel).Return([]models.CategoryBreakdown{
+					{YearMonth: "2025-01", TotalExpense: 8000, Categories: []models.CategoryBreakdownEntry{{CategoryName: "Insurance", Expense: 8000}}},
+				}, nil)
+			}
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/categories/breakdown"+tt.query, nil, userID)
+
+			handler.GetCategoryBreakdown(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var breakdowns []models.CategoryBreakdown
+				helpers.ParseJSONResponse(t, w, &breakdowns)
+				assert.Len(t, breakdowns, 1)
+			}
+		})
+	}
+}
+
+func TestBudgetHandler_GetCategory(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		err            error
+		expectedStatus int
+	}{
+		{name: "found", id: uuid.New().String(), expectedStatus: http.StatusOK},
+		{name: "another user's category", id: uuid.New().String(), err: sql.ErrNoRows, expectedStatus: http.StatusNotFound},
+		{name: "invalid id", id: "not-a-uuid", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockBudgetServiceInterface(t)
+			handler := NewBudgetHandler(mockService)
+
+			userID := uuid.New()
+			if id, err := uuid.Parse(tt.id); err == nil {
+				var category *models.Category
+				if tt.err == nil {
+					category = &models.Category{ID: id, UserID: userID, Name: "Insurance"}
+				}
+				mockService.On("GetCategory", mock.Anything, userID, id).Return(category, tt.err)
+			}
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/categories/"+tt.id, nil, userID)
+			c.Params = gin.Params{{Key: "id", Value: tt.id}}
+
+			handler.GetCategory(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestBudgetHandler_CreateCategory(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupMock      func(*MockBudgetServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name:        "successful creation",
+			requestBody: map[string]interface{}{"name": "Food"},
+			setupMock: func(m *MockBudgetServiceInterface) {
+				m.On("CreateCategory", mock.Anything, mock.MatchedBy(func(category *models.Category) bool {
+					return category.UserID == uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d") && category.Name == "Food"
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "validation error",
+			requestBody: map[string]interface{}{"name": ""},
+			setupMock: func(m *MockBudgetServiceInterface) {
+				m.On("CreateCategory", mock.Anything, mock.AnythingOfType("*models.Category")).Return(services.NewValidationError("name", "is required"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockBudgetServiceInterface(t)
+			handler := NewBudgetHandler(mockService)
+			tt.setupMock(mockService)
+
+			userID := uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/categories", tt.requestBody, userID)
+
+			handler.CreateCategory(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestBudgetHandler_GetTransactions(t *testing.T) {
+	mockService := NewMockBudgetServiceInterface(t)
+	handler := NewBudgetHandler(mockService)
+
+	userID := uuid.New()
+	mockService.On("GetTransactions", mock.Anything, userID, "2024-12").Return([]models.Transaction{
+		{ID: uuid.New(), UserID: userID, TransactionDate: "2024-12-20", Amount: 3200, Description: "Supermarket"},
+	}, nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "GET", "/transactions?year_month=2024-12", nil, userID)
+
+	handler.GetTransactions(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var transactions []models.Transaction
+	helpers.ParseJSONResponse(t, w, &transactions)
+	assert.Len(t, transactions, 1)
+}
+
+func TestBudgetHandler_UpdateTransaction(t *testing.T) {
+	mockService := NewMockBudgetServiceInterface(t)
+	handler := NewBudgetHandler(mockService)
+
+	userID, transactionID := uuid.New(), uuid.New()
+	mockService.On("UpdateTransaction", mock.Anything, userID, mock.MatchedBy(func(transaction *models.Transaction) bool {
+		return transaction.ID == transactionID && transaction.Amount == 4800
+	})).Return(nil)
+
+	requestBody := map[string]interface{}{
+		"transaction_date": "2024-12-20",
+		"amount":           int64(4800),
+		"description":      "Supermarket",
+	}
+	c, w := helpers.CreateTestContextWithUserID(t, "PUT", fmt.Sprintf("/transactions/%s", transactionID), requestBody, userID)
+	c.Params = gin.Params{{Key: "id", Value: transactionID.String()}}
+
+	handler.UpdateTransaction(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestBudgetHandler_DeleteBudget(t *testing.T) {
+	mockService := NewMockBudgetServiceInterface(t)
+	handler := NewBudgetHandler(mockService)
+
+	userID, budgetID := uuid.New(), uuid.New()
+	mockService.On("DeleteBudget", mock.Anything, userID, budgetID).Return(nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "DELETE", fmt.Sprintf("/budgets/%s", budgetID), nil, userID)
+	c.Params = gin.Params{{Key: "id", Value: budgetID.String()}}
+
+	handler.DeleteBudget(c)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/ical"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// calendarFeedPath is the public path of a feed, followed by its token and ".ics"
+const calendarFeedPath = "/api/v1/calendar/"
+
+type CalendarHandler struct {
+	calendarService CalendarServiceInterface
+	baseURL         string // Origin the feed addresses start with
+}
+
+func NewCalendarHandler(calendarService CalendarServiceInterface, baseURL string) *CalendarHandler {
+	return &CalendarHandler{
+		calendarService: calendarService,
+		baseURL:         strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// @Summary Get calendar feed
+// @Description Get the address of the user's iCalendar feed of projected payments and income
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.CalendarFeed
+// @Failure 404 {object} map[string]string
+// @Router /calendar-feed [get]
+func (h *CalendarHandler) GetCalendarFeed(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	feed, err := h.calendarService.GetCalendarFeed(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.withURL(feed))
+}
+
+// @Summary Create calendar feed
+// @Description Create the user's iCalendar feed with a new secret address. The address of an existing feed stops working.
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 201 {object} models.CalendarFeed
+// @Router /calendar-feed [post]
+func (h *CalendarHandler) CreateCalendarFeed(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	feed, err := h.calendarService.CreateCalendarFeed(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, h.withURL(feed))
+}
+
+// @Summary Delete calendar feed
+// @Description Turn the user's iCalendar feed off
+// @Tags calendar
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 204
+// @Router /calendar-feed [delete]
+func (h *CalendarHandler) DeleteCalendarFeed(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	if err := h.calendarService.DeleteCalendarFeed(c.Request.Context(), userUUID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Get calendar feed file
+// @Description Get the iCalendar file of a feed. The secret token in the address authenticates the request, so calendar apps can subscribe without logging in.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token path string true "Feed token followed by .ics"
+// @Success 200 {file} file
+// @Failure 404 {object} map[string]string
+// @Router /calendar/{token} [get]
+func (h *CalendarHandler) GetCalendarFeedFile(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	feed, err := h.calendarService.RenderCalendarFeed(c.Request.Context(), token, time.Now())
+	if err != nil {
+		status := serviceErrorStatus(err)
+		if status == http.StatusNotFound {
+			c.JSON(status, gin.H{"error": "calendar feed not found"})
+			return
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Data(http.StatusOK, ical.ContentType, feed)
+}
+
+// withURL fills in the address to subscribe to
+func (h *CalendarHandler) withURL(feed *models.CalendarFeed) *models.CalendarFeed {
+	feed.URL = h.baseURL + calendarFeedPath + feed.Token + ".ics"
+	return feed
+}
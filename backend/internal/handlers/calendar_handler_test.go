@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCalendarHandler_CreateCalendarFeed(t *testing.T) {
+	mockService := NewMockCalendarServiceInterface(t)
+	handler := NewCalendarHandler(mockService, "https://flow.example.com/")
+
+	userID := uuid.New()
+	mockService.On("CreateCalendarFeed", mock.Anything, userID).Return(&models.CalendarFeed{UserID: userID, Token: "secret"}, nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "POST", "/calendar-feed", nil, userID)
+
+	handler.CreateCalendarFeed(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var feed models.CalendarFeed
+	helpers.ParseJSONResponse(t, w, &feed)
+	assert.Equal(t, "https://flow.example.com/api/v1/calendar/secret.ics", feed.URL)
+}
+
+func TestCalendarHandler_GetCalendarFeed(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*MockCalendarServiceInterface, uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "feed exists",
+			setupMock: func(m *MockCalendarServiceInterface, userID uuid.UUID) {
+				m.On("GetCalendarFeed", mock.Anything, userID).Return(&models.CalendarFeed{UserID: userID, Token: "secret"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "no feed",
+			setupMock: func(m *MockCalendarServiceInterface, userID uuid.UUID) {
+				m.On("GetCalendarFeed", mock.Anything, userID).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockCalendarServiceInterface(t)
+			handler := NewCalendarHandler(mockService, "https://flow.example.com")
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/calendar-feed", nil, userID)
+
+			handler.GetCalendarFeed(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestCalendarHandler_GetCalendarFeedFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          string
+		setupMock      func(*MockCalendarServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name:  "known token",
+			token: "secret.ics",
+			setupMock: func(m *MockCalendarServiceInterface) {
+				m.On("RenderCalendarFeed", mock.Anything, "secret", mock.AnythingOfType("time.Time")).Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "unknown token",
+			token: "guess.ics",
+			setupMock: func(m *MockCalendarServiceInterface) {
+				m.On("RenderCalendarFeed", mock.Anything, "guess", mock.AnythingOfType("time.Time")).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockCalendarServiceInterface(t)
+			handler := NewCalendarHandler(mockService, "")
+			tt.setupMock(mockService)
+
+			c, w := helpers.CreateTestContext(t, "GET", "/calendar/"+tt.token, nil, false)
+			c.Params = gin.Params{{Key: "token", Value: tt.token}}
+
+			handler.GetCalendarFeedFile(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
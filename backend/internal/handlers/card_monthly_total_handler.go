@@ -40,7 +40,7 @@ func (h *CardMonthlyTotalHandler) GetCardMonthlyTotals(c *gin.Context) {
 		return
 	}
 
-	totals, err := h.cardMonthlyTotalService.GetCardMonthlyTotals(creditCardID)
+	totals, err := h.cardMonthlyTotalService.GetCardMonthlyTotals(c.Request.Context(), creditCardID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -65,7 +65,7 @@ func (h *CardMonthlyTotalHandler) GetCardMonthlyTotal(c *gin.Context) {
 		return
 	}
 
-	total, err := h.cardMonthlyTotalService.GetCardMonthlyTotal(id)
+	total, err := h.cardMonthlyTotalService.GetCardMonthlyTotal(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "card monthly total not found"})
 		return
@@ -89,7 +89,7 @@ func (h *CardMonthlyTotalHandler) CreateCardMonthlyTotal(c *gin.Context) {
 		return
 	}
 
-	if err := h.cardMonthlyTotalService.CreateCardMonthlyTotal(&total); err != nil {
+	if err := h.cardMonthlyTotalService.CreateCardMonthlyTotal(c.Request.Context(), &total); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -121,7 +121,7 @@ func (h *CardMonthlyTotalHandler) UpdateCardMonthlyTotal(c *gin.Context) {
 	}
 
 	total.ID = id
-	if err := h.cardMonthlyTotalService.UpdateCardMonthlyTotal(&total); err != nil {
+	if err := h.cardMonthlyTotalService.UpdateCardMonthlyTotal(c.Request.Context(), &total); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -145,7 +145,7 @@ func (h *CardMonthlyTotalHandler) DeleteCardMonthlyTotal(c *gin.Context) {
 		return
 	}
 
-	if err := h.cardMonthlyTotalService.DeleteCardMonthlyTotal(id); err != nil {
+	if err := h.cardMonthlyTotalService.DeleteCardMonthlyTotal(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
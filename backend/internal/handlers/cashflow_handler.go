@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"github.com/Soli0222/flow-sight/backend/internal/export"
 	"github.com/Soli0222/flow-sight/backend/internal/services"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -20,13 +22,20 @@ func NewCashflowHandler(cashflowService *services.CashflowService) *CashflowHand
 }
 
 // @Summary Get cashflow projection
-// @Description Get cashflow projection for a user
+// @Description Get cashflow projection for a user. With a week or month granularity the days are summed up per period and a list of models.CashflowPeriod is returned.
 // @Tags cashflow
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param months query int false "Number of months to project" default(36)
-// @Param onlyChanges query bool false "Only return days with changes" default(false)
+// @Param granularity query string false "day, week or month" default(day)
+// @Param onlyChanges query bool false "Only return days with changes; day granularity only" default(false)
+// @Param prepay_payment_id query string false "Loan (recurring payment ID) to simulate a prepayment for"
+// @Param prepay_date query string false "Prepayment date (YYYY-MM-DD)"
+// @Param prepay_amount query int false "Prepayment amount"
+// @Param prepay_mode query string false "shorten_term or reduce_payment" default(shorten_term)
+// @Param format query string false "csv or xlsx to download the projection as a file instead of JSON"
+// @Param amount_unit query string false "yen (whole currency units) or minor (hundredths) for a file download" default(yen)
 // @Success 200 {array} models.CashflowProjection
 // @Router /cashflow-projection [get]
 func (h *CashflowHandler) GetCashflowProjection(c *gin.Context) {
@@ -53,14 +62,125 @@ func (h *CashflowHandler) GetCashflowProjection(c *gin.Context) {
 		months = 120 // Limit to 120 months (10 years)
 	}
 
+	granularity := c.DefaultQuery("granularity", services.GranularityDay)
+	if granularity != services.GranularityDay && granularity != services.GranularityWeek && granularity != services.GranularityMonth {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid granularity parameter"})
+		return
+	}
+
 	onlyChangesStr := c.DefaultQuery("onlyChanges", "false")
 	onlyChanges := onlyChangesStr == "true"
 
-	projections, err := h.cashflowService.GetCashflowProjection(userUUID, months, onlyChanges)
+	var options services.ProjectionOptions
+	if paymentIDStr := c.Query("prepay_payment_id"); paymentIDStr != "" {
+		paymentID, err := uuid.Parse(paymentIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid prepay_payment_id parameter"})
+			return
+		}
+
+		prepayment, err := parsePrepaymentQuery(c, paymentID)
+		if err != nil || prepayment == nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "prepay_date and prepay_amount are required for a prepayment"})
+			return
+		}
+		options.Prepayments = append(options.Prepayments, *prepayment)
+	}
+
+	// A format downloads the projection as a spreadsheet file
+	var format export.Format
+	var unit export.Unit
+	if c.Query("format") != "" {
+		if format, unit, ok = parseExportQuery(c, ""); !ok {
+			return
+		}
+	}
+
+	if granularity != services.GranularityDay {
+		periods, err := h.cashflowService.GetCashflowPeriods(c.Request.Context(), userUUID, months, granularity, options)
+		if err != nil {
+			c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		if format != "" {
+			table := services.CashflowPeriodTable(periods, h.cashflowService.GetBaseCurrency(c.Request.Context(), userUUID), unit)
+			writeExport(c, format, "cashflow-projection-"+granularity, table)
+			return
+		}
+		c.JSON(http.StatusOK, periods)
+		return
+	}
+
+	projections, err := h.cashflowService.GetCashflowProjectionWithOptions(c.Request.Context(), userUUID, months, onlyChanges, options)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
+	if format != "" {
+		table := services.CashflowProjectionTable(projections, h.cashflowService.GetBaseCurrency(c.Request.Context(), userUUID), unit)
+		writeExport(c, format, "cashflow-projection", table)
+		return
+	}
 	c.JSON(http.StatusOK, projections)
 }
+
+// @Summary Get probabilistic cashflow projection
+// @Description Run a seeded Monte Carlo simulation of the cashflow projection and return P10/P50/P90 balance bands per day
+// @Tags cashflow
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param months query int false "Number of months to project" default(36)
+// @Param simulations query int false "Number of simulation runs (max 5000)" default(1000)
+// @Param seed query int false "Random seed; the same seed reproduces the same result"
+// @Success 200 {object} models.ProbabilisticProjection
+// @Router /cashflow-projection/probabilistic [get]
+func (h *CashflowHandler) GetProbabilisticProjection(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	months, err := strconv.Atoi(c.DefaultQuery("months", "36"))
+	if err != nil || months <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid months parameter"})
+		return
+	}
+
+	if months > 120 {
+		months = 120 // Limit to 120 months (10 years)
+	}
+
+	simulations, err := strconv.Atoi(c.DefaultQuery("simulations", strconv.Itoa(services.DefaultSimulationCount)))
+	if err != nil || simulations <= 0 || simulations > services.MaxSimulationCount {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid simulations parameter"})
+		return
+	}
+
+	// Without an explicit seed every request gets a fresh one; it is returned so the run can be reproduced
+	seed := time.Now().UnixNano()
+	if seedStr := c.Query("seed"); seedStr != "" {
+		seed, err = strconv.ParseInt(seedStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid seed parameter"})
+			return
+		}
+	}
+
+	projection, err := h.cashflowService.GetProbabilisticProjection(c.Request.Context(), userUUID, months, simulations, seed)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, projection)
+}
@@ -39,7 +39,7 @@ func (h *CreditCardHandler) GetCreditCards(c *gin.Context) {
 		return
 	}
 
-	creditCards, err := h.creditCardService.GetCreditCards(userUUID)
+	creditCards, err := h.creditCardService.GetCreditCards(c.Request.Context(), userUUID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -64,7 +64,7 @@ func (h *CreditCardHandler) GetCreditCard(c *gin.Context) {
 		return
 	}
 
-	creditCard, err := h.creditCardService.GetCreditCard(id)
+	creditCard, err := h.creditCardService.GetCreditCard(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "credit card not found"})
 		return
@@ -104,8 +104,8 @@ func (h *CreditCardHandler) CreateCreditCard(c *gin.Context) {
 	// Set the user_id from the authenticated user
 	creditCard.UserID = userUUID
 
-	if err := h.creditCardService.CreateCreditCard(&creditCard); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.creditCardService.CreateCreditCard(c.Request.Context(), &creditCard); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -136,8 +136,8 @@ func (h *CreditCardHandler) UpdateCreditCard(c *gin.Context) {
 	}
 
 	creditCard.ID = id
-	if err := h.creditCardService.UpdateCreditCard(&creditCard); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.creditCardService.UpdateCreditCard(c.Request.Context(), &creditCard); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -160,7 +160,7 @@ func (h *CreditCardHandler) DeleteCreditCard(c *gin.Context) {
 		return
 	}
 
-	if err := h.creditCardService.DeleteCreditCard(id); err != nil {
+	if err := h.creditCardService.DeleteCreditCard(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
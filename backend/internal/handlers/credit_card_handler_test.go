@@ -41,7 +41,7 @@ func TestCreditCardHandler_GetCreditCards(t *testing.T) {
 						UpdatedAt:   time.Now(),
 					},
 				}
-				m.On("GetCreditCards", uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return(testCreditCards, nil)
+				m.On("GetCreditCards", mock.Anything, uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return(testCreditCards, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -59,7 +59,7 @@ func TestCreditCardHandler_GetCreditCards(t *testing.T) {
 			name:          "service error",
 			authenticated: true,
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("GetCreditCards", uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return([]models.CreditCard{}, assert.AnError)
+				m.On("GetCreditCards", mock.Anything, uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return([]models.CreditCard{}, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedCount:  0,
@@ -121,7 +121,7 @@ func TestCreditCardHandler_GetCreditCard(t *testing.T) {
 					CreatedAt:   time.Now(),
 					UpdatedAt:   time.Now(),
 				}
-				m.On("GetCreditCard", uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testCreditCard, nil)
+				m.On("GetCreditCard", mock.Anything, uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testCreditCard, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -137,7 +137,7 @@ func TestCreditCardHandler_GetCreditCard(t *testing.T) {
 			name:         "credit card not found",
 			creditCardID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("GetCreditCard", uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.CreditCard)(nil), assert.AnError)
+				m.On("GetCreditCard", mock.Anything, uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.CreditCard)(nil), assert.AnError)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -182,7 +182,7 @@ func TestCreditCardHandler_CreateCreditCard(t *testing.T) {
 				"bank_account": "aabbccdd-eeff-1122-3344-556677889900",
 			},
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("CreateCreditCard", mock.AnythingOfType("*models.CreditCard")).Return(nil)
+				m.On("CreateCreditCard", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -207,7 +207,7 @@ func TestCreditCardHandler_CreateCreditCard(t *testing.T) {
 				"name": "", // 空の名前でサービスエラーが発生することを期待
 			},
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("CreateCreditCard", mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
+				m.On("CreateCreditCard", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -221,7 +221,7 @@ func TestCreditCardHandler_CreateCreditCard(t *testing.T) {
 				"bank_account": "aabbccdd-eeff-1122-3344-556677889900",
 			},
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("CreateCreditCard", mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
+				m.On("CreateCreditCard", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -272,7 +272,7 @@ func TestCreditCardHandler_UpdateCreditCard(t *testing.T) {
 				"bank_account": "aabbccdd-eeff-1122-3344-556677889900",
 			},
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("UpdateCreditCard", mock.AnythingOfType("*models.CreditCard")).Return(nil)
+				m.On("UpdateCreditCard", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -300,7 +300,7 @@ func TestCreditCardHandler_UpdateCreditCard(t *testing.T) {
 				"bank_account": "aabbccdd-eeff-1122-3344-556677889900",
 			},
 			setupMock: func(m *MockCreditCardServiceInterface) {
-				m.On("UpdateCreditCard", mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
+				m.On("UpdateCreditCard", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -339,7 +339,7 @@ func TestCreditCardHandler_DeleteCreditCard(t *testing.T) {
 			creditCardID: "11223344-5566-7788-99aa-bbccddeeff00",
 			setupMock: func(m *MockCreditCardServiceInterface) {
 				creditCardUUID, _ := uuid.Parse("11223344-5566-7788-99aa-bbccddeeff00")
-				m.On("DeleteCreditCard", creditCardUUID).Return(nil)
+				m.On("DeleteCreditCard", mock.Anything, creditCardUUID).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
 		},
@@ -356,7 +356,7 @@ func TestCreditCardHandler_DeleteCreditCard(t *testing.T) {
 			creditCardID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockCreditCardServiceInterface) {
 				creditCardUUID, _ := uuid.Parse("99999999-9999-9999-9999-999999999999")
-				m.On("DeleteCreditCard", creditCardUUID).Return(assert.AnError)
+				m.On("DeleteCreditCard", mock.Anything, creditCardUUID).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
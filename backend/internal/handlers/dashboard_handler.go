@@ -3,6 +3,7 @@ package handlers
 import (
 	"github.com/Soli0222/flow-sight/backend/internal/services"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -19,7 +20,7 @@ func NewDashboardHandler(dashboardService *services.DashboardService) *Dashboard
 }
 
 // @Summary Get dashboard summary
-// @Description Get dashboard summary data including balance, monthly income/expense, asset count
+// @Description Get dashboard summary data including balance, monthly income/expense, total assets, liabilities and net worth
 // @Tags dashboard
 // @Accept json
 // @Produce json
@@ -39,11 +40,49 @@ func (h *DashboardHandler) GetDashboardSummary(c *gin.Context) {
 		return
 	}
 
-	summary, err := h.dashboardService.GetDashboardSummary(userUUID)
+	summary, err := h.dashboardService.GetDashboardSummary(c.Request.Context(), userUUID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, summary)
 }
+
+// @Summary Get dashboard overview
+// @Description Get income and expense trends of the last 12 months, upcoming items, the lowest projected balance and months of runway
+// @Tags dashboard
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param upcoming_days query int false "Number of days of upcoming items" default(30)
+// @Success 200 {object} models.DashboardOverview
+// @Failure 400 {object} map[string]string
+// @Router /dashboard/overview [get]
+func (h *DashboardHandler) GetDashboardOverview(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	upcomingDays, err := strconv.Atoi(c.DefaultQuery("upcoming_days", strconv.Itoa(services.DefaultUpcomingDays)))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid upcoming_days parameter"})
+		return
+	}
+
+	overview, err := h.dashboardService.GetDashboardOverview(c.Request.Context(), userUUID, upcomingDays)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, overview)
+}
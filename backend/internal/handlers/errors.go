@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+)
+
+// serviceErrorStatus maps an error returned by a service to an HTTP status code
+func serviceErrorStatus(err error) int {
+	var validationErr *services.ValidationError
+	if errors.As(err, &validationErr) {
+		return http.StatusBadRequest
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
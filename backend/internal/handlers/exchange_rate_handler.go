@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxExchangeRateImportSize limits the size of an imported CSV file
+const maxExchangeRateImportSize = 1 << 20
+
+type ExchangeRateHandler struct {
+	exchangeRateService ExchangeRateServiceInterface
+}
+
+func NewExchangeRateHandler(exchangeRateService ExchangeRateServiceInterface) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		exchangeRateService: exchangeRateService,
+	}
+}
+
+// @Summary Get all exchange rates
+// @Description Get all exchange rates of the user, oldest date first
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.ExchangeRate
+// @Router /exchange-rates [get]
+func (h *ExchangeRateHandler) GetExchangeRates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	rates, err := h.exchangeRateService.GetExchangeRates(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
+
+// @Summary Get exchange rate by ID
+// @Description Get a specific exchange rate by ID
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param id path string true "Exchange Rate ID"
+// @Success 200 {object} models.ExchangeRate
+// @Router /exchange-rates/{id} [get]
+func (h *ExchangeRateHandler) GetExchangeRate(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid exchange rate id format"})
+		return
+	}
+
+	rate, err := h.exchangeRateService.GetExchangeRate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "exchange rate not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, rate)
+}
+
+// @Summary Create exchange rate
+// @Description Create the rate of a currency pair on a date
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param rate body models.ExchangeRate true "Exchange Rate data"
+// @Success 201 {object} models.ExchangeRate
+// @Failure 400 {object} map[string]string
+// @Router /exchange-rates [post]
+func (h *ExchangeRateHandler) CreateExchangeRate(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var rate models.ExchangeRate
+	if err := c.ShouldBindJSON(&rate); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	rate.UserID = userUUID
+
+	if err := h.exchangeRateService.CreateExchangeRate(c.Request.Context(), &rate); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, rate)
+}
+
+// @Summary Update exchange rate
+// @Description Update an existing exchange rate
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param id path string true "Exchange Rate ID"
+// @Param rate body models.ExchangeRate true "Exchange Rate data"
+// @Success 200 {object} models.ExchangeRate
+// @Failure 400 {object} map[string]string
+// @Router /exchange-rates/{id} [put]
+func (h *ExchangeRateHandler) UpdateExchangeRate(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid exchange rate id format"})
+		return
+	}
+
+	var rate models.ExchangeRate
+	if err := c.ShouldBindJSON(&rate); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rate.ID = id
+	if err := h.exchangeRateService.UpdateExchangeRate(c.Request.Context(), &rate); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, rate)
+}
+
+// @Summary Delete exchange rate
+// @Description Delete an exchange rate
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param id path string true "Exchange Rate ID"
+// @Success 204
+// @Router /exchange-rates/{id} [delete]
+func (h *ExchangeRateHandler) DeleteExchangeRate(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid exchange rate id format"})
+		return
+	}
+
+	if err := h.exchangeRateService.DeleteExchangeRate(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// @Summary Import exchange rates
+// @Description Import rates from a CSV file with the columns date, from, to and rate. The file is sent as the "file" field of a multipart form or as the request body.
+// @Tags exchange-rates
+// @Accept multipart/form-data,text/csv
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file false "CSV file"
+// @Success 200 {object} models.ExchangeRateImportResult
+// @Failure 400 {object} map[string]string
+// @Router /exchange-rates/import [post]
+func (h *ExchangeRateHandler) ImportExchangeRates(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxExchangeRateImportSize)
+
+	var file io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+			return
+		}
+		upload, err := header.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer upload.Close()
+		file = upload
+	}
+
+	result, err := h.exchangeRateService.ImportExchangeRates(c.Request.Context(), userUUID, file)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+			return
+		}
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
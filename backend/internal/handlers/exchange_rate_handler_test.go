@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeRateHandler_GetExchangeRates(t *testing.T) {
+	tests := []struct {
+		name           string
+		authenticated  bool
+		setupMock      func(*MockExchangeRateServiceInterface, uuid.UUID)
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:          "successful retrieval",
+			authenticated: true,
+			setupMock: func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {
+				m.On("GetExchangeRates", mock.Anything, userID).Return([]models.ExchangeRate{
+					{ID: uuid.New(), UserID: userID, FromCurrency: "USD", ToCurrency: "JPY", Rate: 148.25, RateDate: "2024-01-15"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "unauthenticated user",
+			authenticated:  false,
+			setupMock:      func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "service error",
+			authenticated: true,
+			setupMock: func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {
+				m.On("GetExchangeRates", mock.Anything, userID).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockExchangeRateServiceInterface(t)
+			handler := NewExchangeRateHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			var c *gin.Context
+			var w *httptest.ResponseRecorder
+			if tt.authenticated {
+				c, w = helpers.CreateTestContextWithUserID(t, "GET", "/exchange-rates", nil, userID)
+			} else {
+				c, w = helpers.CreateTestContext(t, "GET", "/exchange-rates", nil, false)
+			}
+
+			handler.GetExchangeRates(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var rates []models.ExchangeRate
+				helpers.ParseJSONResponse(t, w, &rates)
+				assert.Len(t, rates, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestExchangeRateHandler_CreateExchangeRate(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupMock      func(*MockExchangeRateServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			requestBody: map[string]interface{}{
+				"from_currency": "USD",
+				"to_currency":   "JPY",
+				"rate":          148.25,
+				"rate_date":     "2024-01-15",
+			},
+			setupMock: func(m *MockExchangeRateServiceInterface) {
+				m.On("CreateExchangeRate", mock.Anything, mock.MatchedBy(func(rate *models.ExchangeRate) bool {
+					return rate.UserID == uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d") && rate.Rate == 148.25
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "invalid request body",
+			requestBody: map[string]interface{}{
+				"rate": "high",
+			},
+			setupMock:      func(m *MockExchangeRateServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "validation error",
+			requestBody: map[string]interface{}{
+				"from_currency": "USD",
+				"to_currency":   "USD",
+				"rate":          1,
+				"rate_date":     "2024-01-15",
+			},
+			setupMock: func(m *MockExchangeRateServiceInterface) {
+				m.On("CreateExchangeRate", mock.Anything, mock.AnythingOfType("*models.ExchangeRate")).
+					Return(services.NewValidationError("to_currency", "must be different from from_currency"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockExchangeRateServiceInterface(t)
+			handler := NewExchangeRateHandler(mockService)
+			tt.setupMock(mockService)
+
+			userID := uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/exchange-rates", tt.requestBody, userID)
+
+			handler.CreateExchangeRate(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestExchangeRateHandler_DeleteExchangeRate(t *testing.T) {
+	mockService := NewMockExchangeRateServiceInterface(t)
+	handler := NewExchangeRateHandler(mockService)
+	rateID := uuid.New()
+	mockService.On("DeleteExchangeRate", mock.Anything, rateID).Return(nil)
+
+	c, _ := helpers.CreateTestContext(t, "DELETE", "/exchange-rates/"+rateID.String(), nil, true)
+	c.Params = gin.Params{{Key: "id", Value: rateID.String()}}
+
+	handler.DeleteExchangeRate(c)
+
+	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+}
+
+func TestExchangeRateHandler_ImportExchangeRates(t *testing.T) {
+	const csvData = "date,from,to,rate\n2024-01-15,USD,JPY,148.25\n"
+
+	multipartBody := func(t *testing.T, field string) (*bytes.Buffer, string) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile(field, "rates.csv")
+		require.NoError(t, err)
+		_, err = part.Write([]byte(csvData))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return body, writer.FormDataContentType()
+	}
+	readsCSV := mock.MatchedBy(func(r io.Reader) bool {
+		data, err := io.ReadAll(r)
+		return err == nil && string(data) == csvData
+	})
+
+	tests := []struct {
+		name           string
+		request        func(*testing.T) (io.Reader, string)
+		setupMock      func(*MockExchangeRateServiceInterface, uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "multipart upload",
+			request: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "file")
+			},
+			setupMock: func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {
+				m.On("ImportExchangeRates", mock.Anything, userID, readsCSV).Return(&models.ExchangeRateImportResult{Imported: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "raw CSV body",
+			request: func(t *testing.T) (io.Reader, string) {
+				return strings.NewReader(csvData), "text/csv"
+			},
+			setupMock: func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {
+				m.On("ImportExchangeRates", mock.Anything, userID, readsCSV).Return(&models.ExchangeRateImportResult{Imported: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "multipart without a file field",
+			request: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "upload")
+			},
+			setupMock:      func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid file",
+			request: func(t *testing.T) (io.Reader, string) {
+				return strings.NewReader("2024-01-15,USD,JPY,abc\n"), "text/csv"
+			},
+			setupMock: func(m *MockExchangeRateServiceInterface, userID uuid.UUID) {
+				m.On("ImportExchangeRates", mock.Anything, userID, mock.Anything).
+					Return(nil, services.NewValidationError("file", "line 1: rate must be a number"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockExchangeRateServiceInterface(t)
+			handler := NewExchangeRateHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/exchange-rates/import", nil, userID)
+			body, contentType := tt.request(t)
+			c.Request = httptest.NewRequest("POST", "/exchange-rates/import", body)
+			c.Request.Header.Set("Content-Type", contentType)
+
+			handler.ImportExchangeRates(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var result models.ExchangeRateImportResult
+				helpers.ParseJSONResponse(t, w, &result)
+				assert.Equal(t, 1, result.Imported)
+			}
+		})
+	}
+}
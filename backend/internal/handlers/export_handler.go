@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Soli0222/flow-sight/backend/internal/export"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ExportHandler struct {
+	exportService ExportServiceInterface
+}
+
+func NewExportHandler(exportService ExportServiceInterface) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// @Summary Export bank accounts
+// @Description Download the bank accounts as a CSV (UTF-8 with BOM) or Excel file
+// @Tags export
+// @Produce text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "csv or xlsx" default(csv)
+// @Param amount_unit query string false "yen (whole currency units) or minor (hundredths)" default(yen)
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Router /bank-accounts/export [get]
+func (h *ExportHandler) ExportBankAccounts(c *gin.Context) {
+	h.export(c, "bank-accounts", h.exportService.ExportBankAccounts)
+}
+
+// @Summary Export credit cards
+// @Description Download the credit cards as a CSV (UTF-8 with BOM) or Excel file
+// @Tags export
+// @Produce text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "csv or xlsx" default(csv)
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Router /credit-cards/export [get]
+func (h *ExportHandler) ExportCreditCards(c *gin.Context) {
+	h.export(c, "credit-cards", h.exportService.ExportCreditCards)
+}
+
+// @Summary Export card monthly totals
+// @Description Download the monthly statement totals of every credit card as a CSV (UTF-8 with BOM) or Excel file
+// @Tags export
+// @Produce text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "csv or xlsx" default(csv)
+// @Param amount_unit query string false "yen (whole currency units) or minor (hundredths)" default(yen)
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Router /card-monthly-totals/export [get]
+func (h *ExportHandler) ExportCardMonthlyTotals(c *gin.Context) {
+	h.export(c, "card-monthly-totals", h.exportService.ExportCardMonthlyTotals)
+}
+
+// @Summary Export income sources
+// @Description Download the income sources as a CSV (UTF-8 with BOM) or Excel file
+// @Tags export
+// @Produce text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "csv or xlsx" default(csv)
+// @Param amount_unit query string false "yen (whole currency units) or minor (hundredths)" default(yen)
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Router /income-sources/export [get]
+func (h *ExportHandler) ExportIncomeSources(c *gin.Context) {
+	h.export(c, "income-sources", h.exportService.ExportIncomeSources)
+}
+
+// @Summary Export recurring payments
+// @Description Download the recurring payments and loans as a CSV (UTF-8 with BOM) or Excel file
+// @Tags export
+// @Produce text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param format query string false "csv or xlsx" default(csv)
+// @Param amount_unit query string false "yen (whole currency units) or minor (hundredths)" default(yen)
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Router /recurring-payments/export [get]
+func (h *ExportHandler) ExportRecurringPayments(c *gin.Context) {
+	h.export(c, "recurring-payments", h.exportService.ExportRecurringPayments)
+}
+
+// export builds a table of the authenticated user's data and sends it as a file
+func (h *ExportHandler) export(c *gin.Context, name string, build func(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	format, unit, ok := parseExportQuery(c, string(export.CSV))
+	if !ok {
+		return
+	}
+
+	table, err := build(c.Request.Context(), userUUID, unit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	writeExport(c, format, name, table)
+}
+
+// parseExportQuery reads the format and amount_unit query parameters of an export. It responds
+// with 400 and returns false for an invalid parameter.
+func parseExportQuery(c *gin.Context, defaultFormat string) (export.Format, export.Unit, bool) {
+	format, ok := export.ParseFormat(c.DefaultQuery("format", defaultFormat))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format parameter"})
+		return "", "", false
+	}
+
+	unit, ok := export.ParseUnit(c.DefaultQuery("amount_unit", string(export.Yen)))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid amount_unit parameter"})
+		return "", "", false
+	}
+
+	return format, unit, true
+}
+
+// writeExport sends a table as a file download in the format
+func writeExport(c *gin.Context, format export.Format, name string, table *export.Table) {
+	var buf bytes.Buffer
+	if err := export.Write(&buf, format, table); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, format.Filename(name)))
+	c.Data(http.StatusOK, format.ContentType(), buf.Bytes())
+}
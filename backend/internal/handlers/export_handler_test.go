@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/export"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportHandler_ExportBankAccounts(t *testing.T) {
+	table := &export.Table{Name: "銀行口座", Headers: []string{"名前", "通貨", "残高"}}
+	table.AddRow("メイン", "JPY", export.Number("1234"))
+
+	tests := []struct {
+		name                string
+		query               string
+		setupMock           func(*MockExportServiceInterface, uuid.UUID)
+		expectedStatus      int
+		expectedContentType string
+		expectedFilename    string
+	}{
+		{
+			name: "csv by default",
+			setupMock: func(m *MockExportServiceInterface, userID uuid.UUID) {
+				m.On("ExportBankAccounts", mock.Anything, userID, export.Yen).Return(table, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedFilename:    "bank-accounts.csv",
+		},
+		{
+			name:  "xlsx in minor units",
+			query: "?format=xlsx&amount_unit=minor",
+			setupMock: func(m *MockExportServiceInterface, userID uuid.UUID) {
+				m.On("ExportBankAccounts", mock.Anything, userID, export.Minor).Return(table, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			expectedFilename:    "bank-accounts.xlsx",
+		},
+		{
+			name:           "invalid format",
+			query:          "?format=pdf",
+			setupMock:      func(m *MockExportServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid amount unit",
+			query:          "?amount_unit=sen",
+			setupMock:      func(m *MockExportServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "service error",
+			query: "?format=csv",
+			setupMock: func(m *MockExportServiceInterface, userID uuid.UUID) {
+				m.On("ExportBankAccounts", mock.Anything, userID, export.Yen).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockExportServiceInterface(t)
+			handler := NewExportHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/bank-accounts/export"+tt.query, nil, userID)
+
+			handler.ExportBankAccounts(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="`+tt.expectedFilename+`"`, w.Header().Get("Content-Disposition"))
+			}
+			if tt.expectedFilename == "bank-accounts.csv" {
+				assert.True(t, strings.HasPrefix(w.Body.String(), "\ufeff名前,通貨,残高\r\n"))
+			}
+		})
+	}
+}
+
+func TestExportHandler_Unauthenticated(t *testing.T) {
+	handler := NewExportHandler(NewMockExportServiceInterface(t))
+
+	c, w := helpers.CreateTestContext(t, "GET", "/recurring-payments/export", nil, false)
+
+	handler.ExportRecurringPayments(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
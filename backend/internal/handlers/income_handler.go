@@ -41,7 +41,7 @@ func (h *IncomeHandler) GetIncomeSources(c *gin.Context) {
 		return
 	}
 
-	sources, err := h.incomeService.GetIncomeSources(userUUID)
+	sources, err := h.incomeService.GetIncomeSources(c.Request.Context(), userUUID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -66,7 +66,7 @@ func (h *IncomeHandler) GetIncomeSource(c *gin.Context) {
 		return
 	}
 
-	source, err := h.incomeService.GetIncomeSource(id)
+	source, err := h.incomeService.GetIncomeSource(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "income source not found"})
 		return
@@ -106,8 +106,8 @@ func (h *IncomeHandler) CreateIncomeSource(c *gin.Context) {
 	// Set the user_id from the authenticated user
 	source.UserID = userUUID
 
-	if err := h.incomeService.CreateIncomeSource(&source); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.incomeService.CreateIncomeSource(c.Request.Context(), &source); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -138,8 +138,8 @@ func (h *IncomeHandler) UpdateIncomeSource(c *gin.Context) {
 	}
 
 	source.ID = id
-	if err := h.incomeService.UpdateIncomeSource(&source); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.incomeService.UpdateIncomeSource(c.Request.Context(), &source); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -162,7 +162,7 @@ func (h *IncomeHandler) DeleteIncomeSource(c *gin.Context) {
 		return
 	}
 
-	if err := h.incomeService.DeleteIncomeSource(id); err != nil {
+	if err := h.incomeService.DeleteIncomeSource(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -193,7 +193,7 @@ func (h *IncomeHandler) GetMonthlyIncomeRecords(c *gin.Context) {
 		return
 	}
 
-	records, err := h.incomeService.GetMonthlyIncomeRecords(incomeSourceID)
+	records, err := h.incomeService.GetMonthlyIncomeRecords(c.Request.Context(), incomeSourceID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -218,7 +218,7 @@ func (h *IncomeHandler) GetMonthlyIncomeRecord(c *gin.Context) {
 		return
 	}
 
-	record, err := h.incomeService.GetMonthlyIncomeRecord(id)
+	record, err := h.incomeService.GetMonthlyIncomeRecord(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "monthly income record not found"})
 		return
@@ -242,7 +242,7 @@ func (h *IncomeHandler) CreateMonthlyIncomeRecord(c *gin.Context) {
 		return
 	}
 
-	if err := h.incomeService.CreateMonthlyIncomeRecord(&record); err != nil {
+	if err := h.incomeService.CreateMonthlyIncomeRecord(c.Request.Context(), &record); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -274,7 +274,7 @@ func (h *IncomeHandler) UpdateMonthlyIncomeRecord(c *gin.Context) {
 	}
 
 	record.ID = id
-	if err := h.incomeService.UpdateMonthlyIncomeRecord(&record); err != nil {
+	if err := h.incomeService.UpdateMonthlyIncomeRecord(c.Request.Context(), &record); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -298,7 +298,7 @@ func (h *IncomeHandler) DeleteMonthlyIncomeRecord(c *gin.Context) {
 		return
 	}
 
-	if err := h.incomeService.DeleteMonthlyIncomeRecord(id); err != nil {
+	if err := h.incomeService.DeleteMonthlyIncomeRecord(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -43,7 +43,7 @@ func TestIncomeHandler_GetMonthlyIncomeRecords(t *testing.T) {
 						UpdatedAt:      time.Now(),
 					},
 				}
-				m.On("GetMonthlyIncomeRecords", uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testRecords, nil)
+				m.On("GetMonthlyIncomeRecords", mock.Anything, uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testRecords, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -66,7 +66,7 @@ func TestIncomeHandler_GetMonthlyIncomeRecords(t *testing.T) {
 						UpdatedAt:      time.Now(),
 					},
 				}
-				m.On("GetMonthlyIncomeRecords", uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testRecords, nil)
+				m.On("GetMonthlyIncomeRecords", mock.Anything, uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testRecords, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -86,7 +86,7 @@ func TestIncomeHandler_GetMonthlyIncomeRecords(t *testing.T) {
 			authenticated:  true,
 			incomeSourceID: "11223344-5566-7788-99aa-bbccddeeff00",
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("GetMonthlyIncomeRecords", uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return([]models.MonthlyIncomeRecord{}, assert.AnError)
+				m.On("GetMonthlyIncomeRecords", mock.Anything, uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return([]models.MonthlyIncomeRecord{}, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedCount:  0,
@@ -148,7 +148,7 @@ func TestIncomeHandler_GetMonthlyIncomeRecord(t *testing.T) {
 					CreatedAt:      time.Now(),
 					UpdatedAt:      time.Now(),
 				}
-				m.On("GetMonthlyIncomeRecord", uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff")).Return(testRecord, nil)
+				m.On("GetMonthlyIncomeRecord", mock.Anything, uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff")).Return(testRecord, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -164,7 +164,7 @@ func TestIncomeHandler_GetMonthlyIncomeRecord(t *testing.T) {
 			name:     "record not found",
 			recordID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("GetMonthlyIncomeRecord", uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.MonthlyIncomeRecord)(nil), assert.AnError)
+				m.On("GetMonthlyIncomeRecord", mock.Anything, uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.MonthlyIncomeRecord)(nil), assert.AnError)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -210,7 +210,7 @@ func TestIncomeHandler_CreateMonthlyIncomeRecord(t *testing.T) {
 				"note":             "December salary",
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("CreateMonthlyIncomeRecord", mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(nil)
+				m.On("CreateMonthlyIncomeRecord", mock.Anything, mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -226,7 +226,7 @@ func TestIncomeHandler_CreateMonthlyIncomeRecord(t *testing.T) {
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
 				// Handler doesn't check auth, so it will still call service
-				m.On("CreateMonthlyIncomeRecord", mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(nil)
+				m.On("CreateMonthlyIncomeRecord", mock.Anything, mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -241,7 +241,7 @@ func TestIncomeHandler_CreateMonthlyIncomeRecord(t *testing.T) {
 				"note":             "December salary",
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("CreateMonthlyIncomeRecord", mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(assert.AnError)
+				m.On("CreateMonthlyIncomeRecord", mock.Anything, mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -293,7 +293,7 @@ func TestIncomeHandler_UpdateMonthlyIncomeRecord(t *testing.T) {
 				"note":             "Updated December salary",
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("UpdateMonthlyIncomeRecord", mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(nil)
+				m.On("UpdateMonthlyIncomeRecord", mock.Anything, mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -323,7 +323,7 @@ func TestIncomeHandler_UpdateMonthlyIncomeRecord(t *testing.T) {
 				"note":             "Updated December salary",
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("UpdateMonthlyIncomeRecord", mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(assert.AnError)
+				m.On("UpdateMonthlyIncomeRecord", mock.Anything, mock.AnythingOfType("*models.MonthlyIncomeRecord")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -362,7 +362,7 @@ func TestIncomeHandler_DeleteMonthlyIncomeRecord(t *testing.T) {
 			recordID: "ffffffff-ffff-ffff-ffff-ffffffffffff",
 			setupMock: func(m *MockIncomeServiceInterface) {
 				recordUUID, _ := uuid.Parse("ffffffff-ffff-ffff-ffff-ffffffffffff")
-				m.On("DeleteMonthlyIncomeRecord", recordUUID).Return(nil)
+				m.On("DeleteMonthlyIncomeRecord", mock.Anything, recordUUID).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
 		},
@@ -379,7 +379,7 @@ func TestIncomeHandler_DeleteMonthlyIncomeRecord(t *testing.T) {
 			recordID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockIncomeServiceInterface) {
 				recordUUID, _ := uuid.Parse("99999999-9999-9999-9999-999999999999")
-				m.On("DeleteMonthlyIncomeRecord", recordUUID).Return(assert.AnError)
+				m.On("DeleteMonthlyIncomeRecord", mock.Anything, recordUUID).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 
 	"github.com/gin-gonic/gin"
@@ -45,7 +46,7 @@ func TestIncomeHandler_GetIncomeSources(t *testing.T) {
 						UpdatedAt:          time.Now(),
 					},
 				}
-				m.On("GetIncomeSources", uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return(testSources, nil)
+				m.On("GetIncomeSources", mock.Anything, uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return(testSources, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -63,7 +64,7 @@ func TestIncomeHandler_GetIncomeSources(t *testing.T) {
 			name:          "service error",
 			authenticated: true,
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("GetIncomeSources", uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return([]models.IncomeSource{}, assert.AnError)
+				m.On("GetIncomeSources", mock.Anything, uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return([]models.IncomeSource{}, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedCount:  0,
@@ -129,7 +130,7 @@ func TestIncomeHandler_GetIncomeSource(t *testing.T) {
 					CreatedAt:          time.Now(),
 					UpdatedAt:          time.Now(),
 				}
-				m.On("GetIncomeSource", uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testSource, nil)
+				m.On("GetIncomeSource", mock.Anything, uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testSource, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -145,7 +146,7 @@ func TestIncomeHandler_GetIncomeSource(t *testing.T) {
 			name:     "source not found",
 			sourceID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("GetIncomeSource", uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.IncomeSource)(nil), assert.AnError)
+				m.On("GetIncomeSource", mock.Anything, uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.IncomeSource)(nil), assert.AnError)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -192,7 +193,7 @@ func TestIncomeHandler_CreateIncomeSource(t *testing.T) {
 				"is_active":    true,
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("CreateIncomeSource", mock.AnythingOfType("*models.IncomeSource")).Return(nil)
+				m.On("CreateIncomeSource", mock.Anything, mock.AnythingOfType("*models.IncomeSource")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -208,10 +209,49 @@ func TestIncomeHandler_CreateIncomeSource(t *testing.T) {
 				"is_active":      true,
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("CreateIncomeSource", mock.AnythingOfType("*models.IncomeSource")).Return(nil)
+				m.On("CreateIncomeSource", mock.Anything, mock.AnythingOfType("*models.IncomeSource")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
+		{
+			name:          "successful creation - semi annual",
+			authenticated: true,
+			requestBody: map[string]interface{}{
+				"name":           "Bonus",
+				"income_type":    "semi_annual",
+				"base_amount":    int64(600000),
+				"bank_account":   "aabbccdd-eeff-1122-3344-556677889900",
+				"payment_day":    10,
+				"payment_months": []int{6, 12},
+				"raise_rate":     2.5,
+				"is_active":      true,
+			},
+			setupMock: func(m *MockIncomeServiceInterface) {
+				m.On("CreateIncomeSource", mock.Anything, mock.MatchedBy(func(source *models.IncomeSource) bool {
+					return source.IncomeType == "semi_annual" &&
+						assert.ObjectsAreEqual(models.MonthList{6, 12}, source.PaymentMonths) &&
+						source.RaiseRate != nil && *source.RaiseRate == 2.5
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:          "invalid payment months",
+			authenticated: true,
+			requestBody: map[string]interface{}{
+				"name":           "Bonus",
+				"income_type":    "semi_annual",
+				"base_amount":    int64(600000),
+				"bank_account":   "aabbccdd-eeff-1122-3344-556677889900",
+				"payment_months": []int{6},
+				"is_active":      true,
+			},
+			setupMock: func(m *MockIncomeServiceInterface) {
+				m.On("CreateIncomeSource", mock.Anything, mock.AnythingOfType("*models.IncomeSource")).
+					Return(services.NewValidationError("payment_months", "semi_annual income requires exactly 2 months"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:          "unauthenticated user",
 			authenticated: false,
@@ -240,7 +280,7 @@ func TestIncomeHandler_CreateIncomeSource(t *testing.T) {
 				"is_active":    true,
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("CreateIncomeSource", mock.AnythingOfType("*models.IncomeSource")).Return(assert.AnError)
+				m.On("CreateIncomeSource", mock.Anything, mock.AnythingOfType("*models.IncomeSource")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -293,7 +333,7 @@ func TestIncomeHandler_UpdateIncomeSource(t *testing.T) {
 				"is_active":    true,
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("UpdateIncomeSource", mock.AnythingOfType("*models.IncomeSource")).Return(nil)
+				m.On("UpdateIncomeSource", mock.Anything, mock.AnythingOfType("*models.IncomeSource")).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -325,7 +365,7 @@ func TestIncomeHandler_UpdateIncomeSource(t *testing.T) {
 				"is_active":    true,
 			},
 			setupMock: func(m *MockIncomeServiceInterface) {
-				m.On("UpdateIncomeSource", mock.AnythingOfType("*models.IncomeSource")).Return(assert.AnError)
+				m.On("UpdateIncomeSource", mock.Anything, mock.AnythingOfType("*models.IncomeSource")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -364,7 +404,7 @@ func TestIncomeHandler_DeleteIncomeSource(t *testing.T) {
 			sourceID: "11223344-5566-7788-99aa-bbccddeeff00",
 			setupMock: func(m *MockIncomeServiceInterface) {
 				sourceUUID, _ := uuid.Parse("11223344-5566-7788-99aa-bbccddeeff00")
-				m.On("DeleteIncomeSource", sourceUUID).Return(nil)
+				m.On("DeleteIncomeSource", mock.Anything, sourceUUID).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
 		},
@@ -381,7 +421,7 @@ func TestIncomeHandler_DeleteIncomeSource(t *testing.T) {
 			sourceID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockIncomeServiceInterface) {
 				sourceUUID, _ := uuid.Parse("99999999-9999-9999-9999-999999999999")
-				m.On("DeleteIncomeSource", sourceUUID).Return(assert.AnError)
+				m.On("DeleteIncomeSource", mock.Anything, sourceUUID).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -1,6 +1,11 @@
 package handlers
 
 import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/export"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/services"
 
@@ -9,50 +14,166 @@ import (
 
 // BankAccountServiceInterface defines the interface for bank account service
 type BankAccountServiceInterface interface {
-	GetBankAccounts(userID uuid.UUID) ([]models.BankAccount, error)
-	GetBankAccount(id uuid.UUID) (*models.BankAccount, error)
-	CreateBankAccount(account *models.BankAccount) error
-	UpdateBankAccount(account *models.BankAccount) error
-	DeleteBankAccount(id uuid.UUID) error
+	GetBankAccounts(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error)
+	GetBankAccount(ctx context.Context, id uuid.UUID) (*models.BankAccount, error)
+	CreateBankAccount(ctx context.Context, account *models.BankAccount) error
+	UpdateBankAccount(ctx context.Context, account *models.BankAccount) error
+	DeleteBankAccount(ctx context.Context, id uuid.UUID) error
 }
 
 // CreditCardServiceInterface defines the interface for credit card service
 type CreditCardServiceInterface interface {
-	GetCreditCards(userID uuid.UUID) ([]models.CreditCard, error)
-	GetCreditCard(id uuid.UUID) (*models.CreditCard, error)
-	CreateCreditCard(creditCard *models.CreditCard) error
-	UpdateCreditCard(creditCard *models.CreditCard) error
-	DeleteCreditCard(id uuid.UUID) error
+	GetCreditCards(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error)
+	GetCreditCard(ctx context.Context, id uuid.UUID) (*models.CreditCard, error)
+	CreateCreditCard(ctx context.Context, creditCard *models.CreditCard) error
+	UpdateCreditCard(ctx context.Context, creditCard *models.CreditCard) error
+	DeleteCreditCard(ctx context.Context, id uuid.UUID) error
 }
 
 // AuthServiceInterface defines the interface for auth service
 type AuthServiceInterface interface {
 	GetGoogleAuthURL(state string) string
-	HandleGoogleCallback(code string) (*models.User, string, error)
+	HandleGoogleCallback(ctx context.Context, code string) (*models.User, string, error)
 	GenerateJWT(user *models.User) (string, error)
 	ValidateJWT(tokenString string) (*services.Claims, error)
-	GetUserByID(userID string) (*models.User, error)
+	GetUserByID(ctx context.Context, userID string) (*models.User, error)
 }
 
 // RecurringPaymentServiceInterface defines the interface for recurring payment service
 type RecurringPaymentServiceInterface interface {
-	GetRecurringPayments(userID uuid.UUID) ([]models.RecurringPayment, error)
-	GetRecurringPayment(id uuid.UUID) (*models.RecurringPayment, error)
-	CreateRecurringPayment(payment *models.RecurringPayment) error
-	UpdateRecurringPayment(payment *models.RecurringPayment) error
-	DeleteRecurringPayment(id uuid.UUID) error
+	GetRecurringPayments(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error)
+	GetRecurringPayment(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error)
+	GetAmortizationSchedule(ctx context.Context, id uuid.UUID, prepayment *models.LoanPrepayment) (*models.AmortizationSchedule, error)
+	CreateRecurringPayment(ctx context.Context, payment *models.RecurringPayment) error
+	UpdateRecurringPayment(ctx context.Context, payment *models.RecurringPayment) error
+	DeleteRecurringPayment(ctx context.Context, id uuid.UUID) error
+}
+
+// RecurringTransferServiceInterface defines the interface for recurring transfer service
+type RecurringTransferServiceInterface interface {
+	GetRecurringTransfers(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error)
+	GetRecurringTransfer(ctx context.Context, userID, id uuid.UUID) (*models.RecurringTransfer, error)
+	CreateRecurringTransfer(ctx context.Context, transfer *models.RecurringTransfer) error
+	UpdateRecurringTransfer(ctx context.Context, userID uuid.UUID, transfer *models.RecurringTransfer) error
+	DeleteRecurringTransfer(ctx context.Context, userID, id uuid.UUID) error
+}
+
+// SavingsGoalServiceInterface defines the interface for savings goal service
+type SavingsGoalServiceInterface interface {
+	GetSavingsGoals(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error)
+	GetSavingsGoal(ctx context.Context, userID, id uuid.UUID) (*models.SavingsGoal, error)
+	GetSavingsGoalProgress(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoalProgress, error)
+	CreateSavingsGoal(ctx context.Context, goal *models.SavingsGoal) error
+	UpdateSavingsGoal(ctx context.Context, userID uuid.UUID, goal *models.SavingsGoal) error
+	DeleteSavingsGoal(ctx context.Context, userID, id uuid.UUID) error
+}
+
+// BudgetServiceInterface defines the interface for budget service
+type BudgetServiceInterface interface {
+	GetCategories(ctx context.Context, userID uuid.UUID) ([]models.Category, error)
+	GetCategory(ctx context.Context, userID, id uuid.UUID) (*models.Category, error)
+	CreateCategory(ctx context.Context, category *models.Category) error
+	UpdateCategory(ctx context.Context, userID uuid.UUID, category *models.Category) error
+	DeleteCategory(ctx context.Context, userID, id uuid.UUID) error
+	GetCategoryBreakdown(ctx context.Context, userID uuid.UUID, months int, topLevel bool) ([]models.CategoryBreakdown, error)
+	GetBudgets(ctx context.Context, userID uuid.UUID) ([]models.Budget, error)
+	GetBudget(ctx context.Context, userID, id uuid.UUID) (*models.Budget, error)
+	CreateBudget(ctx context.Context, budget *models.Budget) error
+	UpdateBudget(ctx context.Context, userID uuid.UUID, budget *models.Budget) error
+	DeleteBudget(ctx context.Context, userID, id uuid.UUID) error
+	GetBudgetReport(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.BudgetReport, error)
+	GetTransactions(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.Transaction, error)
+	GetTransaction(ctx context.Context, userID, id uuid.UUID) (*models.Transaction, error)
+	CreateTransaction(ctx context.Context, transaction *models.Transaction) error
+	UpdateTransaction(ctx context.Context, userID uuid.UUID, transaction *models.Transaction) error
+	DeleteTransaction(ctx context.Context, userID, id uuid.UUID) error
 }
 
 // IncomeServiceInterface defines the interface for income service
 type IncomeServiceInterface interface {
-	GetIncomeSources(userID uuid.UUID) ([]models.IncomeSource, error)
-	GetIncomeSource(id uuid.UUID) (*models.IncomeSource, error)
-	CreateIncomeSource(source *models.IncomeSource) error
-	UpdateIncomeSource(source *models.IncomeSource) error
-	DeleteIncomeSource(id uuid.UUID) error
-	GetMonthlyIncomeRecords(incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error)
-	GetMonthlyIncomeRecord(id uuid.UUID) (*models.MonthlyIncomeRecord, error)
-	CreateMonthlyIncomeRecord(record *models.MonthlyIncomeRecord) error
-	UpdateMonthlyIncomeRecord(record *models.MonthlyIncomeRecord) error
-	DeleteMonthlyIncomeRecord(id uuid.UUID) error
+	GetIncomeSources(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error)
+	GetIncomeSource(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error)
+	CreateIncomeSource(ctx context.Context, source *models.IncomeSource) error
+	UpdateIncomeSource(ctx context.Context, source *models.IncomeSource) error
+	DeleteIncomeSource(ctx context.Context, id uuid.UUID) error
+	GetMonthlyIncomeRecords(ctx context.Context, incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error)
+	GetMonthlyIncomeRecord(ctx context.Context, id uuid.UUID) (*models.MonthlyIncomeRecord, error)
+	CreateMonthlyIncomeRecord(ctx context.Context, record *models.MonthlyIncomeRecord) error
+	UpdateMonthlyIncomeRecord(ctx context.Context, record *models.MonthlyIncomeRecord) error
+	DeleteMonthlyIncomeRecord(ctx context.Context, id uuid.UUID) error
+}
+
+// JobSchedulerInterface defines the interface for the background job scheduler
+type JobSchedulerInterface interface {
+	Status(ctx context.Context) ([]models.JobStatus, error)
+}
+
+// ExchangeRateServiceInterface defines the interface for exchange rate service
+type ExchangeRateServiceInterface interface {
+	GetExchangeRates(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error)
+	GetExchangeRate(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error)
+	CreateExchangeRate(ctx context.Context, rate *models.ExchangeRate) error
+	UpdateExchangeRate(ctx context.Context, rate *models.ExchangeRate) error
+	DeleteExchangeRate(ctx context.Context, id uuid.UUID) error
+	ImportExchangeRates(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.ExchangeRateImportResult, error)
+}
+
+// AssetServiceInterface defines the interface for asset service
+type AssetServiceInterface interface {
+	GetAssets(ctx context.Context, userID uuid.UUID) ([]models.Asset, error)
+	GetAsset(ctx context.Context, userID, id uuid.UUID) (*models.Asset, error)
+	CreateAsset(ctx context.Context, asset *models.Asset) error
+	UpdateAsset(ctx context.Context, userID uuid.UUID, asset *models.Asset) error
+	DeleteAsset(ctx context.Context, userID, id uuid.UUID) error
+	GetAssetValuations(ctx context.Context, userID, assetID uuid.UUID) ([]models.AssetValuation, error)
+	CreateAssetValuation(ctx context.Context, userID uuid.UUID, valuation *models.AssetValuation) error
+	DeleteAssetValuation(ctx context.Context, userID, assetID, id uuid.UUID) error
+	ImportAssetValuations(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.AssetValuationImportResult, error)
+}
+
+// NetWorthServiceInterface defines the interface for net worth service
+type NetWorthServiceInterface interface {
+	GetNetWorth(ctx context.Context, userID uuid.UUID) (*models.NetWorth, error)
+	GetNetWorthHistory(ctx context.Context, userID uuid.UUID, months int) ([]models.NetWorthSnapshot, error)
+}
+
+// ExportServiceInterface defines the interface for export service
+type ExportServiceInterface interface {
+	ExportBankAccounts(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)
+	ExportCreditCards(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)
+	ExportCardMonthlyTotals(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)
+	ExportIncomeSources(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)
+	ExportRecurringPayments(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)
+}
+
+// CalendarServiceInterface defines the interface for calendar service
+type CalendarServiceInterface interface {
+	GetCalendarFeed(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error)
+	CreateCalendarFeed(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error)
+	DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error
+	RenderCalendarFeed(ctx context.Context, token string, now time.Time) ([]byte, error)
+}
+
+// ReportServiceInterface defines the interface for report service
+type ReportServiceInterface interface {
+	RenderMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string, now time.Time) ([]byte, error)
+	GetStoredMonthlyReports(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error)
+	GetStoredMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error)
+}
+
+// NotificationServiceInterface defines the interface for notification service
+type NotificationServiceInterface interface {
+	GetNotificationLogs(ctx context.Context, userID uuid.UUID) ([]models.NotificationLog, error)
+	SendTestNotification(ctx context.Context, userID uuid.UUID, channel string, now time.Time) (*models.NotificationLog, error)
+}
+
+// WebhookServiceInterface defines the interface for webhook service
+type WebhookServiceInterface interface {
+	GetWebhooks(ctx context.Context, userID uuid.UUID) ([]models.Webhook, error)
+	GetWebhook(ctx context.Context, userID, id uuid.UUID) (*models.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	UpdateWebhook(ctx context.Context, userID uuid.UUID, webhook *models.Webhook) error
+	DeleteWebhook(ctx context.Context, userID, id uuid.UUID) error
+	GetWebhookDeliveries(ctx context.Context, userID, webhookID uuid.UUID) ([]models.WebhookDelivery, error)
+	SendTestEvent(ctx context.Context, userID, webhookID uuid.UUID, now time.Time) (*models.WebhookDelivery, error)
 }
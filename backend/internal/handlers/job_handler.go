@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobHandler struct {
+	scheduler JobSchedulerInterface
+}
+
+func NewJobHandler(scheduler JobSchedulerInterface) *JobHandler {
+	return &JobHandler{
+		scheduler: scheduler,
+	}
+}
+
+// @Summary Get background jobs
+// @Description Get the schedule, next run and last run of every background job (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.JobStatus
+// @Failure 403 {object} map[string]string
+// @Router /admin/jobs [get]
+func (h *JobHandler) GetJobs(c *gin.Context) {
+	statuses, err := h.scheduler.Status(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, statuses)
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJobHandler_GetJobs(t *testing.T) {
+	nextRunAt := time.Date(2025, 3, 28, 4, 0, 0, 0, time.UTC)
+	finishedAt := nextRunAt.Add(-time.Hour + time.Second)
+
+	tests := []struct {
+		name           string
+		setupMock      func(*MockJobSchedulerInterface)
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(m *MockJobSchedulerInterface) {
+				m.On("Status", mock.Anything).Return([]models.JobStatus{
+					{
+						Name:      "recurring_payment_progress",
+						Schedule:  "@hourly",
+						NextRunAt: &nextRunAt,
+						LastRun:   &models.JobRun{JobName: "recurring_payment_progress", Status: "succeeded", StartedAt: nextRunAt.Add(-time.Hour), FinishedAt: &finishedAt},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name: "service error",
+			setupMock: func(m *MockJobSchedulerInterface) {
+				m.On("Status", mock.Anything).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockScheduler := NewMockJobSchedulerInterface(t)
+			handler := NewJobHandler(mockScheduler)
+
+			c, w := helpers.CreateTestContext(t, "GET", "/admin/jobs", nil, true)
+			tt.setupMock(mockScheduler)
+
+			handler.GetJobs(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var statuses []models.JobStatus
+				helpers.ParseJSONResponse(t, w, &statuses)
+				assert.Len(t, statuses, tt.expectedCount)
+				assert.Equal(t, "succeeded", statuses[0].LastRun.Status)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
 	"github.com/google/uuid"
@@ -12,30 +14,30 @@ type MockBankAccountService struct {
 	mock.Mock
 }
 
-func (m *MockBankAccountService) GetBankAccounts(userID uuid.UUID) ([]models.BankAccount, error) {
-	args := m.Called(userID)
+func (m *MockBankAccountService) GetBankAccounts(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error) {
+	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.BankAccount), args.Error(1)
 }
 
-func (m *MockBankAccountService) GetBankAccount(id uuid.UUID) (*models.BankAccount, error) {
-	args := m.Called(id)
+func (m *MockBankAccountService) GetBankAccount(ctx context.Context, id uuid.UUID) (*models.BankAccount, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*models.BankAccount), args.Error(1)
 }
 
-func (m *MockBankAccountService) CreateBankAccount(account *models.BankAccount) error {
-	args := m.Called(account)
+func (m *MockBankAccountService) CreateBankAccount(ctx context.Context, account *models.BankAccount) error {
+	args := m.Called(ctx, account)
 	return args.Error(0)
 }
 
-func (m *MockBankAccountService) UpdateBankAccount(account *models.BankAccount) error {
-	args := m.Called(account)
+func (m *MockBankAccountService) UpdateBankAccount(ctx context.Context, account *models.BankAccount) error {
+	args := m.Called(ctx, account)
 	return args.Error(0)
 }
 
-func (m *MockBankAccountService) DeleteBankAccount(id uuid.UUID) error {
-	args := m.Called(id)
+func (m *MockBankAccountService) DeleteBankAccount(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
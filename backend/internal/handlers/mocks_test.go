@@ -5,6 +5,11 @@
 package handlers
 
 import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/export"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/services"
 
@@ -40,16 +45,16 @@ func (_m *MockBankAccountServiceInterface) EXPECT() *MockBankAccountServiceInter
 }
 
 // CreateBankAccount provides a mock function for the type MockBankAccountServiceInterface
-func (_mock *MockBankAccountServiceInterface) CreateBankAccount(account *models.BankAccount) error {
-	ret := _mock.Called(account)
+func (_mock *MockBankAccountServiceInterface) CreateBankAccount(ctx context.Context, account *models.BankAccount) error {
+	ret := _mock.Called(ctx, account)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateBankAccount")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.BankAccount) error); ok {
-		r0 = returnFunc(account)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.BankAccount) error); ok {
+		r0 = returnFunc(ctx, account)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -62,19 +67,25 @@ type MockBankAccountServiceInterface_CreateBankAccount_Call struct {
 }
 
 // CreateBankAccount is a helper method to define mock.On call
+//   - ctx context.Context
 //   - account *models.BankAccount
-func (_e *MockBankAccountServiceInterface_Expecter) CreateBankAccount(account interface{}) *MockBankAccountServiceInterface_CreateBankAccount_Call {
-	return &MockBankAccountServiceInterface_CreateBankAccount_Call{Call: _e.mock.On("CreateBankAccount", account)}
+func (_e *MockBankAccountServiceInterface_Expecter) CreateBankAccount(ctx interface{}, account interface{}) *MockBankAccountServiceInterface_CreateBankAccount_Call {
+	return &MockBankAccountServiceInterface_CreateBankAccount_Call{Call: _e.mock.On("CreateBankAccount", ctx, account)}
 }
 
-func (_c *MockBankAccountServiceInterface_CreateBankAccount_Call) Run(run func(account *models.BankAccount)) *MockBankAccountServiceInterface_CreateBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_CreateBankAccount_Call) Run(run func(ctx context.Context, account *models.BankAccount)) *MockBankAccountServiceInterface_CreateBankAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.BankAccount
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.BankAccount)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.BankAccount
+		if args[1] != nil {
+			arg1 = args[1].(*models.BankAccount)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -85,22 +96,22 @@ func (_c *MockBankAccountServiceInterface_CreateBankAccount_Call) Return(err err
 	return _c
 }
 
-func (_c *MockBankAccountServiceInterface_CreateBankAccount_Call) RunAndReturn(run func(account *models.BankAccount) error) *MockBankAccountServiceInterface_CreateBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_CreateBankAccount_Call) RunAndReturn(run func(ctx context.Context, account *models.BankAccount) error) *MockBankAccountServiceInterface_CreateBankAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteBankAccount provides a mock function for the type MockBankAccountServiceInterface
-func (_mock *MockBankAccountServiceInterface) DeleteBankAccount(id uuid.UUID) error {
-	ret := _mock.Called(id)
+func (_mock *MockBankAccountServiceInterface) DeleteBankAccount(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteBankAccount")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -113,19 +124,25 @@ type MockBankAccountServiceInterface_DeleteBankAccount_Call struct {
 }
 
 // DeleteBankAccount is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockBankAccountServiceInterface_Expecter) DeleteBankAccount(id interface{}) *MockBankAccountServiceInterface_DeleteBankAccount_Call {
-	return &MockBankAccountServiceInterface_DeleteBankAccount_Call{Call: _e.mock.On("DeleteBankAccount", id)}
+func (_e *MockBankAccountServiceInterface_Expecter) DeleteBankAccount(ctx interface{}, id interface{}) *MockBankAccountServiceInterface_DeleteBankAccount_Call {
+	return &MockBankAccountServiceInterface_DeleteBankAccount_Call{Call: _e.mock.On("DeleteBankAccount", ctx, id)}
 }
 
-func (_c *MockBankAccountServiceInterface_DeleteBankAccount_Call) Run(run func(id uuid.UUID)) *MockBankAccountServiceInterface_DeleteBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_DeleteBankAccount_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockBankAccountServiceInterface_DeleteBankAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -136,14 +153,14 @@ func (_c *MockBankAccountServiceInterface_DeleteBankAccount_Call) Return(err err
 	return _c
 }
 
-func (_c *MockBankAccountServiceInterface_DeleteBankAccount_Call) RunAndReturn(run func(id uuid.UUID) error) *MockBankAccountServiceInterface_DeleteBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_DeleteBankAccount_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockBankAccountServiceInterface_DeleteBankAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetBankAccount provides a mock function for the type MockBankAccountServiceInterface
-func (_mock *MockBankAccountServiceInterface) GetBankAccount(id uuid.UUID) (*models.BankAccount, error) {
-	ret := _mock.Called(id)
+func (_mock *MockBankAccountServiceInterface) GetBankAccount(ctx context.Context, id uuid.UUID) (*models.BankAccount, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBankAccount")
@@ -151,18 +168,18 @@ func (_mock *MockBankAccountServiceInterface) GetBankAccount(id uuid.UUID) (*mod
 
 	var r0 *models.BankAccount
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (*models.BankAccount, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.BankAccount, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) *models.BankAccount); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.BankAccount); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.BankAccount)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -175,19 +192,25 @@ type MockBankAccountServiceInterface_GetBankAccount_Call struct {
 }
 
 // GetBankAccount is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockBankAccountServiceInterface_Expecter) GetBankAccount(id interface{}) *MockBankAccountServiceInterface_GetBankAccount_Call {
-	return &MockBankAccountServiceInterface_GetBankAccount_Call{Call: _e.mock.On("GetBankAccount", id)}
+func (_e *MockBankAccountServiceInterface_Expecter) GetBankAccount(ctx interface{}, id interface{}) *MockBankAccountServiceInterface_GetBankAccount_Call {
+	return &MockBankAccountServiceInterface_GetBankAccount_Call{Call: _e.mock.On("GetBankAccount", ctx, id)}
 }
 
-func (_c *MockBankAccountServiceInterface_GetBankAccount_Call) Run(run func(id uuid.UUID)) *MockBankAccountServiceInterface_GetBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_GetBankAccount_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockBankAccountServiceInterface_GetBankAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -198,14 +221,14 @@ func (_c *MockBankAccountServiceInterface_GetBankAccount_Call) Return(bankAccoun
 	return _c
 }
 
-func (_c *MockBankAccountServiceInterface_GetBankAccount_Call) RunAndReturn(run func(id uuid.UUID) (*models.BankAccount, error)) *MockBankAccountServiceInterface_GetBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_GetBankAccount_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.BankAccount, error)) *MockBankAccountServiceInterface_GetBankAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetBankAccounts provides a mock function for the type MockBankAccountServiceInterface
-func (_mock *MockBankAccountServiceInterface) GetBankAccounts(userID uuid.UUID) ([]models.BankAccount, error) {
-	ret := _mock.Called(userID)
+func (_mock *MockBankAccountServiceInterface) GetBankAccounts(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBankAccounts")
@@ -213,18 +236,18 @@ func (_mock *MockBankAccountServiceInterface) GetBankAccounts(userID uuid.UUID)
 
 	var r0 []models.BankAccount
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]models.BankAccount, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.BankAccount, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []models.BankAccount); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.BankAccount); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.BankAccount)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -237,19 +260,25 @@ type MockBankAccountServiceInterface_GetBankAccounts_Call struct {
 }
 
 // GetBankAccounts is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockBankAccountServiceInterface_Expecter) GetBankAccounts(userID interface{}) *MockBankAccountServiceInterface_GetBankAccounts_Call {
-	return &MockBankAccountServiceInterface_GetBankAccounts_Call{Call: _e.mock.On("GetBankAccounts", userID)}
+func (_e *MockBankAccountServiceInterface_Expecter) GetBankAccounts(ctx interface{}, userID interface{}) *MockBankAccountServiceInterface_GetBankAccounts_Call {
+	return &MockBankAccountServiceInterface_GetBankAccounts_Call{Call: _e.mock.On("GetBankAccounts", ctx, userID)}
 }
 
-func (_c *MockBankAccountServiceInterface_GetBankAccounts_Call) Run(run func(userID uuid.UUID)) *MockBankAccountServiceInterface_GetBankAccounts_Call {
+func (_c *MockBankAccountServiceInterface_GetBankAccounts_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockBankAccountServiceInterface_GetBankAccounts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -260,22 +289,22 @@ func (_c *MockBankAccountServiceInterface_GetBankAccounts_Call) Return(bankAccou
 	return _c
 }
 
-func (_c *MockBankAccountServiceInterface_GetBankAccounts_Call) RunAndReturn(run func(userID uuid.UUID) ([]models.BankAccount, error)) *MockBankAccountServiceInterface_GetBankAccounts_Call {
+func (_c *MockBankAccountServiceInterface_GetBankAccounts_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error)) *MockBankAccountServiceInterface_GetBankAccounts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateBankAccount provides a mock function for the type MockBankAccountServiceInterface
-func (_mock *MockBankAccountServiceInterface) UpdateBankAccount(account *models.BankAccount) error {
-	ret := _mock.Called(account)
+func (_mock *MockBankAccountServiceInterface) UpdateBankAccount(ctx context.Context, account *models.BankAccount) error {
+	ret := _mock.Called(ctx, account)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateBankAccount")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.BankAccount) error); ok {
-		r0 = returnFunc(account)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.BankAccount) error); ok {
+		r0 = returnFunc(ctx, account)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -288,19 +317,25 @@ type MockBankAccountServiceInterface_UpdateBankAccount_Call struct {
 }
 
 // UpdateBankAccount is a helper method to define mock.On call
+//   - ctx context.Context
 //   - account *models.BankAccount
-func (_e *MockBankAccountServiceInterface_Expecter) UpdateBankAccount(account interface{}) *MockBankAccountServiceInterface_UpdateBankAccount_Call {
-	return &MockBankAccountServiceInterface_UpdateBankAccount_Call{Call: _e.mock.On("UpdateBankAccount", account)}
+func (_e *MockBankAccountServiceInterface_Expecter) UpdateBankAccount(ctx interface{}, account interface{}) *MockBankAccountServiceInterface_UpdateBankAccount_Call {
+	return &MockBankAccountServiceInterface_UpdateBankAccount_Call{Call: _e.mock.On("UpdateBankAccount", ctx, account)}
 }
 
-func (_c *MockBankAccountServiceInterface_UpdateBankAccount_Call) Run(run func(account *models.BankAccount)) *MockBankAccountServiceInterface_UpdateBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_UpdateBankAccount_Call) Run(run func(ctx context.Context, account *models.BankAccount)) *MockBankAccountServiceInterface_UpdateBankAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.BankAccount
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.BankAccount)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.BankAccount
+		if args[1] != nil {
+			arg1 = args[1].(*models.BankAccount)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -311,7 +346,7 @@ func (_c *MockBankAccountServiceInterface_UpdateBankAccount_Call) Return(err err
 	return _c
 }
 
-func (_c *MockBankAccountServiceInterface_UpdateBankAccount_Call) RunAndReturn(run func(account *models.BankAccount) error) *MockBankAccountServiceInterface_UpdateBankAccount_Call {
+func (_c *MockBankAccountServiceInterface_UpdateBankAccount_Call) RunAndReturn(run func(ctx context.Context, account *models.BankAccount) error) *MockBankAccountServiceInterface_UpdateBankAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -344,16 +379,16 @@ func (_m *MockCreditCardServiceInterface) EXPECT() *MockCreditCardServiceInterfa
 }
 
 // CreateCreditCard provides a mock function for the type MockCreditCardServiceInterface
-func (_mock *MockCreditCardServiceInterface) CreateCreditCard(creditCard *models.CreditCard) error {
-	ret := _mock.Called(creditCard)
+func (_mock *MockCreditCardServiceInterface) CreateCreditCard(ctx context.Context, creditCard *models.CreditCard) error {
+	ret := _mock.Called(ctx, creditCard)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateCreditCard")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.CreditCard) error); ok {
-		r0 = returnFunc(creditCard)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.CreditCard) error); ok {
+		r0 = returnFunc(ctx, creditCard)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -366,19 +401,25 @@ type MockCreditCardServiceInterface_CreateCreditCard_Call struct {
 }
 
 // CreateCreditCard is a helper method to define mock.On call
+//   - ctx context.Context
 //   - creditCard *models.CreditCard
-func (_e *MockCreditCardServiceInterface_Expecter) CreateCreditCard(creditCard interface{}) *MockCreditCardServiceInterface_CreateCreditCard_Call {
-	return &MockCreditCardServiceInterface_CreateCreditCard_Call{Call: _e.mock.On("CreateCreditCard", creditCard)}
+func (_e *MockCreditCardServiceInterface_Expecter) CreateCreditCard(ctx interface{}, creditCard interface{}) *MockCreditCardServiceInterface_CreateCreditCard_Call {
+	return &MockCreditCardServiceInterface_CreateCreditCard_Call{Call: _e.mock.On("CreateCreditCard", ctx, creditCard)}
 }
 
-func (_c *MockCreditCardServiceInterface_CreateCreditCard_Call) Run(run func(creditCard *models.CreditCard)) *MockCreditCardServiceInterface_CreateCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_CreateCreditCard_Call) Run(run func(ctx context.Context, creditCard *models.CreditCard)) *MockCreditCardServiceInterface_CreateCreditCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.CreditCard
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.CreditCard)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.CreditCard
+		if args[1] != nil {
+			arg1 = args[1].(*models.CreditCard)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -389,22 +430,22 @@ func (_c *MockCreditCardServiceInterface_CreateCreditCard_Call) Return(err error
 	return _c
 }
 
-func (_c *MockCreditCardServiceInterface_CreateCreditCard_Call) RunAndReturn(run func(creditCard *models.CreditCard) error) *MockCreditCardServiceInterface_CreateCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_CreateCreditCard_Call) RunAndReturn(run func(ctx context.Context, creditCard *models.CreditCard) error) *MockCreditCardServiceInterface_CreateCreditCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteCreditCard provides a mock function for the type MockCreditCardServiceInterface
-func (_mock *MockCreditCardServiceInterface) DeleteCreditCard(id uuid.UUID) error {
-	ret := _mock.Called(id)
+func (_mock *MockCreditCardServiceInterface) DeleteCreditCard(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCreditCard")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -417,19 +458,25 @@ type MockCreditCardServiceInterface_DeleteCreditCard_Call struct {
 }
 
 // DeleteCreditCard is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockCreditCardServiceInterface_Expecter) DeleteCreditCard(id interface{}) *MockCreditCardServiceInterface_DeleteCreditCard_Call {
-	return &MockCreditCardServiceInterface_DeleteCreditCard_Call{Call: _e.mock.On("DeleteCreditCard", id)}
+func (_e *MockCreditCardServiceInterface_Expecter) DeleteCreditCard(ctx interface{}, id interface{}) *MockCreditCardServiceInterface_DeleteCreditCard_Call {
+	return &MockCreditCardServiceInterface_DeleteCreditCard_Call{Call: _e.mock.On("DeleteCreditCard", ctx, id)}
 }
 
-func (_c *MockCreditCardServiceInterface_DeleteCreditCard_Call) Run(run func(id uuid.UUID)) *MockCreditCardServiceInterface_DeleteCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_DeleteCreditCard_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockCreditCardServiceInterface_DeleteCreditCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -440,14 +487,14 @@ func (_c *MockCreditCardServiceInterface_DeleteCreditCard_Call) Return(err error
 	return _c
 }
 
-func (_c *MockCreditCardServiceInterface_DeleteCreditCard_Call) RunAndReturn(run func(id uuid.UUID) error) *MockCreditCardServiceInterface_DeleteCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_DeleteCreditCard_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockCreditCardServiceInterface_DeleteCreditCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetCreditCard provides a mock function for the type MockCreditCardServiceInterface
-func (_mock *MockCreditCardServiceInterface) GetCreditCard(id uuid.UUID) (*models.CreditCard, error) {
-	ret := _mock.Called(id)
+func (_mock *MockCreditCardServiceInterface) GetCreditCard(ctx context.Context, id uuid.UUID) (*models.CreditCard, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCreditCard")
@@ -455,18 +502,18 @@ func (_mock *MockCreditCardServiceInterface) GetCreditCard(id uuid.UUID) (*model
 
 	var r0 *models.CreditCard
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (*models.CreditCard, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.CreditCard, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) *models.CreditCard); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.CreditCard); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.CreditCard)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -479,19 +526,25 @@ type MockCreditCardServiceInterface_GetCreditCard_Call struct {
 }
 
 // GetCreditCard is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockCreditCardServiceInterface_Expecter) GetCreditCard(id interface{}) *MockCreditCardServiceInterface_GetCreditCard_Call {
-	return &MockCreditCardServiceInterface_GetCreditCard_Call{Call: _e.mock.On("GetCreditCard", id)}
+func (_e *MockCreditCardServiceInterface_Expecter) GetCreditCard(ctx interface{}, id interface{}) *MockCreditCardServiceInterface_GetCreditCard_Call {
+	return &MockCreditCardServiceInterface_GetCreditCard_Call{Call: _e.mock.On("GetCreditCard", ctx, id)}
 }
 
-func (_c *MockCreditCardServiceInterface_GetCreditCard_Call) Run(run func(id uuid.UUID)) *MockCreditCardServiceInterface_GetCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_GetCreditCard_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockCreditCardServiceInterface_GetCreditCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -502,14 +555,14 @@ func (_c *MockCreditCardServiceInterface_GetCreditCard_Call) Return(creditCard *
 	return _c
 }
 
-func (_c *MockCreditCardServiceInterface_GetCreditCard_Call) RunAndReturn(run func(id uuid.UUID) (*models.CreditCard, error)) *MockCreditCardServiceInterface_GetCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_GetCreditCard_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.CreditCard, error)) *MockCreditCardServiceInterface_GetCreditCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetCreditCards provides a mock function for the type MockCreditCardServiceInterface
-func (_mock *MockCreditCardServiceInterface) GetCreditCards(userID uuid.UUID) ([]models.CreditCard, error) {
-	ret := _mock.Called(userID)
+func (_mock *MockCreditCardServiceInterface) GetCreditCards(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCreditCards")
@@ -517,18 +570,18 @@ func (_mock *MockCreditCardServiceInterface) GetCreditCards(userID uuid.UUID) ([
 
 	var r0 []models.CreditCard
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]models.CreditCard, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.CreditCard, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []models.CreditCard); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.CreditCard); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.CreditCard)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -541,19 +594,25 @@ type MockCreditCardServiceInterface_GetCreditCards_Call struct {
 }
 
 // GetCreditCards is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockCreditCardServiceInterface_Expecter) GetCreditCards(userID interface{}) *MockCreditCardServiceInterface_GetCreditCards_Call {
-	return &MockCreditCardServiceInterface_GetCreditCards_Call{Call: _e.mock.On("GetCreditCards", userID)}
+func (_e *MockCreditCardServiceInterface_Expecter) GetCreditCards(ctx interface{}, userID interface{}) *MockCreditCardServiceInterface_GetCreditCards_Call {
+	return &MockCreditCardServiceInterface_GetCreditCards_Call{Call: _e.mock.On("GetCreditCards", ctx, userID)}
 }
 
-func (_c *MockCreditCardServiceInterface_GetCreditCards_Call) Run(run func(userID uuid.UUID)) *MockCreditCardServiceInterface_GetCreditCards_Call {
+func (_c *MockCreditCardServiceInterface_GetCreditCards_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockCreditCardServiceInterface_GetCreditCards_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -564,22 +623,22 @@ func (_c *MockCreditCardServiceInterface_GetCreditCards_Call) Return(creditCards
 	return _c
 }
 
-func (_c *MockCreditCardServiceInterface_GetCreditCards_Call) RunAndReturn(run func(userID uuid.UUID) ([]models.CreditCard, error)) *MockCreditCardServiceInterface_GetCreditCards_Call {
+func (_c *MockCreditCardServiceInterface_GetCreditCards_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error)) *MockCreditCardServiceInterface_GetCreditCards_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateCreditCard provides a mock function for the type MockCreditCardServiceInterface
-func (_mock *MockCreditCardServiceInterface) UpdateCreditCard(creditCard *models.CreditCard) error {
-	ret := _mock.Called(creditCard)
+func (_mock *MockCreditCardServiceInterface) UpdateCreditCard(ctx context.Context, creditCard *models.CreditCard) error {
+	ret := _mock.Called(ctx, creditCard)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateCreditCard")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.CreditCard) error); ok {
-		r0 = returnFunc(creditCard)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.CreditCard) error); ok {
+		r0 = returnFunc(ctx, creditCard)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -592,19 +651,25 @@ type MockCreditCardServiceInterface_UpdateCreditCard_Call struct {
 }
 
 // UpdateCreditCard is a helper method to define mock.On call
+//   - ctx context.Context
 //   - creditCard *models.CreditCard
-func (_e *MockCreditCardServiceInterface_Expecter) UpdateCreditCard(creditCard interface{}) *MockCreditCardServiceInterface_UpdateCreditCard_Call {
-	return &MockCreditCardServiceInterface_UpdateCreditCard_Call{Call: _e.mock.On("UpdateCreditCard", creditCard)}
+func (_e *MockCreditCardServiceInterface_Expecter) UpdateCreditCard(ctx interface{}, creditCard interface{}) *MockCreditCardServiceInterface_UpdateCreditCard_Call {
+	return &MockCreditCardServiceInterface_UpdateCreditCard_Call{Call: _e.mock.On("UpdateCreditCard", ctx, creditCard)}
 }
 
-func (_c *MockCreditCardServiceInterface_UpdateCreditCard_Call) Run(run func(creditCard *models.CreditCard)) *MockCreditCardServiceInterface_UpdateCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_UpdateCreditCard_Call) Run(run func(ctx context.Context, creditCard *models.CreditCard)) *MockCreditCardServiceInterface_UpdateCreditCard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.CreditCard
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.CreditCard)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.CreditCard
+		if args[1] != nil {
+			arg1 = args[1].(*models.CreditCard)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -615,7 +680,7 @@ func (_c *MockCreditCardServiceInterface_UpdateCreditCard_Call) Return(err error
 	return _c
 }
 
-func (_c *MockCreditCardServiceInterface_UpdateCreditCard_Call) RunAndReturn(run func(creditCard *models.CreditCard) error) *MockCreditCardServiceInterface_UpdateCreditCard_Call {
+func (_c *MockCreditCardServiceInterface_UpdateCreditCard_Call) RunAndReturn(run func(ctx context.Context, creditCard *models.CreditCard) error) *MockCreditCardServiceInterface_UpdateCreditCard_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -759,8 +824,8 @@ func (_c *MockAuthServiceInterface_GetGoogleAuthURL_Call) RunAndReturn(run func(
 }
 
 // GetUserByID provides a mock function for the type MockAuthServiceInterface
-func (_mock *MockAuthServiceInterface) GetUserByID(userID string) (*models.User, error) {
-	ret := _mock.Called(userID)
+func (_mock *MockAuthServiceInterface) GetUserByID(ctx context.Context, userID string) (*models.User, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserByID")
@@ -768,18 +833,18 @@ func (_mock *MockAuthServiceInterface) GetUserByID(userID string) (*models.User,
 
 	var r0 *models.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*models.User, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *models.User); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -792,19 +857,25 @@ type MockAuthServiceInterface_GetUserByID_Call struct {
 }
 
 // GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID string
-func (_e *MockAuthServiceInterface_Expecter) GetUserByID(userID interface{}) *MockAuthServiceInterface_GetUserByID_Call {
-	return &MockAuthServiceInterface_GetUserByID_Call{Call: _e.mock.On("GetUserByID", userID)}
+func (_e *MockAuthServiceInterface_Expecter) GetUserByID(ctx interface{}, userID interface{}) *MockAuthServiceInterface_GetUserByID_Call {
+	return &MockAuthServiceInterface_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, userID)}
 }
 
-func (_c *MockAuthServiceInterface_GetUserByID_Call) Run(run func(userID string)) *MockAuthServiceInterface_GetUserByID_Call {
+func (_c *MockAuthServiceInterface_GetUserByID_Call) Run(run func(ctx context.Context, userID string)) *MockAuthServiceInterface_GetUserByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -815,14 +886,14 @@ func (_c *MockAuthServiceInterface_GetUserByID_Call) Return(user *models.User, e
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_GetUserByID_Call) RunAndReturn(run func(userID string) (*models.User, error)) *MockAuthServiceInterface_GetUserByID_Call {
+func (_c *MockAuthServiceInterface_GetUserByID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*models.User, error)) *MockAuthServiceInterface_GetUserByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // HandleGoogleCallback provides a mock function for the type MockAuthServiceInterface
-func (_mock *MockAuthServiceInterface) HandleGoogleCallback(code string) (*models.User, string, error) {
-	ret := _mock.Called(code)
+func (_mock *MockAuthServiceInterface) HandleGoogleCallback(ctx context.Context, code string) (*models.User, string, error) {
+	ret := _mock.Called(ctx, code)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleGoogleCallback")
@@ -831,23 +902,25 @@ func (_mock *MockAuthServiceInterface) HandleGoogleCallback(code string) (*model
 	var r0 *models.User
 	var r1 string
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*models.User, string, error)); ok {
-		return returnFunc(code)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*models.User, string, error)); ok {
+		return returnFunc(ctx, code)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *models.User); ok {
-		r0 = returnFunc(code)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = returnFunc(ctx, code)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) string); ok {
-		r1 = returnFunc(code)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) string); ok {
+		r1 = returnFunc(ctx, code)
 	} else {
-		r1 = ret.Get(1).(string)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(string)
+		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
-		r2 = returnFunc(code)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, code)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -860,19 +933,25 @@ type MockAuthServiceInterface_HandleGoogleCallback_Call struct {
 }
 
 // HandleGoogleCallback is a helper method to define mock.On call
+//   - ctx context.Context
 //   - code string
-func (_e *MockAuthServiceInterface_Expecter) HandleGoogleCallback(code interface{}) *MockAuthServiceInterface_HandleGoogleCallback_Call {
-	return &MockAuthServiceInterface_HandleGoogleCallback_Call{Call: _e.mock.On("HandleGoogleCallback", code)}
+func (_e *MockAuthServiceInterface_Expecter) HandleGoogleCallback(ctx interface{}, code interface{}) *MockAuthServiceInterface_HandleGoogleCallback_Call {
+	return &MockAuthServiceInterface_HandleGoogleCallback_Call{Call: _e.mock.On("HandleGoogleCallback", ctx, code)}
 }
 
-func (_c *MockAuthServiceInterface_HandleGoogleCallback_Call) Run(run func(code string)) *MockAuthServiceInterface_HandleGoogleCallback_Call {
+func (_c *MockAuthServiceInterface_HandleGoogleCallback_Call) Run(run func(ctx context.Context, code string)) *MockAuthServiceInterface_HandleGoogleCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -883,7 +962,7 @@ func (_c *MockAuthServiceInterface_HandleGoogleCallback_Call) Return(user *model
 	return _c
 }
 
-func (_c *MockAuthServiceInterface_HandleGoogleCallback_Call) RunAndReturn(run func(code string) (*models.User, string, error)) *MockAuthServiceInterface_HandleGoogleCallback_Call {
+func (_c *MockAuthServiceInterface_HandleGoogleCallback_Call) RunAndReturn(run func(ctx context.Context, code string) (*models.User, string, error)) *MockAuthServiceInterface_HandleGoogleCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -978,16 +1057,16 @@ func (_m *MockRecurringPaymentServiceInterface) EXPECT() *MockRecurringPaymentSe
 }
 
 // CreateRecurringPayment provides a mock function for the type MockRecurringPaymentServiceInterface
-func (_mock *MockRecurringPaymentServiceInterface) CreateRecurringPayment(payment *models.RecurringPayment) error {
-	ret := _mock.Called(payment)
+func (_mock *MockRecurringPaymentServiceInterface) CreateRecurringPayment(ctx context.Context, payment *models.RecurringPayment) error {
+	ret := _mock.Called(ctx, payment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateRecurringPayment")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.RecurringPayment) error); ok {
-		r0 = returnFunc(payment)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.RecurringPayment) error); ok {
+		r0 = returnFunc(ctx, payment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1000,19 +1079,25 @@ type MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call struct {
 }
 
 // CreateRecurringPayment is a helper method to define mock.On call
+//   - ctx context.Context
 //   - payment *models.RecurringPayment
-func (_e *MockRecurringPaymentServiceInterface_Expecter) CreateRecurringPayment(payment interface{}) *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call {
-	return &MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call{Call: _e.mock.On("CreateRecurringPayment", payment)}
+func (_e *MockRecurringPaymentServiceInterface_Expecter) CreateRecurringPayment(ctx interface{}, payment interface{}) *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call {
+	return &MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call{Call: _e.mock.On("CreateRecurringPayment", ctx, payment)}
 }
 
-func (_c *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call) Run(run func(payment *models.RecurringPayment)) *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call) Run(run func(ctx context.Context, payment *models.RecurringPayment)) *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.RecurringPayment
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.RecurringPayment)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.RecurringPayment
+		if args[1] != nil {
+			arg1 = args[1].(*models.RecurringPayment)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1023,22 +1108,22 @@ func (_c *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call) Retu
 	return _c
 }
 
-func (_c *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call) RunAndReturn(run func(payment *models.RecurringPayment) error) *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call) RunAndReturn(run func(ctx context.Context, payment *models.RecurringPayment) error) *MockRecurringPaymentServiceInterface_CreateRecurringPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteRecurringPayment provides a mock function for the type MockRecurringPaymentServiceInterface
-func (_mock *MockRecurringPaymentServiceInterface) DeleteRecurringPayment(id uuid.UUID) error {
-	ret := _mock.Called(id)
+func (_mock *MockRecurringPaymentServiceInterface) DeleteRecurringPayment(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteRecurringPayment")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1051,19 +1136,25 @@ type MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call struct {
 }
 
 // DeleteRecurringPayment is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockRecurringPaymentServiceInterface_Expecter) DeleteRecurringPayment(id interface{}) *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call {
-	return &MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call{Call: _e.mock.On("DeleteRecurringPayment", id)}
+func (_e *MockRecurringPaymentServiceInterface_Expecter) DeleteRecurringPayment(ctx interface{}, id interface{}) *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call {
+	return &MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call{Call: _e.mock.On("DeleteRecurringPayment", ctx, id)}
 }
 
-func (_c *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call) Run(run func(id uuid.UUID)) *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1074,14 +1165,88 @@ func (_c *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call) Retu
 	return _c
 }
 
-func (_c *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call) RunAndReturn(run func(id uuid.UUID) error) *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockRecurringPaymentServiceInterface_DeleteRecurringPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAmortizationSchedule provides a mock function for the type MockRecurringPaymentServiceInterface
+func (_mock *MockRecurringPaymentServiceInterface) GetAmortizationSchedule(ctx context.Context, id uuid.UUID, prepayment *models.LoanPrepayment) (*models.AmortizationSchedule, error) {
+	ret := _mock.Called(ctx, id, prepayment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAmortizationSchedule")
+	}
+
+	var r0 *models.AmortizationSchedule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.LoanPrepayment) (*models.AmortizationSchedule, error)); ok {
+		return returnFunc(ctx, id, prepayment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.LoanPrepayment) *models.AmortizationSchedule); ok {
+		r0 = returnFunc(ctx, id, prepayment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AmortizationSchedule)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *models.LoanPrepayment) error); ok {
+		r1 = returnFunc(ctx, id, prepayment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAmortizationSchedule'
+type MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call struct {
+	*mock.Call
+}
+
+// GetAmortizationSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - prepayment *models.LoanPrepayment
+func (_e *MockRecurringPaymentServiceInterface_Expecter) GetAmortizationSchedule(ctx interface{}, id interface{}, prepayment interface{}) *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call {
+	return &MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call{Call: _e.mock.On("GetAmortizationSchedule", ctx, id, prepayment)}
+}
+
+func (_c *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call) Run(run func(ctx context.Context, id uuid.UUID, prepayment *models.LoanPrepayment)) *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.LoanPrepayment
+		if args[2] != nil {
+			arg2 = args[2].(*models.LoanPrepayment)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call) Return(amortizationSchedule *models.AmortizationSchedule, err error) *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call {
+	_c.Call.Return(amortizationSchedule, err)
+	return _c
+}
+
+func (_c *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, prepayment *models.LoanPrepayment) (*models.AmortizationSchedule, error)) *MockRecurringPaymentServiceInterface_GetAmortizationSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetRecurringPayment provides a mock function for the type MockRecurringPaymentServiceInterface
-func (_mock *MockRecurringPaymentServiceInterface) GetRecurringPayment(id uuid.UUID) (*models.RecurringPayment, error) {
-	ret := _mock.Called(id)
+func (_mock *MockRecurringPaymentServiceInterface) GetRecurringPayment(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRecurringPayment")
@@ -1089,18 +1254,18 @@ func (_mock *MockRecurringPaymentServiceInterface) GetRecurringPayment(id uuid.U
 
 	var r0 *models.RecurringPayment
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (*models.RecurringPayment, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.RecurringPayment, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) *models.RecurringPayment); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.RecurringPayment); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.RecurringPayment)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1113,19 +1278,25 @@ type MockRecurringPaymentServiceInterface_GetRecurringPayment_Call struct {
 }
 
 // GetRecurringPayment is a helper method to define mock.On call
+//   - ctx context.Context
 //   - id uuid.UUID
-func (_e *MockRecurringPaymentServiceInterface_Expecter) GetRecurringPayment(id interface{}) *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call {
-	return &MockRecurringPaymentServiceInterface_GetRecurringPayment_Call{Call: _e.mock.On("GetRecurringPayment", id)}
+func (_e *MockRecurringPaymentServiceInterface_Expecter) GetRecurringPayment(ctx interface{}, id interface{}) *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call {
+	return &MockRecurringPaymentServiceInterface_GetRecurringPayment_Call{Call: _e.mock.On("GetRecurringPayment", ctx, id)}
 }
 
-func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call) Run(run func(id uuid.UUID)) *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1136,14 +1307,14 @@ func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call) Return(
 	return _c
 }
 
-func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call) RunAndReturn(run func(id uuid.UUID) (*models.RecurringPayment, error)) *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error)) *MockRecurringPaymentServiceInterface_GetRecurringPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetRecurringPayments provides a mock function for the type MockRecurringPaymentServiceInterface
-func (_mock *MockRecurringPaymentServiceInterface) GetRecurringPayments(userID uuid.UUID) ([]models.RecurringPayment, error) {
-	ret := _mock.Called(userID)
+func (_mock *MockRecurringPaymentServiceInterface) GetRecurringPayments(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRecurringPayments")
@@ -1151,18 +1322,18 @@ func (_mock *MockRecurringPaymentServiceInterface) GetRecurringPayments(userID u
 
 	var r0 []models.RecurringPayment
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]models.RecurringPayment, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RecurringPayment, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []models.RecurringPayment); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RecurringPayment); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.RecurringPayment)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1175,19 +1346,25 @@ type MockRecurringPaymentServiceInterface_GetRecurringPayments_Call struct {
 }
 
 // GetRecurringPayments is a helper method to define mock.On call
+//   - ctx context.Context
 //   - userID uuid.UUID
-func (_e *MockRecurringPaymentServiceInterface_Expecter) GetRecurringPayments(userID interface{}) *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call {
-	return &MockRecurringPaymentServiceInterface_GetRecurringPayments_Call{Call: _e.mock.On("GetRecurringPayments", userID)}
+func (_e *MockRecurringPaymentServiceInterface_Expecter) GetRecurringPayments(ctx interface{}, userID interface{}) *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call {
+	return &MockRecurringPaymentServiceInterface_GetRecurringPayments_Call{Call: _e.mock.On("GetRecurringPayments", ctx, userID)}
 }
 
-func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call) Run(run func(userID uuid.UUID)) *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call {
+func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1198,22 +1375,22 @@ func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call) Return
 	return _c
 }
 
-func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call) RunAndReturn(run func(userID uuid.UUID) ([]models.RecurringPayment, error)) *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call {
+func (_c *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error)) *MockRecurringPaymentServiceInterface_GetRecurringPayments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateRecurringPayment provides a mock function for the type MockRecurringPaymentServiceInterface
-func (_mock *MockRecurringPaymentServiceInterface) UpdateRecurringPayment(payment *models.RecurringPayment) error {
-	ret := _mock.Called(payment)
+func (_mock *MockRecurringPaymentServiceInterface) UpdateRecurringPayment(ctx context.Context, payment *models.RecurringPayment) error {
+	ret := _mock.Called(ctx, payment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateRecurringPayment")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.RecurringPayment) error); ok {
-		r0 = returnFunc(payment)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.RecurringPayment) error); ok {
+		r0 = returnFunc(ctx, payment)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1226,19 +1403,25 @@ type MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call struct {
 }
 
 // UpdateRecurringPayment is a helper method to define mock.On call
+//   - ctx context.Context
 //   - payment *models.RecurringPayment
-func (_e *MockRecurringPaymentServiceInterface_Expecter) UpdateRecurringPayment(payment interface{}) *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call {
-	return &MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call{Call: _e.mock.On("UpdateRecurringPayment", payment)}
+func (_e *MockRecurringPaymentServiceInterface_Expecter) UpdateRecurringPayment(ctx interface{}, payment interface{}) *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call {
+	return &MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call{Call: _e.mock.On("UpdateRecurringPayment", ctx, payment)}
 }
 
-func (_c *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call) Run(run func(payment *models.RecurringPayment)) *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call) Run(run func(ctx context.Context, payment *models.RecurringPayment)) *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.RecurringPayment
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.RecurringPayment)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.RecurringPayment
+		if args[1] != nil {
+			arg1 = args[1].(*models.RecurringPayment)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1249,18 +1432,18 @@ func (_c *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call) Retu
 	return _c
 }
 
-func (_c *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call) RunAndReturn(run func(payment *models.RecurringPayment) error) *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call {
+func (_c *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call) RunAndReturn(run func(ctx context.Context, payment *models.RecurringPayment) error) *MockRecurringPaymentServiceInterface_UpdateRecurringPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIncomeServiceInterface creates a new instance of MockIncomeServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockRecurringTransferServiceInterface creates a new instance of MockRecurringTransferServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockIncomeServiceInterface(t interface {
+func NewMockRecurringTransferServiceInterface(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockIncomeServiceInterface {
-	mock := &MockIncomeServiceInterface{}
+}) *MockRecurringTransferServiceInterface {
+	mock := &MockRecurringTransferServiceInterface{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -1268,431 +1451,825 @@ func NewMockIncomeServiceInterface(t interface {
 	return mock
 }
 
-// MockIncomeServiceInterface is an autogenerated mock type for the IncomeServiceInterface type
-type MockIncomeServiceInterface struct {
+// MockRecurringTransferServiceInterface is an autogenerated mock type for the RecurringTransferServiceInterface type
+type MockRecurringTransferServiceInterface struct {
 	mock.Mock
 }
 
-type MockIncomeServiceInterface_Expecter struct {
+type MockRecurringTransferServiceInterface_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockIncomeServiceInterface) EXPECT() *MockIncomeServiceInterface_Expecter {
-	return &MockIncomeServiceInterface_Expecter{mock: &_m.Mock}
+func (_m *MockRecurringTransferServiceInterface) EXPECT() *MockRecurringTransferServiceInterface_Expecter {
+	return &MockRecurringTransferServiceInterface_Expecter{mock: &_m.Mock}
 }
 
-// CreateIncomeSource provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) CreateIncomeSource(source *models.IncomeSource) error {
-	ret := _mock.Called(source)
+// CreateRecurringTransfer provides a mock function for the type MockRecurringTransferServiceInterface
+func (_mock *MockRecurringTransferServiceInterface) CreateRecurringTransfer(ctx context.Context, transfer *models.RecurringTransfer) error {
+	ret := _mock.Called(ctx, transfer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateIncomeSource")
+		panic("no return value specified for CreateRecurringTransfer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.IncomeSource) error); ok {
-		r0 = returnFunc(source)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.RecurringTransfer) error); ok {
+		r0 = returnFunc(ctx, transfer)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIncomeServiceInterface_CreateIncomeSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIncomeSource'
-type MockIncomeServiceInterface_CreateIncomeSource_Call struct {
+// MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRecurringTransfer'
+type MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call struct {
 	*mock.Call
 }
 
-// CreateIncomeSource is a helper method to define mock.On call
-//   - source *models.IncomeSource
-func (_e *MockIncomeServiceInterface_Expecter) CreateIncomeSource(source interface{}) *MockIncomeServiceInterface_CreateIncomeSource_Call {
-	return &MockIncomeServiceInterface_CreateIncomeSource_Call{Call: _e.mock.On("CreateIncomeSource", source)}
+// CreateRecurringTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer *models.RecurringTransfer
+func (_e *MockRecurringTransferServiceInterface_Expecter) CreateRecurringTransfer(ctx interface{}, transfer interface{}) *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call {
+	return &MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call{Call: _e.mock.On("CreateRecurringTransfer", ctx, transfer)}
 }
 
-func (_c *MockIncomeServiceInterface_CreateIncomeSource_Call) Run(run func(source *models.IncomeSource)) *MockIncomeServiceInterface_CreateIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call) Run(run func(ctx context.Context, transfer *models.RecurringTransfer)) *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.IncomeSource
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.IncomeSource)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.RecurringTransfer
+		if args[1] != nil {
+			arg1 = args[1].(*models.RecurringTransfer)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_CreateIncomeSource_Call) Return(err error) *MockIncomeServiceInterface_CreateIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call) Return(err error) *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_CreateIncomeSource_Call) RunAndReturn(run func(source *models.IncomeSource) error) *MockIncomeServiceInterface_CreateIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call) RunAndReturn(run func(ctx context.Context, transfer *models.RecurringTransfer) error) *MockRecurringTransferServiceInterface_CreateRecurringTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) CreateMonthlyIncomeRecord(record *models.MonthlyIncomeRecord) error {
-	ret := _mock.Called(record)
+// DeleteRecurringTransfer provides a mock function for the type MockRecurringTransferServiceInterface
+func (_mock *MockRecurringTransferServiceInterface) DeleteRecurringTransfer(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateMonthlyIncomeRecord")
+		panic("no return value specified for DeleteRecurringTransfer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.MonthlyIncomeRecord) error); ok {
-		r0 = returnFunc(record)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMonthlyIncomeRecord'
-type MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call struct {
+// MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecurringTransfer'
+type MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call struct {
 	*mock.Call
 }
 
-// CreateMonthlyIncomeRecord is a helper method to define mock.On call
-//   - record *models.MonthlyIncomeRecord
-func (_e *MockIncomeServiceInterface_Expecter) CreateMonthlyIncomeRecord(record interface{}) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
-	return &MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call{Call: _e.mock.On("CreateMonthlyIncomeRecord", record)}
+// DeleteRecurringTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockRecurringTransferServiceInterface_Expecter) DeleteRecurringTransfer(ctx interface{}, userID interface{}, id interface{}) *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call {
+	return &MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call{Call: _e.mock.On("DeleteRecurringTransfer", ctx, userID, id)}
 }
 
-func (_c *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call) Run(run func(record *models.MonthlyIncomeRecord)) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
+func (_c *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.MonthlyIncomeRecord
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.MonthlyIncomeRecord)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call) Return(err error) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
+func (_c *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call) Return(err error) *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call) RunAndReturn(run func(record *models.MonthlyIncomeRecord) error) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
+func (_c *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockRecurringTransferServiceInterface_DeleteRecurringTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteIncomeSource provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) DeleteIncomeSource(id uuid.UUID) error {
-	ret := _mock.Called(id)
+// GetRecurringTransfer provides a mock function for the type MockRecurringTransferServiceInterface
+func (_mock *MockRecurringTransferServiceInterface) GetRecurringTransfer(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.RecurringTransfer, error) {
+	ret := _mock.Called(ctx, userID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteIncomeSource")
+		panic("no return value specified for GetRecurringTransfer")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = returnFunc(id)
+	var r0 *models.RecurringTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.RecurringTransfer, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.RecurringTransfer); ok {
+		r0 = returnFunc(ctx, userID, id)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RecurringTransfer)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIncomeServiceInterface_DeleteIncomeSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIncomeSource'
-type MockIncomeServiceInterface_DeleteIncomeSource_Call struct {
+// MockRecurringTransferServiceInterface_GetRecurringTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecurringTransfer'
+type MockRecurringTransferServiceInterface_GetRecurringTransfer_Call struct {
 	*mock.Call
 }
 
-// DeleteIncomeSource is a helper method to define mock.On call
+// GetRecurringTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
 //   - id uuid.UUID
-func (_e *MockIncomeServiceInterface_Expecter) DeleteIncomeSource(id interface{}) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
-	return &MockIncomeServiceInterface_DeleteIncomeSource_Call{Call: _e.mock.On("DeleteIncomeSource", id)}
+func (_e *MockRecurringTransferServiceInterface_Expecter) GetRecurringTransfer(ctx interface{}, userID interface{}, id interface{}) *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call {
+	return &MockRecurringTransferServiceInterface_GetRecurringTransfer_Call{Call: _e.mock.On("GetRecurringTransfer", ctx, userID, id)}
 }
 
-func (_c *MockIncomeServiceInterface_DeleteIncomeSource_Call) Run(run func(id uuid.UUID)) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_DeleteIncomeSource_Call) Return(err error) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
-	_c.Call.Return(err)
+func (_c *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call) Return(recurringTransfer *models.RecurringTransfer, err error) *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call {
+	_c.Call.Return(recurringTransfer, err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_DeleteIncomeSource_Call) RunAndReturn(run func(id uuid.UUID) error) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.RecurringTransfer, error)) *MockRecurringTransferServiceInterface_GetRecurringTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) DeleteMonthlyIncomeRecord(id uuid.UUID) error {
-	ret := _mock.Called(id)
+// GetRecurringTransfers provides a mock function for the type MockRecurringTransferServiceInterface
+func (_mock *MockRecurringTransferServiceInterface) GetRecurringTransfers(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteMonthlyIncomeRecord")
+		panic("no return value specified for GetRecurringTransfers")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) error); ok {
-		r0 = returnFunc(id)
+	var r0 []models.RecurringTransfer
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RecurringTransfer, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RecurringTransfer); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RecurringTransfer)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMonthlyIncomeRecord'
-type MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call struct {
+// MockRecurringTransferServiceInterface_GetRecurringTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecurringTransfers'
+type MockRecurringTransferServiceInterface_GetRecurringTransfers_Call struct {
 	*mock.Call
 }
 
-// DeleteMonthlyIncomeRecord is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *MockIncomeServiceInterface_Expecter) DeleteMonthlyIncomeRecord(id interface{}) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
-	return &MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call{Call: _e.mock.On("DeleteMonthlyIncomeRecord", id)}
+// GetRecurringTransfers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockRecurringTransferServiceInterface_Expecter) GetRecurringTransfers(ctx interface{}, userID interface{}) *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call {
+	return &MockRecurringTransferServiceInterface_GetRecurringTransfers_Call{Call: _e.mock.On("GetRecurringTransfers", ctx, userID)}
 }
 
-func (_c *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call) Run(run func(id uuid.UUID)) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
+func (_c *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call) Return(err error) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
-	_c.Call.Return(err)
+func (_c *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call) Return(recurringTransfers []models.RecurringTransfer, err error) *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call {
+	_c.Call.Return(recurringTransfers, err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call) RunAndReturn(run func(id uuid.UUID) error) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
+func (_c *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error)) *MockRecurringTransferServiceInterface_GetRecurringTransfers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIncomeSource provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) GetIncomeSource(id uuid.UUID) (*models.IncomeSource, error) {
-	ret := _mock.Called(id)
+// UpdateRecurringTransfer provides a mock function for the type MockRecurringTransferServiceInterface
+func (_mock *MockRecurringTransferServiceInterface) UpdateRecurringTransfer(ctx context.Context, userID uuid.UUID, transfer *models.RecurringTransfer) error {
+	ret := _mock.Called(ctx, userID, transfer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetIncomeSource")
+		panic("no return value specified for UpdateRecurringTransfer")
 	}
 
-	var r0 *models.IncomeSource
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (*models.IncomeSource, error)); ok {
-		return returnFunc(id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) *models.IncomeSource); ok {
-		r0 = returnFunc(id)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.IncomeSource)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(id)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.RecurringTransfer) error); ok {
+		r0 = returnFunc(ctx, userID, transfer)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIncomeServiceInterface_GetIncomeSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIncomeSource'
-type MockIncomeServiceInterface_GetIncomeSource_Call struct {
+// MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRecurringTransfer'
+type MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call struct {
 	*mock.Call
 }
 
-// GetIncomeSource is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *MockIncomeServiceInterface_Expecter) GetIncomeSource(id interface{}) *MockIncomeServiceInterface_GetIncomeSource_Call {
-	return &MockIncomeServiceInterface_GetIncomeSource_Call{Call: _e.mock.On("GetIncomeSource", id)}
+// UpdateRecurringTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - transfer *models.RecurringTransfer
+func (_e *MockRecurringTransferServiceInterface_Expecter) UpdateRecurringTransfer(ctx interface{}, userID interface{}, transfer interface{}) *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call {
+	return &MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call{Call: _e.mock.On("UpdateRecurringTransfer", ctx, userID, transfer)}
 }
 
-func (_c *MockIncomeServiceInterface_GetIncomeSource_Call) Run(run func(id uuid.UUID)) *MockIncomeServiceInterface_GetIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call) Run(run func(ctx context.Context, userID uuid.UUID, transfer *models.RecurringTransfer)) *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.RecurringTransfer
+		if args[2] != nil {
+			arg2 = args[2].(*models.RecurringTransfer)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetIncomeSource_Call) Return(incomeSource *models.IncomeSource, err error) *MockIncomeServiceInterface_GetIncomeSource_Call {
-	_c.Call.Return(incomeSource, err)
+func (_c *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call) Return(err error) *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetIncomeSource_Call) RunAndReturn(run func(id uuid.UUID) (*models.IncomeSource, error)) *MockIncomeServiceInterface_GetIncomeSource_Call {
+func (_c *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, transfer *models.RecurringTransfer) error) *MockRecurringTransferServiceInterface_UpdateRecurringTransfer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIncomeSources provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) GetIncomeSources(userID uuid.UUID) ([]models.IncomeSource, error) {
-	ret := _mock.Called(userID)
+// NewMockIncomeServiceInterface creates a new instance of MockIncomeServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIncomeServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIncomeServiceInterface {
+	mock := &MockIncomeServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIncomeServiceInterface is an autogenerated mock type for the IncomeServiceInterface type
+type MockIncomeServiceInterface struct {
+	mock.Mock
+}
+
+type MockIncomeServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIncomeServiceInterface) EXPECT() *MockIncomeServiceInterface_Expecter {
+	return &MockIncomeServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateIncomeSource provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) CreateIncomeSource(ctx context.Context, source *models.IncomeSource) error {
+	ret := _mock.Called(ctx, source)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetIncomeSources")
+		panic("no return value specified for CreateIncomeSource")
 	}
 
-	var r0 []models.IncomeSource
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]models.IncomeSource, error)); ok {
-		return returnFunc(userID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []models.IncomeSource); ok {
-		r0 = returnFunc(userID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.IncomeSource)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(userID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.IncomeSource) error); ok {
+		r0 = returnFunc(ctx, source)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIncomeServiceInterface_GetIncomeSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIncomeSources'
-type MockIncomeServiceInterface_GetIncomeSources_Call struct {
+// MockIncomeServiceInterface_CreateIncomeSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIncomeSource'
+type MockIncomeServiceInterface_CreateIncomeSource_Call struct {
 	*mock.Call
 }
 
-// GetIncomeSources is a helper method to define mock.On call
-//   - userID uuid.UUID
-func (_e *MockIncomeServiceInterface_Expecter) GetIncomeSources(userID interface{}) *MockIncomeServiceInterface_GetIncomeSources_Call {
-	return &MockIncomeServiceInterface_GetIncomeSources_Call{Call: _e.mock.On("GetIncomeSources", userID)}
+// CreateIncomeSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source *models.IncomeSource
+func (_e *MockIncomeServiceInterface_Expecter) CreateIncomeSource(ctx interface{}, source interface{}) *MockIncomeServiceInterface_CreateIncomeSource_Call {
+	return &MockIncomeServiceInterface_CreateIncomeSource_Call{Call: _e.mock.On("CreateIncomeSource", ctx, source)}
 }
 
-func (_c *MockIncomeServiceInterface_GetIncomeSources_Call) Run(run func(userID uuid.UUID)) *MockIncomeServiceInterface_GetIncomeSources_Call {
+func (_c *MockIncomeServiceInterface_CreateIncomeSource_Call) Run(run func(ctx context.Context, source *models.IncomeSource)) *MockIncomeServiceInterface_CreateIncomeSource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.IncomeSource
+		if args[1] != nil {
+			arg1 = args[1].(*models.IncomeSource)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetIncomeSources_Call) Return(incomeSources []models.IncomeSource, err error) *MockIncomeServiceInterface_GetIncomeSources_Call {
-	_c.Call.Return(incomeSources, err)
+func (_c *MockIncomeServiceInterface_CreateIncomeSource_Call) Return(err error) *MockIncomeServiceInterface_CreateIncomeSource_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetIncomeSources_Call) RunAndReturn(run func(userID uuid.UUID) ([]models.IncomeSource, error)) *MockIncomeServiceInterface_GetIncomeSources_Call {
+func (_c *MockIncomeServiceInterface_CreateIncomeSource_Call) RunAndReturn(run func(ctx context.Context, source *models.IncomeSource) error) *MockIncomeServiceInterface_CreateIncomeSource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) GetMonthlyIncomeRecord(id uuid.UUID) (*models.MonthlyIncomeRecord, error) {
-	ret := _mock.Called(id)
+// CreateMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) CreateMonthlyIncomeRecord(ctx context.Context, record *models.MonthlyIncomeRecord) error {
+	ret := _mock.Called(ctx, record)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMonthlyIncomeRecord")
+		panic("no return value specified for CreateMonthlyIncomeRecord")
 	}
 
-	var r0 *models.MonthlyIncomeRecord
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) (*models.MonthlyIncomeRecord, error)); ok {
-		return returnFunc(id)
-	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) *models.MonthlyIncomeRecord); ok {
-		r0 = returnFunc(id)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.MonthlyIncomeRecord)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(id)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.MonthlyIncomeRecord) error); ok {
+		r0 = returnFunc(ctx, record)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMonthlyIncomeRecord'
-type MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call struct {
+// MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMonthlyIncomeRecord'
+type MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call struct {
 	*mock.Call
 }
 
-// GetMonthlyIncomeRecord is a helper method to define mock.On call
-//   - id uuid.UUID
-func (_e *MockIncomeServiceInterface_Expecter) GetMonthlyIncomeRecord(id interface{}) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
-	return &MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call{Call: _e.mock.On("GetMonthlyIncomeRecord", id)}
+// CreateMonthlyIncomeRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record *models.MonthlyIncomeRecord
+func (_e *MockIncomeServiceInterface_Expecter) CreateMonthlyIncomeRecord(ctx interface{}, record interface{}) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
+	return &MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call{Call: _e.mock.On("CreateMonthlyIncomeRecord", ctx, record)}
 }
 
-func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call) Run(run func(id uuid.UUID)) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
+func (_c *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call) Run(run func(ctx context.Context, record *models.MonthlyIncomeRecord)) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.MonthlyIncomeRecord
+		if args[1] != nil {
+			arg1 = args[1].(*models.MonthlyIncomeRecord)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call) Return(monthlyIncomeRecord *models.MonthlyIncomeRecord, err error) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
-	_c.Call.Return(monthlyIncomeRecord, err)
+func (_c *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call) Return(err error) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call) RunAndReturn(run func(id uuid.UUID) (*models.MonthlyIncomeRecord, error)) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
+func (_c *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call) RunAndReturn(run func(ctx context.Context, record *models.MonthlyIncomeRecord) error) *MockIncomeServiceInterface_CreateMonthlyIncomeRecord_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMonthlyIncomeRecords provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) GetMonthlyIncomeRecords(incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error) {
-	ret := _mock.Called(incomeSourceID)
+// DeleteIncomeSource provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) DeleteIncomeSource(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMonthlyIncomeRecords")
+		panic("no return value specified for DeleteIncomeSource")
 	}
 
-	var r0 []models.MonthlyIncomeRecord
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]models.MonthlyIncomeRecord, error)); ok {
-		return returnFunc(incomeSourceID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []models.MonthlyIncomeRecord); ok {
-		r0 = returnFunc(incomeSourceID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIncomeServiceInterface_DeleteIncomeSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIncomeSource'
+type MockIncomeServiceInterface_DeleteIncomeSource_Call struct {
+	*mock.Call
+}
+
+// DeleteIncomeSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIncomeServiceInterface_Expecter) DeleteIncomeSource(ctx interface{}, id interface{}) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
+	return &MockIncomeServiceInterface_DeleteIncomeSource_Call{Call: _e.mock.On("DeleteIncomeSource", ctx, id)}
+}
+
+func (_c *MockIncomeServiceInterface_DeleteIncomeSource_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_DeleteIncomeSource_Call) Return(err error) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_DeleteIncomeSource_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockIncomeServiceInterface_DeleteIncomeSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) DeleteMonthlyIncomeRecord(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMonthlyIncomeRecord")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMonthlyIncomeRecord'
+type MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call struct {
+	*mock.Call
+}
+
+// DeleteMonthlyIncomeRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIncomeServiceInterface_Expecter) DeleteMonthlyIncomeRecord(ctx interface{}, id interface{}) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
+	return &MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call{Call: _e.mock.On("DeleteMonthlyIncomeRecord", ctx, id)}
+}
+
+func (_c *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call) Return(err error) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockIncomeServiceInterface_DeleteMonthlyIncomeRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIncomeSource provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) GetIncomeSource(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIncomeSource")
+	}
+
+	var r0 *models.IncomeSource
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.IncomeSource, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.IncomeSource); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.IncomeSource)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIncomeServiceInterface_GetIncomeSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIncomeSource'
+type MockIncomeServiceInterface_GetIncomeSource_Call struct {
+	*mock.Call
+}
+
+// GetIncomeSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIncomeServiceInterface_Expecter) GetIncomeSource(ctx interface{}, id interface{}) *MockIncomeServiceInterface_GetIncomeSource_Call {
+	return &MockIncomeServiceInterface_GetIncomeSource_Call{Call: _e.mock.On("GetIncomeSource", ctx, id)}
+}
+
+func (_c *MockIncomeServiceInterface_GetIncomeSource_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIncomeServiceInterface_GetIncomeSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_GetIncomeSource_Call) Return(incomeSource *models.IncomeSource, err error) *MockIncomeServiceInterface_GetIncomeSource_Call {
+	_c.Call.Return(incomeSource, err)
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_GetIncomeSource_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error)) *MockIncomeServiceInterface_GetIncomeSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIncomeSources provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) GetIncomeSources(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIncomeSources")
+	}
+
+	var r0 []models.IncomeSource
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.IncomeSource, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.IncomeSource); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.IncomeSource)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIncomeServiceInterface_GetIncomeSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIncomeSources'
+type MockIncomeServiceInterface_GetIncomeSources_Call struct {
+	*mock.Call
+}
+
+// GetIncomeSources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockIncomeServiceInterface_Expecter) GetIncomeSources(ctx interface{}, userID interface{}) *MockIncomeServiceInterface_GetIncomeSources_Call {
+	return &MockIncomeServiceInterface_GetIncomeSources_Call{Call: _e.mock.On("GetIncomeSources", ctx, userID)}
+}
+
+func (_c *MockIncomeServiceInterface_GetIncomeSources_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockIncomeServiceInterface_GetIncomeSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_GetIncomeSources_Call) Return(incomeSources []models.IncomeSource, err error) *MockIncomeServiceInterface_GetIncomeSources_Call {
+	_c.Call.Return(incomeSources, err)
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_GetIncomeSources_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error)) *MockIncomeServiceInterface_GetIncomeSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) GetMonthlyIncomeRecord(ctx context.Context, id uuid.UUID) (*models.MonthlyIncomeRecord, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlyIncomeRecord")
+	}
+
+	var r0 *models.MonthlyIncomeRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.MonthlyIncomeRecord, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.MonthlyIncomeRecord); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.MonthlyIncomeRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMonthlyIncomeRecord'
+type MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call struct {
+	*mock.Call
+}
+
+// GetMonthlyIncomeRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIncomeServiceInterface_Expecter) GetMonthlyIncomeRecord(ctx interface{}, id interface{}) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
+	return &MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call{Call: _e.mock.On("GetMonthlyIncomeRecord", ctx, id)}
+}
+
+func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call) Return(monthlyIncomeRecord *models.MonthlyIncomeRecord, err error) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
+	_c.Call.Return(monthlyIncomeRecord, err)
+	return _c
+}
+
+func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.MonthlyIncomeRecord, error)) *MockIncomeServiceInterface_GetMonthlyIncomeRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMonthlyIncomeRecords provides a mock function for the type MockIncomeServiceInterface
+func (_mock *MockIncomeServiceInterface) GetMonthlyIncomeRecords(ctx context.Context, incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error) {
+	ret := _mock.Called(ctx, incomeSourceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlyIncomeRecords")
+	}
+
+	var r0 []models.MonthlyIncomeRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.MonthlyIncomeRecord, error)); ok {
+		return returnFunc(ctx, incomeSourceID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.MonthlyIncomeRecord); ok {
+		r0 = returnFunc(ctx, incomeSourceID)
+	} else {
+		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.MonthlyIncomeRecord)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
-		r1 = returnFunc(incomeSourceID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, incomeSourceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1705,19 +2282,25 @@ type MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call struct {
 }
 
 // GetMonthlyIncomeRecords is a helper method to define mock.On call
+//   - ctx context.Context
 //   - incomeSourceID uuid.UUID
-func (_e *MockIncomeServiceInterface_Expecter) GetMonthlyIncomeRecords(incomeSourceID interface{}) *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call {
-	return &MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call{Call: _e.mock.On("GetMonthlyIncomeRecords", incomeSourceID)}
+func (_e *MockIncomeServiceInterface_Expecter) GetMonthlyIncomeRecords(ctx interface{}, incomeSourceID interface{}) *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call {
+	return &MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call{Call: _e.mock.On("GetMonthlyIncomeRecords", ctx, incomeSourceID)}
 }
 
-func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call) Run(run func(incomeSourceID uuid.UUID)) *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call {
+func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call) Run(run func(ctx context.Context, incomeSourceID uuid.UUID)) *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 uuid.UUID
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(uuid.UUID)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1728,22 +2311,22 @@ func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call) Return(monthl
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call) RunAndReturn(run func(incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error)) *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call {
+func (_c *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call) RunAndReturn(run func(ctx context.Context, incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error)) *MockIncomeServiceInterface_GetMonthlyIncomeRecords_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateIncomeSource provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) UpdateIncomeSource(source *models.IncomeSource) error {
-	ret := _mock.Called(source)
+func (_mock *MockIncomeServiceInterface) UpdateIncomeSource(ctx context.Context, source *models.IncomeSource) error {
+	ret := _mock.Called(ctx, source)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateIncomeSource")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.IncomeSource) error); ok {
-		r0 = returnFunc(source)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.IncomeSource) error); ok {
+		r0 = returnFunc(ctx, source)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1756,19 +2339,25 @@ type MockIncomeServiceInterface_UpdateIncomeSource_Call struct {
 }
 
 // UpdateIncomeSource is a helper method to define mock.On call
+//   - ctx context.Context
 //   - source *models.IncomeSource
-func (_e *MockIncomeServiceInterface_Expecter) UpdateIncomeSource(source interface{}) *MockIncomeServiceInterface_UpdateIncomeSource_Call {
-	return &MockIncomeServiceInterface_UpdateIncomeSource_Call{Call: _e.mock.On("UpdateIncomeSource", source)}
+func (_e *MockIncomeServiceInterface_Expecter) UpdateIncomeSource(ctx interface{}, source interface{}) *MockIncomeServiceInterface_UpdateIncomeSource_Call {
+	return &MockIncomeServiceInterface_UpdateIncomeSource_Call{Call: _e.mock.On("UpdateIncomeSource", ctx, source)}
 }
 
-func (_c *MockIncomeServiceInterface_UpdateIncomeSource_Call) Run(run func(source *models.IncomeSource)) *MockIncomeServiceInterface_UpdateIncomeSource_Call {
+func (_c *MockIncomeServiceInterface_UpdateIncomeSource_Call) Run(run func(ctx context.Context, source *models.IncomeSource)) *MockIncomeServiceInterface_UpdateIncomeSource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.IncomeSource
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.IncomeSource)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.IncomeSource
+		if args[1] != nil {
+			arg1 = args[1].(*models.IncomeSource)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1779,22 +2368,22 @@ func (_c *MockIncomeServiceInterface_UpdateIncomeSource_Call) Return(err error)
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_UpdateIncomeSource_Call) RunAndReturn(run func(source *models.IncomeSource) error) *MockIncomeServiceInterface_UpdateIncomeSource_Call {
+func (_c *MockIncomeServiceInterface_UpdateIncomeSource_Call) RunAndReturn(run func(ctx context.Context, source *models.IncomeSource) error) *MockIncomeServiceInterface_UpdateIncomeSource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateMonthlyIncomeRecord provides a mock function for the type MockIncomeServiceInterface
-func (_mock *MockIncomeServiceInterface) UpdateMonthlyIncomeRecord(record *models.MonthlyIncomeRecord) error {
-	ret := _mock.Called(record)
+func (_mock *MockIncomeServiceInterface) UpdateMonthlyIncomeRecord(ctx context.Context, record *models.MonthlyIncomeRecord) error {
+	ret := _mock.Called(ctx, record)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateMonthlyIncomeRecord")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*models.MonthlyIncomeRecord) error); ok {
-		r0 = returnFunc(record)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.MonthlyIncomeRecord) error); ok {
+		r0 = returnFunc(ctx, record)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1807,19 +2396,25 @@ type MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call struct {
 }
 
 // UpdateMonthlyIncomeRecord is a helper method to define mock.On call
+//   - ctx context.Context
 //   - record *models.MonthlyIncomeRecord
-func (_e *MockIncomeServiceInterface_Expecter) UpdateMonthlyIncomeRecord(record interface{}) *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call {
-	return &MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call{Call: _e.mock.On("UpdateMonthlyIncomeRecord", record)}
+func (_e *MockIncomeServiceInterface_Expecter) UpdateMonthlyIncomeRecord(ctx interface{}, record interface{}) *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call {
+	return &MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call{Call: _e.mock.On("UpdateMonthlyIncomeRecord", ctx, record)}
 }
 
-func (_c *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call) Run(run func(record *models.MonthlyIncomeRecord)) *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call {
+func (_c *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call) Run(run func(ctx context.Context, record *models.MonthlyIncomeRecord)) *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *models.MonthlyIncomeRecord
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*models.MonthlyIncomeRecord)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.MonthlyIncomeRecord
+		if args[1] != nil {
+			arg1 = args[1].(*models.MonthlyIncomeRecord)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -1830,7 +2425,4508 @@ func (_c *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call) Return(err
 	return _c
 }
 
-func (_c *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call) RunAndReturn(run func(record *models.MonthlyIncomeRecord) error) *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call {
+func (_c *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call) RunAndReturn(run func(ctx context.Context, record *models.MonthlyIncomeRecord) error) *MockIncomeServiceInterface_UpdateMonthlyIncomeRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJobSchedulerInterface creates a new instance of MockJobSchedulerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJobSchedulerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJobSchedulerInterface {
+	mock := &MockJobSchedulerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockJobSchedulerInterface is an autogenerated mock type for the JobSchedulerInterface type
+type MockJobSchedulerInterface struct {
+	mock.Mock
+}
+
+type MockJobSchedulerInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJobSchedulerInterface) EXPECT() *MockJobSchedulerInterface_Expecter {
+	return &MockJobSchedulerInterface_Expecter{mock: &_m.Mock}
+}
+
+// Status provides a mock function for the type MockJobSchedulerInterface
+func (_mock *MockJobSchedulerInterface) Status(ctx context.Context) ([]models.JobStatus, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 []models.JobStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]models.JobStatus, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []models.JobStatus); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JobStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockJobSchedulerInterface_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockJobSchedulerInterface_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockJobSchedulerInterface_Expecter) Status(ctx interface{}) *MockJobSchedulerInterface_Status_Call {
+	return &MockJobSchedulerInterface_Status_Call{Call: _e.mock.On("Status", ctx)}
+}
+
+func (_c *MockJobSchedulerInterface_Status_Call) Run(run func(ctx context.Context)) *MockJobSchedulerInterface_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockJobSchedulerInterface_Status_Call) Return(jobStatuss []models.JobStatus, err error) *MockJobSchedulerInterface_Status_Call {
+	_c.Call.Return(jobStatuss, err)
+	return _c
+}
+
+func (_c *MockJobSchedulerInterface_Status_Call) RunAndReturn(run func(ctx context.Context) ([]models.JobStatus, error)) *MockJobSchedulerInterface_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSavingsGoalServiceInterface creates a new instance of MockSavingsGoalServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSavingsGoalServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSavingsGoalServiceInterface {
+	mock := &MockSavingsGoalServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSavingsGoalServiceInterface is an autogenerated mock type for the SavingsGoalServiceInterface type
+type MockSavingsGoalServiceInterface struct {
+	mock.Mock
+}
+
+type MockSavingsGoalServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSavingsGoalServiceInterface) EXPECT() *MockSavingsGoalServiceInterface_Expecter {
+	return &MockSavingsGoalServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateSavingsGoal provides a mock function for the type MockSavingsGoalServiceInterface
+func (_mock *MockSavingsGoalServiceInterface) CreateSavingsGoal(ctx context.Context, goal *models.SavingsGoal) error {
+	ret := _mock.Called(ctx, goal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSavingsGoal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.SavingsGoal) error); ok {
+		r0 = returnFunc(ctx, goal)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSavingsGoalServiceInterface_CreateSavingsGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSavingsGoal'
+type MockSavingsGoalServiceInterface_CreateSavingsGoal_Call struct {
+	*mock.Call
+}
+
+// CreateSavingsGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - goal *models.SavingsGoal
+func (_e *MockSavingsGoalServiceInterface_Expecter) CreateSavingsGoal(ctx interface{}, goal interface{}) *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call {
+	return &MockSavingsGoalServiceInterface_CreateSavingsGoal_Call{Call: _e.mock.On("CreateSavingsGoal", ctx, goal)}
+}
+
+func (_c *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call) Run(run func(ctx context.Context, goal *models.SavingsGoal)) *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.SavingsGoal
+		if args[1] != nil {
+			arg1 = args[1].(*models.SavingsGoal)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call) Return(err error) *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call) RunAndReturn(run func(ctx context.Context, goal *models.SavingsGoal) error) *MockSavingsGoalServiceInterface_CreateSavingsGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSavingsGoal provides a mock function for the type MockSavingsGoalServiceInterface
+func (_mock *MockSavingsGoalServiceInterface) DeleteSavingsGoal(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSavingsGoal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSavingsGoal'
+type MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call struct {
+	*mock.Call
+}
+
+// DeleteSavingsGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockSavingsGoalServiceInterface_Expecter) DeleteSavingsGoal(ctx interface{}, userID interface{}, id interface{}) *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call {
+	return &MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call{Call: _e.mock.On("DeleteSavingsGoal", ctx, userID, id)}
+}
+
+func (_c *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call) Return(err error) *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockSavingsGoalServiceInterface_DeleteSavingsGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavingsGoal provides a mock function for the type MockSavingsGoalServiceInterface
+func (_mock *MockSavingsGoalServiceInterface) GetSavingsGoal(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.SavingsGoal, error) {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavingsGoal")
+	}
+
+	var r0 *models.SavingsGoal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.SavingsGoal, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.SavingsGoal); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SavingsGoal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSavingsGoalServiceInterface_GetSavingsGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavingsGoal'
+type MockSavingsGoalServiceInterface_GetSavingsGoal_Call struct {
+	*mock.Call
+}
+
+// GetSavingsGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockSavingsGoalServiceInterface_Expecter) GetSavingsGoal(ctx interface{}, userID interface{}, id interface{}) *MockSavingsGoalServiceInterface_GetSavingsGoal_Call {
+	return &MockSavingsGoalServiceInterface_GetSavingsGoal_Call{Call: _e.mock.On("GetSavingsGoal", ctx, userID, id)}
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoal_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockSavingsGoalServiceInterface_GetSavingsGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoal_Call) Return(savingsGoal *models.SavingsGoal, err error) *MockSavingsGoalServiceInterface_GetSavingsGoal_Call {
+	_c.Call.Return(savingsGoal, err)
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoal_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.SavingsGoal, error)) *MockSavingsGoalServiceInterface_GetSavingsGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavingsGoalProgress provides a mock function for the type MockSavingsGoalServiceInterface
+func (_mock *MockSavingsGoalServiceInterface) GetSavingsGoalProgress(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoalProgress, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavingsGoalProgress")
+	}
+
+	var r0 []models.SavingsGoalProgress
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.SavingsGoalProgress, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.SavingsGoalProgress); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SavingsGoalProgress)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavingsGoalProgress'
+type MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call struct {
+	*mock.Call
+}
+
+// GetSavingsGoalProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockSavingsGoalServiceInterface_Expecter) GetSavingsGoalProgress(ctx interface{}, userID interface{}) *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call {
+	return &MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call{Call: _e.mock.On("GetSavingsGoalProgress", ctx, userID)}
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call) Return(savingsGoalProgresss []models.SavingsGoalProgress, err error) *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call {
+	_c.Call.Return(savingsGoalProgresss, err)
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoalProgress, error)) *MockSavingsGoalServiceInterface_GetSavingsGoalProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSavingsGoals provides a mock function for the type MockSavingsGoalServiceInterface
+func (_mock *MockSavingsGoalServiceInterface) GetSavingsGoals(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSavingsGoals")
+	}
+
+	var r0 []models.SavingsGoal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.SavingsGoal, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.SavingsGoal); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SavingsGoal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSavingsGoalServiceInterface_GetSavingsGoals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSavingsGoals'
+type MockSavingsGoalServiceInterface_GetSavingsGoals_Call struct {
+	*mock.Call
+}
+
+// GetSavingsGoals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockSavingsGoalServiceInterface_Expecter) GetSavingsGoals(ctx interface{}, userID interface{}) *MockSavingsGoalServiceInterface_GetSavingsGoals_Call {
+	return &MockSavingsGoalServiceInterface_GetSavingsGoals_Call{Call: _e.mock.On("GetSavingsGoals", ctx, userID)}
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoals_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockSavingsGoalServiceInterface_GetSavingsGoals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoals_Call) Return(savingsGoals []models.SavingsGoal, err error) *MockSavingsGoalServiceInterface_GetSavingsGoals_Call {
+	_c.Call.Return(savingsGoals, err)
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_GetSavingsGoals_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error)) *MockSavingsGoalServiceInterface_GetSavingsGoals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSavingsGoal provides a mock function for the type MockSavingsGoalServiceInterface
+func (_mock *MockSavingsGoalServiceInterface) UpdateSavingsGoal(ctx context.Context, userID uuid.UUID, goal *models.SavingsGoal) error {
+	ret := _mock.Called(ctx, userID, goal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSavingsGoal")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.SavingsGoal) error); ok {
+		r0 = returnFunc(ctx, userID, goal)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSavingsGoal'
+type MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call struct {
+	*mock.Call
+}
+
+// UpdateSavingsGoal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - goal *models.SavingsGoal
+func (_e *MockSavingsGoalServiceInterface_Expecter) UpdateSavingsGoal(ctx interface{}, userID interface{}, goal interface{}) *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call {
+	return &MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call{Call: _e.mock.On("UpdateSavingsGoal", ctx, userID, goal)}
+}
+
+func (_c *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call) Run(run func(ctx context.Context, userID uuid.UUID, goal *models.SavingsGoal)) *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.SavingsGoal
+		if args[2] != nil {
+			arg2 = args[2].(*models.SavingsGoal)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call) Return(err error) *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, goal *models.SavingsGoal) error) *MockSavingsGoalServiceInterface_UpdateSavingsGoal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBudgetServiceInterface creates a new instance of MockBudgetServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBudgetServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBudgetServiceInterface {
+	mock := &MockBudgetServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBudgetServiceInterface is an autogenerated mock type for the BudgetServiceInterface type
+type MockBudgetServiceInterface struct {
+	mock.Mock
+}
+
+type MockBudgetServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBudgetServiceInterface) EXPECT() *MockBudgetServiceInterface_Expecter {
+	return &MockBudgetServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateBudget provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) CreateBudget(ctx context.Context, budget *models.Budget) error {
+	ret := _mock.Called(ctx, budget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBudget")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Budget) error); ok {
+		r0 = returnFunc(ctx, budget)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_CreateBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBudget'
+type MockBudgetServiceInterface_CreateBudget_Call struct {
+	*mock.Call
+}
+
+// CreateBudget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - budget *models.Budget
+func (_e *MockBudgetServiceInterface_Expecter) CreateBudget(ctx interface{}, budget interface{}) *MockBudgetServiceInterface_CreateBudget_Call {
+	return &MockBudgetServiceInterface_CreateBudget_Call{Call: _e.mock.On("CreateBudget", ctx, budget)}
+}
+
+func (_c *MockBudgetServiceInterface_CreateBudget_Call) Run(run func(ctx context.Context, budget *models.Budget)) *MockBudgetServiceInterface_CreateBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Budget
+		if args[1] != nil {
+			arg1 = args[1].(*models.Budget)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_CreateBudget_Call) Return(err error) *MockBudgetServiceInterface_CreateBudget_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_CreateBudget_Call) RunAndReturn(run func(ctx context.Context, budget *models.Budget) error) *MockBudgetServiceInterface_CreateBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) CreateCategory(ctx context.Context, category *models.Category) error {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Category) error); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type MockBudgetServiceInterface_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *models.Category
+func (_e *MockBudgetServiceInterface_Expecter) CreateCategory(ctx interface{}, category interface{}) *MockBudgetServiceInterface_CreateCategory_Call {
+	return &MockBudgetServiceInterface_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
+}
+
+func (_c *MockBudgetServiceInterface_CreateCategory_Call) Run(run func(ctx context.Context, category *models.Category)) *MockBudgetServiceInterface_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Category
+		if args[1] != nil {
+			arg1 = args[1].(*models.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_CreateCategory_Call) Return(err error) *MockBudgetServiceInterface_CreateCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_CreateCategory_Call) RunAndReturn(run func(ctx context.Context, category *models.Category) error) *MockBudgetServiceInterface_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTransaction provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) CreateTransaction(ctx context.Context, transaction *models.Transaction) error {
+	ret := _mock.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTransaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Transaction) error); ok {
+		r0 = returnFunc(ctx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_CreateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTransaction'
+type MockBudgetServiceInterface_CreateTransaction_Call struct {
+	*mock.Call
+}
+
+// CreateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction *models.Transaction
+func (_e *MockBudgetServiceInterface_Expecter) CreateTransaction(ctx interface{}, transaction interface{}) *MockBudgetServiceInterface_CreateTransaction_Call {
+	return &MockBudgetServiceInterface_CreateTransaction_Call{Call: _e.mock.On("CreateTransaction", ctx, transaction)}
+}
+
+func (_c *MockBudgetServiceInterface_CreateTransaction_Call) Run(run func(ctx context.Context, transaction *models.Transaction)) *MockBudgetServiceInterface_CreateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Transaction
+		if args[1] != nil {
+			arg1 = args[1].(*models.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_CreateTransaction_Call) Return(err error) *MockBudgetServiceInterface_CreateTransaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_CreateTransaction_Call) RunAndReturn(run func(ctx context.Context, transaction *models.Transaction) error) *MockBudgetServiceInterface_CreateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBudget provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) DeleteBudget(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBudget")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_DeleteBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBudget'
+type MockBudgetServiceInterface_DeleteBudget_Call struct {
+	*mock.Call
+}
+
+// DeleteBudget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) DeleteBudget(ctx interface{}, userID interface{}, id interface{}) *MockBudgetServiceInterface_DeleteBudget_Call {
+	return &MockBudgetServiceInterface_DeleteBudget_Call{Call: _e.mock.On("DeleteBudget", ctx, userID, id)}
+}
+
+func (_c *MockBudgetServiceInterface_DeleteBudget_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockBudgetServiceInterface_DeleteBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_DeleteBudget_Call) Return(err error) *MockBudgetServiceInterface_DeleteBudget_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_DeleteBudget_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockBudgetServiceInterface_DeleteBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) DeleteCategory(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type MockBudgetServiceInterface_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) DeleteCategory(ctx interface{}, userID interface{}, id interface{}) *MockBudgetServiceInterface_DeleteCategory_Call {
+	return &MockBudgetServiceInterface_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, userID, id)}
+}
+
+func (_c *MockBudgetServiceInterface_DeleteCategory_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockBudgetServiceInterface_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_DeleteCategory_Call) Return(err error) *MockBudgetServiceInterface_DeleteCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_DeleteCategory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockBudgetServiceInterface_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTransaction provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) DeleteTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTransaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_DeleteTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTransaction'
+type MockBudgetServiceInterface_DeleteTransaction_Call struct {
+	*mock.Call
+}
+
+// DeleteTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) DeleteTransaction(ctx interface{}, userID interface{}, id interface{}) *MockBudgetServiceInterface_DeleteTransaction_Call {
+	return &MockBudgetServiceInterface_DeleteTransaction_Call{Call: _e.mock.On("DeleteTransaction", ctx, userID, id)}
+}
+
+func (_c *MockBudgetServiceInterface_DeleteTransaction_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockBudgetServiceInterface_DeleteTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_DeleteTransaction_Call) Return(err error) *MockBudgetServiceInterface_DeleteTransaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_DeleteTransaction_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockBudgetServiceInterface_DeleteTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBudget provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetBudget(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Budget, error) {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBudget")
+	}
+
+	var r0 *models.Budget
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Budget, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Budget); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Budget)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBudget'
+type MockBudgetServiceInterface_GetBudget_Call struct {
+	*mock.Call
+}
+
+// GetBudget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) GetBudget(ctx interface{}, userID interface{}, id interface{}) *MockBudgetServiceInterface_GetBudget_Call {
+	return &MockBudgetServiceInterface_GetBudget_Call{Call: _e.mock.On("GetBudget", ctx, userID, id)}
+}
+
+func (_c *MockBudgetServiceInterface_GetBudget_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockBudgetServiceInterface_GetBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetBudget_Call) Return(budget *models.Budget, err error) *MockBudgetServiceInterface_GetBudget_Call {
+	_c.Call.Return(budget, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetBudget_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Budget, error)) *MockBudgetServiceInterface_GetBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBudgetReport provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetBudgetReport(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.BudgetReport, error) {
+	ret := _mock.Called(ctx, userID, yearMonth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBudgetReport")
+	}
+
+	var r0 *models.BudgetReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*models.BudgetReport, error)); ok {
+		return returnFunc(ctx, userID, yearMonth)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *models.BudgetReport); ok {
+		r0 = returnFunc(ctx, userID, yearMonth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BudgetReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, yearMonth)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetBudgetReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBudgetReport'
+type MockBudgetServiceInterface_GetBudgetReport_Call struct {
+	*mock.Call
+}
+
+// GetBudgetReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - yearMonth string
+func (_e *MockBudgetServiceInterface_Expecter) GetBudgetReport(ctx interface{}, userID interface{}, yearMonth interface{}) *MockBudgetServiceInterface_GetBudgetReport_Call {
+	return &MockBudgetServiceInterface_GetBudgetReport_Call{Call: _e.mock.On("GetBudgetReport", ctx, userID, yearMonth)}
+}
+
+func (_c *MockBudgetServiceInterface_GetBudgetReport_Call) Run(run func(ctx context.Context, userID uuid.UUID, yearMonth string)) *MockBudgetServiceInterface_GetBudgetReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetBudgetReport_Call) Return(budgetReport *models.BudgetReport, err error) *MockBudgetServiceInterface_GetBudgetReport_Call {
+	_c.Call.Return(budgetReport, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetBudgetReport_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.BudgetReport, error)) *MockBudgetServiceInterface_GetBudgetReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBudgets provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetBudgets(ctx context.Context, userID uuid.UUID) ([]models.Budget, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBudgets")
+	}
+
+	var r0 []models.Budget
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Budget, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Budget); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Budget)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetBudgets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBudgets'
+type MockBudgetServiceInterface_GetBudgets_Call struct {
+	*mock.Call
+}
+
+// GetBudgets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) GetBudgets(ctx interface{}, userID interface{}) *MockBudgetServiceInterface_GetBudgets_Call {
+	return &MockBudgetServiceInterface_GetBudgets_Call{Call: _e.mock.On("GetBudgets", ctx, userID)}
+}
+
+func (_c *MockBudgetServiceInterface_GetBudgets_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockBudgetServiceInterface_GetBudgets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetBudgets_Call) Return(budgets []models.Budget, err error) *MockBudgetServiceInterface_GetBudgets_Call {
+	_c.Call.Return(budgets, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetBudgets_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.Budget, error)) *MockBudgetServiceInterface_GetBudgets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategories provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetCategories(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategories")
+	}
+
+	var r0 []models.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Category, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Category); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategories'
+type MockBudgetServiceInterface_GetCategories_Call struct {
+	*mock.Call
+}
+
+// GetCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) GetCategories(ctx interface{}, userID interface{}) *MockBudgetServiceInterface_GetCategories_Call {
+	return &MockBudgetServiceInterface_GetCategories_Call{Call: _e.mock.On("GetCategories", ctx, userID)}
+}
+
+func (_c *MockBudgetServiceInterface_GetCategories_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockBudgetServiceInterface_GetCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetCategories_Call) Return(categorys []models.Category, err error) *MockBudgetServiceInterface_GetCategories_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetCategories_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.Category, error)) *MockBudgetServiceInterface_GetCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetCategory(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Category, error) {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 *models.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Category, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Category); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type MockBudgetServiceInterface_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) GetCategory(ctx interface{}, userID interface{}, id interface{}) *MockBudgetServiceInterface_GetCategory_Call {
+	return &MockBudgetServiceInterface_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, userID, id)}
+}
+
+func (_c *MockBudgetServiceInterface_GetCategory_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockBudgetServiceInterface_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetCategory_Call) Return(category *models.Category, err error) *MockBudgetServiceInterface_GetCategory_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetCategory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Category, error)) *MockBudgetServiceInterface_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategoryBreakdown provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetCategoryBreakdown(ctx context.Context, userID uuid.UUID, months int, topLevel bool) ([]models.CategoryBreakdown, error) {
+	ret := _mock.Called(ctx, userID, months, topLevel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategoryBreakdown")
+	}
+
+	var r0 []models.CategoryBreakdown
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, bool) ([]models.CategoryBreakdown, error)); ok {
+		return returnFunc(ctx, userID, months, topLevel)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, bool) []models.CategoryBreakdown); ok {
+		r0 = returnFunc(ctx, userID, months, topLevel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CategoryBreakdown)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, bool) error); ok {
+		r1 = returnFunc(ctx, userID, months, topLevel)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetCategoryBreakdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategoryBreakdown'
+type MockBudgetServiceInterface_GetCategoryBreakdown_Call struct {
+	*mock.Call
+}
+
+// GetCategoryBreakdown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - months int
+//   - topLevel bool
+func (_e *MockBudgetServiceInterface_Expecter) GetCategoryBreakdown(ctx interface{}, userID interface{}, months interface{}, topLevel interface{}) *MockBudgetServiceInterface_GetCategoryBreakdown_Call {
+	return &MockBudgetServiceInterface_GetCategoryBreakdown_Call{Call: _e.mock.On("GetCategoryBreakdown", ctx, userID, months, topLevel)}
+}
+
+func (_c *MockBudgetServiceInterface_GetCategoryBreakdown_Call) Run(run func(ctx context.Context, userID uuid.UUID, months int, topLevel bool)) *MockBudgetServiceInterface_GetCategoryBreakdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetCategoryBreakdown_Call) Return(categoryBreakdowns []models.CategoryBreakdown, err error) *MockBudgetServiceInterface_GetCategoryBreakdown_Call {
+	_c.Call.Return(categoryBreakdowns, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetCategoryBreakdown_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, months int, topLevel bool) ([]models.CategoryBreakdown, error)) *MockBudgetServiceInterface_GetCategoryBreakdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransaction provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetTransaction(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Transaction, error) {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *models.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Transaction, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Transaction); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransaction'
+type MockBudgetServiceInterface_GetTransaction_Call struct {
+	*mock.Call
+}
+
+// GetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockBudgetServiceInterface_Expecter) GetTransaction(ctx interface{}, userID interface{}, id interface{}) *MockBudgetServiceInterface_GetTransaction_Call {
+	return &MockBudgetServiceInterface_GetTransaction_Call{Call: _e.mock.On("GetTransaction", ctx, userID, id)}
+}
+
+func (_c *MockBudgetServiceInterface_GetTransaction_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockBudgetServiceInterface_GetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetTransaction_Call) Return(transaction *models.Transaction, err error) *MockBudgetServiceInterface_GetTransaction_Call {
+	_c.Call.Return(transaction, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetTransaction_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Transaction, error)) *MockBudgetServiceInterface_GetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactions provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) GetTransactions(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.Transaction, error) {
+	ret := _mock.Called(ctx, userID, yearMonth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactions")
+	}
+
+	var r0 []models.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.Transaction, error)); ok {
+		return returnFunc(ctx, userID, yearMonth)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.Transaction); ok {
+		r0 = returnFunc(ctx, userID, yearMonth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, yearMonth)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBudgetServiceInterface_GetTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactions'
+type MockBudgetServiceInterface_GetTransactions_Call struct {
+	*mock.Call
+}
+
+// GetTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - yearMonth string
+func (_e *MockBudgetServiceInterface_Expecter) GetTransactions(ctx interface{}, userID interface{}, yearMonth interface{}) *MockBudgetServiceInterface_GetTransactions_Call {
+	return &MockBudgetServiceInterface_GetTransactions_Call{Call: _e.mock.On("GetTransactions", ctx, userID, yearMonth)}
+}
+
+func (_c *MockBudgetServiceInterface_GetTransactions_Call) Run(run func(ctx context.Context, userID uuid.UUID, yearMonth string)) *MockBudgetServiceInterface_GetTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetTransactions_Call) Return(transactions []models.Transaction, err error) *MockBudgetServiceInterface_GetTransactions_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_GetTransactions_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.Transaction, error)) *MockBudgetServiceInterface_GetTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBudget provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) UpdateBudget(ctx context.Context, userID uuid.UUID, budget *models.Budget) error {
+	ret := _mock.Called(ctx, userID, budget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBudget")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.Budget) error); ok {
+		r0 = returnFunc(ctx, userID, budget)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_UpdateBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBudget'
+type MockBudgetServiceInterface_UpdateBudget_Call struct {
+	*mock.Call
+}
+
+// UpdateBudget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - budget *models.Budget
+func (_e *MockBudgetServiceInterface_Expecter) UpdateBudget(ctx interface{}, userID interface{}, budget interface{}) *MockBudgetServiceInterface_UpdateBudget_Call {
+	return &MockBudgetServiceInterface_UpdateBudget_Call{Call: _e.mock.On("UpdateBudget", ctx, userID, budget)}
+}
+
+func (_c *MockBudgetServiceInterface_UpdateBudget_Call) Run(run func(ctx context.Context, userID uuid.UUID, budget *models.Budget)) *MockBudgetServiceInterface_UpdateBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.Budget
+		if args[2] != nil {
+			arg2 = args[2].(*models.Budget)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_UpdateBudget_Call) Return(err error) *MockBudgetServiceInterface_UpdateBudget_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_UpdateBudget_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, budget *models.Budget) error) *MockBudgetServiceInterface_UpdateBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) UpdateCategory(ctx context.Context, userID uuid.UUID, category *models.Category) error {
+	ret := _mock.Called(ctx, userID, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.Category) error); ok {
+		r0 = returnFunc(ctx, userID, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type MockBudgetServiceInterface_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - category *models.Category
+func (_e *MockBudgetServiceInterface_Expecter) UpdateCategory(ctx interface{}, userID interface{}, category interface{}) *MockBudgetServiceInterface_UpdateCategory_Call {
+	return &MockBudgetServiceInterface_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, userID, category)}
+}
+
+func (_c *MockBudgetServiceInterface_UpdateCategory_Call) Run(run func(ctx context.Context, userID uuid.UUID, category *models.Category)) *MockBudgetServiceInterface_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.Category
+		if args[2] != nil {
+			arg2 = args[2].(*models.Category)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_UpdateCategory_Call) Return(err error) *MockBudgetServiceInterface_UpdateCategory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_UpdateCategory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, category *models.Category) error) *MockBudgetServiceInterface_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTransaction provides a mock function for the type MockBudgetServiceInterface
+func (_mock *MockBudgetServiceInterface) UpdateTransaction(ctx context.Context, userID uuid.UUID, transaction *models.Transaction) error {
+	ret := _mock.Called(ctx, userID, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTransaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.Transaction) error); ok {
+		r0 = returnFunc(ctx, userID, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBudgetServiceInterface_UpdateTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTransaction'
+type MockBudgetServiceInterface_UpdateTransaction_Call struct {
+	*mock.Call
+}
+
+// UpdateTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - transaction *models.Transaction
+func (_e *MockBudgetServiceInterface_Expecter) UpdateTransaction(ctx interface{}, userID interface{}, transaction interface{}) *MockBudgetServiceInterface_UpdateTransaction_Call {
+	return &MockBudgetServiceInterface_UpdateTransaction_Call{Call: _e.mock.On("UpdateTransaction", ctx, userID, transaction)}
+}
+
+func (_c *MockBudgetServiceInterface_UpdateTransaction_Call) Run(run func(ctx context.Context, userID uuid.UUID, transaction *models.Transaction)) *MockBudgetServiceInterface_UpdateTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.Transaction
+		if args[2] != nil {
+			arg2 = args[2].(*models.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_UpdateTransaction_Call) Return(err error) *MockBudgetServiceInterface_UpdateTransaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBudgetServiceInterface_UpdateTransaction_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, transaction *models.Transaction) error) *MockBudgetServiceInterface_UpdateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExchangeRateServiceInterface creates a new instance of MockExchangeRateServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExchangeRateServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExchangeRateServiceInterface {
+	mock := &MockExchangeRateServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExchangeRateServiceInterface is an autogenerated mock type for the ExchangeRateServiceInterface type
+type MockExchangeRateServiceInterface struct {
+	mock.Mock
+}
+
+type MockExchangeRateServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExchangeRateServiceInterface) EXPECT() *MockExchangeRateServiceInterface_Expecter {
+	return &MockExchangeRateServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateExchangeRate provides a mock function for the type MockExchangeRateServiceInterface
+func (_mock *MockExchangeRateServiceInterface) CreateExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	ret := _mock.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExchangeRate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ExchangeRate) error); ok {
+		r0 = returnFunc(ctx, rate)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExchangeRateServiceInterface_CreateExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateExchangeRate'
+type MockExchangeRateServiceInterface_CreateExchangeRate_Call struct {
+	*mock.Call
+}
+
+// CreateExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate *models.ExchangeRate
+func (_e *MockExchangeRateServiceInterface_Expecter) CreateExchangeRate(ctx interface{}, rate interface{}) *MockExchangeRateServiceInterface_CreateExchangeRate_Call {
+	return &MockExchangeRateServiceInterface_CreateExchangeRate_Call{Call: _e.mock.On("CreateExchangeRate", ctx, rate)}
+}
+
+func (_c *MockExchangeRateServiceInterface_CreateExchangeRate_Call) Run(run func(ctx context.Context, rate *models.ExchangeRate)) *MockExchangeRateServiceInterface_CreateExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(*models.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_CreateExchangeRate_Call) Return(err error) *MockExchangeRateServiceInterface_CreateExchangeRate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_CreateExchangeRate_Call) RunAndReturn(run func(ctx context.Context, rate *models.ExchangeRate) error) *MockExchangeRateServiceInterface_CreateExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExchangeRate provides a mock function for the type MockExchangeRateServiceInterface
+func (_mock *MockExchangeRateServiceInterface) DeleteExchangeRate(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExchangeRate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExchangeRateServiceInterface_DeleteExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExchangeRate'
+type MockExchangeRateServiceInterface_DeleteExchangeRate_Call struct {
+	*mock.Call
+}
+
+// DeleteExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockExchangeRateServiceInterface_Expecter) DeleteExchangeRate(ctx interface{}, id interface{}) *MockExchangeRateServiceInterface_DeleteExchangeRate_Call {
+	return &MockExchangeRateServiceInterface_DeleteExchangeRate_Call{Call: _e.mock.On("DeleteExchangeRate", ctx, id)}
+}
+
+func (_c *MockExchangeRateServiceInterface_DeleteExchangeRate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockExchangeRateServiceInterface_DeleteExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_DeleteExchangeRate_Call) Return(err error) *MockExchangeRateServiceInterface_DeleteExchangeRate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_DeleteExchangeRate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockExchangeRateServiceInterface_DeleteExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExchangeRate provides a mock function for the type MockExchangeRateServiceInterface
+func (_mock *MockExchangeRateServiceInterface) GetExchangeRate(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExchangeRate")
+	}
+
+	var r0 *models.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.ExchangeRate, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.ExchangeRate); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateServiceInterface_GetExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExchangeRate'
+type MockExchangeRateServiceInterface_GetExchangeRate_Call struct {
+	*mock.Call
+}
+
+// GetExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockExchangeRateServiceInterface_Expecter) GetExchangeRate(ctx interface{}, id interface{}) *MockExchangeRateServiceInterface_GetExchangeRate_Call {
+	return &MockExchangeRateServiceInterface_GetExchangeRate_Call{Call: _e.mock.On("GetExchangeRate", ctx, id)}
+}
+
+func (_c *MockExchangeRateServiceInterface_GetExchangeRate_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockExchangeRateServiceInterface_GetExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_GetExchangeRate_Call) Return(exchangeRate *models.ExchangeRate, err error) *MockExchangeRateServiceInterface_GetExchangeRate_Call {
+	_c.Call.Return(exchangeRate, err)
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_GetExchangeRate_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error)) *MockExchangeRateServiceInterface_GetExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExchangeRates provides a mock function for the type MockExchangeRateServiceInterface
+func (_mock *MockExchangeRateServiceInterface) GetExchangeRates(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExchangeRates")
+	}
+
+	var r0 []models.ExchangeRate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ExchangeRate, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ExchangeRate); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ExchangeRate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateServiceInterface_GetExchangeRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExchangeRates'
+type MockExchangeRateServiceInterface_GetExchangeRates_Call struct {
+	*mock.Call
+}
+
+// GetExchangeRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockExchangeRateServiceInterface_Expecter) GetExchangeRates(ctx interface{}, userID interface{}) *MockExchangeRateServiceInterface_GetExchangeRates_Call {
+	return &MockExchangeRateServiceInterface_GetExchangeRates_Call{Call: _e.mock.On("GetExchangeRates", ctx, userID)}
+}
+
+func (_c *MockExchangeRateServiceInterface_GetExchangeRates_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockExchangeRateServiceInterface_GetExchangeRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_GetExchangeRates_Call) Return(exchangeRates []models.ExchangeRate, err error) *MockExchangeRateServiceInterface_GetExchangeRates_Call {
+	_c.Call.Return(exchangeRates, err)
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_GetExchangeRates_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error)) *MockExchangeRateServiceInterface_GetExchangeRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportExchangeRates provides a mock function for the type MockExchangeRateServiceInterface
+func (_mock *MockExchangeRateServiceInterface) ImportExchangeRates(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.ExchangeRateImportResult, error) {
+	ret := _mock.Called(ctx, userID, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportExchangeRates")
+	}
+
+	var r0 *models.ExchangeRateImportResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, io.Reader) (*models.ExchangeRateImportResult, error)); ok {
+		return returnFunc(ctx, userID, r)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, io.Reader) *models.ExchangeRateImportResult); ok {
+		r0 = returnFunc(ctx, userID, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExchangeRateImportResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, io.Reader) error); ok {
+		r1 = returnFunc(ctx, userID, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExchangeRateServiceInterface_ImportExchangeRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportExchangeRates'
+type MockExchangeRateServiceInterface_ImportExchangeRates_Call struct {
+	*mock.Call
+}
+
+// ImportExchangeRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - r io.Reader
+func (_e *MockExchangeRateServiceInterface_Expecter) ImportExchangeRates(ctx interface{}, userID interface{}, r interface{}) *MockExchangeRateServiceInterface_ImportExchangeRates_Call {
+	return &MockExchangeRateServiceInterface_ImportExchangeRates_Call{Call: _e.mock.On("ImportExchangeRates", ctx, userID, r)}
+}
+
+func (_c *MockExchangeRateServiceInterface_ImportExchangeRates_Call) Run(run func(ctx context.Context, userID uuid.UUID, r io.Reader)) *MockExchangeRateServiceInterface_ImportExchangeRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_ImportExchangeRates_Call) Return(exchangeRateImportResult *models.ExchangeRateImportResult, err error) *MockExchangeRateServiceInterface_ImportExchangeRates_Call {
+	_c.Call.Return(exchangeRateImportResult, err)
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_ImportExchangeRates_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.ExchangeRateImportResult, error)) *MockExchangeRateServiceInterface_ImportExchangeRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateExchangeRate provides a mock function for the type MockExchangeRateServiceInterface
+func (_mock *MockExchangeRateServiceInterface) UpdateExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	ret := _mock.Called(ctx, rate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExchangeRate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.ExchangeRate) error); ok {
+		r0 = returnFunc(ctx, rate)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockExchangeRateServiceInterface_UpdateExchangeRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExchangeRate'
+type MockExchangeRateServiceInterface_UpdateExchangeRate_Call struct {
+	*mock.Call
+}
+
+// UpdateExchangeRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rate *models.ExchangeRate
+func (_e *MockExchangeRateServiceInterface_Expecter) UpdateExchangeRate(ctx interface{}, rate interface{}) *MockExchangeRateServiceInterface_UpdateExchangeRate_Call {
+	return &MockExchangeRateServiceInterface_UpdateExchangeRate_Call{Call: _e.mock.On("UpdateExchangeRate", ctx, rate)}
+}
+
+func (_c *MockExchangeRateServiceInterface_UpdateExchangeRate_Call) Run(run func(ctx context.Context, rate *models.ExchangeRate)) *MockExchangeRateServiceInterface_UpdateExchangeRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.ExchangeRate
+		if args[1] != nil {
+			arg1 = args[1].(*models.ExchangeRate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_UpdateExchangeRate_Call) Return(err error) *MockExchangeRateServiceInterface_UpdateExchangeRate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockExchangeRateServiceInterface_UpdateExchangeRate_Call) RunAndReturn(run func(ctx context.Context, rate *models.ExchangeRate) error) *MockExchangeRateServiceInterface_UpdateExchangeRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAssetServiceInterface creates a new instance of MockAssetServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAssetServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAssetServiceInterface {
+	mock := &MockAssetServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAssetServiceInterface is an autogenerated mock type for the AssetServiceInterface type
+type MockAssetServiceInterface struct {
+	mock.Mock
+}
+
+type MockAssetServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAssetServiceInterface) EXPECT() *MockAssetServiceInterface_Expecter {
+	return &MockAssetServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateAsset provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) CreateAsset(ctx context.Context, asset *models.Asset) error {
+	ret := _mock.Called(ctx, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAsset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Asset) error); ok {
+		r0 = returnFunc(ctx, asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssetServiceInterface_CreateAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAsset'
+type MockAssetServiceInterface_CreateAsset_Call struct {
+	*mock.Call
+}
+
+// CreateAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - asset *models.Asset
+func (_e *MockAssetServiceInterface_Expecter) CreateAsset(ctx interface{}, asset interface{}) *MockAssetServiceInterface_CreateAsset_Call {
+	return &MockAssetServiceInterface_CreateAsset_Call{Call: _e.mock.On("CreateAsset", ctx, asset)}
+}
+
+func (_c *MockAssetServiceInterface_CreateAsset_Call) Run(run func(ctx context.Context, asset *models.Asset)) *MockAssetServiceInterface_CreateAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Asset
+		if args[1] != nil {
+			arg1 = args[1].(*models.Asset)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_CreateAsset_Call) Return(err error) *MockAssetServiceInterface_CreateAsset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_CreateAsset_Call) RunAndReturn(run func(ctx context.Context, asset *models.Asset) error) *MockAssetServiceInterface_CreateAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAssetValuation provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) CreateAssetValuation(ctx context.Context, userID uuid.UUID, valuation *models.AssetValuation) error {
+	ret := _mock.Called(ctx, userID, valuation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAssetValuation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.AssetValuation) error); ok {
+		r0 = returnFunc(ctx, userID, valuation)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssetServiceInterface_CreateAssetValuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAssetValuation'
+type MockAssetServiceInterface_CreateAssetValuation_Call struct {
+	*mock.Call
+}
+
+// CreateAssetValuation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - valuation *models.AssetValuation
+func (_e *MockAssetServiceInterface_Expecter) CreateAssetValuation(ctx interface{}, userID interface{}, valuation interface{}) *MockAssetServiceInterface_CreateAssetValuation_Call {
+	return &MockAssetServiceInterface_CreateAssetValuation_Call{Call: _e.mock.On("CreateAssetValuation", ctx, userID, valuation)}
+}
+
+func (_c *MockAssetServiceInterface_CreateAssetValuation_Call) Run(run func(ctx context.Context, userID uuid.UUID, valuation *models.AssetValuation)) *MockAssetServiceInterface_CreateAssetValuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.AssetValuation
+		if args[2] != nil {
+			arg2 = args[2].(*models.AssetValuation)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_CreateAssetValuation_Call) Return(err error) *MockAssetServiceInterface_CreateAssetValuation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_CreateAssetValuation_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, valuation *models.AssetValuation) error) *MockAssetServiceInterface_CreateAssetValuation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAsset provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) DeleteAsset(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAsset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssetServiceInterface_DeleteAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAsset'
+type MockAssetServiceInterface_DeleteAsset_Call struct {
+	*mock.Call
+}
+
+// DeleteAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockAssetServiceInterface_Expecter) DeleteAsset(ctx interface{}, userID interface{}, id interface{}) *MockAssetServiceInterface_DeleteAsset_Call {
+	return &MockAssetServiceInterface_DeleteAsset_Call{Call: _e.mock.On("DeleteAsset", ctx, userID, id)}
+}
+
+func (_c *MockAssetServiceInterface_DeleteAsset_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockAssetServiceInterface_DeleteAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_DeleteAsset_Call) Return(err error) *MockAssetServiceInterface_DeleteAsset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_DeleteAsset_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockAssetServiceInterface_DeleteAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAssetValuation provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) DeleteAssetValuation(ctx context.Context, userID uuid.UUID, assetID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, assetID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAssetValuation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, assetID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssetServiceInterface_DeleteAssetValuation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAssetValuation'
+type MockAssetServiceInterface_DeleteAssetValuation_Call struct {
+	*mock.Call
+}
+
+// DeleteAssetValuation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - assetID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockAssetServiceInterface_Expecter) DeleteAssetValuation(ctx interface{}, userID interface{}, assetID interface{}, id interface{}) *MockAssetServiceInterface_DeleteAssetValuation_Call {
+	return &MockAssetServiceInterface_DeleteAssetValuation_Call{Call: _e.mock.On("DeleteAssetValuation", ctx, userID, assetID, id)}
+}
+
+func (_c *MockAssetServiceInterface_DeleteAssetValuation_Call) Run(run func(ctx context.Context, userID uuid.UUID, assetID uuid.UUID, id uuid.UUID)) *MockAssetServiceInterface_DeleteAssetValuation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 uuid.UUID
+		if args[3] != nil {
+			arg3 = args[3].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_DeleteAssetValuation_Call) Return(err error) *MockAssetServiceInterface_DeleteAssetValuation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_DeleteAssetValuation_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, assetID uuid.UUID, id uuid.UUID) error) *MockAssetServiceInterface_DeleteAssetValuation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAsset provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) GetAsset(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Asset, error) {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAsset")
+	}
+
+	var r0 *models.Asset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Asset, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Asset); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Asset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssetServiceInterface_GetAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAsset'
+type MockAssetServiceInterface_GetAsset_Call struct {
+	*mock.Call
+}
+
+// GetAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockAssetServiceInterface_Expecter) GetAsset(ctx interface{}, userID interface{}, id interface{}) *MockAssetServiceInterface_GetAsset_Call {
+	return &MockAssetServiceInterface_GetAsset_Call{Call: _e.mock.On("GetAsset", ctx, userID, id)}
+}
+
+func (_c *MockAssetServiceInterface_GetAsset_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockAssetServiceInterface_GetAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_GetAsset_Call) Return(asset *models.Asset, err error) *MockAssetServiceInterface_GetAsset_Call {
+	_c.Call.Return(asset, err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_GetAsset_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Asset, error)) *MockAssetServiceInterface_GetAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssetValuations provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) GetAssetValuations(ctx context.Context, userID uuid.UUID, assetID uuid.UUID) ([]models.AssetValuation, error) {
+	ret := _mock.Called(ctx, userID, assetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssetValuations")
+	}
+
+	var r0 []models.AssetValuation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.AssetValuation, error)); ok {
+		return returnFunc(ctx, userID, assetID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.AssetValuation); ok {
+		r0 = returnFunc(ctx, userID, assetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AssetValuation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, assetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssetServiceInterface_GetAssetValuations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssetValuations'
+type MockAssetServiceInterface_GetAssetValuations_Call struct {
+	*mock.Call
+}
+
+// GetAssetValuations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - assetID uuid.UUID
+func (_e *MockAssetServiceInterface_Expecter) GetAssetValuations(ctx interface{}, userID interface{}, assetID interface{}) *MockAssetServiceInterface_GetAssetValuations_Call {
+	return &MockAssetServiceInterface_GetAssetValuations_Call{Call: _e.mock.On("GetAssetValuations", ctx, userID, assetID)}
+}
+
+func (_c *MockAssetServiceInterface_GetAssetValuations_Call) Run(run func(ctx context.Context, userID uuid.UUID, assetID uuid.UUID)) *MockAssetServiceInterface_GetAssetValuations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_GetAssetValuations_Call) Return(assetValuations []models.AssetValuation, err error) *MockAssetServiceInterface_GetAssetValuations_Call {
+	_c.Call.Return(assetValuations, err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_GetAssetValuations_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, assetID uuid.UUID) ([]models.AssetValuation, error)) *MockAssetServiceInterface_GetAssetValuations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAssets provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) GetAssets(ctx context.Context, userID uuid.UUID) ([]models.Asset, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssets")
+	}
+
+	var r0 []models.Asset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Asset, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Asset); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Asset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssetServiceInterface_GetAssets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAssets'
+type MockAssetServiceInterface_GetAssets_Call struct {
+	*mock.Call
+}
+
+// GetAssets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockAssetServiceInterface_Expecter) GetAssets(ctx interface{}, userID interface{}) *MockAssetServiceInterface_GetAssets_Call {
+	return &MockAssetServiceInterface_GetAssets_Call{Call: _e.mock.On("GetAssets", ctx, userID)}
+}
+
+func (_c *MockAssetServiceInterface_GetAssets_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockAssetServiceInterface_GetAssets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_GetAssets_Call) Return(assets []models.Asset, err error) *MockAssetServiceInterface_GetAssets_Call {
+	_c.Call.Return(assets, err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_GetAssets_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.Asset, error)) *MockAssetServiceInterface_GetAssets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportAssetValuations provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) ImportAssetValuations(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.AssetValuationImportResult, error) {
+	ret := _mock.Called(ctx, userID, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportAssetValuations")
+	}
+
+	var r0 *models.AssetValuationImportResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, io.Reader) (*models.AssetValuationImportResult, error)); ok {
+		return returnFunc(ctx, userID, r)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, io.Reader) *models.AssetValuationImportResult); ok {
+		r0 = returnFunc(ctx, userID, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AssetValuationImportResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, io.Reader) error); ok {
+		r1 = returnFunc(ctx, userID, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAssetServiceInterface_ImportAssetValuations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportAssetValuations'
+type MockAssetServiceInterface_ImportAssetValuations_Call struct {
+	*mock.Call
+}
+
+// ImportAssetValuations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - r io.Reader
+func (_e *MockAssetServiceInterface_Expecter) ImportAssetValuations(ctx interface{}, userID interface{}, r interface{}) *MockAssetServiceInterface_ImportAssetValuations_Call {
+	return &MockAssetServiceInterface_ImportAssetValuations_Call{Call: _e.mock.On("ImportAssetValuations", ctx, userID, r)}
+}
+
+func (_c *MockAssetServiceInterface_ImportAssetValuations_Call) Run(run func(ctx context.Context, userID uuid.UUID, r io.Reader)) *MockAssetServiceInterface_ImportAssetValuations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_ImportAssetValuations_Call) Return(assetValuationImportResult *models.AssetValuationImportResult, err error) *MockAssetServiceInterface_ImportAssetValuations_Call {
+	_c.Call.Return(assetValuationImportResult, err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_ImportAssetValuations_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.AssetValuationImportResult, error)) *MockAssetServiceInterface_ImportAssetValuations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAsset provides a mock function for the type MockAssetServiceInterface
+func (_mock *MockAssetServiceInterface) UpdateAsset(ctx context.Context, userID uuid.UUID, asset *models.Asset) error {
+	ret := _mock.Called(ctx, userID, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAsset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.Asset) error); ok {
+		r0 = returnFunc(ctx, userID, asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAssetServiceInterface_UpdateAsset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAsset'
+type MockAssetServiceInterface_UpdateAsset_Call struct {
+	*mock.Call
+}
+
+// UpdateAsset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - asset *models.Asset
+func (_e *MockAssetServiceInterface_Expecter) UpdateAsset(ctx interface{}, userID interface{}, asset interface{}) *MockAssetServiceInterface_UpdateAsset_Call {
+	return &MockAssetServiceInterface_UpdateAsset_Call{Call: _e.mock.On("UpdateAsset", ctx, userID, asset)}
+}
+
+func (_c *MockAssetServiceInterface_UpdateAsset_Call) Run(run func(ctx context.Context, userID uuid.UUID, asset *models.Asset)) *MockAssetServiceInterface_UpdateAsset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.Asset
+		if args[2] != nil {
+			arg2 = args[2].(*models.Asset)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_UpdateAsset_Call) Return(err error) *MockAssetServiceInterface_UpdateAsset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAssetServiceInterface_UpdateAsset_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, asset *models.Asset) error) *MockAssetServiceInterface_UpdateAsset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNetWorthServiceInterface creates a new instance of MockNetWorthServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNetWorthServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNetWorthServiceInterface {
+	mock := &MockNetWorthServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNetWorthServiceInterface is an autogenerated mock type for the NetWorthServiceInterface type
+type MockNetWorthServiceInterface struct {
+	mock.Mock
+}
+
+type MockNetWorthServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNetWorthServiceInterface) EXPECT() *MockNetWorthServiceInterface_Expecter {
+	return &MockNetWorthServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetNetWorth provides a mock function for the type MockNetWorthServiceInterface
+func (_mock *MockNetWorthServiceInterface) GetNetWorth(ctx context.Context, userID uuid.UUID) (*models.NetWorth, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetWorth")
+	}
+
+	var r0 *models.NetWorth
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.NetWorth, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.NetWorth); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NetWorth)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNetWorthServiceInterface_GetNetWorth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetWorth'
+type MockNetWorthServiceInterface_GetNetWorth_Call struct {
+	*mock.Call
+}
+
+// GetNetWorth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockNetWorthServiceInterface_Expecter) GetNetWorth(ctx interface{}, userID interface{}) *MockNetWorthServiceInterface_GetNetWorth_Call {
+	return &MockNetWorthServiceInterface_GetNetWorth_Call{Call: _e.mock.On("GetNetWorth", ctx, userID)}
+}
+
+func (_c *MockNetWorthServiceInterface_GetNetWorth_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockNetWorthServiceInterface_GetNetWorth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNetWorthServiceInterface_GetNetWorth_Call) Return(netWorth *models.NetWorth, err error) *MockNetWorthServiceInterface_GetNetWorth_Call {
+	_c.Call.Return(netWorth, err)
+	return _c
+}
+
+func (_c *MockNetWorthServiceInterface_GetNetWorth_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*models.NetWorth, error)) *MockNetWorthServiceInterface_GetNetWorth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNetWorthHistory provides a mock function for the type MockNetWorthServiceInterface
+func (_mock *MockNetWorthServiceInterface) GetNetWorthHistory(ctx context.Context, userID uuid.UUID, months int) ([]models.NetWorthSnapshot, error) {
+	ret := _mock.Called(ctx, userID, months)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNetWorthHistory")
+	}
+
+	var r0 []models.NetWorthSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.NetWorthSnapshot, error)); ok {
+		return returnFunc(ctx, userID, months)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.NetWorthSnapshot); ok {
+		r0 = returnFunc(ctx, userID, months)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NetWorthSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, userID, months)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNetWorthServiceInterface_GetNetWorthHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetWorthHistory'
+type MockNetWorthServiceInterface_GetNetWorthHistory_Call struct {
+	*mock.Call
+}
+
+// GetNetWorthHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - months int
+func (_e *MockNetWorthServiceInterface_Expecter) GetNetWorthHistory(ctx interface{}, userID interface{}, months interface{}) *MockNetWorthServiceInterface_GetNetWorthHistory_Call {
+	return &MockNetWorthServiceInterface_GetNetWorthHistory_Call{Call: _e.mock.On("GetNetWorthHistory", ctx, userID, months)}
+}
+
+func (_c *MockNetWorthServiceInterface_GetNetWorthHistory_Call) Run(run func(ctx context.Context, userID uuid.UUID, months int)) *MockNetWorthServiceInterface_GetNetWorthHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNetWorthServiceInterface_GetNetWorthHistory_Call) Return(netWorthSnapshots []models.NetWorthSnapshot, err error) *MockNetWorthServiceInterface_GetNetWorthHistory_Call {
+	_c.Call.Return(netWorthSnapshots, err)
+	return _c
+}
+
+func (_c *MockNetWorthServiceInterface_GetNetWorthHistory_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, months int) ([]models.NetWorthSnapshot, error)) *MockNetWorthServiceInterface_GetNetWorthHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportServiceInterface creates a new instance of MockExportServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportServiceInterface {
+	mock := &MockExportServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockExportServiceInterface is an autogenerated mock type for the ExportServiceInterface type
+type MockExportServiceInterface struct {
+	mock.Mock
+}
+
+type MockExportServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportServiceInterface) EXPECT() *MockExportServiceInterface_Expecter {
+	return &MockExportServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// ExportBankAccounts provides a mock function for the type MockExportServiceInterface
+func (_mock *MockExportServiceInterface) ExportBankAccounts(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ret := _mock.Called(ctx, userID, unit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBankAccounts")
+	}
+
+	var r0 *export.Table
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) (*export.Table, error)); ok {
+		return returnFunc(ctx, userID, unit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) *export.Table); ok {
+		r0 = returnFunc(ctx, userID, unit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*export.Table)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, export.Unit) error); ok {
+		r1 = returnFunc(ctx, userID, unit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportServiceInterface_ExportBankAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportBankAccounts'
+type MockExportServiceInterface_ExportBankAccounts_Call struct {
+	*mock.Call
+}
+
+// ExportBankAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - unit export.Unit
+func (_e *MockExportServiceInterface_Expecter) ExportBankAccounts(ctx interface{}, userID interface{}, unit interface{}) *MockExportServiceInterface_ExportBankAccounts_Call {
+	return &MockExportServiceInterface_ExportBankAccounts_Call{Call: _e.mock.On("ExportBankAccounts", ctx, userID, unit)}
+}
+
+func (_c *MockExportServiceInterface_ExportBankAccounts_Call) Run(run func(ctx context.Context, userID uuid.UUID, unit export.Unit)) *MockExportServiceInterface_ExportBankAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 export.Unit
+		if args[2] != nil {
+			arg2 = args[2].(export.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportBankAccounts_Call) Return(table *export.Table, err error) *MockExportServiceInterface_ExportBankAccounts_Call {
+	_c.Call.Return(table, err)
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportBankAccounts_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)) *MockExportServiceInterface_ExportBankAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportCardMonthlyTotals provides a mock function for the type MockExportServiceInterface
+func (_mock *MockExportServiceInterface) ExportCardMonthlyTotals(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ret := _mock.Called(ctx, userID, unit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCardMonthlyTotals")
+	}
+
+	var r0 *export.Table
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) (*export.Table, error)); ok {
+		return returnFunc(ctx, userID, unit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) *export.Table); ok {
+		r0 = returnFunc(ctx, userID, unit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*export.Table)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, export.Unit) error); ok {
+		r1 = returnFunc(ctx, userID, unit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportServiceInterface_ExportCardMonthlyTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCardMonthlyTotals'
+type MockExportServiceInterface_ExportCardMonthlyTotals_Call struct {
+	*mock.Call
+}
+
+// ExportCardMonthlyTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - unit export.Unit
+func (_e *MockExportServiceInterface_Expecter) ExportCardMonthlyTotals(ctx interface{}, userID interface{}, unit interface{}) *MockExportServiceInterface_ExportCardMonthlyTotals_Call {
+	return &MockExportServiceInterface_ExportCardMonthlyTotals_Call{Call: _e.mock.On("ExportCardMonthlyTotals", ctx, userID, unit)}
+}
+
+func (_c *MockExportServiceInterface_ExportCardMonthlyTotals_Call) Run(run func(ctx context.Context, userID uuid.UUID, unit export.Unit)) *MockExportServiceInterface_ExportCardMonthlyTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 export.Unit
+		if args[2] != nil {
+			arg2 = args[2].(export.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportCardMonthlyTotals_Call) Return(table *export.Table, err error) *MockExportServiceInterface_ExportCardMonthlyTotals_Call {
+	_c.Call.Return(table, err)
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportCardMonthlyTotals_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)) *MockExportServiceInterface_ExportCardMonthlyTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportCreditCards provides a mock function for the type MockExportServiceInterface
+func (_mock *MockExportServiceInterface) ExportCreditCards(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ret := _mock.Called(ctx, userID, unit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCreditCards")
+	}
+
+	var r0 *export.Table
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) (*export.Table, error)); ok {
+		return returnFunc(ctx, userID, unit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) *export.Table); ok {
+		r0 = returnFunc(ctx, userID, unit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*export.Table)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, export.Unit) error); ok {
+		r1 = returnFunc(ctx, userID, unit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportServiceInterface_ExportCreditCards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCreditCards'
+type MockExportServiceInterface_ExportCreditCards_Call struct {
+	*mock.Call
+}
+
+// ExportCreditCards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - unit export.Unit
+func (_e *MockExportServiceInterface_Expecter) ExportCreditCards(ctx interface{}, userID interface{}, unit interface{}) *MockExportServiceInterface_ExportCreditCards_Call {
+	return &MockExportServiceInterface_ExportCreditCards_Call{Call: _e.mock.On("ExportCreditCards", ctx, userID, unit)}
+}
+
+func (_c *MockExportServiceInterface_ExportCreditCards_Call) Run(run func(ctx context.Context, userID uuid.UUID, unit export.Unit)) *MockExportServiceInterface_ExportCreditCards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 export.Unit
+		if args[2] != nil {
+			arg2 = args[2].(export.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportCreditCards_Call) Return(table *export.Table, err error) *MockExportServiceInterface_ExportCreditCards_Call {
+	_c.Call.Return(table, err)
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportCreditCards_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)) *MockExportServiceInterface_ExportCreditCards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportIncomeSources provides a mock function for the type MockExportServiceInterface
+func (_mock *MockExportServiceInterface) ExportIncomeSources(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ret := _mock.Called(ctx, userID, unit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportIncomeSources")
+	}
+
+	var r0 *export.Table
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) (*export.Table, error)); ok {
+		return returnFunc(ctx, userID, unit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) *export.Table); ok {
+		r0 = returnFunc(ctx, userID, unit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*export.Table)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, export.Unit) error); ok {
+		r1 = returnFunc(ctx, userID, unit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportServiceInterface_ExportIncomeSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportIncomeSources'
+type MockExportServiceInterface_ExportIncomeSources_Call struct {
+	*mock.Call
+}
+
+// ExportIncomeSources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - unit export.Unit
+func (_e *MockExportServiceInterface_Expecter) ExportIncomeSources(ctx interface{}, userID interface{}, unit interface{}) *MockExportServiceInterface_ExportIncomeSources_Call {
+	return &MockExportServiceInterface_ExportIncomeSources_Call{Call: _e.mock.On("ExportIncomeSources", ctx, userID, unit)}
+}
+
+func (_c *MockExportServiceInterface_ExportIncomeSources_Call) Run(run func(ctx context.Context, userID uuid.UUID, unit export.Unit)) *MockExportServiceInterface_ExportIncomeSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 export.Unit
+		if args[2] != nil {
+			arg2 = args[2].(export.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportIncomeSources_Call) Return(table *export.Table, err error) *MockExportServiceInterface_ExportIncomeSources_Call {
+	_c.Call.Return(table, err)
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportIncomeSources_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)) *MockExportServiceInterface_ExportIncomeSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportRecurringPayments provides a mock function for the type MockExportServiceInterface
+func (_mock *MockExportServiceInterface) ExportRecurringPayments(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ret := _mock.Called(ctx, userID, unit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRecurringPayments")
+	}
+
+	var r0 *export.Table
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) (*export.Table, error)); ok {
+		return returnFunc(ctx, userID, unit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, export.Unit) *export.Table); ok {
+		r0 = returnFunc(ctx, userID, unit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*export.Table)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, export.Unit) error); ok {
+		r1 = returnFunc(ctx, userID, unit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockExportServiceInterface_ExportRecurringPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportRecurringPayments'
+type MockExportServiceInterface_ExportRecurringPayments_Call struct {
+	*mock.Call
+}
+
+// ExportRecurringPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - unit export.Unit
+func (_e *MockExportServiceInterface_Expecter) ExportRecurringPayments(ctx interface{}, userID interface{}, unit interface{}) *MockExportServiceInterface_ExportRecurringPayments_Call {
+	return &MockExportServiceInterface_ExportRecurringPayments_Call{Call: _e.mock.On("ExportRecurringPayments", ctx, userID, unit)}
+}
+
+func (_c *MockExportServiceInterface_ExportRecurringPayments_Call) Run(run func(ctx context.Context, userID uuid.UUID, unit export.Unit)) *MockExportServiceInterface_ExportRecurringPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 export.Unit
+		if args[2] != nil {
+			arg2 = args[2].(export.Unit)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportRecurringPayments_Call) Return(table *export.Table, err error) *MockExportServiceInterface_ExportRecurringPayments_Call {
+	_c.Call.Return(table, err)
+	return _c
+}
+
+func (_c *MockExportServiceInterface_ExportRecurringPayments_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error)) *MockExportServiceInterface_ExportRecurringPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCalendarServiceInterface creates a new instance of MockCalendarServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCalendarServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCalendarServiceInterface {
+	mock := &MockCalendarServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCalendarServiceInterface is an autogenerated mock type for the CalendarServiceInterface type
+type MockCalendarServiceInterface struct {
+	mock.Mock
+}
+
+type MockCalendarServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCalendarServiceInterface) EXPECT() *MockCalendarServiceInterface_Expecter {
+	return &MockCalendarServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateCalendarFeed provides a mock function for the type MockCalendarServiceInterface
+func (_mock *MockCalendarServiceInterface) CreateCalendarFeed(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCalendarFeed")
+	}
+
+	var r0 *models.CalendarFeed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.CalendarFeed, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.CalendarFeed); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CalendarFeed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarServiceInterface_CreateCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCalendarFeed'
+type MockCalendarServiceInterface_CreateCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// CreateCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockCalendarServiceInterface_Expecter) CreateCalendarFeed(ctx interface{}, userID interface{}) *MockCalendarServiceInterface_CreateCalendarFeed_Call {
+	return &MockCalendarServiceInterface_CreateCalendarFeed_Call{Call: _e.mock.On("CreateCalendarFeed", ctx, userID)}
+}
+
+func (_c *MockCalendarServiceInterface_CreateCalendarFeed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockCalendarServiceInterface_CreateCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_CreateCalendarFeed_Call) Return(calendarFeed *models.CalendarFeed, err error) *MockCalendarServiceInterface_CreateCalendarFeed_Call {
+	_c.Call.Return(calendarFeed, err)
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_CreateCalendarFeed_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error)) *MockCalendarServiceInterface_CreateCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCalendarFeed provides a mock function for the type MockCalendarServiceInterface
+func (_mock *MockCalendarServiceInterface) DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCalendarFeed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCalendarServiceInterface_DeleteCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCalendarFeed'
+type MockCalendarServiceInterface_DeleteCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// DeleteCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockCalendarServiceInterface_Expecter) DeleteCalendarFeed(ctx interface{}, userID interface{}) *MockCalendarServiceInterface_DeleteCalendarFeed_Call {
+	return &MockCalendarServiceInterface_DeleteCalendarFeed_Call{Call: _e.mock.On("DeleteCalendarFeed", ctx, userID)}
+}
+
+func (_c *MockCalendarServiceInterface_DeleteCalendarFeed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockCalendarServiceInterface_DeleteCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_DeleteCalendarFeed_Call) Return(err error) *MockCalendarServiceInterface_DeleteCalendarFeed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_DeleteCalendarFeed_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) error) *MockCalendarServiceInterface_DeleteCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCalendarFeed provides a mock function for the type MockCalendarServiceInterface
+func (_mock *MockCalendarServiceInterface) GetCalendarFeed(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarFeed")
+	}
+
+	var r0 *models.CalendarFeed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.CalendarFeed, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.CalendarFeed); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CalendarFeed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarServiceInterface_GetCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendarFeed'
+type MockCalendarServiceInterface_GetCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// GetCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockCalendarServiceInterface_Expecter) GetCalendarFeed(ctx interface{}, userID interface{}) *MockCalendarServiceInterface_GetCalendarFeed_Call {
+	return &MockCalendarServiceInterface_GetCalendarFeed_Call{Call: _e.mock.On("GetCalendarFeed", ctx, userID)}
+}
+
+func (_c *MockCalendarServiceInterface_GetCalendarFeed_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockCalendarServiceInterface_GetCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_GetCalendarFeed_Call) Return(calendarFeed *models.CalendarFeed, err error) *MockCalendarServiceInterface_GetCalendarFeed_Call {
+	_c.Call.Return(calendarFeed, err)
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_GetCalendarFeed_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error)) *MockCalendarServiceInterface_GetCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderCalendarFeed provides a mock function for the type MockCalendarServiceInterface
+func (_mock *MockCalendarServiceInterface) RenderCalendarFeed(ctx context.Context, token string, now time.Time) ([]byte, error) {
+	ret := _mock.Called(ctx, token, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderCalendarFeed")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]byte, error)); ok {
+		return returnFunc(ctx, token, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []byte); ok {
+		r0 = returnFunc(ctx, token, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, token, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCalendarServiceInterface_RenderCalendarFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderCalendarFeed'
+type MockCalendarServiceInterface_RenderCalendarFeed_Call struct {
+	*mock.Call
+}
+
+// RenderCalendarFeed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+//   - now time.Time
+func (_e *MockCalendarServiceInterface_Expecter) RenderCalendarFeed(ctx interface{}, token interface{}, now interface{}) *MockCalendarServiceInterface_RenderCalendarFeed_Call {
+	return &MockCalendarServiceInterface_RenderCalendarFeed_Call{Call: _e.mock.On("RenderCalendarFeed", ctx, token, now)}
+}
+
+func (_c *MockCalendarServiceInterface_RenderCalendarFeed_Call) Run(run func(ctx context.Context, token string, now time.Time)) *MockCalendarServiceInterface_RenderCalendarFeed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_RenderCalendarFeed_Call) Return(bytes []byte, err error) *MockCalendarServiceInterface_RenderCalendarFeed_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockCalendarServiceInterface_RenderCalendarFeed_Call) RunAndReturn(run func(ctx context.Context, token string, now time.Time) ([]byte, error)) *MockCalendarServiceInterface_RenderCalendarFeed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReportServiceInterface creates a new instance of MockReportServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReportServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReportServiceInterface {
+	mock := &MockReportServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReportServiceInterface is an autogenerated mock type for the ReportServiceInterface type
+type MockReportServiceInterface struct {
+	mock.Mock
+}
+
+type MockReportServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReportServiceInterface) EXPECT() *MockReportServiceInterface_Expecter {
+	return &MockReportServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetStoredMonthlyReport provides a mock function for the type MockReportServiceInterface
+func (_mock *MockReportServiceInterface) GetStoredMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error) {
+	ret := _mock.Called(ctx, userID, yearMonth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStoredMonthlyReport")
+	}
+
+	var r0 *models.StoredMonthlyReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*models.StoredMonthlyReport, error)); ok {
+		return returnFunc(ctx, userID, yearMonth)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *models.StoredMonthlyReport); ok {
+		r0 = returnFunc(ctx, userID, yearMonth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StoredMonthlyReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, userID, yearMonth)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportServiceInterface_GetStoredMonthlyReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStoredMonthlyReport'
+type MockReportServiceInterface_GetStoredMonthlyReport_Call struct {
+	*mock.Call
+}
+
+// GetStoredMonthlyReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - yearMonth string
+func (_e *MockReportServiceInterface_Expecter) GetStoredMonthlyReport(ctx interface{}, userID interface{}, yearMonth interface{}) *MockReportServiceInterface_GetStoredMonthlyReport_Call {
+	return &MockReportServiceInterface_GetStoredMonthlyReport_Call{Call: _e.mock.On("GetStoredMonthlyReport", ctx, userID, yearMonth)}
+}
+
+func (_c *MockReportServiceInterface_GetStoredMonthlyReport_Call) Run(run func(ctx context.Context, userID uuid.UUID, yearMonth string)) *MockReportServiceInterface_GetStoredMonthlyReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportServiceInterface_GetStoredMonthlyReport_Call) Return(storedMonthlyReport *models.StoredMonthlyReport, err error) *MockReportServiceInterface_GetStoredMonthlyReport_Call {
+	_c.Call.Return(storedMonthlyReport, err)
+	return _c
+}
+
+func (_c *MockReportServiceInterface_GetStoredMonthlyReport_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error)) *MockReportServiceInterface_GetStoredMonthlyReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStoredMonthlyReports provides a mock function for the type MockReportServiceInterface
+func (_mock *MockReportServiceInterface) GetStoredMonthlyReports(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStoredMonthlyReports")
+	}
+
+	var r0 []models.StoredMonthlyReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.StoredMonthlyReport, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.StoredMonthlyReport); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StoredMonthlyReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportServiceInterface_GetStoredMonthlyReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStoredMonthlyReports'
+type MockReportServiceInterface_GetStoredMonthlyReports_Call struct {
+	*mock.Call
+}
+
+// GetStoredMonthlyReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockReportServiceInterface_Expecter) GetStoredMonthlyReports(ctx interface{}, userID interface{}) *MockReportServiceInterface_GetStoredMonthlyReports_Call {
+	return &MockReportServiceInterface_GetStoredMonthlyReports_Call{Call: _e.mock.On("GetStoredMonthlyReports", ctx, userID)}
+}
+
+func (_c *MockReportServiceInterface_GetStoredMonthlyReports_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockReportServiceInterface_GetStoredMonthlyReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportServiceInterface_GetStoredMonthlyReports_Call) Return(storedMonthlyReports []models.StoredMonthlyReport, err error) *MockReportServiceInterface_GetStoredMonthlyReports_Call {
+	_c.Call.Return(storedMonthlyReports, err)
+	return _c
+}
+
+func (_c *MockReportServiceInterface_GetStoredMonthlyReports_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error)) *MockReportServiceInterface_GetStoredMonthlyReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderMonthlyReport provides a mock function for the type MockReportServiceInterface
+func (_mock *MockReportServiceInterface) RenderMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string, now time.Time) ([]byte, error) {
+	ret := _mock.Called(ctx, userID, yearMonth, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderMonthlyReport")
+	}
+
+	var r0 []byte
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) ([]byte, error)); ok {
+		return returnFunc(ctx, userID, yearMonth, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) []byte); ok {
+		r0 = returnFunc(ctx, userID, yearMonth, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, yearMonth, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReportServiceInterface_RenderMonthlyReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderMonthlyReport'
+type MockReportServiceInterface_RenderMonthlyReport_Call struct {
+	*mock.Call
+}
+
+// RenderMonthlyReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - yearMonth string
+//   - now time.Time
+func (_e *MockReportServiceInterface_Expecter) RenderMonthlyReport(ctx interface{}, userID interface{}, yearMonth interface{}, now interface{}) *MockReportServiceInterface_RenderMonthlyReport_Call {
+	return &MockReportServiceInterface_RenderMonthlyReport_Call{Call: _e.mock.On("RenderMonthlyReport", ctx, userID, yearMonth, now)}
+}
+
+func (_c *MockReportServiceInterface_RenderMonthlyReport_Call) Run(run func(ctx context.Context, userID uuid.UUID, yearMonth string, now time.Time)) *MockReportServiceInterface_RenderMonthlyReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReportServiceInterface_RenderMonthlyReport_Call) Return(bytes []byte, err error) *MockReportServiceInterface_RenderMonthlyReport_Call {
+	_c.Call.Return(bytes, err)
+	return _c
+}
+
+func (_c *MockReportServiceInterface_RenderMonthlyReport_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, yearMonth string, now time.Time) ([]byte, error)) *MockReportServiceInterface_RenderMonthlyReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotificationServiceInterface creates a new instance of MockNotificationServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotificationServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotificationServiceInterface {
+	mock := &MockNotificationServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockNotificationServiceInterface is an autogenerated mock type for the NotificationServiceInterface type
+type MockNotificationServiceInterface struct {
+	mock.Mock
+}
+
+type MockNotificationServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotificationServiceInterface) EXPECT() *MockNotificationServiceInterface_Expecter {
+	return &MockNotificationServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetNotificationLogs provides a mock function for the type MockNotificationServiceInterface
+func (_mock *MockNotificationServiceInterface) GetNotificationLogs(ctx context.Context, userID uuid.UUID) ([]models.NotificationLog, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationLogs")
+	}
+
+	var r0 []models.NotificationLog
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.NotificationLog, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.NotificationLog); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NotificationLog)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationServiceInterface_GetNotificationLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationLogs'
+type MockNotificationServiceInterface_GetNotificationLogs_Call struct {
+	*mock.Call
+}
+
+// GetNotificationLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockNotificationServiceInterface_Expecter) GetNotificationLogs(ctx interface{}, userID interface{}) *MockNotificationServiceInterface_GetNotificationLogs_Call {
+	return &MockNotificationServiceInterface_GetNotificationLogs_Call{Call: _e.mock.On("GetNotificationLogs", ctx, userID)}
+}
+
+func (_c *MockNotificationServiceInterface_GetNotificationLogs_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockNotificationServiceInterface_GetNotificationLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationServiceInterface_GetNotificationLogs_Call) Return(notificationLogs []models.NotificationLog, err error) *MockNotificationServiceInterface_GetNotificationLogs_Call {
+	_c.Call.Return(notificationLogs, err)
+	return _c
+}
+
+func (_c *MockNotificationServiceInterface_GetNotificationLogs_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.NotificationLog, error)) *MockNotificationServiceInterface_GetNotificationLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTestNotification provides a mock function for the type MockNotificationServiceInterface
+func (_mock *MockNotificationServiceInterface) SendTestNotification(ctx context.Context, userID uuid.UUID, channel string, now time.Time) (*models.NotificationLog, error) {
+	ret := _mock.Called(ctx, userID, channel, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTestNotification")
+	}
+
+	var r0 *models.NotificationLog
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) (*models.NotificationLog, error)); ok {
+		return returnFunc(ctx, userID, channel, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) *models.NotificationLog); ok {
+		r0 = returnFunc(ctx, userID, channel, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.NotificationLog)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, channel, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockNotificationServiceInterface_SendTestNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTestNotification'
+type MockNotificationServiceInterface_SendTestNotification_Call struct {
+	*mock.Call
+}
+
+// SendTestNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - channel string
+//   - now time.Time
+func (_e *MockNotificationServiceInterface_Expecter) SendTestNotification(ctx interface{}, userID interface{}, channel interface{}, now interface{}) *MockNotificationServiceInterface_SendTestNotification_Call {
+	return &MockNotificationServiceInterface_SendTestNotification_Call{Call: _e.mock.On("SendTestNotification", ctx, userID, channel, now)}
+}
+
+func (_c *MockNotificationServiceInterface_SendTestNotification_Call) Run(run func(ctx context.Context, userID uuid.UUID, channel string, now time.Time)) *MockNotificationServiceInterface_SendTestNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockNotificationServiceInterface_SendTestNotification_Call) Return(notificationLog *models.NotificationLog, err error) *MockNotificationServiceInterface_SendTestNotification_Call {
+	_c.Call.Return(notificationLog, err)
+	return _c
+}
+
+func (_c *MockNotificationServiceInterface_SendTestNotification_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, channel string, now time.Time) (*models.NotificationLog, error)) *MockNotificationServiceInterface_SendTestNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWebhookServiceInterface creates a new instance of MockWebhookServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookServiceInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookServiceInterface {
+	mock := &MockWebhookServiceInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookServiceInterface is an autogenerated mock type for the WebhookServiceInterface type
+type MockWebhookServiceInterface struct {
+	mock.Mock
+}
+
+type MockWebhookServiceInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookServiceInterface) EXPECT() *MockWebhookServiceInterface_Expecter {
+	return &MockWebhookServiceInterface_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *models.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookServiceInterface_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookServiceInterface_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *models.Webhook
+func (_e *MockWebhookServiceInterface_Expecter) CreateWebhook(ctx interface{}, webhook interface{}) *MockWebhookServiceInterface_CreateWebhook_Call {
+	return &MockWebhookServiceInterface_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, webhook)}
+}
+
+func (_c *MockWebhookServiceInterface_CreateWebhook_Call) Run(run func(ctx context.Context, webhook *models.Webhook)) *MockWebhookServiceInterface_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *models.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*models.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_CreateWebhook_Call) Return(err error) *MockWebhookServiceInterface_CreateWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook *models.Webhook) error) *MockWebhookServiceInterface_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) DeleteWebhook(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookServiceInterface_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookServiceInterface_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockWebhookServiceInterface_Expecter) DeleteWebhook(ctx interface{}, userID interface{}, id interface{}) *MockWebhookServiceInterface_DeleteWebhook_Call {
+	return &MockWebhookServiceInterface_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, userID, id)}
+}
+
+func (_c *MockWebhookServiceInterface_DeleteWebhook_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockWebhookServiceInterface_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_DeleteWebhook_Call) Return(err error) *MockWebhookServiceInterface_DeleteWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) error) *MockWebhookServiceInterface_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) GetWebhook(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Webhook, error) {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 *models.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Webhook, error)); ok {
+		return returnFunc(ctx, userID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Webhook); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookServiceInterface_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockWebhookServiceInterface_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockWebhookServiceInterface_Expecter) GetWebhook(ctx interface{}, userID interface{}, id interface{}) *MockWebhookServiceInterface_GetWebhook_Call {
+	return &MockWebhookServiceInterface_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, userID, id)}
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhook_Call) Run(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID)) *MockWebhookServiceInterface_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhook_Call) Return(webhook *models.Webhook, err error) *MockWebhookServiceInterface_GetWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhook_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*models.Webhook, error)) *MockWebhookServiceInterface_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) GetWebhookDeliveries(ctx context.Context, userID uuid.UUID, webhookID uuid.UUID) ([]models.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, userID, webhookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, userID, webhookID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, userID, webhookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, webhookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookServiceInterface_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockWebhookServiceInterface_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - webhookID uuid.UUID
+func (_e *MockWebhookServiceInterface_Expecter) GetWebhookDeliveries(ctx interface{}, userID interface{}, webhookID interface{}) *MockWebhookServiceInterface_GetWebhookDeliveries_Call {
+	return &MockWebhookServiceInterface_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, userID, webhookID)}
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, userID uuid.UUID, webhookID uuid.UUID)) *MockWebhookServiceInterface_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhookDeliveries_Call) Return(webhookDeliverys []models.WebhookDelivery, err error) *MockWebhookServiceInterface_GetWebhookDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhookDeliveries_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, webhookID uuid.UUID) ([]models.WebhookDelivery, error)) *MockWebhookServiceInterface_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) GetWebhooks(ctx context.Context, userID uuid.UUID) ([]models.Webhook, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 []models.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Webhook, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Webhook); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookServiceInterface_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockWebhookServiceInterface_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *MockWebhookServiceInterface_Expecter) GetWebhooks(ctx interface{}, userID interface{}) *MockWebhookServiceInterface_GetWebhooks_Call {
+	return &MockWebhookServiceInterface_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", ctx, userID)}
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhooks_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *MockWebhookServiceInterface_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhooks_Call) Return(webhooks []models.Webhook, err error) *MockWebhookServiceInterface_GetWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_GetWebhooks_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID) ([]models.Webhook, error)) *MockWebhookServiceInterface_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTestEvent provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) SendTestEvent(ctx context.Context, userID uuid.UUID, webhookID uuid.UUID, now time.Time) (*models.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, userID, webhookID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTestEvent")
+	}
+
+	var r0 *models.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) (*models.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, userID, webhookID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) *models.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, userID, webhookID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, webhookID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookServiceInterface_SendTestEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTestEvent'
+type MockWebhookServiceInterface_SendTestEvent_Call struct {
+	*mock.Call
+}
+
+// SendTestEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - webhookID uuid.UUID
+//   - now time.Time
+func (_e *MockWebhookServiceInterface_Expecter) SendTestEvent(ctx interface{}, userID interface{}, webhookID interface{}, now interface{}) *MockWebhookServiceInterface_SendTestEvent_Call {
+	return &MockWebhookServiceInterface_SendTestEvent_Call{Call: _e.mock.On("SendTestEvent", ctx, userID, webhookID, now)}
+}
+
+func (_c *MockWebhookServiceInterface_SendTestEvent_Call) Run(run func(ctx context.Context, userID uuid.UUID, webhookID uuid.UUID, now time.Time)) *MockWebhookServiceInterface_SendTestEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_SendTestEvent_Call) Return(webhookDelivery *models.WebhookDelivery, err error) *MockWebhookServiceInterface_SendTestEvent_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_SendTestEvent_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, webhookID uuid.UUID, now time.Time) (*models.WebhookDelivery, error)) *MockWebhookServiceInterface_SendTestEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhook provides a mock function for the type MockWebhookServiceInterface
+func (_mock *MockWebhookServiceInterface) UpdateWebhook(ctx context.Context, userID uuid.UUID, webhook *models.Webhook) error {
+	ret := _mock.Called(ctx, userID, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *models.Webhook) error); ok {
+		r0 = returnFunc(ctx, userID, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookServiceInterface_UpdateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhook'
+type MockWebhookServiceInterface_UpdateWebhook_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - webhook *models.Webhook
+func (_e *MockWebhookServiceInterface_Expecter) UpdateWebhook(ctx interface{}, userID interface{}, webhook interface{}) *MockWebhookServiceInterface_UpdateWebhook_Call {
+	return &MockWebhookServiceInterface_UpdateWebhook_Call{Call: _e.mock.On("UpdateWebhook", ctx, userID, webhook)}
+}
+
+func (_c *MockWebhookServiceInterface_UpdateWebhook_Call) Run(run func(ctx context.Context, userID uuid.UUID, webhook *models.Webhook)) *MockWebhookServiceInterface_UpdateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *models.Webhook
+		if args[2] != nil {
+			arg2 = args[2].(*models.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_UpdateWebhook_Call) Return(err error) *MockWebhookServiceInterface_UpdateWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookServiceInterface_UpdateWebhook_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, webhook *models.Webhook) error) *MockWebhookServiceInterface_UpdateWebhook_Call {
 	_c.Call.Return(run)
 	return _c
 }
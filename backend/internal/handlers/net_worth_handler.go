@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NetWorthHandler struct {
+	netWorthService NetWorthServiceInterface
+}
+
+func NewNetWorthHandler(netWorthService NetWorthServiceInterface) *NetWorthHandler {
+	return &NetWorthHandler{
+		netWorthService: netWorthService,
+	}
+}
+
+// @Summary Get net worth
+// @Description Get the current assets by type, the loan balances and the net worth in the base currency
+// @Tags net-worth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.NetWorth
+// @Failure 400 {object} map[string]string
+// @Router /net-worth [get]
+func (h *NetWorthHandler) GetNetWorth(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	netWorth, err := h.netWorthService.GetNetWorth(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, netWorth)
+}
+
+// @Summary Get net worth history
+// @Description Get the daily net worth snapshots of the last months, oldest first
+// @Tags net-worth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param months query int false "Number of months of history" default(12)
+// @Success 200 {array} models.NetWorthSnapshot
+// @Failure 400 {object} map[string]string
+// @Router /net-worth/history [get]
+func (h *NetWorthHandler) GetNetWorthHistory(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	months, err := strconv.Atoi(c.DefaultQuery("months", "12"))
+	if err != nil || months <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid months parameter"})
+		return
+	}
+	if months > 120 {
+		months = 120 // Limit to 120 months (10 years)
+	}
+
+	snapshots, err := h.netWorthService.GetNetWorthHistory(c.Request.Context(), userUUID, months)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, snapshots)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNetWorthHandler_GetNetWorth(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*MockNetWorthServiceInterface, uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(m *MockNetWorthServiceInterface, userID uuid.UUID) {
+				m.On("GetNetWorth", mock.Anything, userID).Return(&models.NetWorth{
+					Date: "2024-01-31", Currency: "JPY", TotalAssets: 500000, TotalLiabilities: 200000, NetWorth: 300000,
+					Assets:      []models.AssetTypeTotal{{AssetType: "bank_account", Amount: 500000}},
+					Liabilities: []models.LiabilityBalance{{RecurringPaymentID: uuid.New(), Name: "Car Loan", Amount: 200000}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "missing exchange rate",
+			setupMock: func(m *MockNetWorthServiceInterface, userID uuid.UUID) {
+				m.On("GetNetWorth", mock.Anything, userID).Return(nil, services.NewValidationError("currency", "no exchange rate from USD to JPY"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockNetWorthServiceInterface(t)
+			handler := NewNetWorthHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/net-worth", nil, userID)
+
+			handler.GetNetWorth(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var netWorth models.NetWorth
+				helpers.ParseJSONResponse(t, w, &netWorth)
+				assert.Equal(t, int64(300000), netWorth.NetWorth)
+				assert.Len(t, netWorth.Liabilities, 1)
+			}
+		})
+	}
+}
+
+func TestNetWorthHandler_GetNetWorthHistory(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*MockNetWorthServiceInterface, uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "default months",
+			setupMock: func(m *MockNetWorthServiceInterface, userID uuid.UUID) {
+				m.On("GetNetWorthHistory", mock.Anything, userID, 12).Return([]models.NetWorthSnapshot{{UserID: userID, SnapshotDate: "2024-01-31"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "months are capped",
+			query: "?months=600",
+			setupMock: func(m *MockNetWorthServiceInterface, userID uuid.UUID) {
+				m.On("GetNetWorthHistory", mock.Anything, userID, 120).Return([]models.NetWorthSnapshot{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid months",
+			query:          "?months=zero",
+			setupMock:      func(m *MockNetWorthServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockNetWorthServiceInterface(t)
+			handler := NewNetWorthHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/net-worth/history"+tt.query, nil, userID)
+
+			handler.GetNetWorthHistory(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NotificationHandler struct {
+	notificationService NotificationServiceInterface
+}
+
+func NewNotificationHandler(notificationService NotificationServiceInterface) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// @Summary Get notification log
+// @Description Get the latest 50 notifications sent to the user, latest first. Failed deliveries are retried by the next run of the notification job.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.NotificationLog
+// @Router /notifications [get]
+func (h *NotificationHandler) GetNotificationLogs(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	logs, err := h.notificationService.GetNotificationLogs(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, logs)
+}
+
+// @Summary Send test notification
+// @Description Send a test notification on a channel, whether or not its notifications are turned on: e-mail goes to the user's notification address, Slack, Discord and LINE to the webhook URL or token of the user's settings. The result of the delivery is returned as a log entry.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param channel query string false "email (default), slack, discord or line"
+// @Success 200 {object} models.NotificationLog
+// @Failure 400 {object} map[string]string
+// @Router /notifications/test [post]
+func (h *NotificationHandler) SendTestNotification(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	log, err := h.notificationService.SendTestNotification(c.Request.Context(), userUUID, c.Query("channel"), time.Now())
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, log)
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationHandler_GetNotificationLogs(t *testing.T) {
+	mockService := NewMockNotificationServiceInterface(t)
+	handler := NewNotificationHandler(mockService)
+
+	userID := uuid.New()
+	mockService.On("GetNotificationLogs", mock.Anything, userID).Return([]models.NotificationLog{
+		{ID: uuid.New(), UserID: userID, Channel: "email", EventType: "low_balance", Status: "sent"},
+	}, nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "GET", "/notifications", nil, userID)
+
+	handler.GetNotificationLogs(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var logs []map[string]any
+	helpers.ParseJSONResponse(t, w, &logs)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "low_balance", logs[0]["event_type"])
+}
+
+func TestNotificationHandler_SendTestNotification(t *testing.T) {
+	tests := []struct {
+		name           string
+		channel        string
+		log            *models.NotificationLog
+		err            error
+		expectedStatus int
+	}{
+		{name: "sent", log: &models.NotificationLog{EventType: "test", Status: "sent"}, expectedStatus: http.StatusOK},
+		{name: "delivery failed", log: &models.NotificationLog{EventType: "test", Status: "failed"}, expectedStatus: http.StatusOK},
+		{name: "slack", channel: "slack", log: &models.NotificationLog{Channel: "slack", EventType: "test", Status: "sent"}, expectedStatus: http.StatusOK},
+		{name: "chat not set up", channel: "discord", err: services.NewValidationError("notification_discord_webhook_url", "is not set"), expectedStatus: http.StatusBadRequest},
+		{name: "not configured", err: services.NewValidationError("email", "notifications are not configured on this server"), expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockNotificationServiceInterface(t)
+			handler := NewNotificationHandler(mockService)
+
+			userID := uuid.New()
+			mockService.On("SendTestNotification", mock.Anything, userID, tt.channel, mock.AnythingOfType("time.Time")).Return(tt.log, tt.err)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/notifications/test?channel="+tt.channel, nil, userID)
+
+			handler.SendTestNotification(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.log != nil {
+				var log map[string]any
+				helpers.ParseJSONResponse(t, w, &log)
+				assert.Equal(t, tt.log.Status, log["status"])
+			}
+		})
+	}
+}
+
+func TestNotificationHandler_Unauthorized(t *testing.T) {
+	handler := NewNotificationHandler(NewMockNotificationServiceInterface(t))
+
+	c, w := helpers.CreateTestContext(t, "POST", "/notifications/test", nil, false)
+
+	handler.SendTestNotification(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
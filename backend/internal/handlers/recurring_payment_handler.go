@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"fmt"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -39,7 +41,7 @@ func (h *RecurringPaymentHandler) GetRecurringPayments(c *gin.Context) {
 		return
 	}
 
-	payments, err := h.recurringPaymentService.GetRecurringPayments(userUUID)
+	payments, err := h.recurringPaymentService.GetRecurringPayments(c.Request.Context(), userUUID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -64,7 +66,7 @@ func (h *RecurringPaymentHandler) GetRecurringPayment(c *gin.Context) {
 		return
 	}
 
-	payment, err := h.recurringPaymentService.GetRecurringPayment(id)
+	payment, err := h.recurringPaymentService.GetRecurringPayment(c.Request.Context(), id)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "recurring payment not found"})
 		return
@@ -104,8 +106,8 @@ func (h *RecurringPaymentHandler) CreateRecurringPayment(c *gin.Context) {
 	// Set the user_id from the authenticated user
 	payment.UserID = userUUID
 
-	if err := h.recurringPaymentService.CreateRecurringPayment(&payment); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.recurringPaymentService.CreateRecurringPayment(c.Request.Context(), &payment); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -136,8 +138,8 @@ func (h *RecurringPaymentHandler) UpdateRecurringPayment(c *gin.Context) {
 	}
 
 	payment.ID = id
-	if err := h.recurringPaymentService.UpdateRecurringPayment(&payment); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err := h.recurringPaymentService.UpdateRecurringPayment(c.Request.Context(), &payment); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -160,10 +162,69 @@ func (h *RecurringPaymentHandler) DeleteRecurringPayment(c *gin.Context) {
 		return
 	}
 
-	if err := h.recurringPaymentService.DeleteRecurringPayment(id); err != nil {
+	if err := h.recurringPaymentService.DeleteRecurringPayment(c.Request.Context(), id); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusNoContent, nil)
 }
+
+// @Summary Get loan amortization schedule
+// @Description Get the repayment schedule of a loan with remaining principal, optionally simulating a lump-sum prepayment
+// @Tags recurring-payments
+// @Accept json
+// @Produce json
+// @Param id path string true "Recurring Payment ID"
+// @Param prepay_date query string false "Prepayment date (YYYY-MM-DD)"
+// @Param prepay_amount query int false "Prepayment amount"
+// @Param prepay_mode query string false "shorten_term or reduce_payment" default(shorten_term)
+// @Success 200 {object} models.AmortizationSchedule
+// @Router /recurring-payments/{id}/amortization [get]
+func (h *RecurringPaymentHandler) GetAmortizationSchedule(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid recurring payment id format"})
+		return
+	}
+
+	prepayment, err := parsePrepaymentQuery(c, id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := h.recurringPaymentService.GetAmortizationSchedule(c.Request.Context(), id, prepayment)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, schedule)
+}
+
+// parsePrepaymentQuery reads a simulated loan prepayment from the prepay_* query parameters.
+// It returns nil when no prepayment is requested.
+func parsePrepaymentQuery(c *gin.Context, paymentID uuid.UUID) (*models.LoanPrepayment, error) {
+	date := c.Query("prepay_date")
+	amountStr := c.Query("prepay_amount")
+	if date == "" && amountStr == "" {
+		return nil, nil
+	}
+	if date == "" || amountStr == "" {
+		return nil, fmt.Errorf("prepay_date and prepay_amount must be given together")
+	}
+
+	amount, err := strconv.ParseInt(amountStr, 10, 64)
+	if err != nil || amount <= 0 {
+		return nil, fmt.Errorf("invalid prepay_amount parameter")
+	}
+
+	return &models.LoanPrepayment{
+		RecurringPaymentID: paymentID,
+		Date:               date,
+		Amount:             amount,
+		Mode:               c.Query("prepay_mode"),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 
 	"github.com/gin-gonic/gin"
@@ -46,7 +48,7 @@ func TestRecurringPaymentHandler_GetRecurringPayments(t *testing.T) {
 						UpdatedAt:         time.Now(),
 					},
 				}
-				m.On("GetRecurringPayments", uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return(testPayments, nil)
+				m.On("GetRecurringPayments", mock.Anything, uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return(testPayments, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -64,7 +66,7 @@ func TestRecurringPaymentHandler_GetRecurringPayments(t *testing.T) {
 			name:          "service error",
 			authenticated: true,
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("GetRecurringPayments", uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return([]models.RecurringPayment{}, assert.AnError)
+				m.On("GetRecurringPayments", mock.Anything, uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")).Return([]models.RecurringPayment{}, assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedCount:  0,
@@ -131,7 +133,7 @@ func TestRecurringPaymentHandler_GetRecurringPayment(t *testing.T) {
 					CreatedAt:         time.Now(),
 					UpdatedAt:         time.Now(),
 				}
-				m.On("GetRecurringPayment", uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testPayment, nil)
+				m.On("GetRecurringPayment", mock.Anything, uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")).Return(testPayment, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -147,7 +149,7 @@ func TestRecurringPaymentHandler_GetRecurringPayment(t *testing.T) {
 			name:      "payment not found",
 			paymentID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("GetRecurringPayment", uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.RecurringPayment)(nil), assert.AnError)
+				m.On("GetRecurringPayment", mock.Anything, uuid.MustParse("99999999-9999-9999-9999-999999999999")).Return((*models.RecurringPayment)(nil), assert.AnError)
 			},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -174,6 +176,105 @@ func TestRecurringPaymentHandler_GetRecurringPayment(t *testing.T) {
 	}
 }
 
+func TestRecurringPaymentHandler_GetAmortizationSchedule(t *testing.T) {
+	paymentID := uuid.MustParse("11223344-5566-7788-99aa-bbccddeeff00")
+
+	tests := []struct {
+		name           string
+		paymentID      string
+		query          string
+		setupMock      func(*MockRecurringPaymentServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name:      "successful retrieval",
+			paymentID: paymentID.String(),
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				m.On("GetAmortizationSchedule", mock.Anything, paymentID, (*models.LoanPrepayment)(nil)).
+					Return(&models.AmortizationSchedule{RecurringPaymentID: paymentID, Principal: 1000000}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "with prepayment",
+			paymentID: paymentID.String(),
+			query:     "?prepay_date=2026-04-01&prepay_amount=500000&prepay_mode=reduce_payment",
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				m.On("GetAmortizationSchedule", mock.Anything, paymentID, &models.LoanPrepayment{
+					RecurringPaymentID: paymentID,
+					Date:               "2026-04-01",
+					Amount:             500000,
+					Mode:               "reduce_payment",
+				}).Return(&models.AmortizationSchedule{RecurringPaymentID: paymentID, InterestSaved: 12000}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "invalid payment ID",
+			paymentID: "invalid-uuid",
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				// No mock setup needed for invalid UUID
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "prepayment amount without date",
+			paymentID: paymentID.String(),
+			query:     "?prepay_amount=500000",
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				// No mock setup needed for invalid query
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "invalid prepayment amount",
+			paymentID: paymentID.String(),
+			query:     "?prepay_date=2026-04-01&prepay_amount=-1",
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				// No mock setup needed for invalid query
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "not a loan",
+			paymentID: paymentID.String(),
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				m.On("GetAmortizationSchedule", mock.Anything, paymentID, (*models.LoanPrepayment)(nil)).
+					Return(nil, services.NewValidationError("loan_principal", "recurring payment is not a loan"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "payment not found",
+			paymentID: paymentID.String(),
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				m.On("GetAmortizationSchedule", mock.Anything, paymentID, (*models.LoanPrepayment)(nil)).Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockRecurringPaymentServiceInterface(t)
+			handler := NewRecurringPaymentHandler(mockService)
+
+			// Setup mock
+			tt.setupMock(mockService)
+
+			// Create test context
+			c, w := helpers.CreateTestContext(t, "GET", fmt.Sprintf("/recurring-payments/%s/amortization%s", tt.paymentID, tt.query), nil, true)
+			c.Params = gin.Params{{Key: "id", Value: tt.paymentID}}
+
+			// Call handler
+			handler.GetAmortizationSchedule(c)
+
+			// Assert response
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
 func TestRecurringPaymentHandler_CreateRecurringPayment(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -195,7 +296,7 @@ func TestRecurringPaymentHandler_CreateRecurringPayment(t *testing.T) {
 				"note":             "Netflix subscription",
 			},
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("CreateRecurringPayment", mock.AnythingOfType("*models.RecurringPayment")).Return(nil)
+				m.On("CreateRecurringPayment", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 		},
@@ -223,10 +324,28 @@ func TestRecurringPaymentHandler_CreateRecurringPayment(t *testing.T) {
 				"name": "", // 空の名前でサービスエラーが発生することを期待
 			},
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("CreateRecurringPayment", mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
+				m.On("CreateRecurringPayment", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
+		{
+			name:          "invalid recurrence rule",
+			authenticated: true,
+			requestBody: map[string]interface{}{
+				"name":             "Car Tax",
+				"amount":           int64(3450000),
+				"payment_day":      31,
+				"start_year_month": "2024-05",
+				"recurrence_rule":  "FREQ=HOURLY",
+				"bank_account":     "aabbccdd-eeff-1122-3344-556677889900",
+				"is_active":        true,
+			},
+			setupMock: func(m *MockRecurringPaymentServiceInterface) {
+				m.On("CreateRecurringPayment", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).
+					Return(services.NewValidationError("recurrence_rule", "unsupported FREQ: HOURLY"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:          "service error",
 			authenticated: true,
@@ -240,7 +359,7 @@ func TestRecurringPaymentHandler_CreateRecurringPayment(t *testing.T) {
 				"note":             "Netflix subscription",
 			},
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("CreateRecurringPayment", mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
+				m.On("CreateRecurringPayment", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -294,7 +413,7 @@ func TestRecurringPaymentHandler_UpdateRecurringPayment(t *testing.T) {
 				"note":             "Updated Netflix subscription",
 			},
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("UpdateRecurringPayment", mock.AnythingOfType("*models.RecurringPayment")).Return(nil)
+				m.On("UpdateRecurringPayment", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
@@ -328,7 +447,7 @@ func TestRecurringPaymentHandler_UpdateRecurringPayment(t *testing.T) {
 				"note":             "Updated Netflix subscription",
 			},
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
-				m.On("UpdateRecurringPayment", mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
+				m.On("UpdateRecurringPayment", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -367,7 +486,7 @@ func TestRecurringPaymentHandler_DeleteRecurringPayment(t *testing.T) {
 			paymentID: "11223344-5566-7788-99aa-bbccddeeff00",
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
 				paymentUUID, _ := uuid.Parse("11223344-5566-7788-99aa-bbccddeeff00")
-				m.On("DeleteRecurringPayment", paymentUUID).Return(nil)
+				m.On("DeleteRecurringPayment", mock.Anything, paymentUUID).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
 		},
@@ -384,7 +503,7 @@ func TestRecurringPaymentHandler_DeleteRecurringPayment(t *testing.T) {
 			paymentID: "99999999-9999-9999-9999-999999999999",
 			setupMock: func(m *MockRecurringPaymentServiceInterface) {
 				paymentUUID, _ := uuid.Parse("99999999-9999-9999-9999-999999999999")
-				m.On("DeleteRecurringPayment", paymentUUID).Return(assert.AnError)
+				m.On("DeleteRecurringPayment", mock.Anything, paymentUUID).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
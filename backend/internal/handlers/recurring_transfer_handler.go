@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RecurringTransferHandler struct {
+	recurringTransferService RecurringTransferServiceInterface
+}
+
+func NewRecurringTransferHandler(recurringTransferService RecurringTransferServiceInterface) *RecurringTransferHandler {
+	return &RecurringTransferHandler{
+		recurringTransferService: recurringTransferService,
+	}
+}
+
+// @Summary Get all recurring transfers
+// @Description Get all transfers between the user's bank accounts
+// @Tags recurring-transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.RecurringTransfer
+// @Router /recurring-transfers [get]
+func (h *RecurringTransferHandler) GetRecurringTransfers(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	transfers, err := h.recurringTransferService.GetRecurringTransfers(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transfers)
+}
+
+// @Summary Get recurring transfer by ID
+// @Description Get a specific recurring transfer by ID
+// @Tags recurring-transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Recurring Transfer ID"
+// @Success 200 {object} models.RecurringTransfer
+// @Failure 404 {object} map[string]string
+// @Router /recurring-transfers/{id} [get]
+func (h *RecurringTransferHandler) GetRecurringTransfer(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid recurring transfer id format"})
+		return
+	}
+
+	transfer, err := h.recurringTransferService.GetRecurringTransfer(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer)
+}
+
+// @Summary Create recurring transfer
+// @Description Create a fixed or top-up transfer between two bank accounts
+// @Tags recurring-transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param transfer body models.RecurringTransfer true "Recurring Transfer data"
+// @Success 201 {object} models.RecurringTransfer
+// @Failure 400 {object} map[string]string
+// @Router /recurring-transfers [post]
+func (h *RecurringTransferHandler) CreateRecurringTransfer(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var transfer models.RecurringTransfer
+	if err := c.ShouldBindJSON(&transfer); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	transfer.UserID = userUUID
+
+	if err := h.recurringTransferService.CreateRecurringTransfer(c.Request.Context(), &transfer); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, transfer)
+}
+
+// @Summary Update recurring transfer
+// @Description Update an existing recurring transfer
+// @Tags recurring-transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Recurring Transfer ID"
+// @Param transfer body models.RecurringTransfer true "Recurring Transfer data"
+// @Success 200 {object} models.RecurringTransfer
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring-transfers/{id} [put]
+func (h *RecurringTransferHandler) UpdateRecurringTransfer(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid recurring transfer id format"})
+		return
+	}
+
+	var transfer models.RecurringTransfer
+	if err := c.ShouldBindJSON(&transfer); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	transfer.ID = id
+	if err := h.recurringTransferService.UpdateRecurringTransfer(c.Request.Context(), userUUID, &transfer); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer)
+}
+
+// @Summary Delete recurring transfer
+// @Description Delete a recurring transfer
+// @Tags recurring-transfers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Recurring Transfer ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /recurring-transfers/{id} [delete]
+func (h *RecurringTransferHandler) DeleteRecurringTransfer(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid recurring transfer id format"})
+		return
+	}
+
+	if err := h.recurringTransferService.DeleteRecurringTransfer(c.Request.Context(), userUUID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecurringTransferHandler_GetRecurringTransfers(t *testing.T) {
+	tests := []struct {
+		name           string
+		authenticated  bool
+		setupMock      func(*MockRecurringTransferServiceInterface, uuid.UUID)
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:          "successful retrieval",
+			authenticated: true,
+			setupMock: func(m *MockRecurringTransferServiceInterface, userID uuid.UUID) {
+				amount := int64(50000)
+				day := 25
+				m.On("GetRecurringTransfers", mock.Anything, userID).Return([]models.RecurringTransfer{
+					{ID: uuid.New(), UserID: userID, Name: "Savings", FromAccount: uuid.New(), ToAccount: uuid.New(), TransferType: "fixed", Amount: &amount, TransferDay: &day, IsActive: true},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "unauthenticated user",
+			authenticated:  false,
+			setupMock:      func(m *MockRecurringTransferServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "service error",
+			authenticated: true,
+			setupMock: func(m *MockRecurringTransferServiceInterface, userID uuid.UUID) {
+				m.On("GetRecurringTransfers", mock.Anything, userID).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockRecurringTransferServiceInterface(t)
+			handler := NewRecurringTransferHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			var c *gin.Context
+			var w *httptest.ResponseRecorder
+			if tt.authenticated {
+				c, w = helpers.CreateTestContextWithUserID(t, "GET", "/recurring-transfers", nil, userID)
+			} else {
+				c, w = helpers.CreateTestContext(t, "GET", "/recurring-transfers", nil, false)
+			}
+
+			handler.GetRecurringTransfers(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var transfers []models.RecurringTransfer
+				helpers.ParseJSONResponse(t, w, &transfers)
+				assert.Len(t, transfers, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestRecurringTransferHandler_CreateRecurringTransfer(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupMock      func(*MockRecurringTransferServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			requestBody: map[string]interface{}{
+				"name":           "Card account top-up",
+				"from_account":   "aabbccdd-eeff-1122-3344-556677889900",
+				"to_account":     "bbccddee-ff00-1122-3344-556677889900",
+				"transfer_type":  "top_up",
+				"target_balance": int64(100000),
+				"is_active":      true,
+			},
+			setupMock: func(m *MockRecurringTransferServiceInterface) {
+				m.On("CreateRecurringTransfer", mock.Anything, mock.MatchedBy(func(transfer *models.RecurringTransfer) bool {
+					return transfer.UserID == uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d") && *transfer.TargetBalance == 100000
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "invalid request body",
+			requestBody: map[string]interface{}{
+				"from_account": "not-a-uuid",
+			},
+			setupMock:      func(m *MockRecurringTransferServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "validation error",
+			requestBody: map[string]interface{}{
+				"name":          "Savings",
+				"from_account":  "aabbccdd-eeff-1122-3344-556677889900",
+				"to_account":    "aabbccdd-eeff-1122-3344-556677889900",
+				"transfer_type": "fixed",
+			},
+			setupMock: func(m *MockRecurringTransferServiceInterface) {
+				m.On("CreateRecurringTransfer", mock.Anything, mock.AnythingOfType("*models.RecurringTransfer")).
+					Return(services.NewValidationError("to_account", "must be different from from_account"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "service error",
+			requestBody: map[string]interface{}{
+				"name":          "Savings",
+				"from_account":  "aabbccdd-eeff-1122-3344-556677889900",
+				"to_account":    "bbccddee-ff00-1122-3344-556677889900",
+				"transfer_type": "fixed",
+				"amount":        int64(50000),
+				"transfer_day":  25,
+			},
+			setupMock: func(m *MockRecurringTransferServiceInterface) {
+				m.On("CreateRecurringTransfer", mock.Anything, mock.AnythingOfType("*models.RecurringTransfer")).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockRecurringTransferServiceInterface(t)
+			handler := NewRecurringTransferHandler(mockService)
+			tt.setupMock(mockService)
+
+			userID := uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/recurring-transfers", tt.requestBody, userID)
+
+			handler.CreateRecurringTransfer(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRecurringTransferHandler_UpdateRecurringTransfer(t *testing.T) {
+	transferID := uuid.New().String()
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		transferID     string
+		setupMock      func(*MockRecurringTransferServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name:       "successful update",
+			transferID: transferID,
+			setupMock: func(m *MockRecurringTransferServiceInterface) {
+				m.On("UpdateRecurringTransfer", mock.Anything, userID, mock.MatchedBy(func(transfer *models.RecurringTransfer) bool {
+					return transfer.ID.String() == transferID
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "another user's transfer",
+			transferID: transferID,
+			setupMock: func(m *MockRecurringTransferServiceInterface) {
+				m.On("UpdateRecurringTransfer", mock.Anything, userID, mock.AnythingOfType("*models.RecurringTransfer")).Return(sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid id",
+			transferID:     "invalid-uuid",
+			setupMock:      func(m *MockRecurringTransferServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockRecurringTransferServiceInterface(t)
+			handler := NewRecurringTransferHandler(mockService)
+			tt.setupMock(mockService)
+
+			requestBody := map[string]interface{}{
+				"name":          "Savings",
+				"from_account":  "aabbccdd-eeff-1122-3344-556677889900",
+				"to_account":    "bbccddee-ff00-1122-3344-556677889900",
+				"transfer_type": "fixed",
+				"amount":        int64(60000),
+				"transfer_day":  25,
+			}
+			c, w := helpers.CreateTestContextWithUserID(t, "PUT", fmt.Sprintf("/recurring-transfers/%s", tt.transferID), requestBody, userID)
+			c.Params = gin.Params{{Key: "id", Value: tt.transferID}}
+
+			handler.UpdateRecurringTransfer(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRecurringTransferHandler_DeleteRecurringTransfer(t *testing.T) {
+	mockService := NewMockRecurringTransferServiceInterface(t)
+	handler := NewRecurringTransferHandler(mockService)
+
+	userID, transferID := uuid.New(), uuid.New()
+	mockService.On("DeleteRecurringTransfer", mock.Anything, userID, transferID).Return(nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "DELETE", fmt.Sprintf("/recurring-transfers/%s", transferID), nil, userID)
+	c.Params = gin.Params{{Key: "id", Value: transferID.String()}}
+
+	handler.DeleteRecurringTransfer(c)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
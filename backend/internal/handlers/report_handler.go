@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/pdf"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	reportService ReportServiceInterface
+}
+
+func NewReportHandler(reportService ReportServiceInterface) *ReportHandler {
+	return &ReportHandler{
+		reportService: reportService,
+	}
+}
+
+// @Summary Get monthly report
+// @Description Download the statement of a month as a PDF: opening and closing balances, income and payments, card statements, variance against the schedule and the projected balances of the next 12 months
+// @Tags reports
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param year_month query string false "Month in YYYY-MM format; defaults to the previous month"
+// @Success 200 {file} file
+// @Failure 400 {object} map[string]string
+// @Router /reports/monthly [get]
+func (h *ReportHandler) GetMonthlyReport(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	now := time.Now()
+	previousMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+	yearMonth := c.DefaultQuery("year_month", previousMonth.Format("2006-01"))
+
+	content, err := h.reportService.RenderMonthlyReport(c.Request.Context(), userUUID, yearMonth, now)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	writeMonthlyReport(c, yearMonth, content)
+}
+
+// @Summary Get stored monthly reports
+// @Description Get the monthly reports the scheduled job produced, latest month first. The job runs for users with the monthly_report_enabled setting on.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.StoredMonthlyReport
+// @Router /reports/monthly/archive [get]
+func (h *ReportHandler) GetStoredMonthlyReports(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	reports, err := h.reportService.GetStoredMonthlyReports(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, reports)
+}
+
+// @Summary Get stored monthly report
+// @Description Download the PDF the scheduled job produced for a month
+// @Tags reports
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param year_month path string true "Month in YYYY-MM format"
+// @Success 200 {file} file
+// @Failure 404 {object} map[string]string
+// @Router /reports/monthly/archive/{year_month} [get]
+func (h *ReportHandler) GetStoredMonthlyReport(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	report, err := h.reportService.GetStoredMonthlyReport(c.Request.Context(), userUUID, c.Param("year_month"))
+	if err != nil {
+		status := serviceErrorStatus(err)
+		if status == http.StatusNotFound {
+			c.JSON(status, gin.H{"error": "monthly report not found"})
+			return
+		}
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	writeMonthlyReport(c, report.YearMonth, report.Content)
+}
+
+// writeMonthlyReport sends a report as a file download
+func writeMonthlyReport(c *gin.Context, yearMonth string, content []byte) {
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="monthly-report-%s.pdf"`, yearMonth))
+	c.Data(http.StatusOK, pdf.ContentType, content)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReportHandler_GetMonthlyReport(t *testing.T) {
+	previousMonth := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0).Format("2006-01")
+
+	tests := []struct {
+		name              string
+		url               string
+		expectedYearMonth string
+		err               error
+		expectedStatus    int
+	}{
+		{name: "requested month", url: "/reports/monthly?year_month=2025-01", expectedYearMonth: "2025-01", expectedStatus: http.StatusOK},
+		{name: "previous month by default", url: "/reports/monthly", expectedYearMonth: previousMonth, expectedStatus: http.StatusOK},
+		{name: "invalid month", url: "/reports/monthly?year_month=2025", expectedYearMonth: "2025", err: services.NewValidationError("year_month", "must be in YYYY-MM format"), expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockReportServiceInterface(t)
+			handler := NewReportHandler(mockService)
+
+			userID := uuid.New()
+			var content []byte
+			if tt.err == nil {
+				content = []byte("%PDF-1.4")
+			}
+			mockService.On("RenderMonthlyReport", mock.Anything, userID, tt.expectedYearMonth, mock.AnythingOfType("time.Time")).Return(content, tt.err)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", tt.url, nil, userID)
+
+			handler.GetMonthlyReport(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.err == nil {
+				assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="monthly-report-`+tt.expectedYearMonth+`.pdf"`, w.Header().Get("Content-Disposition"))
+				assert.Equal(t, "%PDF-1.4", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestReportHandler_GetStoredMonthlyReports(t *testing.T) {
+	mockService := NewMockReportServiceInterface(t)
+	handler := NewReportHandler(mockService)
+
+	userID := uuid.New()
+	mockService.On("GetStoredMonthlyReports", mock.Anything, userID).Return([]models.StoredMonthlyReport{
+		{ID: uuid.New(), UserID: userID, YearMonth: "2025-01", Content: []byte("%PDF-1.4"), Size: 8},
+	}, nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "GET", "/reports/monthly/archive", nil, userID)
+
+	handler.GetStoredMonthlyReports(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var reports []map[string]any
+	helpers.ParseJSONResponse(t, w, &reports)
+	assert.Len(t, reports, 1)
+	assert.Equal(t, "2025-01", reports[0]["year_month"])
+	assert.NotContains(t, reports[0], "content")
+}
+
+func TestReportHandler_GetStoredMonthlyReport(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*MockReportServiceInterface, uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name: "report stored",
+			setupMock: func(m *MockReportServiceInterface, userID uuid.UUID) {
+				m.On("GetStoredMonthlyReport", mock.Anything, userID, "2025-01").Return(&models.StoredMonthlyReport{YearMonth: "2025-01", Content: []byte("%PDF-1.4")}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "no report for the month",
+			setupMock: func(m *MockReportServiceInterface, userID uuid.UUID) {
+				m.On("GetStoredMonthlyReport", mock.Anything, userID, "2025-01").Return(nil, sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockReportServiceInterface(t)
+			handler := NewReportHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/reports/monthly/archive/2025-01", nil, userID)
+			c.Params = gin.Params{{Key: "year_month", Value: "2025-01"}}
+
+			handler.GetStoredMonthlyReport(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestReportHandler_Unauthorized(t *testing.T) {
+	handler := NewReportHandler(NewMockReportServiceInterface(t))
+
+	c, w := helpers.CreateTestContext(t, "GET", "/reports/monthly", nil, false)
+
+	handler.GetMonthlyReport(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SavingsGoalHandler struct {
+	savingsGoalService SavingsGoalServiceInterface
+}
+
+func NewSavingsGoalHandler(savingsGoalService SavingsGoalServiceInterface) *SavingsGoalHandler {
+	return &SavingsGoalHandler{
+		savingsGoalService: savingsGoalService,
+	}
+}
+
+// @Summary Get all savings goals
+// @Description Get all savings goals of the user
+// @Tags savings-goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.SavingsGoal
+// @Router /savings-goals [get]
+func (h *SavingsGoalHandler) GetSavingsGoals(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	goals, err := h.savingsGoalService.GetSavingsGoals(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, goals)
+}
+
+// @Summary Get savings goal progress
+// @Description Get the progress of the active savings goals and the projected date each is reached
+// @Tags savings-goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.SavingsGoalProgress
+// @Router /savings-goals/progress [get]
+func (h *SavingsGoalHandler) GetSavingsGoalProgress(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	progress, err := h.savingsGoalService.GetSavingsGoalProgress(c.Request.Context(), userUUID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+// @Summary Get savings goal by ID
+// @Description Get a specific savings goal by ID
+// @Tags savings-goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Savings Goal ID"
+// @Success 200 {object} models.SavingsGoal
+// @Failure 404 {object} map[string]string
+// @Router /savings-goals/{id} [get]
+func (h *SavingsGoalHandler) GetSavingsGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid savings goal id format"})
+		return
+	}
+
+	goal, err := h.savingsGoalService.GetSavingsGoal(c.Request.Context(), userUUID, id)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, goal)
+}
+
+// @Summary Create savings goal
+// @Description Create a savings goal funded from a bank account or a ring-fenced portion of one
+// @Tags savings-goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param goal body models.SavingsGoal true "Savings Goal data"
+// @Success 201 {object} models.SavingsGoal
+// @Failure 400 {object} map[string]string
+// @Router /savings-goals [post]
+func (h *SavingsGoalHandler) CreateSavingsGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	var goal models.SavingsGoal
+	if err := c.ShouldBindJSON(&goal); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Set the user_id from the authenticated user
+	goal.UserID = userUUID
+
+	if err := h.savingsGoalService.CreateSavingsGoal(c.Request.Context(), &goal); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, goal)
+}
+
+// @Summary Update savings goal
+// @Description Update an existing savings goal
+// @Tags savings-goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Savings Goal ID"
+// @Param goal body models.SavingsGoal true "Savings Goal data"
+// @Success 200 {object} models.SavingsGoal
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /savings-goals/{id} [put]
+func (h *SavingsGoalHandler) UpdateSavingsGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid savings goal id format"})
+		return
+	}
+
+	var goal models.SavingsGoal
+	if err := c.ShouldBindJSON(&goal); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	goal.ID = id
+	if err := h.savingsGoalService.UpdateSavingsGoal(c.Request.Context(), userUUID, &goal); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, goal)
+}
+
+// @Summary Delete savings goal
+// @Description Delete a savings goal
+// @Tags savings-goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Savings Goal ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /savings-goals/{id} [delete]
+func (h *SavingsGoalHandler) DeleteSavingsGoal(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusForbidden, gin.H{"error": "user not authenticated"})
+		return
+	}
+
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "invalid user_id format in context"})
+		return
+	}
+
+	idStr := c.Param("id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid savings goal id format"})
+		return
+	}
+
+	if err := h.savingsGoalService.DeleteSavingsGoal(c.Request.Context(), userUUID, id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSavingsGoalHandler_GetSavingsGoalProgress(t *testing.T) {
+	tests := []struct {
+		name           string
+		authenticated  bool
+		setupMock      func(*MockSavingsGoalServiceInterface, uuid.UUID)
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:          "successful retrieval",
+			authenticated: true,
+			setupMock: func(m *MockSavingsGoalServiceInterface, userID uuid.UUID) {
+				date := "2027-01-25"
+				m.On("GetSavingsGoalProgress", mock.Anything, userID).Return([]models.SavingsGoalProgress{
+					{SavingsGoalID: uuid.New(), Name: "Emergency fund", TargetAmount: 1000000, CurrentAmount: 400000, ProgressRate: 40, ProjectedDate: &date},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "unauthenticated user",
+			authenticated:  false,
+			setupMock:      func(m *MockSavingsGoalServiceInterface, userID uuid.UUID) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "service error",
+			authenticated: true,
+			setupMock: func(m *MockSavingsGoalServiceInterface, userID uuid.UUID) {
+				m.On("GetSavingsGoalProgress", mock.Anything, userID).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockSavingsGoalServiceInterface(t)
+			handler := NewSavingsGoalHandler(mockService)
+
+			userID := uuid.New()
+			tt.setupMock(mockService, userID)
+
+			var c *gin.Context
+			var w *httptest.ResponseRecorder
+			if tt.authenticated {
+				c, w = helpers.CreateTestContextWithUserID(t, "GET", "/savings-goals/progress", nil, userID)
+			} else {
+				c, w = helpers.CreateTestContext(t, "GET", "/savings-goals/progress", nil, false)
+			}
+
+			handler.GetSavingsGoalProgress(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var progress []models.SavingsGoalProgress
+				helpers.ParseJSONResponse(t, w, &progress)
+				assert.Len(t, progress, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestSavingsGoalHandler_CreateSavingsGoal(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		setupMock      func(*MockSavingsGoalServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			requestBody: map[string]interface{}{
+				"name":              "Emergency fund",
+				"target_amount":     int64(1000000),
+				"target_year_month": "2027-03",
+				"bank_account":      "aabbccdd-eeff-1122-3344-556677889900",
+				"is_reserved":       true,
+				"is_active":         true,
+			},
+			setupMock: func(m *MockSavingsGoalServiceInterface) {
+				m.On("CreateSavingsGoal", mock.Anything, mock.MatchedBy(func(goal *models.SavingsGoal) bool {
+					return goal.UserID == uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d") && goal.IsReserved
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "invalid request body",
+			requestBody: map[string]interface{}{
+				"bank_account": "not-a-uuid",
+			},
+			setupMock:      func(m *MockSavingsGoalServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "validation error",
+			requestBody: map[string]interface{}{
+				"name":         "Trip",
+				"bank_account": "aabbccdd-eeff-1122-3344-556677889900",
+			},
+			setupMock: func(m *MockSavingsGoalServiceInterface) {
+				m.On("CreateSavingsGoal", mock.Anything, mock.AnythingOfType("*models.SavingsGoal")).
+					Return(services.NewValidationError("target_amount", "must be positive"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockSavingsGoalServiceInterface(t)
+			handler := NewSavingsGoalHandler(mockService)
+			tt.setupMock(mockService)
+
+			userID := uuid.MustParse("cbf3d545-d81d-450d-acb3-c5c49a597d6d")
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/savings-goals", tt.requestBody, userID)
+
+			handler.CreateSavingsGoal(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestSavingsGoalHandler_UpdateSavingsGoal(t *testing.T) {
+	goalID := uuid.New().String()
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		goalID         string
+		setupMock      func(*MockSavingsGoalServiceInterface)
+		expectedStatus int
+	}{
+		{
+			name:   "successful update",
+			goalID: goalID,
+			setupMock: func(m *MockSavingsGoalServiceInterface) {
+				m.On("UpdateSavingsGoal", mock.Anything, userID, mock.MatchedBy(func(goal *models.SavingsGoal) bool {
+					return goal.ID.String() == goalID && *goal.AllocatedAmount == 80000
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "another user's goal",
+			goalID: goalID,
+			setupMock: func(m *MockSavingsGoalServiceInterface) {
+				m.On("UpdateSavingsGoal", mock.Anything, userID, mock.AnythingOfType("*models.SavingsGoal")).Return(sql.ErrNoRows)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid id",
+			goalID:         "invalid-uuid",
+			setupMock:      func(m *MockSavingsGoalServiceInterface) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockSavingsGoalServiceInterface(t)
+			handler := NewSavingsGoalHandler(mockService)
+			tt.setupMock(mockService)
+
+			requestBody := map[string]interface{}{
+				"name":             "Trip",
+				"target_amount":    int64(300000),
+				"bank_account":     "aabbccdd-eeff-1122-3344-556677889900",
+				"allocated_amount": int64(80000),
+				"is_active":        true,
+			}
+			c, w := helpers.CreateTestContextWithUserID(t, "PUT", fmt.Sprintf("/savings-goals/%s", tt.goalID), requestBody, userID)
+			c.Params = gin.Params{{Key: "id", Value: tt.goalID}}
+
+			handler.UpdateSavingsGoal(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestSavingsGoalHandler_DeleteSavingsGoal(t *testing.T) {
+	mockService := NewMockSavingsGoalServiceInterface(t)
+	handler := NewSavingsGoalHandler(mockService)
+
+	userID, goalID := uuid.New(), uuid.New()
+	mockService.On("DeleteSavingsGoal", mock.Anything, userID, goalID).Return(nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "DELETE", fmt.Sprintf("/savings-goals/%s", goalID), nil, userID)
+	c.Params = gin.Params{{Key: "id", Value: goalID.String()}}
+
+	handler.DeleteSavingsGoal(c)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebhookHandler_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           map[string]any
+		err            error
+		expectedStatus int
+	}{
+		{name: "created", body: map[string]any{"name": "Home Assistant", "url": "https://ha.example.com/api/webhook/flow"}, expectedStatus: http.StatusCreated},
+		{name: "invalid url", body: map[string]any{"name": "Relay", "url": "relay"}, err: services.NewValidationError("url", "must be an http or https URL"), expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockWebhookServiceInterface(t)
+			handler := NewWebhookHandler(mockService)
+
+			userID := uuid.New()
+			mockService.On("CreateWebhook", mock.Anything, mock.MatchedBy(func(webhook *models.Webhook) bool {
+				return webhook.UserID == userID && webhook.Name == tt.body["name"]
+			})).Return(tt.err)
+
+			c, w := helpers.CreateTestContextWithUserID(t, "POST", "/webhooks", tt.body, userID)
+
+			handler.CreateWebhook(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestWebhookHandler_GetWebhookDeliveries(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		err            error
+		expectedStatus int
+	}{
+		{name: "found", id: uuid.New().String(), expectedStatus: http.StatusOK},
+		{name: "another user's webhook", id: uuid.New().String(), err: sql.ErrNoRows, expectedStatus: http.StatusNotFound},
+		{name: "invalid id", id: "not-a-uuid", expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := NewMockWebhookServiceInterface(t)
+			handler := NewWebhookHandler(mockService)
+
+			userID := uuid.New()
+			if id, err := uuid.Parse(tt.id); err == nil {
+				mockService.On("GetWebhookDeliveries", mock.Anything, userID, id).Return([]models.WebhookDelivery{
+					{ID: uuid.New(), WebhookID: id, EventType: "alert.triggered", Status: "succeeded", Attempts: 1},
+				}, tt.err)
+			}
+
+			c, w := helpers.CreateTestContextWithUserID(t, "GET", "/webhooks/"+tt.id+"/deliveries", nil, userID)
+			c.Params = gin.Params{{Key: "id", Value: tt.id}}
+
+			handler.GetWebhookDeliveries(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var deliveries []map[string]any
+				helpers.ParseJSONResponse(t, w, &deliveries)
+				assert.Len(t, deliveries, 1)
+				assert.Equal(t, "alert.triggered", deliveries[0]["event_type"])
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_SendTestEvent(t *testing.T) {
+	mockService := NewMockWebhookServiceInterface(t)
+	handler := NewWebhookHandler(mockService)
+
+	userID, webhookID := uuid.New(), uuid.New()
+	mockService.On("SendTestEvent", mock.Anything, userID, webhookID, mock.AnythingOfType("time.Time")).Return(&models.WebhookDelivery{
+		WebhookID: webhookID, EventType: "test", Status: "failed", Attempts: 1,
+	}, nil)
+
+	c, w := helpers.CreateTestContextWithUserID(t, "POST", "/webhooks/"+webhookID.String()+"/test", nil, userID)
+	c.Params = gin.Params{{Key: "id", Value: webhookID.String()}}
+
+	handler.SendTestEvent(c)
+
+	assert.Equal(t, http.StatusOK, w.Code, "a failed attempt is returned as a delivery")
+	var delivery map[string]any
+	helpers.ParseJSONResponse(t, w, &delivery)
+	assert.Equal(t, "failed", delivery["status"])
+}
+
+func TestWebhookHandler_Unauthorized(t *testing.T) {
+	handler := NewWebhookHandler(NewMockWebhookServiceInterface(t))
+
+	c, w := helpers.CreateTestContext(t, "GET", "/webhooks", nil, false)
+
+	handler.GetWebhooks(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,140 @@
+// Package ical writes iCalendar (RFC 5545) feeds of all-day events that calendar apps can
+// subscribe to.
+//
+// Text values are escaped and lines are folded at 75 octets without splitting UTF-8 characters.
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ContentType is the MIME type of an iCalendar file
+const ContentType = "text/calendar; charset=utf-8"
+
+// Calendar is a feed of events
+type Calendar struct {
+	ProductID string        // Identifies the application that wrote the feed
+	Name      string        // Shown as the name of the subscribed calendar
+	Refresh   time.Duration // How often subscribers should reload the feed; zero leaves it to the app
+	Events    []Event
+}
+
+// Event is an all-day event
+type Event struct {
+	UID         string // Stays the same across refreshes so apps update the event instead of adding one
+	Date        time.Time
+	Summary     string
+	Description string
+	Alarm       *Alarm
+}
+
+// Alarm reminds of an event some days before it
+type Alarm struct {
+	DaysBefore  int
+	Description string
+}
+
+// Write writes the calendar to w. now is recorded as the time the events were created.
+func (c *Calendar) Write(w io.Writer, now time.Time) error {
+	lw := &lineWriter{w: w}
+	lw.line("BEGIN:VCALENDAR")
+	lw.line("VERSION:2.0")
+	lw.line("PRODID:" + c.ProductID)
+	lw.line("CALSCALE:GREGORIAN")
+	lw.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.Refresh > 0 {
+		interval := duration(c.Refresh)
+		lw.line("REFRESH-INTERVAL;VALUE=DURATION:" + interval)
+		lw.line("X-PUBLISHED-TTL:" + interval)
+	}
+
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, event := range c.Events {
+		lw.line("BEGIN:VEVENT")
+		lw.line("UID:" + escapeText(event.UID))
+		lw.line("DTSTAMP:" + stamp)
+		lw.line("DTSTART;VALUE=DATE:" + event.Date.Format("20060102"))
+		lw.line("DTEND;VALUE=DATE:" + event.Date.AddDate(0, 0, 1).Format("20060102"))
+		lw.line("SUMMARY:" + escapeText(event.Summary))
+		if event.Description != "" {
+			lw.line("DESCRIPTION:" + escapeText(event.Description))
+		}
+		lw.line("TRANSP:TRANSPARENT")
+		if event.Alarm != nil {
+			lw.line("BEGIN:VALARM")
+			lw.line("ACTION:DISPLAY")
+			lw.line(fmt.Sprintf("TRIGGER:-P%dD", event.Alarm.DaysBefore))
+			lw.line("DESCRIPTION:" + escapeText(event.Alarm.Description))
+			lw.line("END:VALARM")
+		}
+		lw.line("END:VEVENT")
+	}
+
+	lw.line("END:VCALENDAR")
+	return lw.err
+}
+
+// duration formats a duration as an iCalendar duration in whole minutes, e.g. "PT1H30M"
+func duration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	value := "PT"
+	if hours := minutes / 60; hours > 0 {
+		value += fmt.Sprintf("%dH", hours)
+	}
+	if rest := minutes % 60; rest > 0 {
+		value += fmt.Sprintf("%dM", rest)
+	}
+	return value
+}
+
+// escapeText escapes a TEXT value
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(s)
+}
+
+// maxLineLength is the longest a content line may be in octets, excluding the line break
+const maxLineLength = 75
+
+// lineWriter writes folded content lines and keeps the first error
+type lineWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (lw *lineWriter) line(content string) {
+	if lw.err != nil {
+		return
+	}
+	_, lw.err = io.WriteString(lw.w, fold(content)+"\r\n")
+}
+
+// fold breaks a content line into lines of at most 75 octets, continuation lines starting with a
+// space. Multi-byte characters are kept whole.
+func fold(content string) string {
+	if len(content) <= maxLineLength {
+		return content
+	}
+
+	var b strings.Builder
+	length := 0
+	for _, r := range content {
+		size := len(string(r))
+		if length+size > maxLineLength {
+			b.WriteString("\r\n ")
+			length = 1 // The leading space counts towards the line
+		}
+		b.WriteRune(r)
+		length += size
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Write(t *testing.T) {
+	calendar := &Calendar{
+		ProductID: "-//Flow Sight//JA",
+		Name:      "Flow Sight",
+		Refresh:   90 * time.Minute,
+		Events: []Event{
+			{
+				UID:     "2025-01-25-income@flow-sight",
+				Date:    time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC),
+				Summary: "給与 +¥300,000",
+			},
+			{
+				UID:         "2025-01-27-rent@flow-sight",
+				Date:        time.Date(2025, 1, 27, 0, 0, 0, 0, time.UTC),
+				Summary:     "家賃; 管理費込み",
+				Description: "メイン口座\nから引き落とし",
+				Alarm:       &Alarm{DaysBefore: 3, Description: "家賃"},
+			},
+		},
+	}
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	var buf bytes.Buffer
+	require.NoError(t, calendar.Write(&buf, now))
+	feed := buf.String()
+
+	assert.True(t, strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Flow Sight//JA\r\n"))
+	assert.True(t, strings.HasSuffix(feed, "END:VCALENDAR\r\n"))
+	assert.Contains(t, feed, "REFRESH-INTERVAL;VALUE=DURATION:PT1H30M\r\n")
+	assert.Contains(t, feed, "DTSTAMP:20250101T000000Z\r\n")
+	assert.Contains(t, feed, "DTSTART;VALUE=DATE:20250125\r\nDTEND;VALUE=DATE:20250126\r\n")
+	assert.Contains(t, feed, "SUMMARY:給与 +¥300\\,000\r\n")
+	assert.Contains(t, feed, "SUMMARY:家賃\\; 管理費込み\r\n")
+	assert.Contains(t, feed, "DESCRIPTION:メイン口座\\nから引き落とし\r\n")
+	assert.Contains(t, feed, "BEGIN:VALARM\r\nACTION:DISPLAY\r\nTRIGGER:-P3D\r\n")
+	assert.Equal(t, 1, strings.Count(feed, "BEGIN:VALARM"))
+}
+
+func TestFold(t *testing.T) {
+	short := "SUMMARY:short"
+	assert.Equal(t, short, fold(short))
+
+	long := "SUMMARY:" + strings.Repeat("あ", 40)
+	folded := fold(long)
+	for _, line := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineLength)
+	}
+	assert.Equal(t, long, strings.ReplaceAll(folded, "\r\n ", ""), "unfolding restores the line")
+}
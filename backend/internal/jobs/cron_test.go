@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// 2025-03-28 is a Friday
+	from := time.Date(2025, 3, 28, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{spec: "* * * * *", expected: time.Date(2025, 3, 28, 10, 18, 0, 0, time.UTC)},
+		{spec: "@hourly", expected: time.Date(2025, 3, 28, 11, 0, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", expected: time.Date(2025, 3, 28, 10, 30, 0, 0, time.UTC)},
+		{spec: "5/20 * * * *", expected: time.Date(2025, 3, 28, 10, 25, 0, 0, time.UTC)},
+		{spec: "0 3 * * *", expected: time.Date(2025, 3, 29, 3, 0, 0, 0, time.UTC)},
+		{spec: "30 9 * * 1-5", expected: time.Date(2025, 3, 31, 9, 30, 0, 0, time.UTC)},
+		{spec: "0 0 * * 7", expected: time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 1 * *", expected: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 12 1,15 6 *", expected: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)},
+		{spec: "@yearly", expected: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// Day of month and day of week match either way when both are restricted
+		{spec: "0 0 1 * 6", expected: time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+			assert.Equal(t, tt.spec, schedule.String())
+		})
+	}
+}
+
+func TestSchedule_NextNever(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 31 2 *")
+	require.NoError(t, err)
+	assert.True(t, schedule.Next(time.Now()).IsZero())
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@sometimes"} {
+		_, err := ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
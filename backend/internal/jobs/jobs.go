@@ -0,0 +1,108 @@
+// Package jobs runs background work inside the API server and records every run in job_runs.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/logger"
+	"github.com/Soli0222/flow-sight/backend/internal/metrics"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/Soli0222/flow-sight/backend/internal/jobs")
+
+// Run statuses
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Job is a named unit of background work. Jobs must be idempotent: running one twice in a row
+// must leave the data as a single run would.
+type Job interface {
+	Name() string
+	// Run does the work and returns a summary of what it did
+	Run(ctx context.Context) (string, error)
+}
+
+// RunStore persists job runs
+type RunStore interface {
+	Create(ctx context.Context, run *models.JobRun) error
+	Update(ctx context.Context, run *models.JobRun) error
+}
+
+// Runner executes jobs and records their runs
+type Runner struct {
+	store  RunStore
+	logger *logger.Logger
+	now    func() time.Time
+}
+
+func NewRunner(store RunStore, appLogger *logger.Logger) *Runner {
+	return &Runner{
+		store:  store,
+		logger: appLogger,
+		now:    time.Now,
+	}
+}
+
+// Run executes the job once and records the run. A failure to record the run is logged but
+// does not stop the job.
+func (r *Runner) Run(ctx context.Context, job Job) (*models.JobRun, error) {
+	// Each run is a trace of its own, since no request started it
+	ctx, span := tracer.Start(ctx, "job "+job.Name(), trace.WithNewRoot())
+	defer span.End()
+
+	run := &models.JobRun{
+		ID:        uuid.New(),
+		JobName:   job.Name(),
+		Status:    StatusRunning,
+		StartedAt: r.now(),
+	}
+	if err := r.store.Create(ctx, run); err != nil {
+		r.logger.ErrorContext(ctx, "Failed to record job run", "job", run.JobName, "error", err.Error())
+	}
+
+	summary, err := r.execute(ctx, job)
+
+	finishedAt := r.now()
+	run.FinishedAt = &finishedAt
+	run.Summary = summary
+	if err != nil {
+		message := err.Error()
+		run.Status = StatusFailed
+		run.Error = &message
+		span.SetStatus(codes.Error, message)
+		r.logger.ErrorContext(ctx, "Job failed", "job", run.JobName, "error", message)
+	} else {
+		run.Status = StatusSucceeded
+		r.logger.InfoContext(ctx, "Job completed", "job", run.JobName, "duration", finishedAt.Sub(run.StartedAt).String())
+	}
+
+	metrics.ObserveJobRun(run.JobName, run.Status, finishedAt.Sub(run.StartedAt))
+
+	if storeErr := r.store.Update(ctx, run); storeErr != nil {
+		r.logger.ErrorContext(ctx, "Failed to record job result", "job", run.JobName, "error", storeErr.Error())
+	}
+
+	return run, err
+}
+
+// execute runs the job, turning a panic into an error so one broken job cannot stop the server
+func (r *Runner) execute(ctx context.Context, job Job) (summary string, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+
+	return job.Run(ctx)
+}
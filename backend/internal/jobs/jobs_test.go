@@ -0,0 +1,143 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunStore struct {
+	created []models.JobRun
+	updated []models.JobRun
+	err     error
+}
+
+func (s *fakeRunStore) Create(ctx context.Context, run *models.JobRun) error {
+	s.created = append(s.created, *run)
+	return s.err
+}
+
+func (s *fakeRunStore) Update(ctx context.Context, run *models.JobRun) error {
+	s.updated = append(s.updated, *run)
+	return s.err
+}
+
+type fakeJob struct {
+	summary string
+	err     error
+	panics  bool
+	runs    int
+}
+
+func (j *fakeJob) Name() string { return "fake" }
+
+func (j *fakeJob) Run(ctx context.Context) (string, error) {
+	j.runs++
+	if j.panics {
+		panic("broken")
+	}
+	return j.summary, j.err
+}
+
+func TestRunner_Run(t *testing.T) {
+	tests := []struct {
+		name           string
+		job            *fakeJob
+		storeErr       error
+		expectedStatus string
+		expectedError  string
+	}{
+		{name: "success", job: &fakeJob{summary: "done"}, expectedStatus: StatusSucceeded},
+		{name: "failure", job: &fakeJob{err: errors.New("boom")}, expectedStatus: StatusFailed, expectedError: "boom"},
+		{name: "panic", job: &fakeJob{panics: true}, expectedStatus: StatusFailed, expectedError: "job panicked: broken"},
+		{name: "store error does not stop the job", job: &fakeJob{summary: "done"}, storeErr: errors.New("db down"), expectedStatus: StatusSucceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeRunStore{err: tt.storeErr}
+			runner := NewRunner(store, helpers.CreateTestLogger())
+
+			run, err := runner.Run(context.Background(), tt.job)
+
+			assert.Equal(t, 1, tt.job.runs)
+			require.Len(t, store.created, 1)
+			require.Len(t, store.updated, 1)
+			assert.Equal(t, StatusRunning, store.created[0].Status)
+			assert.Equal(t, tt.expectedStatus, run.Status)
+			assert.Equal(t, store.created[0].ID, run.ID)
+			assert.NotNil(t, run.FinishedAt)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Nil(t, run.Error)
+				assert.Equal(t, tt.job.summary, run.Summary)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Equal(t, tt.expectedError, *run.Error)
+			}
+		})
+	}
+}
+
+func TestServiceJob_Run(t *testing.T) {
+	now := time.Date(2025, 3, 28, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		report          *models.PaymentProgressReport
+		err             error
+		expectedSummary string
+		expectedError   string
+	}{
+		{
+			name:            "report",
+			report:          &models.PaymentProgressReport{Checked: 4, Updated: 1, Completed: 1, Changes: []models.PaymentProgressChange{}},
+			expectedSummary: `{"checked":4,"updated":1,"completed":1,"changes":[]}`,
+		},
+		{
+			name:            "report of a partial run keeps the error",
+			report:          &models.PaymentProgressReport{Checked: 4, Updated: 1, Changes: []models.PaymentProgressChange{}},
+			err:             errors.New("payment 2: db down"),
+			expectedSummary: `{"checked":4,"updated":1,"completed":0,"changes":[]}`,
+			expectedError:   "payment 2: db down",
+		},
+		{
+			name:          "no report",
+			err:           errors.New("db down"),
+			expectedError: "db down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calledWith time.Time
+			job := NewServiceJob(RecurringPaymentProgressJobName, func(ctx context.Context, at time.Time) (*models.PaymentProgressReport, error) {
+				calledWith = at
+				return tt.report, tt.err
+			})
+			job.now = func() time.Time { return now }
+
+			summary, err := job.Run(context.Background())
+
+			assert.Equal(t, now, calledWith)
+			assert.Equal(t, RecurringPaymentProgressJobName, job.Name())
+			if tt.expectedSummary == "" {
+				assert.Empty(t, summary)
+			} else {
+				assert.JSONEq(t, tt.expectedSummary, summary)
+			}
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/logger"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLockTTL is how long a replica may hold a job lock before another replica can take it
+// over. It only matters when a replica dies while running a job.
+const DefaultLockTTL = time.Hour
+
+// Locker makes sure each scheduled run of a job happens on only one replica
+type Locker interface {
+	// Acquire takes the lock for the run of the job scheduled at scheduledAt. It fails if another
+	// owner holds the lock or the run has already been taken.
+	Acquire(ctx context.Context, jobName, owner string, scheduledAt time.Time, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, jobName, owner string) error
+}
+
+// RunHistory returns past runs of a job
+type RunHistory interface {
+	GetRecentByJobName(ctx context.Context, jobName string, limit int) ([]models.JobRun, error)
+}
+
+// Scheduler runs registered jobs on their cron schedules
+type Scheduler struct {
+	runner  *Runner
+	locker  Locker
+	history RunHistory
+	logger  *logger.Logger
+	owner   string
+	lockTTL time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries []*entry
+	started bool
+}
+
+type entry struct {
+	job      Job
+	schedule *Schedule
+	next     time.Time
+}
+
+func NewScheduler(runner *Runner, locker Locker, history RunHistory, appLogger *logger.Logger) *Scheduler {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &Scheduler{
+		runner:  runner,
+		locker:  locker,
+		history: history,
+		logger:  appLogger,
+		owner:   fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8]),
+		lockTTL: DefaultLockTTL,
+		now:     time.Now,
+	}
+}
+
+// Register adds a job with a cron schedule. Job names must be unique, and jobs must be
+// registered before the scheduler is started.
+func (s *Scheduler) Register(spec string, job Job) error {
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("cannot register job %s after the scheduler has started", job.Name())
+	}
+	for _, e := range s.entries {
+		if e.job.Name() == job.Name() {
+			return fmt.Errorf("job %s is already registered", job.Name())
+		}
+	}
+
+	s.entries = append(s.entries, &entry{job: job, schedule: schedule})
+	return nil
+}
+
+// Start runs every registered job on its schedule until ctx is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	s.started = true
+	entries := append([]*entry(nil), s.entries...)
+	s.mu.Unlock()
+
+	for _, e := range entries {
+		go s.loop(ctx, e)
+	}
+	s.logger.InfoContext(ctx, "Job scheduler started", "jobs", len(entries), "owner", s.owner)
+}
+
+// Status returns the schedule, next run and last recorded run of every registered job
+func (s *Scheduler) Status(ctx context.Context) ([]models.JobStatus, error) {
+	s.mu.Lock()
+	statuses := make([]models.JobStatus, len(s.entries))
+	for i, e := range s.entries {
+		statuses[i] = models.JobStatus{
+			Name:     e.job.Name(),
+			Schedule: e.schedule.String(),
+		}
+		next := e.next
+		if next.IsZero() {
+			next = e.schedule.Next(s.now())
+		}
+		if !next.IsZero() {
+			statuses[i].NextRunAt = &next
+		}
+	}
+	s.mu.Unlock()
+
+	for i := range statuses {
+		runs, err := s.history.GetRecentByJobName(ctx, statuses[i].Name, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) > 0 {
+			statuses[i].LastRun = &runs[0]
+		}
+	}
+	return statuses, nil
+}
+
+func (s *Scheduler) loop(ctx context.Context, e *entry) {
+	for {
+		next := e.schedule.Next(s.now())
+		if next.IsZero() {
+			s.logger.ErrorContext(ctx, "Job schedule never fires", "job", e.job.Name(), "schedule", e.schedule.String())
+			return
+		}
+
+		s.mu.Lock()
+		e.next = next
+		s.mu.Unlock()
+
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runScheduled(ctx, e, next)
+	}
+}
+
+// runScheduled runs the job for the given scheduled time if this replica wins the lock
+func (s *Scheduler) runScheduled(ctx context.Context, e *entry, scheduledAt time.Time) {
+	name := e.job.Name()
+
+	acquired, err := s.locker.Acquire(ctx, name, s.owner, scheduledAt, s.lockTTL)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to acquire job lock", "job", name, "error", err.Error())
+		return
+	}
+	if !acquired {
+		s.logger.DebugContext(ctx, "Job is handled by another replica", "job", name)
+		return
+	}
+	defer func() {
+		if err := s.locker.Release(ctx, name, s.owner); err != nil {
+			s.logger.ErrorContext(ctx, "Failed to release job lock", "job", name, "error", err.Error())
+		}
+	}()
+
+	_, _ = s.runner.Run(ctx, e.job)
+}
@@ -0,0 +1,132 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLocker behaves like the job_locks table: a run can be taken once, and only while the lock is free
+type fakeLocker struct {
+	owner       string
+	scheduledAt time.Time
+	acquired    int
+	released    int
+}
+
+func (l *fakeLocker) Acquire(ctx context.Context, jobName, owner string, scheduledAt time.Time, ttl time.Duration) (bool, error) {
+	if l.owner != "" || !scheduledAt.After(l.scheduledAt) {
+		return false, nil
+	}
+	l.owner, l.scheduledAt = owner, scheduledAt
+	l.acquired++
+	return true, nil
+}
+
+func (l *fakeLocker) Release(ctx context.Context, jobName, owner string) error {
+	if l.owner == owner {
+		l.owner = ""
+		l.released++
+	}
+	return nil
+}
+
+type fakeRunHistory struct {
+	runs map[string][]models.JobRun
+}
+
+func (h *fakeRunHistory) GetRecentByJobName(ctx context.Context, jobName string, limit int) ([]models.JobRun, error) {
+	runs := h.runs[jobName]
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
+
+func newTestScheduler(locker Locker) *Scheduler {
+	appLogger := helpers.CreateTestLogger()
+	return NewScheduler(NewRunner(&fakeRunStore{}, appLogger), locker, &fakeRunHistory{}, appLogger)
+}
+
+func TestScheduler_Register(t *testing.T) {
+	scheduler := newTestScheduler(&fakeLocker{})
+
+	require.NoError(t, scheduler.Register("@hourly", &fakeJob{}))
+	assert.Error(t, scheduler.Register("@daily", &fakeJob{}), "duplicate name")
+	assert.Error(t, scheduler.Register("not a schedule", &namedJob{name: "other"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	scheduler.Start(ctx)
+	assert.Error(t, scheduler.Register("@daily", &namedJob{name: "late"}))
+}
+
+func TestScheduler_RunScheduledOncePerRun(t *testing.T) {
+	locker := &fakeLocker{}
+	job := &fakeJob{}
+	scheduledAt := time.Date(2025, 3, 28, 11, 0, 0, 0, time.UTC)
+
+	// Two replicas fire for the same scheduled time
+	first := newTestScheduler(locker)
+	second := newTestScheduler(locker)
+	require.NoError(t, first.Register("@hourly", job))
+	require.NoError(t, second.Register("@hourly", job))
+
+	first.runScheduled(context.Background(), first.entries[0], scheduledAt)
+	second.runScheduled(context.Background(), second.entries[0], scheduledAt)
+	assert.Equal(t, 1, job.runs)
+	assert.Equal(t, 1, locker.released)
+
+	second.runScheduled(context.Background(), second.entries[0], scheduledAt.Add(time.Hour))
+	assert.Equal(t, 2, job.runs)
+}
+
+func TestScheduler_SkipsWhileLocked(t *testing.T) {
+	locker := &fakeLocker{owner: "another-replica"}
+	job := &fakeJob{}
+	scheduler := newTestScheduler(locker)
+	require.NoError(t, scheduler.Register("@hourly", job))
+
+	scheduler.runScheduled(context.Background(), scheduler.entries[0], time.Now())
+	assert.Equal(t, 0, job.runs)
+}
+
+func TestScheduler_Status(t *testing.T) {
+	now := time.Date(2025, 3, 28, 10, 17, 0, 0, time.UTC)
+	finishedAt := now.Add(-17 * time.Minute)
+	appLogger := helpers.CreateTestLogger()
+	history := &fakeRunHistory{runs: map[string][]models.JobRun{
+		"fake": {{JobName: "fake", Status: StatusSucceeded, FinishedAt: &finishedAt}},
+	}}
+	scheduler := NewScheduler(NewRunner(&fakeRunStore{}, appLogger), &fakeLocker{}, history, appLogger)
+	scheduler.now = func() time.Time { return now }
+
+	require.NoError(t, scheduler.Register("@hourly", &fakeJob{}))
+	require.NoError(t, scheduler.Register("0 3 * * *", &namedJob{name: "nightly"}))
+
+	statuses, err := scheduler.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	assert.Equal(t, "fake", statuses[0].Name)
+	assert.Equal(t, "@hourly", statuses[0].Schedule)
+	assert.Equal(t, time.Date(2025, 3, 28, 11, 0, 0, 0, time.UTC), *statuses[0].NextRunAt)
+	assert.Equal(t, StatusSucceeded, statuses[0].LastRun.Status)
+
+	assert.Equal(t, "nightly", statuses[1].Name)
+	assert.Nil(t, statuses[1].LastRun)
+}
+
+type namedJob struct {
+	name string
+}
+
+func (j *namedJob) Name() string { return j.name }
+
+func (j *namedJob) Run(ctx context.Context) (string, error) { return "", nil }
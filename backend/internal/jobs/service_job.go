@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Names recorded for the jobs that run a service operation
+const (
+	// RecurringPaymentProgressJobName advances loan counters once their payment dates have passed
+	// and deactivates payments that are finished
+	RecurringPaymentProgressJobName = "recurring_payment_progress"
+	// NetWorthSnapshotJobName records the day's net worth of every user. A second run on the same
+	// day replaces the day's snapshot.
+	NetWorthSnapshotJobName = "net_worth_snapshot"
+	// MonthlyReportJobName produces the report of the month just ended for the users who turned
+	// scheduled reports on. A second run in the same month replaces the stored reports.
+	MonthlyReportJobName = "monthly_report"
+	// NotificationJobName sends the alerts and digests of the day. Events already sent are
+	// skipped, so another run only retries failed deliveries and sends new events.
+	NotificationJobName = "notifications"
+	// WebhookDeliveryJobName posts queued events to webhooks and retries failed deliveries once
+	// their backoff has passed
+	WebhookDeliveryJobName = "webhook_deliveries"
+)
+
+// ServiceJob runs a service operation that takes the current time and reports what it did. The
+// report becomes the summary of the run as JSON.
+type ServiceJob[T any] struct {
+	name string
+	run  func(ctx context.Context, now time.Time) (*T, error)
+	now  func() time.Time
+}
+
+func NewServiceJob[T any](name string, run func(ctx context.Context, now time.Time) (*T, error)) *ServiceJob[T] {
+	return &ServiceJob[T]{
+		name: name,
+		run:  run,
+		now:  time.Now,
+	}
+}
+
+func (j *ServiceJob[T]) Name() string {
+	return j.name
+}
+
+// Run returns the report as a JSON summary. A report that comes with an error is still recorded,
+// so partial runs show what succeeded.
+func (j *ServiceJob[T]) Run(ctx context.Context) (string, error) {
+	report, err := j.run(ctx, j.now())
+	if report == nil {
+		return "", err
+	}
+
+	summary, marshalErr := json.Marshal(report)
+	if marshalErr != nil && err == nil {
+		err = marshalErr
+	}
+	return string(summary), err
+}
@@ -0,0 +1,187 @@
+// Package loan calculates amortization schedules for loans repaid in monthly instalments,
+// using either equal payments (元利均等) or equal principal (元金均等) repayment.
+//
+// Amounts are integers in the smallest currency unit. Interest is rounded per instalment and
+// the final instalment absorbs any rounding difference so the loan is repaid exactly.
+package loan
+
+import (
+	"fmt"
+	"math"
+)
+
+// Method is the repayment method of a loan
+type Method string
+
+const (
+	EqualPayment   Method = "equal_payment"   // 元利均等: the same payment every month
+	EqualPrincipal Method = "equal_principal" // 元金均等: the same principal every month
+)
+
+// PrepaymentMode decides what a lump-sum prepayment reduces
+type PrepaymentMode string
+
+const (
+	ShortenTerm   PrepaymentMode = "shorten_term"   // 期間短縮型: keep the payment, finish earlier
+	ReducePayment PrepaymentMode = "reduce_payment" // 返済額軽減型: keep the term, pay less each month
+)
+
+// Loan describes the terms of a loan
+type Loan struct {
+	Principal  int64
+	AnnualRate float64 // Annual interest rate in percent, e.g. 1.2
+	Term       int     // Number of monthly instalments
+	Method     Method
+}
+
+// Prepayment is a lump-sum repayment made right after the given instalment
+type Prepayment struct {
+	AfterInstallment int
+	Amount           int64
+	Mode             PrepaymentMode
+}
+
+// Installment is one row of an amortization schedule
+type Installment struct {
+	Number             int
+	Payment            int64 // Principal + Interest
+	Principal          int64
+	Interest           int64
+	Prepayment         int64 // Lump sum repaid after this instalment
+	RemainingPrincipal int64 // Balance after the instalment and any prepayment
+}
+
+// ParseMethod validates a repayment method
+func ParseMethod(value string) (Method, error) {
+	switch Method(value) {
+	case EqualPayment, EqualPrincipal:
+		return Method(value), nil
+	}
+	return "", fmt.Errorf("unsupported repayment method: %s", value)
+}
+
+// ParsePrepaymentMode validates a prepayment mode. An empty value means ShortenTerm.
+func ParsePrepaymentMode(value string) (PrepaymentMode, error) {
+	switch PrepaymentMode(value) {
+	case "":
+		return ShortenTerm, nil
+	case ShortenTerm, ReducePayment:
+		return PrepaymentMode(value), nil
+	}
+	return "", fmt.Errorf("unsupported prepayment mode: %s", value)
+}
+
+// Validate checks the loan terms
+func (l Loan) Validate() error {
+	if l.Principal <= 0 {
+		return fmt.Errorf("principal must be positive")
+	}
+	if l.AnnualRate < 0 || l.AnnualRate > 100 {
+		return fmt.Errorf("annual rate must be between 0 and 100")
+	}
+	if l.Term <= 0 {
+		return fmt.Errorf("term must be positive")
+	}
+	if _, err := ParseMethod(string(l.Method)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Schedule returns the amortization schedule of the loan with the given prepayments applied.
+// Prepayments larger than the outstanding balance repay the rest of the loan.
+func (l Loan) Schedule(prepayments []Prepayment) ([]Installment, error) {
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+
+	prepaymentsAfter := make(map[int][]Prepayment)
+	for _, prepayment := range prepayments {
+		if prepayment.Amount <= 0 {
+			return nil, fmt.Errorf("prepayment amount must be positive")
+		}
+		if prepayment.AfterInstallment < 1 || prepayment.AfterInstallment >= l.Term {
+			return nil, fmt.Errorf("prepayment must be made between the first and the last instalment")
+		}
+		if _, err := ParsePrepaymentMode(string(prepayment.Mode)); err != nil {
+			return nil, err
+		}
+		prepaymentsAfter[prepayment.AfterInstallment] = append(prepaymentsAfter[prepayment.AfterInstallment], prepayment)
+	}
+
+	rate := l.AnnualRate / 100 / 12
+	balance := l.Principal
+
+	// level is the fixed part of each instalment: the payment for EqualPayment, the principal for EqualPrincipal
+	level := l.level(balance, rate, l.Term)
+
+	applyPrepayments := func(after int) int64 {
+		total := int64(0)
+		for _, prepayment := range prepaymentsAfter[after] {
+			amount := prepayment.Amount
+			if amount > balance {
+				amount = balance
+			}
+			balance -= amount
+			total += amount
+
+			if prepayment.Mode == ReducePayment && balance > 0 {
+				level = l.level(balance, rate, l.Term-after)
+			}
+		}
+		return total
+	}
+
+	schedule := make([]Installment, 0, l.Term)
+	for number := 1; number <= l.Term && balance > 0; number++ {
+		interest := int64(math.Round(float64(balance) * rate))
+
+		var principal int64
+		if l.Method == EqualPayment {
+			principal = level - interest
+		} else {
+			principal = level
+		}
+		if principal > balance || number == l.Term {
+			principal = balance
+		}
+		if principal < 0 {
+			principal = 0
+		}
+		balance -= principal
+
+		installment := Installment{
+			Number:    number,
+			Payment:   principal + interest,
+			Principal: principal,
+			Interest:  interest,
+		}
+		installment.Prepayment = applyPrepayments(number)
+		installment.RemainingPrincipal = balance
+		schedule = append(schedule, installment)
+	}
+
+	return schedule, nil
+}
+
+// level returns the fixed payment (EqualPayment) or fixed principal (EqualPrincipal) that repays
+// balance over the given number of instalments
+func (l Loan) level(balance int64, rate float64, installments int) int64 {
+	if installments <= 0 {
+		return balance
+	}
+	if l.Method == EqualPrincipal || rate == 0 {
+		return int64(math.Ceil(float64(balance) / float64(installments)))
+	}
+	factor := math.Pow(1+rate, float64(installments))
+	return int64(math.Round(float64(balance) * rate * factor / (factor - 1)))
+}
+
+// TotalInterest sums the interest of a schedule
+func TotalInterest(schedule []Installment) int64 {
+	total := int64(0)
+	for _, installment := range schedule {
+		total += installment.Interest
+	}
+	return total
+}
@@ -0,0 +1,148 @@
+package loan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumPrincipal(schedule []Installment) int64 {
+	total := int64(0)
+	for _, installment := range schedule {
+		total += installment.Principal + installment.Prepayment
+	}
+	return total
+}
+
+func TestSchedule_EqualPayment(t *testing.T) {
+	l := Loan{Principal: 1000000, AnnualRate: 12, Term: 12, Method: EqualPayment}
+
+	schedule, err := l.Schedule(nil)
+	require.NoError(t, err)
+	require.Len(t, schedule, 12)
+
+	first := schedule[0]
+	assert.Equal(t, int64(88849), first.Payment)
+	assert.Equal(t, int64(10000), first.Interest)
+	assert.Equal(t, int64(78849), first.Principal)
+	assert.Equal(t, int64(921151), first.RemainingPrincipal)
+
+	for _, installment := range schedule[:11] {
+		assert.Equal(t, int64(88849), installment.Payment)
+	}
+	assert.InDelta(t, 88849, schedule[11].Payment, 10)
+	assert.Equal(t, int64(0), schedule[11].RemainingPrincipal)
+	assert.Equal(t, l.Principal, sumPrincipal(schedule))
+	assert.InDelta(t, 66185, TotalInterest(schedule), 10)
+}
+
+func TestSchedule_EqualPrincipal(t *testing.T) {
+	l := Loan{Principal: 1200000, AnnualRate: 12, Term: 12, Method: EqualPrincipal}
+
+	schedule, err := l.Schedule(nil)
+	require.NoError(t, err)
+	require.Len(t, schedule, 12)
+
+	assert.Equal(t, int64(100000), schedule[0].Principal)
+	assert.Equal(t, int64(12000), schedule[0].Interest)
+	assert.Equal(t, int64(112000), schedule[0].Payment)
+	assert.Equal(t, int64(1000), schedule[11].Interest)
+	assert.Equal(t, int64(101000), schedule[11].Payment)
+	assert.Equal(t, l.Principal, sumPrincipal(schedule))
+	assert.Equal(t, int64(78000), TotalInterest(schedule))
+}
+
+func TestSchedule_ZeroRate(t *testing.T) {
+	l := Loan{Principal: 100000, AnnualRate: 0, Term: 3, Method: EqualPayment}
+
+	schedule, err := l.Schedule(nil)
+	require.NoError(t, err)
+	require.Len(t, schedule, 3)
+
+	assert.Equal(t, int64(33334), schedule[0].Payment)
+	assert.Equal(t, int64(33334), schedule[1].Payment)
+	assert.Equal(t, int64(33332), schedule[2].Payment)
+	assert.Equal(t, int64(0), TotalInterest(schedule))
+}
+
+func TestSchedule_PrepaymentShortenTerm(t *testing.T) {
+	l := Loan{Principal: 1200000, AnnualRate: 12, Term: 12, Method: EqualPayment}
+	base, err := l.Schedule(nil)
+	require.NoError(t, err)
+
+	schedule, err := l.Schedule([]Prepayment{{AfterInstallment: 3, Amount: 300000, Mode: ShortenTerm}})
+	require.NoError(t, err)
+
+	assert.Less(t, len(schedule), 12)
+	assert.Equal(t, int64(300000), schedule[2].Prepayment)
+	assert.Equal(t, base[3].Payment, schedule[3].Payment)
+	assert.Equal(t, l.Principal, sumPrincipal(schedule))
+	assert.Less(t, TotalInterest(schedule), TotalInterest(base))
+	assert.Equal(t, int64(0), schedule[len(schedule)-1].RemainingPrincipal)
+}
+
+func TestSchedule_PrepaymentReducePayment(t *testing.T) {
+	l := Loan{Principal: 1200000, AnnualRate: 12, Term: 12, Method: EqualPayment}
+	base, err := l.Schedule(nil)
+	require.NoError(t, err)
+
+	schedule, err := l.Schedule([]Prepayment{{AfterInstallment: 3, Amount: 300000, Mode: ReducePayment}})
+	require.NoError(t, err)
+
+	assert.Len(t, schedule, 12)
+	assert.Less(t, schedule[3].Payment, base[3].Payment)
+	assert.Equal(t, l.Principal, sumPrincipal(schedule))
+	assert.Less(t, TotalInterest(schedule), TotalInterest(base))
+}
+
+func TestSchedule_PrepaymentRepaysEverything(t *testing.T) {
+	l := Loan{Principal: 500000, AnnualRate: 2, Term: 24, Method: EqualPrincipal}
+
+	schedule, err := l.Schedule([]Prepayment{{AfterInstallment: 1, Amount: 10000000}})
+	require.NoError(t, err)
+
+	require.Len(t, schedule, 1)
+	assert.Equal(t, l.Principal-schedule[0].Principal, schedule[0].Prepayment)
+	assert.Equal(t, int64(0), schedule[0].RemainingPrincipal)
+}
+
+func TestSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		loan        Loan
+		prepayments []Prepayment
+	}{
+		{name: "zero principal", loan: Loan{Principal: 0, Term: 12, Method: EqualPayment}},
+		{name: "negative rate", loan: Loan{Principal: 1000, AnnualRate: -1, Term: 12, Method: EqualPayment}},
+		{name: "zero term", loan: Loan{Principal: 1000, Term: 0, Method: EqualPayment}},
+		{name: "unknown method", loan: Loan{Principal: 1000, Term: 12, Method: "balloon"}},
+		{
+			name:        "prepayment after the last instalment",
+			loan:        Loan{Principal: 1000, Term: 12, Method: EqualPayment},
+			prepayments: []Prepayment{{AfterInstallment: 12, Amount: 100}},
+		},
+		{
+			name:        "prepayment before the first instalment",
+			loan:        Loan{Principal: 1000, Term: 12, Method: EqualPayment},
+			prepayments: []Prepayment{{AfterInstallment: 0, Amount: 100}},
+		},
+		{
+			name:        "negative prepayment",
+			loan:        Loan{Principal: 1000, Term: 12, Method: EqualPayment},
+			prepayments: []Prepayment{{AfterInstallment: 1, Amount: -100}},
+		},
+		{
+			name:        "unknown prepayment mode",
+			loan:        Loan{Principal: 1000, Term: 12, Method: EqualPayment},
+			prepayments: []Prepayment{{AfterInstallment: 1, Amount: 100, Mode: "skip"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.loan.Schedule(tt.prepayments)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -63,7 +63,7 @@ func New(cfg *config.Config, version string) *Logger {
 	}
 
 	// Add default attributes
-	logger := slog.New(handler).With(
+	logger := slog.New(traceHandler{handler}).With(
 		"service", logConfig.Service,
 		"version", logConfig.Version,
 		"environment", logConfig.Environment,
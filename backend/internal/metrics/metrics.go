@@ -0,0 +1,142 @@
+// Package metrics collects the Prometheus metrics of the backend and serves them for scraping.
+//
+// The collectors are package level so every layer can record without threading a recorder
+// through constructors. They are registered on a registry of their own, which is served on a
+// separate port rather than next to the API.
+package metrics
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/version"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "flow_sight"
+
+// UnmatchedRoute is the route label of requests that matched no route, so unknown paths
+// cannot grow the number of series
+const UnmatchedRoute = "unmatched"
+
+// Registry holds every collector of the backend
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Time to serve HTTP requests by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	projectionDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "cashflow_projection_duration_seconds",
+		Help:      "Time to compute a cashflow projection.",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	})
+
+	jobRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_runs_total",
+		Help:      "Background job runs by job and status.",
+	}, []string{"job", "status"})
+
+	jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_duration_seconds",
+		Help:      "Time background jobs take to run.",
+		Buckets:   []float64{.01, .1, .5, 1, 5, 15, 60, 300},
+	}, []string{"job"})
+
+	notifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "notifications_total",
+		Help:      "Notifications by channel, event and status.",
+	}, []string{"channel", "event", "status"})
+
+	buildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "build_info",
+		Help:      "Version of the running backend; the value is always 1.",
+	}, []string{"version"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		projectionDuration,
+		jobRuns,
+		jobDuration,
+		notifications,
+		buildInfo,
+	)
+	buildInfo.WithLabelValues(version.Version).Set(1)
+}
+
+// RegisterDB adds the connection pool statistics of the database
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// ObserveRequest records a served HTTP request. The route is the registered pattern, not the
+// requested path, so IDs in paths do not become labels.
+func ObserveRequest(method, route string, status int, duration time.Duration) {
+	if route == "" {
+		route = UnmatchedRoute
+	}
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpRequestDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// ObserveProjection records the time a cashflow projection took
+func ObserveProjection(duration time.Duration) {
+	projectionDuration.Observe(duration.Seconds())
+}
+
+// ObserveJobRun records a finished background job run
+func ObserveJobRun(job, status string, duration time.Duration) {
+	jobRuns.WithLabelValues(job, status).Inc()
+	jobDuration.WithLabelValues(job).Observe(duration.Seconds())
+}
+
+// CountNotification records a notification delivered or failed on a channel
+func CountNotification(channel, event, status string) {
+	notifications.WithLabelValues(channel, event, status).Inc()
+}
+
+// Handler serves the metrics in the Prometheus exposition format. With a token, scrapes must
+// send it as a bearer token.
+func Handler(token string) http.Handler {
+	handler := promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+	if token == "" {
+		return handler
+	}
+
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObserveRequest(t *testing.T) {
+	ObserveRequest(http.MethodGet, "/api/v1/credit-cards/:id", http.StatusOK, 20*time.Millisecond)
+	ObserveRequest(http.MethodGet, "", http.StatusNotFound, time.Millisecond)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(httpRequests.WithLabelValues("GET", "/api/v1/credit-cards/:id", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(httpRequests.WithLabelValues("GET", UnmatchedRoute, "404")), "unknown paths share one label")
+}
+
+func TestHandler(t *testing.T) {
+	CountNotification("slack", "low_balance", "sent")
+
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		expectedCode  int
+	}{
+		{name: "open", expectedCode: http.StatusOK},
+		{name: "valid token", token: "s3cret", authorization: "Bearer s3cret", expectedCode: http.StatusOK},
+		{name: "wrong token", token: "s3cret", authorization: "Bearer guess", expectedCode: http.StatusUnauthorized},
+		{name: "no token", token: "s3cret", expectedCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			Handler(tt.token).ServeHTTP(w, req)
+
+			require.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode == http.StatusOK {
+				assert.Contains(t, w.Body.String(), `flow_sight_notifications_total{channel="slack",event="low_balance",status="sent"} 1`)
+				assert.Contains(t, w.Body.String(), "flow_sight_build_info")
+				assert.Contains(t, w.Body.String(), "go_goroutines")
+			}
+		})
+	}
+}
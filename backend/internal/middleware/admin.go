@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminMiddleware allows only the users whose email is in adminEmails. It must run after
+// AuthMiddleware. With no admin emails configured every request is rejected.
+func AdminMiddleware(adminEmails []string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(adminEmails))
+	for _, email := range adminEmails {
+		allowed[strings.ToLower(email)] = true
+	}
+
+	return func(c *gin.Context) {
+		logger := GetLogger(c)
+
+		email := c.GetString("user_email")
+		if email == "" || !allowed[strings.ToLower(email)] {
+			userID := ""
+			if value, exists := c.Get("user_id"); exists {
+				userID = fmt.Sprint(value)
+			}
+			logger.Security(c.Request.Context(), "admin_access_denied", userID, c.ClientIP(), false)
+			c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
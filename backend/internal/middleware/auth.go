@@ -1,7 +1,6 @@
 package middleware
 
 import (
-	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/services"
 	"net/http"
 	"strings"
@@ -13,7 +12,7 @@ import (
 func AuthMiddleware(authService *services.AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger := GetLogger(c)
-		ctx := context.Background()
+		ctx := c.Request.Context()
 
 		authHeader := c.GetHeader("Authorization")
 
@@ -1,12 +1,14 @@
 package middleware
 
 import (
-	"context"
 	"time"
 
 	"github.com/Soli0222/flow-sight/backend/internal/logger"
+	"github.com/Soli0222/flow-sight/backend/internal/metrics"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -30,8 +32,11 @@ func RequestLogger(baseLogger *logger.Logger) gin.HandlerFunc {
 		// Add request ID to response header for debugging
 		c.Header("X-Request-ID", requestID)
 
+		// Join the request ID to the trace of the request
+		ctx := c.Request.Context()
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestID))
+
 		// Log request start (debug level)
-		ctx := context.Background()
 		reqLogger.DebugContext(ctx, "Request started",
 			"method", c.Request.Method,
 			"path", c.Request.URL.Path,
@@ -45,6 +50,9 @@ func RequestLogger(baseLogger *logger.Logger) gin.HandlerFunc {
 		// Calculate duration
 		duration := time.Since(start)
 
+		// Record the request by its route pattern
+		metrics.ObserveRequest(c.Request.Method, c.FullPath(), c.Writer.Status(), duration)
+
 		// Get user ID if available
 		userID := ""
 		if uid, exists := c.Get("user_id"); exists {
@@ -0,0 +1,30 @@
+package middleware
+
+import "github.com/gin-gonic/gin"
+
+// RedactPaths keeps secrets in the addresses of the given routes out of traces and logs. On a
+// matching route the request path is replaced with the route pattern, e.g.
+// /api/v1/calendar/:token, so it must run before the tracing and logging middleware. Handlers
+// still read the secret with c.Param.
+func RedactPaths(routes ...string) gin.HandlerFunc {
+	redacted := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		redacted[route] = true
+	}
+
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if !redacted[route] {
+			return
+		}
+
+		request := c.Request.WithContext(c.Request.Context())
+		address := *request.URL
+		address.Path = route
+		address.RawPath = ""
+		address.RawQuery = ""
+		request.URL = &address
+		request.RequestURI = address.RequestURI()
+		c.Request = request
+	}
+}
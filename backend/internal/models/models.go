@@ -1,6 +1,11 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,14 +13,17 @@ import (
 
 // CreditCard represents a credit card
 type CreditCard struct {
-	ID          uuid.UUID `json:"id" db:"id"`
-	UserID      uuid.UUID `json:"user_id" db:"user_id"`
-	Name        string    `json:"name" db:"name"`
-	ClosingDay  *int      `json:"closing_day,omitempty" db:"closing_day"` // Closing day of the month
-	PaymentDay  int       `json:"payment_day" db:"payment_day"`
-	BankAccount uuid.UUID `json:"bank_account" db:"bank_account"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID          uuid.UUID  `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Name        string     `json:"name" db:"name"`
+	ClosingDay  *int       `json:"closing_day,omitempty" db:"closing_day"` // Closing day of the month
+	PaymentDay  int        `json:"payment_day" db:"payment_day"`
+	BankAccount uuid.UUID  `json:"bank_account" db:"bank_account"`
+	CategoryID  *uuid.UUID `json:"category_id,omitempty" db:"category_id"` // Category of the card's statement totals
+	Tags        TagList    `json:"tags,omitempty" db:"tags"`
+	Currency    string     `json:"currency" db:"currency"` // ISO 4217 code of the statement totals
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // BankAccount represents a user's bank account
@@ -23,25 +31,115 @@ type BankAccount struct {
 	ID        uuid.UUID `json:"id" db:"id"`
 	UserID    uuid.UUID `json:"user_id" db:"user_id"`
 	Name      string    `json:"name" db:"name"`
-	Balance   int64     `json:"balance" db:"balance"` // Amount in cents
+	Balance   int64     `json:"balance" db:"balance"`   // Amount in cents
+	Currency  string    `json:"currency" db:"currency"` // ISO 4217 code, e.g. "JPY" or "USD"
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // IncomeSource represents a source of income
 type IncomeSource struct {
-	ID                 uuid.UUID `json:"id" db:"id"`
-	UserID             uuid.UUID `json:"user_id" db:"user_id"`
-	Name               string    `json:"name" db:"name"`
-	IncomeType         string    `json:"income_type" db:"income_type"` // "monthly_fixed" or "one_time"
-	BaseAmount         int64     `json:"base_amount" db:"base_amount"` // Amount in cents
-	BankAccount        uuid.UUID `json:"bank_account" db:"bank_account"`
-	PaymentDay         *int      `json:"payment_day,omitempty" db:"payment_day"`                   // For monthly_fixed income (1-31)
-	ScheduledDate      *string   `json:"scheduled_date,omitempty" db:"scheduled_date"`             // For one_time income (YYYY-MM-DD format)
-	ScheduledYearMonth *string   `json:"scheduled_year_month,omitempty" db:"scheduled_year_month"` // For one-time income (backward compatibility)
-	IsActive           bool      `json:"is_active" db:"is_active"`
-	CreatedAt          time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt          time.Time `json:"updated_at" db:"updated_at"`
+	ID                 uuid.UUID  `json:"id" db:"id"`
+	UserID             uuid.UUID  `json:"user_id" db:"user_id"`
+	Name               string     `json:"name" db:"name"`
+	IncomeType         string     `json:"income_type" db:"income_type"` // "monthly_fixed", "one_time", "semi_annual", "custom_months" or "annual"
+	BaseAmount         int64      `json:"base_amount" db:"base_amount"` // Amount in cents
+	BankAccount        uuid.UUID  `json:"bank_account" db:"bank_account"`
+	PaymentDay         *int       `json:"payment_day,omitempty" db:"payment_day"`                   // For scheduled income (1-31)
+	PaymentMonths      MonthList  `json:"payment_months,omitempty" db:"payment_months"`             // For semi_annual, custom_months and annual income
+	RaiseRate          *float64   `json:"raise_rate,omitempty" db:"raise_rate"`                     // Yearly raise in percent, e.g. 2.5
+	BaseYear           *int       `json:"base_year,omitempty" db:"base_year"`                       // Year BaseAmount applies to before raises
+	ScheduledDate      *string    `json:"scheduled_date,omitempty" db:"scheduled_date"`             // For one_time income (YYYY-MM-DD format)
+	ScheduledYearMonth *string    `json:"scheduled_year_month,omitempty" db:"scheduled_year_month"` // For one-time income (backward compatibility)
+	IsActive           bool       `json:"is_active" db:"is_active"`
+	CategoryID         *uuid.UUID `json:"category_id,omitempty" db:"category_id"`
+	Tags               TagList    `json:"tags,omitempty" db:"tags"`
+	Currency           string     `json:"currency" db:"currency"` // ISO 4217 code of the amounts
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// MonthList is a set of calendar months (1-12), stored as a comma separated string such as "6,12"
+type MonthList []int
+
+// Value implements driver.Valuer
+func (m MonthList) Value() (driver.Value, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	parts := make([]string, len(m))
+	for i, month := range m {
+		parts[i] = strconv.Itoa(month)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// Scan implements sql.Scanner
+func (m *MonthList) Scan(src interface{}) error {
+	var value string
+	switch v := src.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into MonthList", src)
+	}
+
+	if strings.TrimSpace(value) == "" {
+		*m = nil
+		return nil
+	}
+
+	parts := strings.Split(value, ",")
+	months := make(MonthList, 0, len(parts))
+	for _, part := range parts {
+		month, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return fmt.Errorf("invalid month in MonthList: %q", part)
+		}
+		months = append(months, month)
+	}
+	*m = months
+	return nil
+}
+
+// TagList is a set of free-form tags, stored as a comma separated string such as "family,tax"
+type TagList []string
+
+// Value implements driver.Valuer
+func (t TagList) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return nil, nil
+	}
+	return strings.Join(t, ","), nil
+}
+
+// Scan implements sql.Scanner
+func (t *TagList) Scan(src interface{}) error {
+	var value string
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into TagList", src)
+	}
+
+	if strings.TrimSpace(value) == "" {
+		*t = nil
+		return nil
+	}
+
+	*t = strings.Split(value, ",")
+	return nil
 }
 
 // MonthlyIncomeRecord represents actual income for a specific month
@@ -58,19 +156,186 @@ type MonthlyIncomeRecord struct {
 
 // RecurringPayment represents a fixed recurring payment
 type RecurringPayment struct {
-	ID                uuid.UUID `json:"id" db:"id"`
-	UserID            uuid.UUID `json:"user_id" db:"user_id"`
-	Name              string    `json:"name" db:"name"`
-	Amount            int64     `json:"amount" db:"amount"` // Amount in cents
-	PaymentDay        int       `json:"payment_day" db:"payment_day"`
-	StartYearMonth    string    `json:"start_year_month" db:"start_year_month"`       // Format: "2024-01"
-	TotalPayments     *int      `json:"total_payments,omitempty" db:"total_payments"` // For loans
-	RemainingPayments *int      `json:"remaining_payments,omitempty" db:"remaining_payments"`
-	BankAccount       uuid.UUID `json:"bank_account" db:"bank_account"`
-	IsActive          bool      `json:"is_active" db:"is_active"`
-	Note              string    `json:"note" db:"note"`
-	CreatedAt         time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+	ID                uuid.UUID  `json:"id" db:"id"`
+	UserID            uuid.UUID  `json:"user_id" db:"user_id"`
+	Name              string     `json:"name" db:"name"`
+	Amount            int64      `json:"amount" db:"amount"` // Amount in cents
+	PaymentDay        int        `json:"payment_day" db:"payment_day"`
+	StartYearMonth    string     `json:"start_year_month" db:"start_year_month"`         // Format: "2024-01"
+	RecurrenceRule    *string    `json:"recurrence_rule,omitempty" db:"recurrence_rule"` // RRULE subset, e.g. "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=31"
+	TotalPayments     *int       `json:"total_payments,omitempty" db:"total_payments"`   // For loans
+	RemainingPayments *int       `json:"remaining_payments,omitempty" db:"remaining_payments"`
+	BankAccount       uuid.UUID  `json:"bank_account" db:"bank_account"`
+	IsActive          bool       `json:"is_active" db:"is_active"`
+	Note              string     `json:"note" db:"note"`
+	CategoryID        *uuid.UUID `json:"category_id,omitempty" db:"category_id"`
+	Tags              TagList    `json:"tags,omitempty" db:"tags"`
+	Currency          string     `json:"currency" db:"currency"` // ISO 4217 code of the amounts
+	CreatedAt         time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
+
+	// Loan terms; TotalPayments is the number of monthly instalments
+	LoanPrincipal       *int64   `json:"loan_principal,omitempty" db:"loan_principal"`
+	LoanAnnualRate      *float64 `json:"loan_annual_rate,omitempty" db:"loan_annual_rate"`           // Percent, e.g. 1.2
+	LoanRepaymentMethod *string  `json:"loan_repayment_method,omitempty" db:"loan_repayment_method"` // "equal_payment" or "equal_principal"
+	RemainingPrincipal  *int64   `json:"remaining_principal,omitempty" db:"-"`                       // Calculated from the amortization schedule
+}
+
+// RecurringTransfer represents money moved between two of the user's bank accounts.
+// A "fixed" transfer moves Amount on TransferDay every month. A "top_up" transfer moves
+// whatever brings ToAccount back up to TargetBalance (at most Amount when set), on TransferDay
+// or, without a day, whenever the balance falls below the target.
+type RecurringTransfer struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	UserID        uuid.UUID `json:"user_id" db:"user_id"`
+	Name          string    `json:"name" db:"name"`
+	FromAccount   uuid.UUID `json:"from_account" db:"from_account"`
+	ToAccount     uuid.UUID `json:"to_account" db:"to_account"`
+	TransferType  string    `json:"transfer_type" db:"transfer_type"` // "fixed" or "top_up"
+	Amount        *int64    `json:"amount,omitempty" db:"amount"`
+	TransferDay   *int      `json:"transfer_day,omitempty" db:"transfer_day"`
+	TargetBalance *int64    `json:"target_balance,omitempty" db:"target_balance"`
+	IsActive      bool      `json:"is_active" db:"is_active"`
+	Note          string    `json:"note" db:"note"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SavingsGoal represents a savings target funded from a bank account
+type SavingsGoal struct {
+	ID              uuid.UUID `json:"id" db:"id"`
+	UserID          uuid.UUID `json:"user_id" db:"user_id"`
+	Name            string    `json:"name" db:"name"`
+	TargetAmount    int64     `json:"target_amount" db:"target_amount"`
+	TargetYearMonth *string   `json:"target_year_month,omitempty" db:"target_year_month"` // Format: "2027-03"
+	BankAccount     uuid.UUID `json:"bank_account" db:"bank_account"`
+	AllocatedAmount *int64    `json:"allocated_amount,omitempty" db:"allocated_amount"` // Ring-fenced portion of the account; nil when the whole balance counts
+	IsReserved      bool      `json:"is_reserved" db:"is_reserved"`                     // Excluded from the available balance
+	IsActive        bool      `json:"is_active" db:"is_active"`
+	Note            string    `json:"note" db:"note"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SavingsGoalProgress represents how far a savings goal has come and when it is projected to be reached
+type SavingsGoalProgress struct {
+	SavingsGoalID   uuid.UUID `json:"savings_goal_id"`
+	Name            string    `json:"name"`
+	BankAccount     uuid.UUID `json:"bank_account"`
+	TargetAmount    int64     `json:"target_amount"`
+	TargetYearMonth *string   `json:"target_year_month,omitempty"`
+	CurrentAmount   int64     `json:"current_amount"`
+	RemainingAmount int64     `json:"remaining_amount"`
+	ProgressRate    float64   `json:"progress_rate"` // Percentage of the target saved, up to 100
+	ReservedAmount  int64     `json:"reserved_amount"`
+	Achieved        bool      `json:"achieved"`
+	ProjectedDate   *string   `json:"projected_date,omitempty"` // First projected day the target is reached (YYYY-MM-DD)
+	OnTrack         *bool     `json:"on_track,omitempty"`       // Whether the goal is reached by the target month
+}
+
+// Category represents a spending category
+type Category struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty" db:"parent_id"` // nil for a top-level category
+	Name      string     `json:"name" db:"name"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Budget represents the monthly budget of a category
+type Budget struct {
+	ID         uuid.UUID `json:"id" db:"id"`
+	UserID     uuid.UUID `json:"user_id" db:"user_id"`
+	CategoryID uuid.UUID `json:"category_id" db:"category_id"`
+	Amount     int64     `json:"amount" db:"amount"`
+	YearMonth  *string   `json:"year_month,omitempty" db:"year_month"` // Format: "2024-01"; nil applies to every month without an override
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Transaction represents an actual spending record
+type Transaction struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	UserID          uuid.UUID  `json:"user_id" db:"user_id"`
+	CategoryID      *uuid.UUID `json:"category_id,omitempty" db:"category_id"`
+	TransactionDate string     `json:"transaction_date" db:"transaction_date"` // Format: "2024-01-15"
+	Amount          int64      `json:"amount" db:"amount"`                     // Spending is positive, refunds are negative
+	Description     string     `json:"description" db:"description"`
+	BankAccount     *uuid.UUID `json:"bank_account,omitempty" db:"bank_account"`
+	CreditCard      *uuid.UUID `json:"credit_card,omitempty" db:"credit_card"`
+	Tags            TagList    `json:"tags,omitempty" db:"tags"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// BudgetReport represents budget against actual spending for a month
+type BudgetReport struct {
+	YearMonth           string              `json:"year_month"`
+	TotalBudget         int64               `json:"total_budget"`
+	TotalActual         int64               `json:"total_actual"`
+	UncategorizedActual int64               `json:"uncategorized_actual"` // Spending without a category
+	Categories          []BudgetReportEntry `json:"categories"`
+}
+
+// BudgetReportEntry represents budget against actual spending for a single category
+type BudgetReportEntry struct {
+	CategoryID   uuid.UUID `json:"category_id"`
+	CategoryName string    `json:"category_name"`
+	Budget       int64     `json:"budget"`
+	Actual       int64     `json:"actual"`
+	Remaining    int64     `json:"remaining"`  // Negative when over budget
+	UsageRate    float64   `json:"usage_rate"` // Percentage of the budget spent; 0 without a budget
+}
+
+// CategoryBreakdown represents the projected income and expenses of a month per category
+type CategoryBreakdown struct {
+	YearMonth    string                   `json:"year_month"`
+	TotalIncome  int64                    `json:"total_income"`
+	TotalExpense int64                    `json:"total_expense"`
+	Categories   []CategoryBreakdownEntry `json:"categories"`
+}
+
+// CategoryBreakdownEntry represents the projected amounts of a single category in a month
+type CategoryBreakdownEntry struct {
+	CategoryID   *uuid.UUID `json:"category_id,omitempty"` // nil for uncategorized items
+	ParentID     *uuid.UUID `json:"parent_id,omitempty"`
+	CategoryName string     `json:"category_name"` // Full path such as "Insurance / Life"
+	Income       int64      `json:"income"`
+	Expense      int64      `json:"expense"`
+}
+
+// AmortizationSchedule represents the repayment schedule of a loan
+type AmortizationSchedule struct {
+	RecurringPaymentID uuid.UUID           `json:"recurring_payment_id"`
+	Principal          int64               `json:"principal"`
+	AnnualRate         float64             `json:"annual_rate"`
+	RepaymentMethod    string              `json:"repayment_method"`
+	TotalPayments      int                 `json:"total_payments"`
+	TotalInterest      int64               `json:"total_interest"`
+	RemainingPrincipal int64               `json:"remaining_principal"` // As of today
+	RemainingPayments  int                 `json:"remaining_payments"`  // As of today
+	InterestSaved      int64               `json:"interest_saved,omitempty"`
+	Entries            []AmortizationEntry `json:"entries"`
+}
+
+// AmortizationEntry represents a single instalment of a loan
+type AmortizationEntry struct {
+	Number             int    `json:"number"`
+	Date               string `json:"date"`
+	Payment            int64  `json:"payment"`
+	Principal          int64  `json:"principal"`
+	Interest           int64  `json:"interest"`
+	Prepayment         int64  `json:"prepayment,omitempty"`
+	RemainingPrincipal int64  `json:"remaining_principal"`
+}
+
+// LoanPrepayment represents a simulated lump-sum repayment of a loan
+type LoanPrepayment struct {
+	RecurringPaymentID uuid.UUID `json:"recurring_payment_id"`
+	Date               string    `json:"date"` // YYYY-MM-DD
+	Amount             int64     `json:"amount"`
+	Mode               string    `json:"mode,omitempty"` // "shorten_term" (default) or "reduce_payment"
 }
 
 // CardMonthlyTotal represents monthly credit card usage
@@ -94,31 +359,360 @@ type AppSetting struct {
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// ExchangeRate represents the value of one unit of a currency in another currency on a day
+type ExchangeRate struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	UserID       uuid.UUID `json:"user_id" db:"user_id"`
+	FromCurrency string    `json:"from_currency" db:"from_currency"` // ISO 4217 code, e.g. "USD"
+	ToCurrency   string    `json:"to_currency" db:"to_currency"`     // ISO 4217 code, e.g. "JPY"
+	Rate         float64   `json:"rate" db:"rate"`                   // Units of ToCurrency per unit of FromCurrency
+	RateDate     string    `json:"rate_date" db:"rate_date"`         // Format: "2024-01-15"
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ExchangeRateImportResult reports the outcome of an exchange rate CSV import
+type ExchangeRateImportResult struct {
+	Imported int `json:"imported"` // Rates created or updated
+}
+
+// Asset represents an investment or other non-cash asset whose value is recorded by hand or by CSV import
+type Asset struct {
+	ID           uuid.UUID `json:"id" db:"id"`
+	UserID       uuid.UUID `json:"user_id" db:"user_id"`
+	Name         string    `json:"name" db:"name"`
+	AssetType    string    `json:"asset_type" db:"asset_type"` // "securities", "nisa", "pension", "property", "crypto" or "other"
+	Currency     string    `json:"currency" db:"currency"`     // ISO 4217 code of the valuations
+	IsActive     bool      `json:"is_active" db:"is_active"`
+	Note         *string   `json:"note,omitempty" db:"note"`
+	CurrentValue *int64    `json:"current_value,omitempty"` // Latest valuation; nil before the first one
+	ValuedOn     *string   `json:"valued_on,omitempty"`     // Date of the latest valuation, e.g. "2024-01-31"
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// AssetValuation represents the value of an asset on a day
+type AssetValuation struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	AssetID       uuid.UUID `json:"asset_id" db:"asset_id"`
+	ValuationDate string    `json:"valuation_date" db:"valuation_date"` // Format: "2024-01-31"
+	Value         int64     `json:"value" db:"value"`                   // In the currency of the asset
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// AssetValuationImportResult reports the outcome of an asset valuation CSV import
+type AssetValuationImportResult struct {
+	Imported int `json:"imported"` // Valuations created or updated
+}
+
+// NetWorth represents the user's assets and liabilities on a day in the base currency
+type NetWorth struct {
+	Date             string             `json:"date"`
+	Currency         string             `json:"currency"`
+	TotalAssets      int64              `json:"total_assets"`      // Bank balances plus the latest asset valuations
+	TotalLiabilities int64              `json:"total_liabilities"` // Remaining principal of loans
+	NetWorth         int64              `json:"net_worth"`
+	Assets           []AssetTypeTotal   `json:"assets"`
+	Liabilities      []LiabilityBalance `json:"liabilities"`
+}
+
+// AssetTypeTotal represents the value of the assets of one type
+type AssetTypeTotal struct {
+	AssetType string `json:"asset_type"` // "bank_account" for bank balances, otherwise the type of the assets
+	Amount    int64  `json:"amount"`
+}
+
+// LiabilityBalance represents the outstanding balance of a loan
+type LiabilityBalance struct {
+	RecurringPaymentID uuid.UUID `json:"recurring_payment_id"`
+	Name               string    `json:"name"`
+	Amount             int64     `json:"amount"`                      // In the base currency
+	OriginalAmount     *int64    `json:"original_amount,omitempty"`   // In OriginalCurrency when it is not the base currency
+	OriginalCurrency   string    `json:"original_currency,omitempty"` // Currency of the loan
+}
+
+// NetWorthSnapshot records the net worth of a user at the end of a day
+type NetWorthSnapshot struct {
+	ID               uuid.UUID `json:"id" db:"id"`
+	UserID           uuid.UUID `json:"user_id" db:"user_id"`
+	SnapshotDate     string    `json:"snapshot_date" db:"snapshot_date"` // Format: "2024-01-31"
+	Currency         string    `json:"currency" db:"currency"`
+	TotalAssets      int64     `json:"total_assets" db:"total_assets"`
+	TotalLiabilities int64     `json:"total_liabilities" db:"total_liabilities"`
+	NetWorth         int64     `json:"net_worth" db:"net_worth"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// NetWorthSnapshotReport describes what a net worth snapshot run recorded
+type NetWorthSnapshotReport struct {
+	Users    int `json:"users"`
+	Recorded int `json:"recorded"`
+	Failed   int `json:"failed"`
+}
+
+// CalendarFeed is the secret token of a user's iCalendar feed of projected payments and income
+type CalendarFeed struct {
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Token     string    `json:"token" db:"token"`
+	URL       string    `json:"url" db:"-"` // Address to subscribe to in a calendar app
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// MonthlyReport is the statement of a month for household reviews, computed from the cashflow projection
+type MonthlyReport struct {
+	YearMonth      string                       `json:"year_month"` // Format: "2024-01"
+	Currency       string                       `json:"currency"`   // Base currency of every amount in the report
+	OpeningBalance int64                        `json:"opening_balance"`
+	ClosingBalance int64                        `json:"closing_balance"`
+	Income         int64                        `json:"income"`
+	Expense        int64                        `json:"expense"`
+	Inflows        []MonthlyReportItem          `json:"inflows"`         // Income of the month, by date
+	Outflows       []MonthlyReportItem          `json:"outflows"`        // Payments and living costs of the month, by date; transfers are left out
+	CardStatements []MonthlyReportCardStatement `json:"card_statements"` // Card payments of the month
+	Variances      []MonthlyReportVariance      `json:"variances"`       // Recorded amounts that differ from the schedule or card estimate
+	Outlook        []MonthlyBalance             `json:"outlook"`         // Projected balance at the end of each of the next 12 months
+}
+
+// MonthlyReportItem represents an income or expense on a day of the reported month
+type MonthlyReportItem struct {
+	Date string `json:"date"` // Format: "2024-01-31"
+	CashflowProjectionDetail
+}
+
+// MonthlyReportCardStatement represents a credit card payment of the reported month
+type MonthlyReportCardStatement struct {
+	CreditCardID   uuid.UUID `json:"credit_card_id"`
+	Name           string    `json:"name"`
+	StatementMonth string    `json:"statement_month"` // Usage month the payment settles, e.g. "2023-12"
+	PaymentDate    string    `json:"payment_date"`
+	Amount         int64     `json:"amount"`
+	IsEstimated    bool      `json:"is_estimated"` // True when no monthly total is recorded for the statement month
+}
+
+// MonthlyReportVariance represents a recorded amount against what the projection planned for it
+type MonthlyReportVariance struct {
+	Date        string `json:"date"`
+	Type        string `json:"type"` // "income" or "card_payment"
+	Description string `json:"description"`
+	Planned     int64  `json:"planned"`
+	Actual      int64  `json:"actual"`
+	Difference  int64  `json:"difference"` // Actual minus planned
+}
+
+// MonthlyBalance represents the projected balance at the end of a month
+type MonthlyBalance struct {
+	YearMonth string `json:"year_month"` // Format: "2024-01"
+	Balance   int64  `json:"balance"`
+}
+
+// StoredMonthlyReport is a monthly report PDF produced by the scheduled job
+type StoredMonthlyReport struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	YearMonth string    `json:"year_month" db:"year_month"` // Format: "2024-01"
+	Content   []byte    `json:"-" db:"content"`             // The PDF file; left out of lists
+	Size      int       `json:"size" db:"-"`                // Size of the file in bytes
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// MonthlyReportRun describes what a monthly report run produced
+type MonthlyReportRun struct {
+	YearMonth string `json:"year_month"`
+	Users     int    `json:"users"`
+	Generated int    `json:"generated"`
+	Skipped   int    `json:"skipped"` // Users who have not turned scheduled reports on
+	Failed    int    `json:"failed"`
+}
+
+// NotificationLog records a notification sent to a user on a channel
+type NotificationLog struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Channel   string    `json:"channel" db:"channel"`       // "email" or "webhook"
+	EventType string    `json:"event_type" db:"event_type"` // "large_debit", "low_balance", "card_unconfirmed", "weekly_digest" or "test"
+	DedupeKey string    `json:"dedupe_key" db:"dedupe_key"` // Identifies the event; an event is sent once per channel
+	Recipient string    `json:"recipient" db:"recipient"`
+	Subject   string    `json:"subject" db:"subject"`
+	Status    string    `json:"status" db:"status"` // "sent" or "failed"
+	Error     *string   `json:"error,omitempty" db:"error"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"` // Time of the latest attempt
+}
+
+// NotificationRun describes what a notification run sent
+type NotificationRun struct {
+	Users   int `json:"users"`
+	Sent    int `json:"sent"`
+	Skipped int `json:"skipped"` // Users with notifications off or no channel to send to
+	Failed  int `json:"failed"`
+}
+
+// Webhook is a URL events of the user are posted to
+type Webhook struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Name      string    `json:"name" db:"name"`
+	URL       string    `json:"url" db:"url"`
+	Secret    string    `json:"secret" db:"secret"`           // Key of the payload signatures; generated when empty
+	Events    TagList   `json:"events,omitempty" db:"events"` // Subscribed event types; empty for every event
+	IsActive  bool      `json:"is_active" db:"is_active"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// WebhookDelivery is an event posted to a webhook, with the result of its latest attempt
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id" db:"id"`
+	WebhookID      uuid.UUID       `json:"webhook_id" db:"webhook_id"`
+	EventID        uuid.UUID       `json:"event_id" db:"event_id"` // The same for the deliveries of one event to several webhooks
+	EventType      string          `json:"event_type" db:"event_type"`
+	Payload        json.RawMessage `json:"payload" db:"payload"`
+	Status         string          `json:"status" db:"status"` // "pending", "succeeded" or "failed"
+	Attempts       int             `json:"attempts" db:"attempts"`
+	ResponseStatus *int            `json:"response_status,omitempty" db:"response_status"` // HTTP status of the latest attempt
+	Error          *string         `json:"error,omitempty" db:"error"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty" db:"next_attempt_at"` // Set while pending
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// WebhookEvent is the JSON body posted to webhooks
+type WebhookEvent struct {
+	ID        uuid.UUID `json:"id"`
+	Type      string    `json:"type"` // e.g. "credit_card.updated", "alert.triggered"
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// WebhookDeliveryRun describes what a delivery run sent
+type WebhookDeliveryRun struct {
+	Attempted int `json:"attempted"`
+	Succeeded int `json:"succeeded"`
+	Retrying  int `json:"retrying"` // Failed attempts that will be retried
+	Failed    int `json:"failed"`   // Deliveries that ran out of attempts
+}
+
+// SettingDefinition describes a known application setting for rendering the settings form
+type SettingDefinition struct {
+	Key         string   `json:"key"`  // A key ending in "<credit_card_id>" is a template for one key per card
+	Type        string   `json:"type"` // "integer", "number", "boolean", "enum" or "string"
+	Default     string   `json:"default"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Unit        string   `json:"unit,omitempty"` // e.g. "cents" or "percent"
+	Minimum     *float64 `json:"minimum,omitempty"`
+	Maximum     *float64 `json:"maximum,omitempty"`
+	Options     []string `json:"options,omitempty"` // Allowed values of an enum
+	Format      string   `json:"format,omitempty"`  // Expected format of a string, e.g. "YYYY-MM:amount,..."
+}
+
 // CashflowProjection represents a cashflow projection result
 type CashflowProjection struct {
-	Date    string                     `json:"date"`
-	Income  int64                      `json:"income"`
-	Expense int64                      `json:"expense"`
-	Balance int64                      `json:"balance"`
-	Details []CashflowProjectionDetail `json:"details"`
+	Date            string                     `json:"date"`
+	Income          int64                      `json:"income"`           // In the base currency
+	Expense         int64                      `json:"expense"`          // In the base currency
+	Balance         int64                      `json:"balance"`          // In the base currency
+	AccountBalances []AccountBalance           `json:"account_balances"` // Balance of each bank account at the end of the day
+	Details         []CashflowProjectionDetail `json:"details"`
+}
+
+// AccountBalance represents the projected balance of a single bank account
+type AccountBalance struct {
+	BankAccountID uuid.UUID `json:"bank_account_id"`
+	Balance       int64     `json:"balance"`  // In the account's currency
+	Currency      string    `json:"currency"` // Currency of the account
 }
 
 // CashflowProjectionDetail represents details of a cashflow projection
 type CashflowProjectionDetail struct {
-	Type        string `json:"type"` // "income", "recurring_payment", "card_payment"
-	Description string `json:"description"`
-	Amount      int64  `json:"amount"`
+	Type            string     `json:"type"` // "income", "recurring_payment", "card_payment", "loan_prepayment", "living_cost" or "transfer"
+	Description     string     `json:"description"`
+	Amount          int64      `json:"amount"`                       // In the base currency
+	IsEstimated     bool       `json:"is_estimated"`                 // True when the amount is estimated rather than a recorded statement
+	PlannedAmount   *int64     `json:"planned_amount,omitempty"`     // Amount the schedule or card estimator predicts, set when a recorded amount replaces it
+	SourceID        *uuid.UUID `json:"source_id,omitempty"`          // Income source, recurring payment, credit card or transfer the amount comes from
+	BankAccountID   *uuid.UUID `json:"bank_account_id,omitempty"`    // Account credited or debited; the source account of a transfer
+	ToBankAccountID *uuid.UUID `json:"to_bank_account_id,omitempty"` // Destination account of a transfer
+	CategoryID      *uuid.UUID `json:"category_id,omitempty"`        // Category of the income source, recurring payment or credit card
+
+	// Amount and currency of an item held in another currency than the base currency
+	OriginalAmount   *int64 `json:"original_amount,omitempty"`
+	OriginalCurrency string `json:"original_currency,omitempty"`
+}
+
+// CashflowPeriod represents the projected cashflow of a week or month
+type CashflowPeriod struct {
+	StartDate       string           `json:"start_date"` // First projected day of the period, e.g. "2024-01-01"
+	EndDate         string           `json:"end_date"`   // Last projected day of the period
+	OpeningBalance  int64            `json:"opening_balance"`
+	ClosingBalance  int64            `json:"closing_balance"`
+	Income          int64            `json:"income"`
+	Expense         int64            `json:"expense"`
+	Subtotals       map[string]int64 `json:"subtotals"`                  // Amounts by detail type ("income", "recurring_payment", "card_payment", ...); transfers are left out
+	AccountBalances []AccountBalance `json:"account_balances,omitempty"` // Balance of each account at the end of the period
+}
+
+// ProbabilisticProjection represents the result of a Monte Carlo cashflow simulation
+type ProbabilisticProjection struct {
+	Simulations         int              `json:"simulations"`
+	Seed                int64            `json:"seed"`
+	ProbabilityNegative float64          `json:"probability_negative"` // Share of runs whose balance goes below 0 at least once
+	Days                []ProjectionBand `json:"days"`
+}
+
+// ProjectionBand represents the simulated balance distribution for a single day
+type ProjectionBand struct {
+	Date                string  `json:"date"`
+	P10                 int64   `json:"p10"`
+	P50                 int64   `json:"p50"`
+	P90                 int64   `json:"p90"`
+	ProbabilityNegative float64 `json:"probability_negative"`
 }
 
 // DashboardSummary represents dashboard summary data
 type DashboardSummary struct {
+	Currency         string               `json:"currency"` // Base currency of every amount in the summary
 	TotalBalance     int64                `json:"total_balance"`
+	ReservedAmount   int64                `json:"reserved_amount"`   // Balance set aside for savings goals
+	AvailableBalance int64                `json:"available_balance"` // Total balance less the reserved amount
 	MonthlyIncome    int64                `json:"monthly_income"`
 	MonthlyExpense   int64                `json:"monthly_expense"`
-	TotalAssets      int                  `json:"total_assets"`
+	TotalAssets      int64                `json:"total_assets"`      // Bank balances plus the latest asset valuations
+	TotalLiabilities int64                `json:"total_liabilities"` // Remaining principal of loans
+	NetWorth         int64                `json:"net_worth"`
 	RecentActivities []CashflowProjection `json:"recent_activities"`
 }
 
+// DashboardOverview represents the trends and outlook of the dashboard, computed from the cashflow projection
+type DashboardOverview struct {
+	Currency          string         `json:"currency"` // Base currency of every amount in the overview
+	Trends            []MonthlyTrend `json:"trends"`   // Last 12 months ending with the current month, oldest first
+	Upcoming          []UpcomingItem `json:"upcoming"` // Items due from today within the requested days, soonest first
+	LowestBalance     int64          `json:"lowest_balance"`
+	LowestBalanceDate string         `json:"lowest_balance_date"` // Date of the lowest projected balance within 12 months from today
+	RunwayMonths      *float64       `json:"runway_months"`       // Months the current balance covers the average projected expense; null without expenses
+}
+
+// MonthlyTrend represents the projected income and expense of a month and the change from the month before
+type MonthlyTrend struct {
+	YearMonth     string   `json:"year_month"` // Format: "2024-01"
+	Income        int64    `json:"income"`
+	Expense       int64    `json:"expense"`
+	Net           int64    `json:"net"`
+	IncomeChange  *float64 `json:"income_change"`  // Percent change from the previous month; null when that month is 0 or not included
+	ExpenseChange *float64 `json:"expense_change"` // Percent change from the previous month; null when that month is 0 or not included
+}
+
+// UpcomingItem represents a projected income or expense on a day
+type UpcomingItem struct {
+	Date string `json:"date"` // Format: "2024-01-31"
+	CashflowProjectionDetail
+}
+
 // User represents a user
 type User struct {
 	ID        uuid.UUID `json:"id" db:"id"`
@@ -130,3 +724,39 @@ type User struct {
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
+
+// JobRun records a single execution of a background job
+type JobRun struct {
+	ID         uuid.UUID  `json:"id" db:"id"`
+	JobName    string     `json:"job_name" db:"job_name"`
+	Status     string     `json:"status" db:"status"` // "running", "succeeded" or "failed"
+	StartedAt  time.Time  `json:"started_at" db:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+	Summary    string     `json:"summary" db:"summary"`
+	Error      *string    `json:"error,omitempty" db:"error"`
+}
+
+// JobStatus describes a scheduled background job and its last run
+type JobStatus struct {
+	Name      string     `json:"name"`
+	Schedule  string     `json:"schedule"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	LastRun   *JobRun    `json:"last_run,omitempty"`
+}
+
+// PaymentProgressReport describes what a recurring payment progress run changed
+type PaymentProgressReport struct {
+	Checked   int                     `json:"checked"`
+	Updated   int                     `json:"updated"`
+	Completed int                     `json:"completed"`
+	Changes   []PaymentProgressChange `json:"changes"`
+}
+
+// PaymentProgressChange describes the change made to a single recurring payment
+type PaymentProgressChange struct {
+	RecurringPaymentID uuid.UUID `json:"recurring_payment_id"`
+	Name               string    `json:"name"`
+	RemainingBefore    *int      `json:"remaining_before"`
+	RemainingAfter     *int      `json:"remaining_after"`
+	Deactivated        bool      `json:"deactivated"`
+}
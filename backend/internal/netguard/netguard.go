@@ -0,0 +1,160 @@
+// Package netguard keeps requests to user-configured URLs, such as webhooks and chat
+// notifications, away from the server's own network.
+//
+// Loopback, link-local, private and other non-public addresses are refused unless the operator
+// allows their network, e.g. a Home Assistant on the LAN. Addresses are checked when a URL is
+// saved and again when connecting, so a host name that later resolves elsewhere is refused too.
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Errors of requests made through a guarded client. They say what went wrong without the
+// details of the server's network, so they can be shown to users.
+var (
+	ErrBlocked       = errors.New("address is not allowed")
+	ErrUnresolvable  = errors.New("host cannot be resolved")
+	ErrTimeout       = errors.New("request timed out")
+	ErrRequestFailed = errors.New("request failed")
+)
+
+// dialTimeout bounds connecting when the client has no shorter timeout
+const dialTimeout = 30 * time.Second
+
+// reservedNetworks are non-public networks the netip predicates do not cover
+var reservedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT, often cluster internal
+}
+
+// Guard decides which addresses requests may connect to
+type Guard struct {
+	allowed []netip.Prefix
+	lookup  func(ctx context.Context, host string) ([]netip.Addr, error)
+}
+
+// New returns a guard that also permits the given networks, written as addresses or CIDR
+// prefixes, e.g. "192.168.1.10" or "10.0.0.0/8"
+func New(allowed []string) (*Guard, error) {
+	prefixes := make([]netip.Prefix, 0, len(allowed))
+	for _, network := range allowed {
+		network = strings.TrimSpace(network)
+		if !strings.Contains(network, "/") {
+			addr, err := netip.ParseAddr(network)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowed network %q", network)
+			}
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed network %q", network)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return &Guard{
+		allowed: prefixes,
+		lookup: func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		},
+	}, nil
+}
+
+// Permits reports whether requests may connect to the address: public addresses and those of
+// the allowed networks
+func (g *Guard) Permits(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range g.allowed {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range reservedNetworks {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckHost resolves the host of a URL and returns ErrBlocked when any of its addresses is not
+// permitted, or ErrUnresolvable when it has none
+func (g *Guard) CheckHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(strings.Trim(host, "[]")); err == nil {
+		if !g.Permits(addr) {
+			return ErrBlocked
+		}
+		return nil
+	}
+
+	addrs, err := g.lookup(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return ErrUnresolvable
+	}
+	for _, addr := range addrs {
+		if !g.Permits(addr) {
+			return ErrBlocked
+		}
+	}
+	return nil
+}
+
+// Client returns an HTTP client that connects only to permitted addresses. It does not follow
+// redirects, which would lead elsewhere than the checked URL, and ignores proxies of the
+// environment, which would connect on its behalf.
+func (g *Guard) Client(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second, Control: g.control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// control refuses connections to addresses that are not permitted. It runs after name
+// resolution, on the address actually dialed.
+func (g *Guard) control(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil || !g.Permits(addrPort.Addr()) {
+		return ErrBlocked
+	}
+	return nil
+}
+
+// RequestError reduces an error of a guarded client's request to one of ErrBlocked, ErrTimeout
+// and ErrRequestFailed, so that messages of the server's network, such as which internal ports
+// refuse connections, are not passed on to users
+func RequestError(err error) error {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, ErrBlocked):
+		return ErrBlocked
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrTimeout
+	default:
+		return ErrRequestFailed
+	}
+}
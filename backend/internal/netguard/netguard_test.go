@@ -0,0 +1,103 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_invalidNetwork(t *testing.T) {
+	for _, network := range []string{"home", "10.0.0.0/33", "192.168.1"} {
+		_, err := New([]string{network})
+		assert.Error(t, err, network)
+	}
+}
+
+func TestGuard_Permits(t *testing.T) {
+	guard, err := New([]string{"192.168.1.10", "10.1.0.0/16"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "93.184.216.34", expected: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{addr: "127.0.0.1"},
+		{addr: "::1"},
+		{addr: "::ffff:127.0.0.1"},
+		{addr: "0.0.0.0"},
+		{addr: "169.254.169.254"},
+		{addr: "fe80::1"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.11"},
+		{addr: "fd00::1"},
+		{addr: "100.64.0.1"},
+		{addr: "192.168.1.10", expected: true},
+		{addr: "10.1.200.3", expected: true},
+		{addr: "10.2.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.expected, guard.Permits(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestGuard_CheckHost(t *testing.T) {
+	guard, err := New(nil)
+	require.NoError(t, err)
+	guard.lookup = func(ctx context.Context, host string) ([]netip.Addr, error) {
+		switch host {
+		case "example.com":
+			return []netip.Addr{netip.MustParseAddr("93.184.216.34")}, nil
+		case "rebind.example.com":
+			return []netip.Addr{netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.5")}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	assert.NoError(t, guard.CheckHost(context.Background(), "example.com"))
+	assert.NoError(t, guard.CheckHost(context.Background(), "93.184.216.34"))
+	assert.ErrorIs(t, guard.CheckHost(context.Background(), "rebind.example.com"), ErrBlocked)
+	assert.ErrorIs(t, guard.CheckHost(context.Background(), "169.254.169.254"), ErrBlocked)
+	assert.ErrorIs(t, guard.CheckHost(context.Background(), "[::1]"), ErrBlocked)
+	assert.ErrorIs(t, guard.CheckHost(context.Background(), "missing.example.com"), ErrUnresolvable)
+}
+
+func TestGuard_Client(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/target", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	blocking, err := New(nil)
+	require.NoError(t, err)
+	_, err = blocking.Client(time.Second).Get(server.URL)
+	assert.ErrorIs(t, err, ErrBlocked, "loopback is refused")
+	assert.Equal(t, ErrBlocked, RequestError(err))
+
+	allowing, err := New([]string{"127.0.0.1"})
+	require.NoError(t, err)
+	resp, err := allowing.Client(time.Second).Get(server.URL + "/redirect")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode, "redirects are not followed")
+}
+
+func TestRequestError(t *testing.T) {
+	assert.Equal(t, ErrTimeout, RequestError(context.DeadlineExceeded))
+	assert.Equal(t, ErrRequestFailed, RequestError(errors.New("dial tcp 10.0.0.5:9090: connect: connection refused")))
+}
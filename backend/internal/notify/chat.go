@@ -0,0 +1,244 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/netguard"
+)
+
+// chatTimeout bounds a delivery when the context has no deadline
+const chatTimeout = 10 * time.Second
+
+// DefaultLINENotifyURL is the endpoint of LINE Notify
+const DefaultLINENotifyURL = "https://notify-api.line.me/api/notify"
+
+// Limits of the chat services. Longer texts are cut rather than rejected by the service.
+const (
+	slackMaxHeader        = 150
+	slackMaxText          = 3000
+	slackMaxFields        = 10 // Fields of a section block
+	discordMaxTitle       = 256
+	discordMaxDescription = 4096
+	discordMaxFields      = 25
+	discordMaxFieldValue  = 1024
+	lineNotifyMaxMessage  = 1000
+)
+
+// linkLabel is the text of the link to the application
+const linkLabel = "Flow Sight"
+
+// discordDefaultColor is the embed stripe color of events without one of their own
+const discordDefaultColor = 0x2563eb
+
+// discordEventColors are the embed stripe colors of events that need attention
+var discordEventColors = map[string]int{
+	"large_debit":      0xf59e0b,
+	"low_balance":      0xdc2626,
+	"card_unconfirmed": 0xf59e0b,
+}
+
+// SlackNotifier posts notifications to Slack incoming webhooks as Block Kit messages. The
+// recipient is the webhook URL; Slack-compatible relays accept the same payload.
+type SlackNotifier struct {
+	http *http.Client
+}
+
+func NewSlackNotifier(guard *netguard.Guard) *SlackNotifier {
+	return &SlackNotifier{http: guard.Client(chatTimeout)}
+}
+
+// Notify posts the notification to the webhook URL
+func (n *SlackNotifier) Notify(ctx context.Context, recipient string, notification Notification) error {
+	body, err := json.Marshal(slackMessage(notification))
+	if err != nil {
+		return err
+	}
+	return post(ctx, n.http, recipient, "application/json", body, nil)
+}
+
+// slackMessage lays the notification out as a header, the body, the fields and a link. The
+// subject is also the plain text shown in push notifications.
+func slackMessage(notification Notification) map[string]any {
+	text := func(kind, value string) map[string]any {
+		return map[string]any{"type": kind, "text": truncate(value, slackMaxText)}
+	}
+
+	blocks := []map[string]any{
+		{"type": "header", "text": text("plain_text", truncate(notification.Subject, slackMaxHeader))},
+	}
+	if notification.Body != "" {
+		blocks = append(blocks, map[string]any{"type": "section", "text": text("mrkdwn", slackEscape(notification.Body))})
+	}
+	for start := 0; start < len(notification.Fields); start += slackMaxFields {
+		end := min(start+slackMaxFields, len(notification.Fields))
+		fields := make([]map[string]any, 0, end-start)
+		for _, field := range notification.Fields[start:end] {
+			fields = append(fields, text("mrkdwn", "*"+slackEscape(field.Name)+"*\n"+slackEscape(field.Value)))
+		}
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+	}
+	if notification.Link != "" {
+		blocks = append(blocks, map[string]any{
+			"type":     "context",
+			"elements": []map[string]any{text("mrkdwn", "<"+notification.Link+"|"+linkLabel+">")},
+		})
+	}
+
+	return map[string]any{"text": notification.Subject, "blocks": blocks}
+}
+
+// slackEscape escapes the characters Slack reads as markup
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// DiscordNotifier posts notifications to Discord incoming webhooks as embeds. The recipient is
+// the webhook URL.
+type DiscordNotifier struct {
+	http *http.Client
+}
+
+func NewDiscordNotifier(guard *netguard.Guard) *DiscordNotifier {
+	return &DiscordNotifier{http: guard.Client(chatTimeout)}
+}
+
+// Notify posts the notification to the webhook URL
+func (n *DiscordNotifier) Notify(ctx context.Context, recipient string, notification Notification) error {
+	body, err := json.Marshal(discordMessage(notification))
+	if err != nil {
+		return err
+	}
+	return post(ctx, n.http, recipient, "application/json", body, nil)
+}
+
+// discordMessage lays the notification out as one embed with inline fields, colored by event
+func discordMessage(notification Notification) map[string]any {
+	color, ok := discordEventColors[notification.Event]
+	if !ok {
+		color = discordDefaultColor
+	}
+
+	embed := map[string]any{
+		"title":       truncate(notification.Subject, discordMaxTitle),
+		"description": truncate(notification.Body, discordMaxDescription),
+		"color":       color,
+	}
+	if notification.Link != "" {
+		embed["url"] = notification.Link
+		embed["footer"] = map[string]any{"text": linkLabel}
+	}
+	fields := make([]map[string]any, 0, len(notification.Fields))
+	for _, field := range notification.Fields {
+		if len(fields) == discordMaxFields {
+			break
+		}
+		fields = append(fields, map[string]any{
+			"name":   truncate(field.Name, discordMaxTitle),
+			"value":  truncate(field.Value, discordMaxFieldValue),
+			"inline": true,
+		})
+	}
+	if len(fields) > 0 {
+		embed["fields"] = fields
+	}
+
+	// Mentions in the texts must not ping anyone
+	return map[string]any{
+		"embeds":           []map[string]any{embed},
+		"allowed_mentions": map[string]any{"parse": []string{}},
+	}
+}
+
+// LINENotifier posts notifications to a LINE Notify-compatible endpoint. The recipient is the
+// access token of the user's chat; the message is plain text.
+type LINENotifier struct {
+	endpoint string
+	http     *http.Client
+}
+
+func NewLINENotifier(endpoint string, guard *netguard.Guard) *LINENotifier {
+	if endpoint == "" {
+		endpoint = DefaultLINENotifyURL
+	}
+	return &LINENotifier{endpoint: endpoint, http: guard.Client(chatTimeout)}
+}
+
+// Notify posts the notification with the access token
+func (n *LINENotifier) Notify(ctx context.Context, recipient string, notification Notification) error {
+	if recipient == "" {
+		return errors.New("no LINE Notify access token")
+	}
+	form := url.Values{"message": {lineMessage(notification)}}
+	return post(ctx, n.http, n.endpoint, "application/x-www-form-urlencoded", []byte(form.Encode()), map[string]string{
+		"Authorization": "Bearer " + recipient,
+	})
+}
+
+// lineMessage writes the subject, body and link as one text. The message starts on a new line
+// because LINE puts the name of the token before it.
+func lineMessage(notification Notification) string {
+	message := "\n" + notification.Subject
+	if notification.Body != "" {
+		message += "\n\n" + notification.Body
+	}
+	if notification.Link != "" {
+		link := "\n\n" + notification.Link
+		return truncate(message, lineNotifyMaxMessage-len([]rune(link))) + link
+	}
+	return truncate(message, lineNotifyMaxMessage)
+}
+
+// post sends a request to a chat service through a netguard client. Responses outside 2xx,
+// including redirects, are returned as an error. Errors are shown to the user and carry neither
+// the response nor the details of the server's network.
+func post(ctx context.Context, client *http.Client, target, contentType string, body []byte, headers map[string]string) error {
+	address, err := url.Parse(target)
+	if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+		return errors.New("invalid webhook URL")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "Flow-Sight-Notifier/1.0")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// The URL is a credential of the user's chat and is left out of the error
+		return fmt.Errorf("%s: %w", address.Host, netguard.RequestError(err))
+	}
+	defer resp.Body.Close()
+	// Read a little of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// truncate cuts s to at most limit characters, marking the cut with an ellipsis
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	if limit <= 1 {
+		return string(runes[:max(limit, 0)])
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,200 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/netguard"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chatStub is a local chat service that records the request it receives
+type chatStub struct {
+	server *httptest.Server
+	status int
+
+	header http.Header
+	body   []byte
+}
+
+func startChatStub(t *testing.T, status int) *chatStub {
+	stub := &chatStub{status: status}
+	stub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stub.header = r.Header.Clone()
+		stub.body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(stub.status)
+		_, _ = w.Write([]byte(`{"message":"stub"}`))
+	}))
+	t.Cleanup(stub.server.Close)
+	return stub
+}
+
+// loopbackGuard allows the chat stubs on the loopback address
+func loopbackGuard(t *testing.T) *netguard.Guard {
+	t.Helper()
+	guard, err := netguard.New([]string{"127.0.0.1"})
+	require.NoError(t, err)
+	return guard
+}
+
+func testChatNotification() Notification {
+	return Notification{
+		Event:   "low_balance",
+		Subject: "2025-02-10 に残高が ¥-5,000 まで減る見込みです",
+		Body:    "キャッシュフロー予測で残高が <基準額> を下回る見込みです。\n\n入金予定をご確認ください。",
+		Fields: []Field{
+			{Name: "日付", Value: "2025-02-10"},
+			{Name: "予測残高", Value: "¥-5,000"},
+		},
+		Link: "https://flow.example.com/cashflow",
+	}
+}
+
+func TestSlackNotifier_Notify(t *testing.T) {
+	stub := startChatStub(t, http.StatusOK)
+
+	err := NewSlackNotifier(loopbackGuard(t)).Notify(context.Background(), stub.server.URL+"/services/T000/B000/XXX", testChatNotification())
+
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", stub.header.Get("Content-Type"))
+
+	var message struct {
+		Text   string `json:"text"`
+		Blocks []struct {
+			Type     string              `json:"type"`
+			Text     map[string]string   `json:"text"`
+			Fields   []map[string]string `json:"fields"`
+			Elements []map[string]string `json:"elements"`
+		} `json:"blocks"`
+	}
+	require.NoError(t, json.Unmarshal(stub.body, &message))
+	assert.Equal(t, "2025-02-10 に残高が ¥-5,000 まで減る見込みです", message.Text)
+	require.Len(t, message.Blocks, 4)
+	assert.Equal(t, "header", message.Blocks[0].Type)
+	assert.Contains(t, message.Blocks[1].Text["text"], "&lt;基準額&gt;", "markup characters are escaped")
+	require.Len(t, message.Blocks[2].Fields, 2)
+	assert.Equal(t, "*予測残高*\n¥-5,000", message.Blocks[2].Fields[1]["text"])
+	assert.Equal(t, "<https://flow.example.com/cashflow|Flow Sight>", message.Blocks[3].Elements[0]["text"])
+}
+
+func TestDiscordNotifier_Notify(t *testing.T) {
+	stub := startChatStub(t, http.StatusNoContent)
+
+	err := NewDiscordNotifier(loopbackGuard(t)).Notify(context.Background(), stub.server.URL+"/api/webhooks/1/token", testChatNotification())
+
+	require.NoError(t, err)
+	var message struct {
+		Embeds []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			Color       int    `json:"color"`
+			Fields      []struct {
+				Name   string `json:"name"`
+				Value  string `json:"value"`
+				Inline bool   `json:"inline"`
+			} `json:"fields"`
+		} `json:"embeds"`
+		AllowedMentions struct {
+			Parse []string `json:"parse"`
+		} `json:"allowed_mentions"`
+	}
+	require.NoError(t, json.Unmarshal(stub.body, &message))
+	require.Len(t, message.Embeds, 1)
+	embed := message.Embeds[0]
+	assert.Equal(t, testChatNotification().Subject, embed.Title)
+	assert.Equal(t, "https://flow.example.com/cashflow", embed.URL)
+	assert.Equal(t, 0xdc2626, embed.Color)
+	require.Len(t, embed.Fields, 2)
+	assert.Equal(t, "日付", embed.Fields[0].Name)
+	assert.True(t, embed.Fields[0].Inline)
+	assert.NotNil(t, message.AllowedMentions.Parse)
+}
+
+func TestLINENotifier_Notify(t *testing.T) {
+	stub := startChatStub(t, http.StatusOK)
+
+	err := NewLINENotifier(stub.server.URL+"/api/notify", loopbackGuard(t)).Notify(context.Background(), "token-123", testChatNotification())
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token-123", stub.header.Get("Authorization"))
+	assert.Equal(t, "application/x-www-form-urlencoded", stub.header.Get("Content-Type"))
+	form, err := url.ParseQuery(string(stub.body))
+	require.NoError(t, err)
+	message := form.Get("message")
+	assert.True(t, strings.HasPrefix(message, "\n2025-02-10 に残高が"))
+	assert.True(t, strings.HasSuffix(message, "\n\nhttps://flow.example.com/cashflow"))
+
+	err = NewLINENotifier(stub.server.URL, loopbackGuard(t)).Notify(context.Background(), "", testChatNotification())
+	assert.Error(t, err, "no access token")
+}
+
+func TestChatNotifier_ErrorStatus(t *testing.T) {
+	stub := startChatStub(t, http.StatusNotFound)
+
+	err := NewSlackNotifier(loopbackGuard(t)).Notify(context.Background(), stub.server.URL+"/services/revoked", testChatNotification())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+	assert.NotContains(t, err.Error(), "stub", "the response is not passed on")
+}
+
+func TestChatNotifier_Redirect(t *testing.T) {
+	var redirected bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			redirected = true
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusFound)
+	}))
+	defer server.Close()
+
+	err := NewSlackNotifier(loopbackGuard(t)).Notify(context.Background(), server.URL+"/services/T000/B000/XXX", testChatNotification())
+
+	assert.ErrorContains(t, err, "302")
+	assert.False(t, redirected, "redirects are not followed")
+}
+
+func TestChatNotifier_Blocked(t *testing.T) {
+	stub := startChatStub(t, http.StatusOK)
+	guard, err := netguard.New(nil)
+	require.NoError(t, err)
+
+	err = NewDiscordNotifier(guard).Notify(context.Background(), stub.server.URL+"/api/webhooks/1/token", testChatNotification())
+
+	assert.ErrorIs(t, err, netguard.ErrBlocked)
+	assert.Nil(t, stub.body, "nothing is sent to the loopback address")
+}
+
+func TestChatNotifier_Unreachable(t *testing.T) {
+	stub := startChatStub(t, http.StatusOK)
+	address := stub.server.URL + "/api/webhooks/1/secret-token"
+	stub.server.Close()
+
+	err := NewDiscordNotifier(loopbackGuard(t)).Notify(context.Background(), address, testChatNotification())
+
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-token", "the webhook URL is a credential")
+
+	err = NewDiscordNotifier(loopbackGuard(t)).Notify(context.Background(), "discord.example.com/hook", testChatNotification())
+	assert.Error(t, err, "not an http URL")
+}
+
+func TestLineMessage_Truncates(t *testing.T) {
+	notification := testChatNotification()
+	notification.Body = strings.Repeat("あ", 2000)
+
+	message := lineMessage(notification)
+
+	assert.Len(t, []rune(message), lineNotifyMaxMessage)
+	assert.True(t, strings.HasSuffix(message, "…\n\nhttps://flow.example.com/cashflow"), "the link survives the cut")
+}
@@ -0,0 +1,29 @@
+// Package notify delivers notifications to people outside the application.
+//
+// A Notification is written once and every Notifier renders it for its transport: e-mail and
+// LINE send the subject and body as plain text, Slack and Discord lay the fields out as rich
+// messages.
+package notify
+
+import "context"
+
+// Notification is a message about an event
+type Notification struct {
+	Event   string  // Type of the event, e.g. "low_balance"
+	Subject string  // One line summary
+	Body    string  // Plain text, lines separated by "\n"
+	Fields  []Field // Key figures of the event
+	Link    string  // Page of the application the notification is about; may be empty
+}
+
+// Field is a named figure of a notification, e.g. the amount of a payment
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Notifier delivers notifications over one transport. The recipient is what the transport
+// addresses, e.g. an e-mail address, an incoming webhook URL or an access token.
+type Notifier interface {
+	Notify(ctx context.Context, recipient string, notification Notification) error
+}
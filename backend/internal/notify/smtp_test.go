@@ -0,0 +1,195 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts one session on localhost and records what the client sent
+type fakeSMTPServer struct {
+	listener   net.Listener
+	rejectRcpt bool
+	done       chan struct{}
+
+	auth string
+	from string
+	to   string
+	data string
+}
+
+func startFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	server := &fakeSMTPServer{listener: listener, done: make(chan struct{})}
+	go server.serve()
+	return server
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch command {
+		case "EHLO":
+			reply("250-fake")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = line
+			reply("235 authenticated")
+		case "MAIL":
+			s.from = line
+			reply("250 ok")
+		case "RCPT":
+			s.to = line
+			if s.rejectRcpt {
+				reply("550 no such user")
+			} else {
+				reply("250 ok")
+			}
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			s.data = data.String()
+			reply("250 queued")
+		case "RSET", "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	server := startFakeSMTPServer(t)
+	notifier := NewSMTPNotifier(SMTPConfig{
+		Host:     "127.0.0.1",
+		Port:     server.port(),
+		Username: "flow",
+		Password: "secret",
+		From:     "Flow Sight <noreply@example.com>",
+	})
+	notifier.now = func() time.Time { return time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC) }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := notifier.Notify(ctx, "user@example.com", Notification{
+		Event:   "low_balance",
+		Subject: "残高が少なくなる見込みです",
+		Body:    "2025-02-10 に残高が -¥12,000 になる見込みです。",
+		Link:    "https://flow.example.com/",
+	})
+	require.NoError(t, err)
+	<-server.done
+
+	assert.Equal(t, "AUTH PLAIN "+base64.StdEncoding.EncodeToString([]byte("\x00flow\x00secret")), server.auth)
+	assert.Equal(t, "MAIL FROM:<noreply@example.com>", server.from)
+	assert.Equal(t, "RCPT TO:<user@example.com>", server.to)
+
+	message, err := mail.ReadMessage(strings.NewReader(server.data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "残高が少なくなる見込みです", subject)
+	assert.Equal(t, `"Flow Sight" <noreply@example.com>`, message.Header.Get("From"))
+	assert.Equal(t, "Mon, 20 Jan 2025 08:00:00 +0000", message.Header.Get("Date"))
+	assert.Equal(t, "low_balance", message.Header.Get("X-Flow-Sight-Event"))
+	assert.True(t, strings.HasSuffix(message.Header.Get("Message-ID"), "@example.com>"))
+
+	encoded, err := io.ReadAll(message.Body)
+	require.NoError(t, err)
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "2025-02-10 に残高が -¥12,000 になる見込みです。\r\n\r\nhttps://flow.example.com/\r\n", string(body))
+}
+
+func TestSMTPNotifier_NotifyRejected(t *testing.T) {
+	server := startFakeSMTPServer(t)
+	server.rejectRcpt = true
+	notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: server.port(), From: "noreply@example.com"})
+
+	err := notifier.Notify(context.Background(), "nobody@example.com", Notification{Subject: "test"})
+
+	assert.ErrorContains(t, err, "550")
+	assert.Empty(t, server.auth, "no credentials configured")
+}
+
+func TestSMTPNotifier_NotifyInvalidAddress(t *testing.T) {
+	notifier := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: 25, From: "noreply@example.com"})
+
+	err := notifier.Notify(context.Background(), "not an address", Notification{})
+
+	assert.ErrorContains(t, err, "invalid recipient address")
+}
+
+func TestBuildMessage_wrapsBody(t *testing.T) {
+	from := &mail.Address{Address: "noreply@example.com"}
+	to := &mail.Address{Address: "user@example.com"}
+
+	message, err := buildMessage(from, to, Notification{Subject: "digest", Body: strings.Repeat("週次", 100)}, time.Now())
+	require.NoError(t, err)
+
+	for _, line := range strings.Split(string(message), "\r\n") {
+		assert.LessOrEqual(t, len(line), 78, strconv.Quote(line))
+	}
+}
+
+func TestBuildMessage_singleLineSubject(t *testing.T) {
+	from := &mail.Address{Address: "noreply@example.com"}
+	to := &mail.Address{Address: "user@example.com"}
+
+	message, err := buildMessage(from, to, Notification{Subject: "Card\r\nBcc: attacker@example.com", Body: "本文"}, time.Now())
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(message)))
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Header.Get("Bcc"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Card  Bcc: attacker@example.com", subject)
+}
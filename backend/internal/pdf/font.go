@@ -0,0 +1,443 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+var errInvalidFont = errors.New("pdf: invalid TrueType font")
+
+// Tables of the glyphs' outlines and metrics, which a font needs, and those a subset copies as
+// they are. Documents select glyphs by ID, so subsets have no character map.
+var (
+	outlineTables = []string{"glyf", "head", "hhea", "hmtx", "loca", "maxp"}
+	subsetTables  = []string{"cvt ", "fpgm", "head", "hhea", "hmtx", "maxp", "prep"}
+)
+
+// Font is a TrueType font, e.g. IPAex Gothic or Noto Sans JP. Documents embed the outlines of
+// the glyphs they use.
+type Font struct {
+	name       string
+	tables     map[string][]byte
+	unitsPerEm float64
+	glyphs     map[rune]uint16 // Glyph IDs by character
+	advances   []uint16        // Advance widths by glyph ID, in font units
+	loca       []uint32        // Offsets of the glyphs in the glyf table, one more than the glyphs
+	bbox       [4]int          // In thousandths of the size, as the metrics below
+	ascent     int
+	descent    int
+	capHeight  int
+}
+
+// LoadFont reads a TrueType font file
+func LoadFont(path string) (*Font, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFont(data)
+}
+
+// ParseFont parses a TrueType font. Fonts with PostScript outlines (OpenType CFF) and font
+// collections are not supported.
+func ParseFont(data []byte) (*Font, error) {
+	f, err := parseOutlines(data)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := f.tables["cmap"]; !ok {
+		return nil, errors.New("pdf: font has no cmap table")
+	}
+	if f.glyphs, err = parseCmap(f.tables["cmap"], len(f.advances)); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// parseOutlines parses the tables of a font but its character map
+func parseOutlines(data []byte) (*Font, error) {
+	if len(data) < 12 {
+		return nil, errInvalidFont
+	}
+	switch binary.BigEndian.Uint32(data) {
+	case 0x00010000, 0x74727565: // TrueType outlines
+	case 0x4f54544f:
+		return nil, errors.New("pdf: fonts with PostScript outlines are not supported")
+	case 0x74746366:
+		return nil, errors.New("pdf: font collections are not supported")
+	default:
+		return nil, errInvalidFont
+	}
+
+	numTables := int(u16(data, 4))
+	if len(data) < 12+16*numTables {
+		return nil, errInvalidFont
+	}
+	tables := make(map[string][]byte, numTables)
+	for i := 0; i < numTables; i++ {
+		record := data[12+16*i:]
+		offset, length := uint64(u32(record, 8)), uint64(u32(record, 12))
+		if offset+length > uint64(len(data)) {
+			return nil, errInvalidFont
+		}
+		tables[string(record[:4])] = data[offset : offset+length]
+	}
+	for _, tag := range outlineTables {
+		if _, ok := tables[tag]; !ok {
+			return nil, fmt.Errorf("pdf: font has no %s table", tag)
+		}
+	}
+
+	head, hhea, maxp, hmtx := tables["head"], tables["hhea"], tables["maxp"], tables["hmtx"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return nil, errInvalidFont
+	}
+	f := &Font{name: postScriptName(tables["name"]), tables: tables, unitsPerEm: float64(u16(head, 18))}
+	if f.unitsPerEm == 0 {
+		return nil, errInvalidFont
+	}
+
+	numGlyphs := int(u16(maxp, 4))
+	numHMetrics := int(u16(hhea, 34))
+	if numGlyphs == 0 || numHMetrics == 0 || numHMetrics > numGlyphs || len(hmtx) < 4*numHMetrics {
+		return nil, errInvalidFont
+	}
+	f.advances = make([]uint16, numGlyphs)
+	for gid := range f.advances {
+		if gid < numHMetrics {
+			f.advances[gid] = u16(hmtx, 4*gid)
+		} else {
+			f.advances[gid] = f.advances[numHMetrics-1]
+		}
+	}
+
+	loca, glyf := tables["loca"], tables["glyf"]
+	longOffsets := int16(u16(head, 50)) == 1
+	if (longOffsets && len(loca) < 4*(numGlyphs+1)) || (!longOffsets && len(loca) < 2*(numGlyphs+1)) {
+		return nil, errInvalidFont
+	}
+	f.loca = make([]uint32, numGlyphs+1)
+	for gid := range f.loca {
+		if longOffsets {
+			f.loca[gid] = u32(loca, 4*gid)
+		} else {
+			f.loca[gid] = 2 * uint32(u16(loca, 2*gid))
+		}
+		if f.loca[gid] > uint32(len(glyf)) || (gid > 0 && f.loca[gid] < f.loca[gid-1]) {
+			return nil, errInvalidFont
+		}
+	}
+
+	for i, offset := range []int{36, 38, 40, 42} {
+		f.bbox[i] = f.scale(int16(u16(head, offset)))
+	}
+	f.ascent = f.scale(int16(u16(hhea, 4)))
+	f.descent = f.scale(int16(u16(hhea, 6)))
+	f.capHeight = f.ascent
+	if os2 := tables["OS/2"]; len(os2) >= 90 && u16(os2, 0) >= 2 {
+		f.capHeight = f.scale(int16(u16(os2, 88)))
+	}
+	return f, nil
+}
+
+// TextWidth returns the width of text drawn at the size
+func (f *Font) TextWidth(text string, size float64) float64 {
+	width := 0
+	for _, r := range text {
+		gid, _ := f.lookup(r)
+		width += int(f.advances[gid])
+	}
+	return float64(width) / f.unitsPerEm * size
+}
+
+// lookup returns the glyph of a character and the character it draws: the character itself, or
+// "?" when the font has no glyph for it
+func (f *Font) lookup(r rune) (uint16, rune) {
+	if gid, ok := f.glyphs[r]; ok {
+		return gid, r
+	}
+	return f.glyphs['?'], '?'
+}
+
+// width returns the advance width of a glyph in thousandths of the size
+func (f *Font) width(gid uint16) int {
+	return f.scale(int16(min(f.advances[gid], math.MaxInt16)))
+}
+
+// scale converts font units to thousandths of the size
+func (f *Font) scale(v int16) int {
+	return int(math.Round(float64(v) * 1000 / f.unitsPerEm))
+}
+
+func (f *Font) glyph(gid uint16) []byte {
+	return f.tables["glyf"][f.loca[gid]:f.loca[gid+1]]
+}
+
+// subset returns a font file with the outlines of the glyphs, those they are composed of and
+// .notdef. Glyph IDs are kept, so the outlines of the other glyphs are left empty.
+func (f *Font) subset(gids []uint16) []byte {
+	used := map[uint16]bool{0: true}
+	queue := append([]uint16{0}, gids...)
+	for len(queue) > 0 {
+		gid := queue[0]
+		queue = queue[1:]
+		used[gid] = true
+		for _, component := range glyphComponents(f.glyph(gid)) {
+			if int(component) < len(f.advances) && !used[component] {
+				queue = append(queue, component)
+			}
+		}
+	}
+
+	var glyf bytes.Buffer
+	loca := make([]byte, 4*len(f.loca))
+	for gid := 0; gid < len(f.advances); gid++ {
+		binary.BigEndian.PutUint32(loca[4*gid:], uint32(glyf.Len()))
+		if used[uint16(gid)] {
+			glyf.Write(f.glyph(uint16(gid)))
+		}
+	}
+	binary.BigEndian.PutUint32(loca[4*len(f.advances):], uint32(glyf.Len()))
+
+	tables := map[string][]byte{"glyf": glyf.Bytes(), "loca": loca}
+	for _, tag := range subsetTables {
+		if table, ok := f.tables[tag]; ok {
+			tables[tag] = table
+		}
+	}
+	// The offsets are written in the long format
+	head := bytes.Clone(tables["head"])
+	binary.BigEndian.PutUint16(head[50:], 1)
+	tables["head"] = head
+
+	return writeFont(tables)
+}
+
+// glyphComponents returns the glyphs a composite glyph is made of
+func glyphComponents(glyph []byte) []uint16 {
+	if len(glyph) < 10 || int16(u16(glyph, 0)) >= 0 {
+		return nil
+	}
+
+	var components []uint16
+	for offset := 10; offset+4 <= len(glyph); {
+		flags := u16(glyph, offset)
+		components = append(components, u16(glyph, offset+2))
+		offset += 4
+		if flags&0x0001 != 0 { // Arguments are words
+			offset += 4
+		} else {
+			offset += 2
+		}
+		switch {
+		case flags&0x0008 != 0: // A scale
+			offset += 2
+		case flags&0x0040 != 0: // An x and a y scale
+			offset += 4
+		case flags&0x0080 != 0: // A two by two transformation
+			offset += 8
+		}
+		if flags&0x0020 == 0 { // No more components
+			break
+		}
+	}
+	return components
+}
+
+// writeFont writes a font file of the tables, with the checksums set
+func writeFont(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= len(tags) {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	var b bytes.Buffer
+	header := make([]byte, 12+16*len(tags))
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(len(tags)))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(16*len(tags)-searchRange))
+
+	headOffset := -1
+	offset := len(header)
+	for i, tag := range tags {
+		table := tables[tag]
+		if tag == "head" {
+			table = bytes.Clone(table)
+			binary.BigEndian.PutUint32(table[8:], 0) // checkSumAdjustment is computed last
+			tables[tag] = table
+			headOffset = offset
+		}
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(offset))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		offset += (len(table) + 3) &^ 3
+	}
+
+	b.Write(header)
+	for _, tag := range tags {
+		b.Write(tables[tag])
+		for b.Len()%4 != 0 {
+			b.WriteByte(0)
+		}
+	}
+
+	font := b.Bytes()
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(font[headOffset+8:], 0xb1b0afba-checksum(font))
+	}
+	return font
+}
+
+// checksum sums the data as big-endian 32-bit words, the last one padded with zeros
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// parseCmap returns the glyph IDs of the Unicode characters of a cmap table. A subtable of the
+// full Unicode range (format 12) is preferred to one of the Basic Multilingual Plane (format 4).
+func parseCmap(cmap []byte, numGlyphs int) (map[rune]uint16, error) {
+	if len(cmap) < 4 || len(cmap) < 4+8*int(u16(cmap, 2)) {
+		return nil, errInvalidFont
+	}
+
+	var bmp, full []byte
+	for i := 0; i < int(u16(cmap, 2)); i++ {
+		platform, encoding, offset := u16(cmap, 4+8*i), u16(cmap, 6+8*i), u32(cmap, 8+8*i)
+		if uint64(offset)+2 > uint64(len(cmap)) || !(platform == 0 || platform == 3 && (encoding == 1 || encoding == 10)) {
+			continue
+		}
+		subtable := cmap[offset:]
+		switch u16(subtable, 0) {
+		case 4:
+			bmp = subtable
+		case 12:
+			full = subtable
+		}
+	}
+
+	glyphs := make(map[rune]uint16)
+	add := func(r rune, gid uint32) {
+		if gid != 0 && gid < uint32(numGlyphs) {
+			glyphs[r] = uint16(gid)
+		}
+	}
+
+	switch {
+	case full != nil:
+		if len(full) < 16 {
+			return nil, errInvalidFont
+		}
+		groups := min(int(u32(full, 12)), (len(full)-16)/12)
+		for i := 0; i < groups; i++ {
+			start, end, startGlyph := u32(full, 16+12*i), u32(full, 20+12*i), u32(full, 24+12*i)
+			if start > end || end > unicodeMax {
+				continue
+			}
+			for c := start; c <= end; c++ {
+				add(rune(c), startGlyph+c-start)
+			}
+		}
+	case bmp != nil:
+		if len(bmp) < 14 {
+			return nil, errInvalidFont
+		}
+		segCountX2 := int(u16(bmp, 6))
+		if len(bmp) < 16+4*segCountX2 {
+			return nil, errInvalidFont
+		}
+		for i := 0; i < segCountX2; i += 2 {
+			end, start := u16(bmp, 14+i), u16(bmp, 16+segCountX2+i)
+			delta, rangeOffsetAt := u16(bmp, 16+2*segCountX2+i), 16+3*segCountX2+i
+			rangeOffset := int(u16(bmp, rangeOffsetAt))
+			for c := int(start); c <= int(end) && c != 0xffff; c++ {
+				if rangeOffset == 0 {
+					add(rune(c), uint32(uint16(c)+delta))
+					continue
+				}
+				at := rangeOffsetAt + rangeOffset + 2*(c-int(start))
+				if at+2 > len(bmp) {
+					break
+				}
+				if gid := u16(bmp, at); gid != 0 {
+					add(rune(c), uint32(gid+delta))
+				}
+			}
+		}
+	default:
+		return nil, errors.New("pdf: font has no Unicode character map")
+	}
+	return glyphs, nil
+}
+
+const unicodeMax = 0x10ffff
+
+// postScriptName returns the PostScript name of a name table, reduced to the characters PDF
+// names can have without escapes
+func postScriptName(table []byte) string {
+	name := ""
+	if len(table) >= 6 {
+		count, storage := int(u16(table, 2)), int(u16(table, 4))
+		for i := 0; i < count && 6+12*(i+1) <= len(table) && name == ""; i++ {
+			record := table[6+12*i:]
+			platform, nameID := u16(record, 0), u16(record, 6)
+			length, offset := int(u16(record, 8)), storage+int(u16(record, 10))
+			if nameID != 6 || offset+length > len(table) {
+				continue
+			}
+			value := table[offset : offset+length]
+			switch platform {
+			case 1:
+				name = string(value)
+			case 0, 3:
+				units := make([]uint16, len(value)/2)
+				for j := range units {
+					units[j] = u16(value, 2*j)
+				}
+				name = string(utf16.Decode(units))
+			}
+		}
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return -1
+	}, name)
+	if name == "" {
+		return "Font"
+	}
+	return name
+}
+
+func u16(b []byte, offset int) uint16 {
+	return binary.BigEndian.Uint16(b[offset:])
+}
+
+func u32(b []byte, offset int) uint32 {
+	return binary.BigEndian.Uint32(b[offset:])
+}
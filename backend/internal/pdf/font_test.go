@@ -0,0 +1,93 @@
+package pdf
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFont(t *testing.T) {
+	font := testFont(t)
+
+	assert.Equal(t, helpers.TestFontPostScriptName, font.name)
+	assert.Equal(t, [4]int{0, -218, 1000, 880}, font.bbox)
+	assert.Equal(t, 880, font.ascent)
+	assert.Equal(t, -218, font.descent)
+	assert.Equal(t, 880, font.capHeight, "the ascent without an OS/2 table")
+	assert.Equal(t, uint16(34), font.glyphs['A'])
+	assert.Equal(t, 500, font.width(34))
+}
+
+func TestParseFont_invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		expectedError string
+	}{
+		{name: "empty", data: nil, expectedError: "pdf: invalid TrueType font"},
+		{name: "PostScript outlines", data: []byte("OTTO\x00\x00\x00\x00\x00\x00\x00\x00"), expectedError: "pdf: fonts with PostScript outlines are not supported"},
+		{name: "collection", data: []byte("ttcf\x00\x01\x00\x00\x00\x00\x00\x01"), expectedError: "pdf: font collections are not supported"},
+		{name: "no tables", data: []byte("\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"), expectedError: "pdf: font has no glyf table"},
+		{name: "truncated", data: helpers.CreateTestFont()[:200], expectedError: "pdf: invalid TrueType font"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFont(tt.data)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestFont_TextWidth(t *testing.T) {
+	font := testFont(t)
+
+	assert.Equal(t, 0.0, font.TextWidth("", 10))
+	assert.Equal(t, 15.0, font.TextWidth("abc", 10))
+	assert.Equal(t, 30.0, font.TextWidth("家賃 ¥", 10))
+	assert.Equal(t, 5.0, font.TextWidth("😀", 10), "characters without a glyph are as wide as ?")
+}
+
+func TestFont_subset(t *testing.T) {
+	font := testFont(t)
+	ellipsis, _ := font.lookup('…')
+	dot, _ := font.lookup('.')
+	a, _ := font.lookup('A')
+
+	data := font.subset([]uint16{ellipsis, a})
+	assert.Equal(t, uint32(0xb1b0afba), checksum(data), "the checksum adjustment is set")
+
+	subset, err := parseOutlines(data)
+	require.NoError(t, err)
+	assert.Equal(t, font.glyph(0), subset.glyph(0), ".notdef is kept")
+	assert.Equal(t, font.glyph(ellipsis), subset.glyph(ellipsis))
+	assert.Equal(t, font.glyph(dot), subset.glyph(dot), "components of the glyphs are kept")
+	x, _ := font.lookup('x')
+	assert.Empty(t, subset.glyph(x), "glyphs not used are left out")
+	assert.Equal(t, font.advances, subset.advances, "glyph IDs and widths are kept")
+}
+
+func TestParseCmap_basicMultilingualPlane(t *testing.T) {
+	// A format 4 subtable of three segments: A to C by a delta, あ and ぃ through the glyph ID
+	// array, and the closing segment
+	words := []uint16{
+		4, 0, 0, 6, 4, 1, 2, // Header
+		0x43, 0x3043, 0xffff, // End codes
+		0,                    // Reserved
+		0x41, 0x3042, 0xffff, // Start codes
+		0xffc9, 0, 1, // Deltas, the first one 10 - 0x41
+		0, 4, 0, // Offsets into the glyph ID array
+		20, 21, // Glyph ID array
+	}
+	cmap := []byte{0, 0, 0, 1, 0, 3, 0, 1, 0, 0, 0, 12}
+	for _, word := range words {
+		cmap = binary.BigEndian.AppendUint16(cmap, word)
+	}
+
+	glyphs, err := parseCmap(cmap, 30)
+	require.NoError(t, err)
+	assert.Equal(t, map[rune]uint16{'A': 10, 'B': 11, 'C': 12, 'あ': 20, 'ぃ': 21}, glyphs)
+}
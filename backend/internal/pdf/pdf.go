@@ -0,0 +1,346 @@
+// Package pdf writes simple PDF documents of text, lines and filled rectangles.
+//
+// Text is set in a TrueType font, such as IPAex Gothic or Noto Sans JP, and the glyphs a document
+// uses are embedded in it, so it looks the same in every reader. Characters the font has no glyph
+// for are drawn as "?". Coordinates are in points from the bottom left corner of the page, as in
+// PDF itself.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// ContentType is the MIME type of a PDF file
+const ContentType = "application/pdf"
+
+// Size of an A4 page in portrait orientation, in points
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// Color is an RGB color with components from 0 to 1. The zero value is black.
+type Color struct {
+	R, G, B float64
+}
+
+// Align is the horizontal alignment of text relative to its x coordinate
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// TextStyle is how text is drawn. A zero Size is drawn at 10 points.
+type TextStyle struct {
+	Size  float64
+	Color Color
+	Align Align
+}
+
+// LineStyle is how lines are stroked. A zero Width is drawn at 1 point.
+type LineStyle struct {
+	Width  float64
+	Color  Color
+	Dashed bool
+}
+
+// Point is a position on a page
+type Point struct {
+	X, Y float64
+}
+
+// Document is a PDF document of pages
+type Document struct {
+	Title     string
+	CreatedAt time.Time
+	font      *Font
+	used      map[uint16]rune // Characters drawn by the glyphs used, for copying text
+	pages     []*Page
+}
+
+// New returns an empty document whose text is set in the font
+func New(title string, createdAt time.Time, font *Font) *Document {
+	return &Document{Title: title, CreatedAt: createdAt, font: font, used: make(map[uint16]rune)}
+}
+
+// AddPage adds an A4 portrait page at the end of the document
+func (d *Document) AddPage() *Page {
+	page := &Page{Width: A4Width, Height: A4Height, doc: d}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Page is a page of the document. Drawing methods add to the page's content in order.
+type Page struct {
+	Width   float64
+	Height  float64
+	doc     *Document
+	content bytes.Buffer
+}
+
+// Text draws a line of text with its baseline at y
+func (p *Page) Text(x, y float64, text string, style TextStyle) {
+	size := style.Size
+	if size == 0 {
+		size = 10
+	}
+	switch style.Align {
+	case AlignCenter:
+		x -= p.doc.font.TextWidth(text, size) / 2
+	case AlignRight:
+		x -= p.doc.font.TextWidth(text, size)
+	}
+
+	fmt.Fprintf(&p.content, "BT %s rg /F1 %s Tf %s %s Td <%s> Tj ET\n",
+		color(style.Color), number(size), number(x), number(y), p.doc.encodeText(text))
+}
+
+// Line draws a straight line
+func (p *Page) Line(x1, y1, x2, y2 float64, style LineStyle) {
+	p.Polyline([]Point{{x1, y1}, {x2, y2}}, style)
+}
+
+// Polyline draws connected line segments through the points
+func (p *Page) Polyline(points []Point, style LineStyle) {
+	if len(points) < 2 {
+		return
+	}
+	width := style.Width
+	if width == 0 {
+		width = 1
+	}
+	dash := "[] 0 d"
+	if style.Dashed {
+		dash = "[3 2] 0 d"
+	}
+
+	fmt.Fprintf(&p.content, "q %s RG %s w %s", color(style.Color), number(width), dash)
+	for i, point := range points {
+		operator := "l"
+		if i == 0 {
+			operator = "m"
+		}
+		fmt.Fprintf(&p.content, " %s %s %s", number(point.X), number(point.Y), operator)
+	}
+	p.content.WriteString(" S Q\n")
+}
+
+// FillRect fills a rectangle whose bottom left corner is at x, y
+func (p *Page) FillRect(x, y, width, height float64, fill Color) {
+	fmt.Fprintf(&p.content, "q %s rg %s %s %s %s re f Q\n",
+		color(fill), number(x), number(y), number(width), number(height))
+}
+
+// Write writes the document to w
+func (d *Document) Write(w io.Writer) error {
+	ow := &objectWriter{}
+	ow.header()
+
+	// Objects 1 to 7 are the catalog, the page tree and the font with its subset and character
+	// map; the pages follow in pairs of a page and its content stream, then the document information
+	pageRefs := make([]string, len(d.pages))
+	for i := range d.pages {
+		pageRefs[i] = fmt.Sprintf("%d 0 R", 8+2*i)
+	}
+	infoID := 8 + 2*len(d.pages)
+
+	ow.object("<< /Type /Catalog /Pages 2 0 R >>")
+	ow.object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageRefs, " "), len(d.pages)))
+
+	// Text is written as glyph IDs, which the CID font maps to the same glyphs of the subset
+	gids := make([]uint16, 0, len(d.used))
+	for gid := range d.used {
+		gids = append(gids, gid)
+	}
+	slices.Sort(gids)
+	font := d.font
+	fontName := subsetTag(gids) + "+" + font.name
+	ow.object(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [4 0 R] /ToUnicode 7 0 R >>", fontName))
+	ow.object(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor 5 0 R /DW 1000 /W [%s] /CIDToGIDMap /Identity >>",
+		fontName, d.glyphWidths(gids)))
+	ow.object(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 6 0 R >>",
+		fontName, font.bbox[0], font.bbox[1], font.bbox[2], font.bbox[3], font.ascent, font.descent, font.capHeight))
+	fontFile := font.subset(gids)
+	compressed, err := deflate(fontFile)
+	if err != nil {
+		return err
+	}
+	ow.stream(fmt.Sprintf("/Length1 %d /Filter /FlateDecode", len(fontFile)), compressed)
+	compressed, err = deflate(d.toUnicode(gids))
+	if err != nil {
+		return err
+	}
+	ow.stream("/Filter /FlateDecode", compressed)
+
+	for i, page := range d.pages {
+		ow.object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			number(page.Width), number(page.Height), 9+2*i))
+
+		compressed, err := deflate(page.content.Bytes())
+		if err != nil {
+			return err
+		}
+		ow.stream("/Filter /FlateDecode", compressed)
+	}
+
+	ow.object(fmt.Sprintf("<< /Title <%s> /Producer (Flow Sight) /CreationDate (D:%s) >>",
+		encodeTextString(d.Title), d.CreatedAt.UTC().Format("20060102150405Z")))
+
+	ow.trailer(infoID)
+	_, err = w.Write(ow.buf.Bytes())
+	return err
+}
+
+// glyphWidths returns the widths of the glyphs as the entries of a CID font's /W array, one
+// entry for each run of consecutive glyph IDs
+func (d *Document) glyphWidths(gids []uint16) string {
+	var b strings.Builder
+	for i, gid := range gids {
+		if i == 0 || gid != gids[i-1]+1 {
+			if i > 0 {
+				b.WriteString("] ")
+			}
+			fmt.Fprintf(&b, "%d [", gid)
+		} else {
+			b.WriteString(" ")
+		}
+		fmt.Fprintf(&b, "%d", d.font.width(gid))
+	}
+	if len(gids) > 0 {
+		b.WriteString("]")
+	}
+	return b.String()
+}
+
+// toUnicode returns a CMap of the glyphs to the characters they draw, so readers can copy and
+// search the text
+func (d *Document) toUnicode(gids []uint16) []byte {
+	var b bytes.Buffer
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// A section holds at most 100 mappings
+	for chunk := range slices.Chunk(gids, 100) {
+		fmt.Fprintf(&b, "%d beginbfchar\n", len(chunk))
+		for _, gid := range chunk {
+			fmt.Fprintf(&b, "<%04X> <%s>\n", gid, encodeUTF16(string(d.used[gid])))
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	return b.Bytes()
+}
+
+// subsetTag returns the six capital letters that name a font subset. The same glyphs give the
+// same tag, so documents of the same text are identical.
+func subsetTag(gids []uint16) string {
+	h := fnv.New64a()
+	for _, gid := range gids {
+		h.Write([]byte{byte(gid >> 8), byte(gid)})
+	}
+	sum := h.Sum64()
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + byte(sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
+
+// deflate compresses the data of a stream for the FlateDecode filter
+func deflate(data []byte) ([]byte, error) {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
+}
+
+// objectWriter numbers the objects of a file from 1 and records where each one starts
+type objectWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (ow *objectWriter) header() {
+	// The binary comment tells transfer programs the file is not text
+	ow.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+}
+
+func (ow *objectWriter) object(body string) {
+	ow.offsets = append(ow.offsets, ow.buf.Len())
+	fmt.Fprintf(&ow.buf, "%d 0 obj\n%s\nendobj\n", len(ow.offsets), body)
+}
+
+func (ow *objectWriter) stream(dictionary string, data []byte) {
+	ow.offsets = append(ow.offsets, ow.buf.Len())
+	fmt.Fprintf(&ow.buf, "%d 0 obj\n<< %s /Length %d >>\nstream\n", len(ow.offsets), dictionary, len(data))
+	ow.buf.Write(data)
+	ow.buf.WriteString("\nendstream\nendobj\n")
+}
+
+func (ow *objectWriter) trailer(infoID int) {
+	xref := ow.buf.Len()
+	fmt.Fprintf(&ow.buf, "xref\n0 %d\n0000000000 65535 f \n", len(ow.offsets)+1)
+	for _, offset := range ow.offsets {
+		fmt.Fprintf(&ow.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&ow.buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(ow.offsets)+1, infoID, xref)
+}
+
+// encodeText encodes text as the hexadecimal glyph IDs of the font and records the glyphs as used
+func (d *Document) encodeText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		gid, drawn := d.font.lookup(r)
+		d.used[gid] = drawn
+		fmt.Fprintf(&b, "%04X", gid)
+	}
+	return b.String()
+}
+
+// encodeTextString encodes a document information string as hexadecimal UTF-16 with a byte order mark
+func encodeTextString(text string) string {
+	return "FEFF" + encodeUTF16(text)
+}
+
+// encodeUTF16 encodes text as hexadecimal UTF-16 code units
+func encodeUTF16(text string) string {
+	var b strings.Builder
+	for _, unit := range utf16.Encode([]rune(text)) {
+		fmt.Fprintf(&b, "%04X", unit)
+	}
+	return b.String()
+}
+
+func color(c Color) string {
+	return number(c.R) + " " + number(c.G) + " " + number(c.B)
+}
+
+// number formats a coordinate with at most two decimals
+func number(n float64) string {
+	n = math.Round(n*100) / 100
+	if n == 0 {
+		n = 0 // Avoids "-0"
+	}
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
@@ -0,0 +1,117 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFont(t *testing.T) *Font {
+	font, err := ParseFont(helpers.CreateTestFont())
+	require.NoError(t, err)
+	return font
+}
+
+func TestDocument_Write(t *testing.T) {
+	doc := New("月次レポート", time.Date(2025, 2, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)), testFont(t))
+	page := doc.AddPage()
+	page.Text(50, 800, "残高 ¥1,000", TextStyle{Size: 12})
+	page.Line(50, 790, 300, 790, LineStyle{})
+	page.Polyline([]Point{{50, 100}, {100, 120}, {150, 90}}, LineStyle{Width: 2, Color: Color{R: 1}, Dashed: true})
+	page.FillRect(50, 50, 100, 20, Color{R: 0.9, G: 0.9, B: 0.9})
+	doc.AddPage()
+
+	var buf bytes.Buffer
+	require.NoError(t, doc.Write(&buf))
+	file := buf.String()
+
+	assert.True(t, strings.HasPrefix(file, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(file, "%%EOF\n"))
+	assert.Contains(t, file, "/Count 2")
+	assert.Contains(t, file, "/Encoding /Identity-H /DescendantFonts [4 0 R] /ToUnicode 7 0 R")
+	assert.Regexp(t, `/BaseFont /[A-Z]{6}\+FlowSightTest-Regular `, file)
+	assert.Contains(t, file, "/W [1 [500] 13 [500] 17 [500 500] 96 [500] 7917 [1000] 20026 [1000]]")
+	assert.Contains(t, file, "/FontBBox [0 -218 1000 880] /ItalicAngle 0 /Ascent 880 /Descent -218 /CapHeight 880 /StemV 80 /FontFile2 6 0 R")
+	assert.Contains(t, file, "/CreationDate (D:20250201000000Z)")
+	assert.Contains(t, file, "/Title <FEFF67086B2130EC30DD30FC30C8>")
+
+	// Every cross-reference entry points at the start of its object
+	xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(file)
+	require.Len(t, xref, 2)
+	start, err := strconv.Atoi(xref[1])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(file[start:], "xref\n0 13\n"))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(file[start:], -1)
+	require.Len(t, entries, 12)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(entry[1])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(file[offset:], strconv.Itoa(i+1)+" 0 obj\n"), "object %d", i+1)
+	}
+
+	// The embedded subset is a font of the glyphs used
+	subset, err := parseOutlines(readStream(t, file, 6))
+	require.NoError(t, err)
+	assert.NotEmpty(t, subset.glyph(0), ".notdef is kept")
+	assert.Equal(t, 1000, subset.width(0x1eed))
+
+	assert.Contains(t, string(readStream(t, file, 7)), "7 beginbfchar\n<0001> <0020>\n<000D> <002C>\n<0011> <0030>\n<0012> <0031>\n<0060> <00A5>\n<1EED> <6B8B>\n<4E3A> <9AD8>\nendbfchar\n")
+
+	// The first page's content stream
+	content := readStream(t, file, 9)
+	assert.Contains(t, string(content), "BT 0 0 0 rg /F1 12 Tf 50 800 Td <1EED4E3A000100600012000D001100110011> Tj ET\n")
+	assert.Contains(t, string(content), "q 1 0 0 RG 2 w [3 2] 0 d 50 100 m 100 120 l 150 90 l S Q\n")
+	assert.Contains(t, string(content), "q 0.9 0.9 0.9 rg 50 50 100 20 re f Q\n")
+}
+
+// readStream returns the decompressed data of a stream object of a file
+func readStream(t *testing.T, file string, id int) []byte {
+	stream := regexp.MustCompile(`(?s)\n` + strconv.Itoa(id) + ` 0 obj\n<< [^>]*/Filter /FlateDecode /Length (\d+) >>\nstream\n`).FindStringSubmatchIndex(file)
+	require.NotNil(t, stream, "object %d", id)
+	length, err := strconv.Atoi(file[stream[2]:stream[3]])
+	require.NoError(t, err)
+	zr, err := zlib.NewReader(strings.NewReader(file[stream[1] : stream[1]+length]))
+	require.NoError(t, err)
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	return data
+}
+
+func TestPage_TextAlign(t *testing.T) {
+	page := New("", time.Time{}, testFont(t)).AddPage()
+	page.Text(100, 10, "ab", TextStyle{Size: 10, Align: AlignRight})
+	page.Text(100, 10, "あ", TextStyle{Size: 10, Align: AlignCenter})
+
+	assert.Contains(t, page.content.String(), " 90 10 Td ")
+	assert.Contains(t, page.content.String(), " 95 10 Td ")
+}
+
+func TestDocument_encodeText(t *testing.T) {
+	doc := New("", time.Time{}, testFont(t))
+
+	assert.Equal(t, "002200A40020", doc.encodeText("Aあ😀"))
+	assert.Equal(t, map[uint16]rune{0x22: 'A', 0xa4: 'あ', 0x20: '?'}, doc.used, "characters without a glyph are drawn as ?")
+}
+
+func TestSubsetTag(t *testing.T) {
+	tag := subsetTag([]uint16{1, 2, 3})
+
+	assert.Regexp(t, `^[A-Z]{6}$`, tag)
+	assert.Equal(t, tag, subsetTag([]uint16{1, 2, 3}))
+	assert.NotEqual(t, tag, subsetTag([]uint16{1, 2, 4}))
+}
+
+func TestNumber(t *testing.T) {
+	assert.Equal(t, "12.35", number(12.345))
+	assert.Equal(t, "-1.5", number(-1.5))
+	assert.Equal(t, "0", number(0))
+}
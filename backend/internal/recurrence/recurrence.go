@@ -0,0 +1,330 @@
+// Package recurrence implements the subset of iCalendar RRULE (RFC 5545) used for recurring
+// payments: FREQ, INTERVAL, BYMONTH, BYMONTHDAY, COUNT and UNTIL.
+//
+// One deliberate difference from RFC 5545: a BYMONTHDAY past the end of a month falls on the
+// month's last day instead of being skipped, so BYMONTHDAY=31 means "month end" the same way
+// a payment day of 31 does. Negative BYMONTHDAY values count from the end of the month.
+package recurrence
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the base period of a rule
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+const dateLayout = "20060102"
+
+// maxPeriods bounds how many periods are walked when expanding a rule
+const maxPeriods = 100000
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	ByMonth    []int
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+// Parse parses a rule such as "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=27;COUNT=8".
+// An optional "RRULE:" prefix is accepted.
+func Parse(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("recurrence rule is empty")
+	}
+
+	rule := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("invalid recurrence rule part: %q", part)
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.TrimSpace(val)
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate recurrence rule part: %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			freq := Frequency(strings.ToUpper(val))
+			switch freq {
+			case Daily, Weekly, Monthly, Yearly:
+				rule.Freq = freq
+			default:
+				return nil, fmt.Errorf("unsupported FREQ: %s", val)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("INTERVAL must be a positive integer: %s", val)
+			}
+			rule.Interval = n
+		case "BYMONTH":
+			months, err := parseIntList(val, 1, 12, false)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTH: %w", err)
+			}
+			sort.Ints(months)
+			rule.ByMonth = months
+		case "BYMONTHDAY":
+			days, err := parseIntList(val, -31, 31, true)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTHDAY: %w", err)
+			}
+			rule.ByMonthDay = days
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("COUNT must be a positive integer: %s", val)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(val)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part: %s", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("recurrence rule requires FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot be used together")
+	}
+
+	return rule, nil
+}
+
+// String formats the rule in canonical form
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+formatIntList(r.ByMonth))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+formatIntList(r.ByMonthDay))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.Format(dateLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Between returns the occurrences of the rule that fall on dates from "from" to "to" inclusive.
+// dtstart is the first possible occurrence; COUNT is counted from dtstart, so occurrences
+// before "from" still use up the count. Only the date part of the arguments is used.
+func (r *Rule) Between(dtstart, from, to time.Time) []time.Time {
+	dtstart, from, to = truncateDate(dtstart), truncateDate(from), truncateDate(to)
+
+	occurrences := make([]time.Time, 0)
+	emitted := 0
+
+	for period := 0; period < maxPeriods; period++ {
+		candidates, periodStart := r.periodCandidates(dtstart, period)
+		if periodStart.After(to) || (r.Until != nil && periodStart.After(*r.Until)) {
+			break
+		}
+
+		for _, candidate := range candidates {
+			if candidate.Before(dtstart) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return occurrences
+			}
+			if candidate.After(to) {
+				return occurrences
+			}
+
+			emitted++
+			if !candidate.Before(from) {
+				occurrences = append(occurrences, candidate)
+			}
+			if r.Count > 0 && emitted >= r.Count {
+				return occurrences
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// OccursOn reports whether the rule has an occurrence on the given date
+func (r *Rule) OccursOn(dtstart, date time.Time) bool {
+	return len(r.Between(dtstart, date, date)) > 0
+}
+
+// periodCandidates expands one period of the rule into sorted candidate dates.
+// It also returns the first day of the period, used to stop walking.
+func (r *Rule) periodCandidates(dtstart time.Time, period int) ([]time.Time, time.Time) {
+	step := period * r.Interval
+
+	switch r.Freq {
+	case Daily:
+		date := dtstart.AddDate(0, 0, step)
+		return r.filterByMonth(r.filterByMonthDay([]time.Time{date})), date
+	case Weekly:
+		date := dtstart.AddDate(0, 0, step*7)
+		return r.filterByMonth(r.filterByMonthDay([]time.Time{date})), date
+	case Monthly:
+		monthStart := time.Date(dtstart.Year(), dtstart.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, step, 0)
+		if len(r.ByMonth) > 0 && !containsInt(r.ByMonth, int(monthStart.Month())) {
+			return nil, monthStart
+		}
+		return r.monthDays(monthStart, dtstart.Day()), monthStart
+	case Yearly:
+		yearStart := time.Date(dtstart.Year()+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []int{int(dtstart.Month())}
+		}
+		candidates := make([]time.Time, 0, len(months))
+		for _, month := range months {
+			monthStart := time.Date(yearStart.Year(), time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+			candidates = append(candidates, r.monthDays(monthStart, dtstart.Day())...)
+		}
+		return candidates, yearStart
+	}
+
+	return nil, dtstart
+}
+
+// monthDays resolves BYMONTHDAY (or the default day) within the month starting at monthStart
+func (r *Rule) monthDays(monthStart time.Time, defaultDay int) []time.Time {
+	days := r.ByMonthDay
+	if len(days) == 0 {
+		days = []int{defaultDay}
+	}
+
+	last := daysIn(monthStart)
+	seen := make(map[int]bool, len(days))
+	resolved := make([]int, 0, len(days))
+	for _, day := range days {
+		actual := day
+		if day < 0 {
+			actual = last + day + 1
+			if actual < 1 {
+				continue
+			}
+		}
+		if actual > last {
+			actual = last
+		}
+		if !seen[actual] {
+			seen[actual] = true
+			resolved = append(resolved, actual)
+		}
+	}
+	sort.Ints(resolved)
+
+	dates := make([]time.Time, 0, len(resolved))
+	for _, day := range resolved {
+		dates = append(dates, monthStart.AddDate(0, 0, day-1))
+	}
+	return dates
+}
+
+func (r *Rule) filterByMonth(dates []time.Time) []time.Time {
+	if len(r.ByMonth) == 0 {
+		return dates
+	}
+	filtered := dates[:0]
+	for _, date := range dates {
+		if containsInt(r.ByMonth, int(date.Month())) {
+			filtered = append(filtered, date)
+		}
+	}
+	return filtered
+}
+
+func (r *Rule) filterByMonthDay(dates []time.Time) []time.Time {
+	if len(r.ByMonthDay) == 0 {
+		return dates
+	}
+	filtered := dates[:0]
+	for _, date := range dates {
+		last := daysIn(date)
+		for _, day := range r.ByMonthDay {
+			if (day > 0 && day == date.Day()) || (day < 0 && last+day+1 == date.Day()) {
+				filtered = append(filtered, date)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func parseIntList(value string, min, max int, rejectZero bool) ([]int, error) {
+	parts := strings.Split(value, ",")
+	values := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n < min || n > max || (rejectZero && n == 0) {
+			return nil, fmt.Errorf("value out of range: %s", part)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{dateLayout, "20060102T150405Z", "20060102T150405", "2006-01-02"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			return truncateDate(until), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL date: %s", value)
+}
+
+func formatIntList(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func containsInt(values []int, target int) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+func daysIn(date time.Time) int {
+	return time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,210 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(value string) time.Time {
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func formatDates(dates []time.Time) []string {
+	formatted := make([]string, len(dates))
+	for i, d := range dates {
+		formatted[i] = d.Format("2006-01-02")
+	}
+	return formatted
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      string
+		expectedError bool
+	}{
+		{name: "monthly", input: "FREQ=MONTHLY;BYMONTHDAY=27", expected: "FREQ=MONTHLY;BYMONTHDAY=27"},
+		{name: "rrule prefix and lower case", input: "RRULE:freq=yearly;bymonth=5;bymonthday=31", expected: "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=31"},
+		{name: "quarterly with count", input: "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=10;COUNT=8", expected: "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=10;COUNT=8"},
+		{name: "weekly until", input: "FREQ=WEEKLY;UNTIL=20260331", expected: "FREQ=WEEKLY;UNTIL=20260331"},
+		{name: "until with time", input: "FREQ=DAILY;UNTIL=20260331T000000Z", expected: "FREQ=DAILY;UNTIL=20260331"},
+		{name: "bymonth is sorted", input: "FREQ=YEARLY;BYMONTH=12,6", expected: "FREQ=YEARLY;BYMONTH=6,12"},
+		{name: "interval 1 is omitted", input: "FREQ=MONTHLY;INTERVAL=1", expected: "FREQ=MONTHLY"},
+		{name: "empty", input: "", expectedError: true},
+		{name: "missing freq", input: "INTERVAL=2", expectedError: true},
+		{name: "unsupported freq", input: "FREQ=HOURLY", expectedError: true},
+		{name: "unsupported part", input: "FREQ=WEEKLY;BYDAY=MO", expectedError: true},
+		{name: "invalid interval", input: "FREQ=MONTHLY;INTERVAL=0", expectedError: true},
+		{name: "invalid bymonth", input: "FREQ=YEARLY;BYMONTH=13", expectedError: true},
+		{name: "zero bymonthday", input: "FREQ=MONTHLY;BYMONTHDAY=0", expectedError: true},
+		{name: "invalid count", input: "FREQ=MONTHLY;COUNT=-1", expectedError: true},
+		{name: "invalid until", input: "FREQ=MONTHLY;UNTIL=tomorrow", expectedError: true},
+		{name: "count and until", input: "FREQ=MONTHLY;COUNT=3;UNTIL=20260101", expectedError: true},
+		{name: "duplicate part", input: "FREQ=MONTHLY;FREQ=YEARLY", expectedError: true},
+		{name: "malformed part", input: "FREQ=MONTHLY;COUNT", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.input)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, rule.String())
+			}
+		})
+	}
+}
+
+func TestRule_Between(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		dtstart  string
+		from     string
+		to       string
+		expected []string
+	}{
+		{
+			name:     "monthly on a fixed day",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=15",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2025-03-31",
+			expected: []string{"2025-01-15", "2025-02-15", "2025-03-15"},
+		},
+		{
+			name:     "month end is clamped",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=31",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2025-04-30",
+			expected: []string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-30"},
+		},
+		{
+			name:     "negative month day",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart:  "2024-01-01",
+			from:     "2024-02-01",
+			to:       "2024-02-29",
+			expected: []string{"2024-02-29"},
+		},
+		{
+			name:     "quarterly",
+			rule:     "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=10",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2025-12-31",
+			expected: []string{"2025-01-10", "2025-04-10", "2025-07-10", "2025-10-10"},
+		},
+		{
+			name:     "yearly car tax",
+			rule:     "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=31",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2027-12-31",
+			expected: []string{"2025-05-31", "2026-05-31", "2027-05-31"},
+		},
+		{
+			name:     "yearly defaults to the start month and day",
+			rule:     "FREQ=YEARLY",
+			dtstart:  "2025-03-20",
+			from:     "2025-01-01",
+			to:       "2026-12-31",
+			expected: []string{"2025-03-20", "2026-03-20"},
+		},
+		{
+			name:     "twice a year",
+			rule:     "FREQ=YEARLY;BYMONTH=12,6;BYMONTHDAY=10",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2025-12-31",
+			expected: []string{"2025-06-10", "2025-12-10"},
+		},
+		{
+			name:     "weekly",
+			rule:     "FREQ=WEEKLY",
+			dtstart:  "2025-04-07",
+			from:     "2025-04-01",
+			to:       "2025-04-30",
+			expected: []string{"2025-04-07", "2025-04-14", "2025-04-21", "2025-04-28"},
+		},
+		{
+			name:     "every other week",
+			rule:     "FREQ=WEEKLY;INTERVAL=2",
+			dtstart:  "2025-04-07",
+			from:     "2025-04-01",
+			to:       "2025-04-30",
+			expected: []string{"2025-04-07", "2025-04-21"},
+		},
+		{
+			name:     "daily in selected months",
+			rule:     "FREQ=DAILY;INTERVAL=10;BYMONTH=2",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2025-03-31",
+			expected: []string{"2025-02-10", "2025-02-20"},
+		},
+		{
+			name:     "count is counted from dtstart",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=1;COUNT=3",
+			dtstart:  "2025-01-01",
+			from:     "2025-02-01",
+			to:       "2025-12-31",
+			expected: []string{"2025-02-01", "2025-03-01"},
+		},
+		{
+			name:     "until is inclusive",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=1;UNTIL=20250301",
+			dtstart:  "2025-01-01",
+			from:     "2025-01-01",
+			to:       "2025-12-31",
+			expected: []string{"2025-01-01", "2025-02-01", "2025-03-01"},
+		},
+		{
+			name:     "occurrences before dtstart are skipped",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=5",
+			dtstart:  "2025-01-10",
+			from:     "2025-01-01",
+			to:       "2025-02-28",
+			expected: []string{"2025-02-05"},
+		},
+		{
+			name:     "range before dtstart",
+			rule:     "FREQ=MONTHLY",
+			dtstart:  "2025-06-01",
+			from:     "2025-01-01",
+			to:       "2025-03-31",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			require.NoError(t, err)
+
+			occurrences := rule.Between(date(tt.dtstart), date(tt.from), date(tt.to))
+			assert.Equal(t, tt.expected, formatDates(occurrences))
+		})
+	}
+}
+
+func TestRule_OccursOn(t *testing.T) {
+	rule, err := Parse("FREQ=YEARLY;BYMONTH=4;BYMONTHDAY=1")
+	require.NoError(t, err)
+
+	assert.True(t, rule.OccursOn(date("2025-01-01"), date("2026-04-01")))
+	assert.False(t, rule.OccursOn(date("2025-01-01"), date("2026-04-02")))
+	assert.False(t, rule.OccursOn(date("2025-01-01"), date("2024-04-01")))
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,7 +16,7 @@ func NewAppSettingRepository(db *sql.DB) *AppSettingRepository {
 	return &AppSettingRepository{db: db}
 }
 
-func (r *AppSettingRepository) GetByUserID(userID uuid.UUID) ([]models.AppSetting, error) {
+func (r *AppSettingRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.AppSetting, error) {
 	query := `
 		SELECT id, user_id, key, value, created_at, updated_at
 		FROM app_settings 
@@ -23,7 +24,7 @@ func (r *AppSettingRepository) GetByUserID(userID uuid.UUID) ([]models.AppSettin
 		ORDER BY key ASC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return []models.AppSetting{}, err
 	}
@@ -45,7 +46,7 @@ func (r *AppSettingRepository) GetByUserID(userID uuid.UUID) ([]models.AppSettin
 	return settings, nil
 }
 
-func (r *AppSettingRepository) GetByKey(userID uuid.UUID, key string) (*models.AppSetting, error) {
+func (r *AppSettingRepository) GetByKey(ctx context.Context, userID uuid.UUID, key string) (*models.AppSetting, error) {
 	query := `
 		SELECT id, user_id, key, value, created_at, updated_at
 		FROM app_settings 
@@ -53,7 +54,7 @@ func (r *AppSettingRepository) GetByKey(userID uuid.UUID, key string) (*models.A
 	`
 
 	var setting models.AppSetting
-	err := r.db.QueryRow(query, userID, key).Scan(
+	err := r.db.QueryRowContext(ctx, query, userID, key).Scan(
 		&setting.ID, &setting.UserID, &setting.Key, &setting.Value,
 		&setting.CreatedAt, &setting.UpdatedAt,
 	)
@@ -65,7 +66,7 @@ func (r *AppSettingRepository) GetByKey(userID uuid.UUID, key string) (*models.A
 	return &setting, nil
 }
 
-func (r *AppSettingRepository) Upsert(setting *models.AppSetting) error {
+func (r *AppSettingRepository) Upsert(ctx context.Context, setting *models.AppSetting) error {
 	query := `
 		INSERT INTO app_settings (id, user_id, key, value, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6)
@@ -73,7 +74,7 @@ func (r *AppSettingRepository) Upsert(setting *models.AppSetting) error {
 		DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		setting.ID, setting.UserID, setting.Key, setting.Value,
 		setting.CreatedAt, setting.UpdatedAt,
 	)
@@ -81,8 +82,36 @@ func (r *AppSettingRepository) Upsert(setting *models.AppSetting) error {
 	return err
 }
 
-func (r *AppSettingRepository) Delete(userID uuid.UUID, key string) error {
+// UpsertAll saves several settings in one transaction, so either all or none of them are stored
+func (r *AppSettingRepository) UpsertAll(ctx context.Context, settings []models.AppSetting) error {
+	query := `
+		INSERT INTO app_settings (id, user_id, key, value, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, key) 
+		DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, setting := range settings {
+		_, err := tx.ExecContext(ctx, query,
+			setting.ID, setting.UserID, setting.Key, setting.Value,
+			setting.CreatedAt, setting.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *AppSettingRepository) Delete(ctx context.Context, userID uuid.UUID, key string) error {
 	query := `DELETE FROM app_settings WHERE user_id = $1 AND key = $2`
-	_, err := r.db.Exec(query, userID, key)
+	_, err := r.db.ExecContext(ctx, query, userID, key)
 	return err
 }
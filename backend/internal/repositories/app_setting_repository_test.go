@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -79,7 +80,7 @@ func TestAppSettingRepository_GetByUserID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			settings, err := repo.GetByUserID(tt.userID)
+			settings, err := repo.GetByUserID(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -147,7 +148,7 @@ func TestAppSettingRepository_GetByKey(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			setting, err := repo.GetByKey(tt.userID, tt.key)
+			setting, err := repo.GetByKey(context.Background(), tt.userID, tt.key)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -210,7 +211,72 @@ func TestAppSettingRepository_Upsert(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			err := repo.Upsert(appSetting)
+			err := repo.Upsert(context.Background(), appSetting)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAppSettingRepository_UpsertAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAppSettingRepository(db)
+	userID := uuid.New()
+	upsert := `INSERT INTO app_settings \(id, user_id, key, value, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\) ON CONFLICT \(user_id, key\) DO UPDATE SET value = EXCLUDED\.value, updated_at = EXCLUDED\.updated_at`
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedError bool
+	}{
+		{
+			name: "successful upsert",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "theme", "dark", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "notification_enabled", "false", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			expectedError: false,
+		},
+		{
+			name: "rolled back on error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "theme", "dark", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "notification_enabled", "false", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := []models.AppSetting{
+				{ID: uuid.New(), UserID: userID, Key: "theme", Value: "dark", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+				{ID: uuid.New(), UserID: userID, Key: "notification_enabled", Value: "false", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+			}
+
+			tt.setupMock(mock)
+
+			err := repo.UpsertAll(context.Background(), settings)
 
 			if tt.expectedError {
 				assert.Error(t, err)
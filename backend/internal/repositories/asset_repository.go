@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type AssetRepository struct {
+	db *sql.DB
+}
+
+func NewAssetRepository(db *sql.DB) *AssetRepository {
+	return &AssetRepository{db: db}
+}
+
+// assetQuery selects assets together with their latest valuation
+const assetQuery = `
+	SELECT a.id, a.user_id, a.name, a.asset_type, a.currency, a.is_active, a.note,
+		v.value, v.valuation_date::text, a.created_at, a.updated_at
+	FROM assets a
+	LEFT JOIN LATERAL (
+		SELECT value, valuation_date
+		FROM asset_valuations
+		WHERE asset_id = a.id
+		ORDER BY valuation_date DESC
+		LIMIT 1
+	) v ON true
+`
+
+func scanAsset(scanner interface{ Scan(...any) error }, asset *models.Asset) error {
+	return scanner.Scan(
+		&asset.ID, &asset.UserID, &asset.Name, &asset.AssetType, &asset.Currency, &asset.IsActive, &asset.Note,
+		&asset.CurrentValue, &asset.ValuedOn, &asset.CreatedAt, &asset.UpdatedAt,
+	)
+}
+
+// GetAll returns the user's assets with their latest valuation, oldest first
+func (r *AssetRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Asset, error) {
+	query := assetQuery + `
+		WHERE a.user_id = $1
+		ORDER BY a.created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return []models.Asset{}, err
+	}
+	defer rows.Close()
+
+	assets := make([]models.Asset, 0)
+	for rows.Next() {
+		var asset models.Asset
+		if err := scanAsset(rows, &asset); err != nil {
+			return []models.Asset{}, err
+		}
+		assets = append(assets, asset)
+	}
+
+	return assets, nil
+}
+
+func (r *AssetRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Asset, error) {
+	query := assetQuery + `
+		WHERE a.id = $1
+	`
+
+	var asset models.Asset
+	if err := scanAsset(r.db.QueryRowContext(ctx, query, id), &asset); err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
+
+func (r *AssetRepository) Create(ctx context.Context, asset *models.Asset) error {
+	query := `
+		INSERT INTO assets (id, user_id, name, asset_type, currency, is_active, note, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		asset.ID, asset.UserID, asset.Name, asset.AssetType, asset.Currency, asset.IsActive, asset.Note,
+		asset.CreatedAt, asset.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *AssetRepository) Update(ctx context.Context, asset *models.Asset) error {
+	query := `
+		UPDATE assets
+		SET name = $2, asset_type = $3, currency = $4, is_active = $5, note = $6, updated_at = $7
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		asset.ID, asset.Name, asset.AssetType, asset.Currency, asset.IsActive, asset.Note, asset.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *AssetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM assets WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// GetValuations returns the valuations of an asset, oldest date first
+func (r *AssetRepository) GetValuations(ctx context.Context, assetID uuid.UUID) ([]models.AssetValuation, error) {
+	query := `
+		SELECT id, asset_id, valuation_date::text, value, created_at, updated_at
+		FROM asset_valuations
+		WHERE asset_id = $1
+		ORDER BY valuation_date ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, assetID)
+	if err != nil {
+		return []models.AssetValuation{}, err
+	}
+	defer rows.Close()
+
+	valuations := make([]models.AssetValuation, 0)
+	for rows.Next() {
+		var valuation models.AssetValuation
+		err := rows.Scan(
+			&valuation.ID, &valuation.AssetID, &valuation.ValuationDate, &valuation.Value,
+			&valuation.CreatedAt, &valuation.UpdatedAt,
+		)
+		if err != nil {
+			return []models.AssetValuation{}, err
+		}
+		valuations = append(valuations, valuation)
+	}
+
+	return valuations, nil
+}
+
+// UpsertValuations saves several valuations in one transaction. A valuation replaces the one of
+// the same asset and date, and the ID and creation time of the stored row are written back.
+func (r *AssetRepository) UpsertValuations(ctx context.Context, valuations []models.AssetValuation) error {
+	query := `
+		INSERT INTO asset_valuations (id, asset_id, valuation_date, value, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (asset_id, valuation_date)
+		DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i := range valuations {
+		valuation := &valuations[i]
+		err := tx.QueryRowContext(ctx, query,
+			valuation.ID, valuation.AssetID, valuation.ValuationDate, valuation.Value,
+			valuation.CreatedAt, valuation.UpdatedAt,
+		).Scan(&valuation.ID, &valuation.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *AssetRepository) DeleteValuation(ctx context.Context, assetID, id uuid.UUID) error {
+	query := `DELETE FROM asset_valuations WHERE id = $1 AND asset_id = $2`
+	_, err := r.db.ExecContext(ctx, query, id, assetID)
+	return err
+}
@@ -0,0 +1,234 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var assetColumns = []string{
+	"id", "user_id", "name", "asset_type", "currency", "is_active", "note",
+	"value", "valuation_date", "created_at", "updated_at",
+}
+
+func TestAssetRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAssetRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(assetColumns).
+					AddRow(uuid.New(), userID, "NISA", "nisa", "JPY", true, nil, int64(120000000), "2024-01-31", time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, "Bitcoin", "crypto", "USD", true, nil, nil, nil, time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT a.id, a.user_id, a.name, a.asset_type, a.currency, a.is_active, a.note, v.value, v.valuation_date::text, a.created_at, a.updated_at FROM assets a LEFT JOIN LATERAL \( SELECT value, valuation_date FROM asset_valuations WHERE asset_id = a.id ORDER BY valuation_date DESC LIMIT 1 \) v ON true WHERE a.user_id = \$1 ORDER BY a.created_at ASC`).
+					WithArgs(userID).
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM assets a (.+) WHERE a.user_id = \$1`).
+					WithArgs(userID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			assets, err := repo.GetAll(context.Background(), userID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, assets, tt.expectedCount)
+				assert.Equal(t, int64(120000000), *assets[0].CurrentValue)
+				assert.Equal(t, "2024-01-31", *assets[0].ValuedOn)
+				assert.Nil(t, assets[1].CurrentValue)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAssetRepository_GetByID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAssetRepository(db)
+	assetID := uuid.New()
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		rows := sqlmock.NewRows(assetColumns).
+			AddRow(assetID, uuid.New(), "NISA", "nisa", "JPY", true, nil, int64(120000000), "2024-01-31", time.Now(), time.Now())
+		mock.ExpectQuery(`SELECT (.+) FROM assets a (.+) WHERE a.id = \$1`).
+			WithArgs(assetID).
+			WillReturnRows(rows)
+
+		asset, err := repo.GetByID(context.Background(), assetID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, assetID, asset.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT (.+) FROM assets a (.+) WHERE a.id = \$1`).
+			WithArgs(assetID).
+			WillReturnError(sql.ErrNoRows)
+
+		asset, err := repo.GetByID(context.Background(), assetID)
+
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, asset)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAssetRepository_CreateUpdateDelete(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAssetRepository(db)
+	asset := &models.Asset{
+		ID: uuid.New(), UserID: uuid.New(), Name: "NISA", AssetType: "nisa", Currency: "JPY", IsActive: true,
+		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO assets \(id, user_id, name, asset_type, currency, is_active, note, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\)`).
+		WithArgs(asset.ID, asset.UserID, "NISA", "nisa", "JPY", true, asset.Note, asset.CreatedAt, asset.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repo.Create(context.Background(), asset))
+
+	mock.ExpectExec(`UPDATE assets SET name = \$2, asset_type = \$3, currency = \$4, is_active = \$5, note = \$6, updated_at = \$7 WHERE id = \$1`).
+		WithArgs(asset.ID, "NISA", "nisa", "JPY", true, asset.Note, asset.UpdatedAt).
+		WillReturnError(sql.ErrConnDone)
+	assert.Error(t, repo.Update(context.Background(), asset))
+
+	mock.ExpectExec(`DELETE FROM assets WHERE id = \$1`).
+		WithArgs(asset.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Delete(context.Background(), asset.ID))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssetRepository_GetValuations(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAssetRepository(db)
+	assetID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"id", "asset_id", "valuation_date", "value", "created_at", "updated_at"}).
+		AddRow(uuid.New(), assetID, "2024-01-31", int64(100000000), time.Now(), time.Now()).
+		AddRow(uuid.New(), assetID, "2024-02-29", int64(110000000), time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT id, asset_id, valuation_date::text, value, created_at, updated_at FROM asset_valuations WHERE asset_id = \$1 ORDER BY valuation_date ASC`).
+		WithArgs(assetID).
+		WillReturnRows(rows)
+
+	valuations, err := repo.GetValuations(context.Background(), assetID)
+
+	assert.NoError(t, err)
+	assert.Len(t, valuations, 2)
+	assert.Equal(t, "2024-02-29", valuations[1].ValuationDate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAssetRepository_UpsertValuations(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAssetRepository(db)
+	assetID := uuid.New()
+	storedID := uuid.New()
+	upsert := `INSERT INTO asset_valuations \(id, asset_id, valuation_date, value, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\) ON CONFLICT \(asset_id, valuation_date\) DO UPDATE SET value = EXCLUDED\.value, updated_at = EXCLUDED\.updated_at RETURNING id, created_at`
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedError bool
+	}{
+		{
+			name: "successful upsert",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(upsert).
+					WithArgs(sqlmock.AnyArg(), assetID, "2024-01-31", int64(100000000), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(storedID, time.Now()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "rolled back on error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(upsert).
+					WithArgs(sqlmock.AnyArg(), assetID, "2024-01-31", int64(100000000), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+			valuations := []models.AssetValuation{
+				{ID: uuid.New(), AssetID: assetID, ValuationDate: "2024-01-31", Value: 100000000, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+			}
+
+			err := repo.UpsertValuations(context.Background(), valuations)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, storedID, valuations[0].ID, "ID of the stored row")
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAssetRepository_DeleteValuation(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewAssetRepository(db)
+	assetID, valuationID := uuid.New(), uuid.New()
+
+	mock.ExpectExec(`DELETE FROM asset_valuations WHERE id = \$1 AND asset_id = \$2`).
+		WithArgs(valuationID, assetID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.DeleteValuation(context.Background(), assetID, valuationID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,15 +16,15 @@ func NewBankAccountRepository(db *sql.DB) *BankAccountRepository {
 	return &BankAccountRepository{db: db}
 }
 
-func (r *BankAccountRepository) GetAll(userID uuid.UUID) ([]models.BankAccount, error) {
+func (r *BankAccountRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error) {
 	query := `
-		SELECT id, user_id, name, balance, created_at, updated_at
+		SELECT id, user_id, name, balance, currency, created_at, updated_at
 		FROM bank_accounts 
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return []models.BankAccount{}, err
 	}
@@ -33,7 +34,7 @@ func (r *BankAccountRepository) GetAll(userID uuid.UUID) ([]models.BankAccount,
 	for rows.Next() {
 		var account models.BankAccount
 		err := rows.Scan(
-			&account.ID, &account.UserID, &account.Name, &account.Balance,
+			&account.ID, &account.UserID, &account.Name, &account.Balance, &account.Currency,
 			&account.CreatedAt, &account.UpdatedAt,
 		)
 		if err != nil {
@@ -45,16 +46,16 @@ func (r *BankAccountRepository) GetAll(userID uuid.UUID) ([]models.BankAccount,
 	return accounts, nil
 }
 
-func (r *BankAccountRepository) GetByID(id uuid.UUID) (*models.BankAccount, error) {
+func (r *BankAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.BankAccount, error) {
 	query := `
-		SELECT id, user_id, name, balance, created_at, updated_at
+		SELECT id, user_id, name, balance, currency, created_at, updated_at
 		FROM bank_accounts 
 		WHERE id = $1
 	`
 
 	var account models.BankAccount
-	err := r.db.QueryRow(query, id).Scan(
-		&account.ID, &account.UserID, &account.Name, &account.Balance,
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&account.ID, &account.UserID, &account.Name, &account.Balance, &account.Currency,
 		&account.CreatedAt, &account.UpdatedAt,
 	)
 
@@ -65,36 +66,36 @@ func (r *BankAccountRepository) GetByID(id uuid.UUID) (*models.BankAccount, erro
 	return &account, nil
 }
 
-func (r *BankAccountRepository) Create(account *models.BankAccount) error {
+func (r *BankAccountRepository) Create(ctx context.Context, account *models.BankAccount) error {
 	query := `
-		INSERT INTO bank_accounts (id, user_id, name, balance, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO bank_accounts (id, user_id, name, balance, currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
-	_, err := r.db.Exec(query,
-		account.ID, account.UserID, account.Name, account.Balance,
+	_, err := r.db.ExecContext(ctx, query,
+		account.ID, account.UserID, account.Name, account.Balance, account.Currency,
 		account.CreatedAt, account.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *BankAccountRepository) Update(account *models.BankAccount) error {
+func (r *BankAccountRepository) Update(ctx context.Context, account *models.BankAccount) error {
 	query := `
 		UPDATE bank_accounts 
-		SET name = $2, balance = $3, updated_at = $4
+		SET name = $2, balance = $3, currency = $4, updated_at = $5
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(query,
-		account.ID, account.Name, account.Balance, account.UpdatedAt,
+	_, err := r.db.ExecContext(ctx, query,
+		account.ID, account.Name, account.Balance, account.Currency, account.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *BankAccountRepository) Delete(id uuid.UUID) error {
+func (r *BankAccountRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM bank_accounts WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -52,7 +53,7 @@ func TestBankAccountRepository_GetAll(t *testing.T) {
 				}
 				rows := helpers.ExpectBankAccountRows(mock, accounts)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -75,7 +76,7 @@ func TestBankAccountRepository_GetAll(t *testing.T) {
 				}
 				rows := helpers.ExpectBankAccountRows(mock, accounts)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -87,10 +88,10 @@ func TestBankAccountRepository_GetAll(t *testing.T) {
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "balance", "created_at", "updated_at",
+					"id", "user_id", "name", "balance", "currency", "created_at", "updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -101,7 +102,7 @@ func TestBankAccountRepository_GetAll(t *testing.T) {
 			name:   "database connection error",
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -113,10 +114,10 @@ func TestBankAccountRepository_GetAll(t *testing.T) {
 			name:   "invalid user ID",
 			userID: uuid.Nil,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(uuid.Nil).
 					WillReturnRows(sqlmock.NewRows([]string{
-						"id", "user_id", "name", "balance", "created_at", "updated_at",
+						"id", "user_id", "name", "balance", "currency", "created_at", "updated_at",
 					}))
 			},
 			expectedCount: 0,
@@ -128,7 +129,7 @@ func TestBankAccountRepository_GetAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			accounts, err := repo.GetAll(tt.userID)
+			accounts, err := repo.GetAll(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -171,13 +172,13 @@ func TestBankAccountRepository_GetByID(t *testing.T) {
 			accountID: uuid.New(),
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
 				row := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "balance", "created_at", "updated_at",
+					"id", "user_id", "name", "balance", "currency", "created_at", "updated_at",
 				}).AddRow(
-					id, uuid.New(), "Test Account", int64(100000),
+					id, uuid.New(), "Test Account", int64(100000), "JPY",
 					time.Now(), time.Now(),
 				)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnRows(row)
 			},
@@ -187,7 +188,7 @@ func TestBankAccountRepository_GetByID(t *testing.T) {
 			name:      "account not found",
 			accountID: uuid.New(),
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -198,7 +199,7 @@ func TestBankAccountRepository_GetByID(t *testing.T) {
 			name:      "database connection error",
 			accountID: uuid.New(),
 			setupMock: func(mock sqlmock.Sqlmock, id uuid.UUID) {
-				mock.ExpectQuery(`SELECT id, user_id, name, balance, created_at, updated_at FROM bank_accounts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, balance, currency, created_at, updated_at FROM bank_accounts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -211,7 +212,7 @@ func TestBankAccountRepository_GetByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock, tt.accountID)
 
-			account, err := repo.GetByID(tt.accountID)
+			account, err := repo.GetByID(context.Background(), tt.accountID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -254,8 +255,8 @@ func TestBankAccountRepository_Create(t *testing.T) {
 			name:    "successful creation",
 			account: helpers.CreateTestBankAccount(uuid.New()),
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO bank_accounts \(id, user_id, name, balance, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO bank_accounts \(id, user_id, name, balance, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: false,
@@ -264,8 +265,8 @@ func TestBankAccountRepository_Create(t *testing.T) {
 			name:    "database error",
 			account: helpers.CreateTestBankAccount(uuid.New()),
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO bank_accounts \(id, user_id, name, balance, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO bank_accounts \(id, user_id, name, balance, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -277,7 +278,7 @@ func TestBankAccountRepository_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Create(tt.account)
+			err := repo.Create(context.Background(), tt.account)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -314,8 +315,8 @@ func TestBankAccountRepository_Update(t *testing.T) {
 			name:    "successful update",
 			account: helpers.CreateTestBankAccount(uuid.New()),
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE bank_accounts SET name = \$2, balance = \$3, updated_at = \$4 WHERE id = \$1`).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE bank_accounts SET name = \$2, balance = \$3, currency = \$4, updated_at = \$5 WHERE id = \$1`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			expectedError: false,
@@ -324,8 +325,8 @@ func TestBankAccountRepository_Update(t *testing.T) {
 			name:    "account not found",
 			account: helpers.CreateTestBankAccount(uuid.New()),
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE bank_accounts SET name = \$2, balance = \$3, updated_at = \$4 WHERE id = \$1`).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE bank_accounts SET name = \$2, balance = \$3, currency = \$4, updated_at = \$5 WHERE id = \$1`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			expectedError: false, // Repository doesn't return error for no rows affected
@@ -334,8 +335,8 @@ func TestBankAccountRepository_Update(t *testing.T) {
 			name:    "database error",
 			account: helpers.CreateTestBankAccount(uuid.New()),
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE bank_accounts SET name = \$2, balance = \$3, updated_at = \$4 WHERE id = \$1`).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE bank_accounts SET name = \$2, balance = \$3, currency = \$4, updated_at = \$5 WHERE id = \$1`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -347,7 +348,7 @@ func TestBankAccountRepository_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Update(tt.account)
+			err := repo.Update(context.Background(), tt.account)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -413,7 +414,7 @@ func TestBankAccountRepository_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock, tt.accountID)
 
-			err := repo.Delete(tt.accountID)
+			err := repo.Delete(context.Background(), tt.accountID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
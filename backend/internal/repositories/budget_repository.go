@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type BudgetRepository struct {
+	db *sql.DB
+}
+
+func NewBudgetRepository(db *sql.DB) *BudgetRepository {
+	return &BudgetRepository{db: db}
+}
+
+// GetAll returns every budget of the user, the default budgets of each category first
+func (r *BudgetRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Budget, error) {
+	query := `
+		SELECT id, user_id, category_id, amount, year_month, created_at, updated_at
+		FROM budgets
+		WHERE user_id = $1
+		ORDER BY year_month ASC NULLS FIRST, created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return []models.Budget{}, err
+	}
+	defer rows.Close()
+
+	budgets := make([]models.Budget, 0)
+	for rows.Next() {
+		var budget models.Budget
+		err := rows.Scan(
+			&budget.ID, &budget.UserID, &budget.CategoryID, &budget.Amount, &budget.YearMonth,
+			&budget.CreatedAt, &budget.UpdatedAt,
+		)
+		if err != nil {
+			return []models.Budget{}, err
+		}
+		budgets = append(budgets, budget)
+	}
+
+	return budgets, nil
+}
+
+func (r *BudgetRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Budget, error) {
+	query := `
+		SELECT id, user_id, category_id, amount, year_month, created_at, updated_at
+		FROM budgets
+		WHERE id = $1
+	`
+
+	var budget models.Budget
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&budget.ID, &budget.UserID, &budget.CategoryID, &budget.Amount, &budget.YearMonth,
+		&budget.CreatedAt, &budget.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &budget, nil
+}
+
+func (r *BudgetRepository) Create(ctx context.Context, budget *models.Budget) error {
+	query := `
+		INSERT INTO budgets (id, user_id, category_id, amount, year_month, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		budget.ID, budget.UserID, budget.CategoryID, budget.Amount, budget.YearMonth,
+		budget.CreatedAt, budget.UpdatedAt,
+	)
+	return err
+}
+
+func (r *BudgetRepository) Update(ctx context.Context, budget *models.Budget) error {
+	query := `
+		UPDATE budgets
+		SET category_id = $2, amount = $3, year_month = $4, updated_at = $5
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, budget.ID, budget.CategoryID, budget.Amount, budget.YearMonth, budget.UpdatedAt)
+	return err
+}
+
+func (r *BudgetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM budgets WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
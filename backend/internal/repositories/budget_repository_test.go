@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewBudgetRepository(db)
+	userID := uuid.New()
+	categoryID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "category_id", "amount", "year_month", "created_at", "updated_at"}).
+		AddRow(uuid.New(), userID, categoryID, int64(50000), nil, time.Now(), time.Now()).
+		AddRow(uuid.New(), userID, categoryID, int64(80000), "2024-12", time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT id, user_id, category_id, amount, year_month, created_at, updated_at FROM budgets WHERE user_id = \$1 ORDER BY year_month ASC NULLS FIRST, created_at ASC`).
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	budgets, err := repo.GetAll(context.Background(), userID)
+
+	assert.NoError(t, err)
+	if assert.Len(t, budgets, 2) {
+		assert.Nil(t, budgets[0].YearMonth)
+		assert.Equal(t, "2024-12", *budgets[1].YearMonth)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBudgetRepository_CreateAndUpdate(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewBudgetRepository(db)
+	yearMonth := "2024-12"
+	budget := &models.Budget{ID: uuid.New(), UserID: uuid.New(), CategoryID: uuid.New(), Amount: 80000, YearMonth: &yearMonth, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`INSERT INTO budgets \(id, user_id, category_id, amount, year_month, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`).
+		WithArgs(budget.ID, budget.UserID, budget.CategoryID, budget.Amount, budget.YearMonth, budget.CreatedAt, budget.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE budgets SET category_id = \$2, amount = \$3, year_month = \$4, updated_at = \$5 WHERE id = \$1`).
+		WithArgs(budget.ID, budget.CategoryID, budget.Amount, budget.YearMonth, budget.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), budget))
+	assert.NoError(t, repo.Update(context.Background(), budget))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
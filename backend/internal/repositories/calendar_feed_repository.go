@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type CalendarFeedRepository struct {
+	db *sql.DB
+}
+
+func NewCalendarFeedRepository(db *sql.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+func (r *CalendarFeedRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error) {
+	query := `
+		SELECT user_id, token, created_at, updated_at
+		FROM calendar_feeds
+		WHERE user_id = $1
+	`
+
+	var feed models.CalendarFeed
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&feed.UserID, &feed.Token, &feed.CreatedAt, &feed.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+func (r *CalendarFeedRepository) GetByToken(ctx context.Context, token string) (*models.CalendarFeed, error) {
+	query := `
+		SELECT user_id, token, created_at, updated_at
+		FROM calendar_feeds
+		WHERE token = $1
+	`
+
+	var feed models.CalendarFeed
+	err := r.db.QueryRowContext(ctx, query, token).Scan(&feed.UserID, &feed.Token, &feed.CreatedAt, &feed.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+// Upsert saves the user's feed, replacing the token of an existing feed
+func (r *CalendarFeedRepository) Upsert(ctx context.Context, feed *models.CalendarFeed) error {
+	query := `
+		INSERT INTO calendar_feeds (user_id, token, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id)
+		DO UPDATE SET token = EXCLUDED.token, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query, feed.UserID, feed.Token, feed.CreatedAt, feed.UpdatedAt)
+	return err
+}
+
+func (r *CalendarFeedRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	query := `DELETE FROM calendar_feeds WHERE user_id = $1`
+	_, err := r.db.ExecContext(ctx, query, userID)
+	return err
+}
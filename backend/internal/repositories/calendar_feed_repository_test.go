@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarFeedRepository_GetByToken(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewCalendarFeedRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "feed found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"user_id", "token", "created_at", "updated_at"}).
+					AddRow(userID, "secret", time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT user_id, token, created_at, updated_at FROM calendar_feeds WHERE token = \$1`).
+					WithArgs("secret").
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "unknown token",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM calendar_feeds WHERE token = \$1`).
+					WithArgs("secret").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			feed, err := repo.GetByToken(context.Background(), "secret")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, feed)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, userID, feed.UserID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCalendarFeedRepository_Upsert(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewCalendarFeedRepository(db)
+	feed := &models.CalendarFeed{UserID: uuid.New(), Token: "secret", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`INSERT INTO calendar_feeds \(user_id, token, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4\) ON CONFLICT \(user_id\) DO UPDATE SET token = EXCLUDED.token`).
+		WithArgs(feed.UserID, "secret", feed.CreatedAt, feed.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Upsert(context.Background(), feed))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCalendarFeedRepository_Delete(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewCalendarFeedRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM calendar_feeds WHERE user_id = \$1`).
+		WithArgs(userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Delete(context.Background(), userID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,7 +16,7 @@ func NewCardMonthlyTotalRepository(db *sql.DB) *CardMonthlyTotalRepository {
 	return &CardMonthlyTotalRepository{db: db}
 }
 
-func (r *CardMonthlyTotalRepository) GetByCreditCardID(creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error) {
+func (r *CardMonthlyTotalRepository) GetByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error) {
 	query := `
 		SELECT id, credit_card_id, year_month, total_amount, is_confirmed, created_at, updated_at
 		FROM card_monthly_totals 
@@ -23,7 +24,7 @@ func (r *CardMonthlyTotalRepository) GetByCreditCardID(creditCardID uuid.UUID) (
 		ORDER BY year_month DESC
 	`
 
-	rows, err := r.db.Query(query, creditCardID)
+	rows, err := r.db.QueryContext(ctx, query, creditCardID)
 	if err != nil {
 		return []models.CardMonthlyTotal{}, err
 	}
@@ -45,7 +46,7 @@ func (r *CardMonthlyTotalRepository) GetByCreditCardID(creditCardID uuid.UUID) (
 	return totals, nil
 }
 
-func (r *CardMonthlyTotalRepository) GetByYearMonth(yearMonth string) ([]models.CardMonthlyTotal, error) {
+func (r *CardMonthlyTotalRepository) GetByYearMonth(ctx context.Context, yearMonth string) ([]models.CardMonthlyTotal, error) {
 	query := `
 		SELECT id, credit_card_id, year_month, total_amount, is_confirmed, created_at, updated_at
 		FROM card_monthly_totals 
@@ -53,7 +54,7 @@ func (r *CardMonthlyTotalRepository) GetByYearMonth(yearMonth string) ([]models.
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, yearMonth)
+	rows, err := r.db.QueryContext(ctx, query, yearMonth)
 	if err != nil {
 		return []models.CardMonthlyTotal{}, err
 	}
@@ -75,7 +76,7 @@ func (r *CardMonthlyTotalRepository) GetByYearMonth(yearMonth string) ([]models.
 	return totals, nil
 }
 
-func (r *CardMonthlyTotalRepository) GetByID(id uuid.UUID) (*models.CardMonthlyTotal, error) {
+func (r *CardMonthlyTotalRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CardMonthlyTotal, error) {
 	query := `
 		SELECT id, credit_card_id, year_month, total_amount, is_confirmed, created_at, updated_at
 		FROM card_monthly_totals 
@@ -83,7 +84,7 @@ func (r *CardMonthlyTotalRepository) GetByID(id uuid.UUID) (*models.CardMonthlyT
 	`
 
 	var total models.CardMonthlyTotal
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&total.ID, &total.CreditCardID, &total.YearMonth, &total.TotalAmount,
 		&total.IsConfirmed, &total.CreatedAt, &total.UpdatedAt,
 	)
@@ -95,14 +96,14 @@ func (r *CardMonthlyTotalRepository) GetByID(id uuid.UUID) (*models.CardMonthlyT
 	return &total, nil
 }
 
-func (r *CardMonthlyTotalRepository) Create(total *models.CardMonthlyTotal) error {
+func (r *CardMonthlyTotalRepository) Create(ctx context.Context, total *models.CardMonthlyTotal) error {
 	query := `
 		INSERT INTO card_monthly_totals (id, credit_card_id, year_month, total_amount, 
 		                                is_confirmed, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		total.ID, total.CreditCardID, total.YearMonth, total.TotalAmount,
 		total.IsConfirmed, total.CreatedAt, total.UpdatedAt,
 	)
@@ -110,22 +111,22 @@ func (r *CardMonthlyTotalRepository) Create(total *models.CardMonthlyTotal) erro
 	return err
 }
 
-func (r *CardMonthlyTotalRepository) Update(total *models.CardMonthlyTotal) error {
+func (r *CardMonthlyTotalRepository) Update(ctx context.Context, total *models.CardMonthlyTotal) error {
 	query := `
 		UPDATE card_monthly_totals 
 		SET year_month = $2, total_amount = $3, is_confirmed = $4, updated_at = $5
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		total.ID, total.YearMonth, total.TotalAmount, total.IsConfirmed, total.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *CardMonthlyTotalRepository) Delete(id uuid.UUID) error {
+func (r *CardMonthlyTotalRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM card_monthly_totals WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 	"regexp"
@@ -45,7 +46,7 @@ func TestCardMonthlyTotalRepository_GetByCreditCardID(t *testing.T) {
 		ORDER BY year_month DESC
 	`)).WithArgs(creditCardID).WillReturnRows(rows)
 
-		result, err := repo.GetByCreditCardID(creditCardID)
+		result, err := repo.GetByCreditCardID(context.Background(), creditCardID)
 
 		assert.NoError(t, err)
 		assert.Len(t, result, 2)
@@ -73,7 +74,7 @@ func TestCardMonthlyTotalRepository_GetByCreditCardID(t *testing.T) {
 		ORDER BY year_month DESC
 	`)).WithArgs(creditCardID).WillReturnRows(rows)
 
-		result, err := repo.GetByCreditCardID(creditCardID)
+		result, err := repo.GetByCreditCardID(context.Background(), creditCardID)
 
 		assert.NoError(t, err)
 		assert.Len(t, result, 0)
@@ -94,7 +95,7 @@ func TestCardMonthlyTotalRepository_GetByCreditCardID(t *testing.T) {
 		ORDER BY year_month DESC
 	`)).WithArgs(creditCardID).WillReturnError(assert.AnError)
 
-		result, err := repo.GetByCreditCardID(creditCardID)
+		result, err := repo.GetByCreditCardID(context.Background(), creditCardID)
 
 		assert.Error(t, err)
 		assert.Empty(t, result)
@@ -135,7 +136,7 @@ func TestCardMonthlyTotalRepository_GetByYearMonth(t *testing.T) {
 		ORDER BY created_at DESC
 	`)).WithArgs(yearMonth).WillReturnRows(rows)
 
-		result, err := repo.GetByYearMonth(yearMonth)
+		result, err := repo.GetByYearMonth(context.Background(), yearMonth)
 
 		assert.NoError(t, err)
 		assert.Len(t, result, 2)
@@ -163,7 +164,7 @@ func TestCardMonthlyTotalRepository_GetByYearMonth(t *testing.T) {
 		ORDER BY created_at DESC
 	`)).WithArgs(yearMonth).WillReturnRows(rows)
 
-		result, err := repo.GetByYearMonth(yearMonth)
+		result, err := repo.GetByYearMonth(context.Background(), yearMonth)
 
 		assert.NoError(t, err)
 		assert.Len(t, result, 0)
@@ -193,7 +194,7 @@ func TestCardMonthlyTotalRepository_GetByID(t *testing.T) {
 		WHERE id = $1
 	`)).WithArgs(expected.ID).WillReturnRows(rows)
 
-		result, err := repo.GetByID(expected.ID)
+		result, err := repo.GetByID(context.Background(), expected.ID)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -215,7 +216,7 @@ func TestCardMonthlyTotalRepository_GetByID(t *testing.T) {
 		WHERE id = $1
 	`)).WithArgs(id).WillReturnError(assert.AnError)
 
-		result, err := repo.GetByID(id)
+		result, err := repo.GetByID(context.Background(), id)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -240,7 +241,7 @@ func TestCardMonthlyTotalRepository_Create(t *testing.T) {
 			total.IsConfirmed, sqlmock.AnyArg(), sqlmock.AnyArg(),
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := repo.Create(total)
+		err := repo.Create(context.Background(), total)
 
 		assert.NoError(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -262,7 +263,7 @@ func TestCardMonthlyTotalRepository_Create(t *testing.T) {
 			total.IsConfirmed, sqlmock.AnyArg(), sqlmock.AnyArg(),
 		).WillReturnError(assert.AnError)
 
-		err := repo.Create(total)
+		err := repo.Create(context.Background(), total)
 
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -288,7 +289,7 @@ func TestCardMonthlyTotalRepository_Update(t *testing.T) {
 			total.ID, total.YearMonth, total.TotalAmount, total.IsConfirmed, sqlmock.AnyArg(),
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := repo.Update(total)
+		err := repo.Update(context.Background(), total)
 
 		assert.NoError(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -309,7 +310,7 @@ func TestCardMonthlyTotalRepository_Update(t *testing.T) {
 			total.ID, total.YearMonth, total.TotalAmount, total.IsConfirmed, sqlmock.AnyArg(),
 		).WillReturnError(assert.AnError)
 
-		err := repo.Update(total)
+		err := repo.Update(context.Background(), total)
 
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -328,7 +329,7 @@ func TestCardMonthlyTotalRepository_Delete(t *testing.T) {
 			WithArgs(id).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := repo.Delete(id)
+		err := repo.Delete(context.Background(), id)
 
 		assert.NoError(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -345,7 +346,7 @@ func TestCardMonthlyTotalRepository_Delete(t *testing.T) {
 			WithArgs(id).
 			WillReturnError(assert.AnError)
 
-		err := repo.Delete(id)
+		err := repo.Delete(context.Background(), id)
 
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+func (r *CategoryRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
+	query := `
+		SELECT id, user_id, parent_id, name, created_at, updated_at
+		FROM categories
+		WHERE user_id = $1
+		ORDER BY name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return []models.Category{}, err
+	}
+	defer rows.Close()
+
+	categories := make([]models.Category, 0)
+	for rows.Next() {
+		var category models.Category
+		err := rows.Scan(&category.ID, &category.UserID, &category.ParentID, &category.Name, &category.CreatedAt, &category.UpdatedAt)
+		if err != nil {
+			return []models.Category{}, err
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error) {
+	query := `
+		SELECT id, user_id, parent_id, name, created_at, updated_at
+		FROM categories
+		WHERE id = $1
+	`
+
+	var category models.Category
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&category.ID, &category.UserID, &category.ParentID, &category.Name, &category.CreatedAt, &category.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category *models.Category) error {
+	query := `
+		INSERT INTO categories (id, user_id, parent_id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, category.ID, category.UserID, category.ParentID, category.Name, category.CreatedAt, category.UpdatedAt)
+	return err
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, category *models.Category) error {
+	query := `
+		UPDATE categories
+		SET parent_id = $2, name = $3, updated_at = $4
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, category.ID, category.ParentID, category.Name, category.UpdatedAt)
+	return err
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM categories WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewCategoryRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				foodID := uuid.New()
+				rows := sqlmock.NewRows([]string{"id", "user_id", "parent_id", "name", "created_at", "updated_at"}).
+					AddRow(foodID, userID, nil, "Food", time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, foodID, "Groceries", time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT id, user_id, parent_id, name, created_at, updated_at FROM categories WHERE user_id = \$1 ORDER BY name ASC`).
+					WithArgs(userID).
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM categories WHERE user_id = \$1`).
+					WithArgs(userID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			categories, err := repo.GetAll(context.Background(), userID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, categories, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCategoryRepository_CreateAndUpdate(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewCategoryRepository(db)
+	parentID := uuid.New()
+	category := &models.Category{ID: uuid.New(), UserID: uuid.New(), ParentID: &parentID, Name: "Groceries", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`INSERT INTO categories \(id, user_id, parent_id, name, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+		WithArgs(category.ID, category.UserID, category.ParentID, category.Name, category.CreatedAt, category.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE categories SET parent_id = \$2, name = \$3, updated_at = \$4 WHERE id = \$1`).
+		WithArgs(category.ID, category.ParentID, category.Name, category.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), category))
+	assert.NoError(t, repo.Update(context.Background(), category))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
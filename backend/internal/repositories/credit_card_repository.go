@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,15 +16,15 @@ func NewCreditCardRepository(db *sql.DB) *CreditCardRepository {
 	return &CreditCardRepository{db: db}
 }
 
-func (r *CreditCardRepository) GetAll(userID uuid.UUID) ([]models.CreditCard, error) {
+func (r *CreditCardRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error) {
 	query := `
-		SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at
+		SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at
 		FROM credit_cards 
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return []models.CreditCard{}, err
 	}
@@ -35,7 +36,7 @@ func (r *CreditCardRepository) GetAll(userID uuid.UUID) ([]models.CreditCard, er
 		err := rows.Scan(
 			&creditCard.ID, &creditCard.UserID, &creditCard.Name,
 			&creditCard.ClosingDay, &creditCard.PaymentDay, &creditCard.BankAccount,
-			&creditCard.CreatedAt, &creditCard.UpdatedAt,
+			&creditCard.CategoryID, &creditCard.Tags, &creditCard.Currency, &creditCard.CreatedAt, &creditCard.UpdatedAt,
 		)
 		if err != nil {
 			return []models.CreditCard{}, err
@@ -46,18 +47,18 @@ func (r *CreditCardRepository) GetAll(userID uuid.UUID) ([]models.CreditCard, er
 	return creditCards, nil
 }
 
-func (r *CreditCardRepository) GetByID(id uuid.UUID) (*models.CreditCard, error) {
+func (r *CreditCardRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CreditCard, error) {
 	query := `
-		SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at
+		SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at
 		FROM credit_cards 
 		WHERE id = $1
 	`
 
 	var creditCard models.CreditCard
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&creditCard.ID, &creditCard.UserID, &creditCard.Name,
 		&creditCard.ClosingDay, &creditCard.PaymentDay, &creditCard.BankAccount,
-		&creditCard.CreatedAt, &creditCard.UpdatedAt,
+		&creditCard.CategoryID, &creditCard.Tags, &creditCard.Currency, &creditCard.CreatedAt, &creditCard.UpdatedAt,
 	)
 
 	if err != nil {
@@ -67,39 +68,40 @@ func (r *CreditCardRepository) GetByID(id uuid.UUID) (*models.CreditCard, error)
 	return &creditCard, nil
 }
 
-func (r *CreditCardRepository) Create(creditCard *models.CreditCard) error {
+func (r *CreditCardRepository) Create(ctx context.Context, creditCard *models.CreditCard) error {
 	query := `
-		INSERT INTO credit_cards (id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO credit_cards (id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		creditCard.ID, creditCard.UserID, creditCard.Name,
 		creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount,
-		creditCard.CreatedAt, creditCard.UpdatedAt,
+		creditCard.CategoryID, creditCard.Tags, creditCard.Currency, creditCard.CreatedAt, creditCard.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *CreditCardRepository) Update(creditCard *models.CreditCard) error {
+func (r *CreditCardRepository) Update(ctx context.Context, creditCard *models.CreditCard) error {
 	query := `
 		UPDATE credit_cards 
 		SET name = $2, closing_day = $3, payment_day = $4, 
-		    bank_account = $5, updated_at = $6
+		    bank_account = $5, category_id = $6, tags = $7, currency = $8, updated_at = $9
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		creditCard.ID, creditCard.Name, creditCard.ClosingDay,
-		creditCard.PaymentDay, creditCard.BankAccount, creditCard.UpdatedAt,
+		creditCard.PaymentDay, creditCard.BankAccount, creditCard.CategoryID,
+		creditCard.Tags, creditCard.Currency, creditCard.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *CreditCardRepository) Delete(id uuid.UUID) error {
+func (r *CreditCardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM credit_cards WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
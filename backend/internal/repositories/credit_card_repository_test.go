@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -33,18 +34,18 @@ func TestCreditCardRepository_GetAll(t *testing.T) {
 				bankAccountID := uuid.New()
 				closingDay := 25
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "created_at", "updated_at",
+					"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
-						uuid.New(), userID, "Main Credit Card", &closingDay, 10, bankAccountID,
+						uuid.New(), userID, "Main Credit Card", &closingDay, 10, bankAccountID, nil, nil, "JPY",
 						time.Now(), time.Now(),
 					).
 					AddRow(
-						uuid.New(), userID, "Sub Credit Card", &closingDay, 15, bankAccountID,
+						uuid.New(), userID, "Sub Credit Card", &closingDay, 15, bankAccountID, uuid.New(), "travel,points", "JPY",
 						time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at FROM credit_cards WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at FROM credit_cards WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -56,10 +57,10 @@ func TestCreditCardRepository_GetAll(t *testing.T) {
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "created_at", "updated_at",
+					"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "category_id", "tags", "currency", "created_at", "updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at FROM credit_cards WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at FROM credit_cards WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -70,7 +71,7 @@ func TestCreditCardRepository_GetAll(t *testing.T) {
 			name:   "database error",
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at FROM credit_cards WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at FROM credit_cards WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -83,7 +84,7 @@ func TestCreditCardRepository_GetAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			creditCards, err := repo.GetAll(tt.userID)
+			creditCards, err := repo.GetAll(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -119,14 +120,14 @@ func TestCreditCardRepository_GetByID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock) {
 				closingDay := 25
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "created_at", "updated_at",
+					"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
-						creditCardID, userID, "Main Credit Card", &closingDay, 10, bankAccountID,
+						creditCardID, userID, "Main Credit Card", &closingDay, 10, bankAccountID, nil, nil, "JPY",
 						time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at FROM credit_cards WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at FROM credit_cards WHERE id = \$1`).
 					WithArgs(creditCardID).
 					WillReturnRows(rows)
 			},
@@ -137,7 +138,7 @@ func TestCreditCardRepository_GetByID(t *testing.T) {
 			name:         "credit card not found",
 			creditCardID: creditCardID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at FROM credit_cards WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at FROM credit_cards WHERE id = \$1`).
 					WithArgs(creditCardID).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -150,7 +151,7 @@ func TestCreditCardRepository_GetByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			creditCard, err := repo.GetByID(tt.creditCardID)
+			creditCard, err := repo.GetByID(context.Background(), tt.creditCardID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -185,8 +186,8 @@ func TestCreditCardRepository_Create(t *testing.T) {
 			name:       "successful creation",
 			creditCard: creditCard,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO credit_cards \(id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`).
-					WithArgs(creditCard.ID, creditCard.UserID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO credit_cards \(id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`).
+					WithArgs(creditCard.ID, creditCard.UserID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, creditCard.CategoryID, creditCard.Tags, creditCard.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: false,
@@ -195,8 +196,8 @@ func TestCreditCardRepository_Create(t *testing.T) {
 			name:       "database error",
 			creditCard: creditCard,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO credit_cards \(id, user_id, name, closing_day, payment_day, bank_account, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`).
-					WithArgs(creditCard.ID, creditCard.UserID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO credit_cards \(id, user_id, name, closing_day, payment_day, bank_account, category_id, tags, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`).
+					WithArgs(creditCard.ID, creditCard.UserID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, creditCard.CategoryID, creditCard.Tags, creditCard.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -207,7 +208,7 @@ func TestCreditCardRepository_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Create(tt.creditCard)
+			err := repo.Create(context.Background(), tt.creditCard)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -240,8 +241,8 @@ func TestCreditCardRepository_Update(t *testing.T) {
 			name:       "successful update",
 			creditCard: creditCard,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE credit_cards SET name = \$2, closing_day = \$3, payment_day = \$4, bank_account = \$5, updated_at = \$6 WHERE id = \$1`).
-					WithArgs(creditCard.ID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE credit_cards SET name = \$2, closing_day = \$3, payment_day = \$4, bank_account = \$5, category_id = \$6, tags = \$7, currency = \$8, updated_at = \$9 WHERE id = \$1`).
+					WithArgs(creditCard.ID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, creditCard.CategoryID, creditCard.Tags, creditCard.Currency, sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: false,
@@ -250,8 +251,8 @@ func TestCreditCardRepository_Update(t *testing.T) {
 			name:       "database error",
 			creditCard: creditCard,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE credit_cards SET name = \$2, closing_day = \$3, payment_day = \$4, bank_account = \$5, updated_at = \$6 WHERE id = \$1`).
-					WithArgs(creditCard.ID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE credit_cards SET name = \$2, closing_day = \$3, payment_day = \$4, bank_account = \$5, category_id = \$6, tags = \$7, currency = \$8, updated_at = \$9 WHERE id = \$1`).
+					WithArgs(creditCard.ID, creditCard.Name, creditCard.ClosingDay, creditCard.PaymentDay, creditCard.BankAccount, creditCard.CategoryID, creditCard.Tags, creditCard.Currency, sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -262,7 +263,7 @@ func TestCreditCardRepository_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Update(tt.creditCard)
+			err := repo.Update(context.Background(), tt.creditCard)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -314,7 +315,7 @@ func TestCreditCardRepository_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Delete(tt.creditCardID)
+			err := repo.Delete(context.Background(), tt.creditCardID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRateRepository struct {
+	db *sql.DB
+}
+
+func NewExchangeRateRepository(db *sql.DB) *ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
+
+// GetAll returns the user's rates, oldest date first
+func (r *ExchangeRateRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error) {
+	query := `
+		SELECT id, user_id, from_currency, to_currency, rate, rate_date::text, created_at, updated_at
+		FROM exchange_rates
+		WHERE user_id = $1
+		ORDER BY rate_date ASC, from_currency ASC, to_currency ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return []models.ExchangeRate{}, err
+	}
+	defer rows.Close()
+
+	rates := make([]models.ExchangeRate, 0)
+	for rows.Next() {
+		var rate models.ExchangeRate
+		err := rows.Scan(
+			&rate.ID, &rate.UserID, &rate.FromCurrency, &rate.ToCurrency, &rate.Rate, &rate.RateDate,
+			&rate.CreatedAt, &rate.UpdatedAt,
+		)
+		if err != nil {
+			return []models.ExchangeRate{}, err
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+func (r *ExchangeRateRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error) {
+	query := `
+		SELECT id, user_id, from_currency, to_currency, rate, rate_date::text, created_at, updated_at
+		FROM exchange_rates
+		WHERE id = $1
+	`
+
+	var rate models.ExchangeRate
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&rate.ID, &rate.UserID, &rate.FromCurrency, &rate.ToCurrency, &rate.Rate, &rate.RateDate,
+		&rate.CreatedAt, &rate.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (r *ExchangeRateRepository) Create(ctx context.Context, rate *models.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates (id, user_id, from_currency, to_currency, rate, rate_date, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		rate.ID, rate.UserID, rate.FromCurrency, rate.ToCurrency, rate.Rate, rate.RateDate,
+		rate.CreatedAt, rate.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *ExchangeRateRepository) Update(ctx context.Context, rate *models.ExchangeRate) error {
+	query := `
+		UPDATE exchange_rates
+		SET from_currency = $2, to_currency = $3, rate = $4, rate_date = $5, updated_at = $6
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		rate.ID, rate.FromCurrency, rate.ToCurrency, rate.Rate, rate.RateDate, rate.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *ExchangeRateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM exchange_rates WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// UpsertAll saves several rates in one transaction. A rate replaces the one of the same currency
+// pair and date, so importing the same file twice does not create duplicates.
+func (r *ExchangeRateRepository) UpsertAll(ctx context.Context, rates []models.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates (id, user_id, from_currency, to_currency, rate, rate_date, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id, from_currency, to_currency, rate_date)
+		DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		_, err := tx.ExecContext(ctx, query,
+			rate.ID, rate.UserID, rate.FromCurrency, rate.ToCurrency, rate.Rate, rate.RateDate,
+			rate.CreatedAt, rate.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
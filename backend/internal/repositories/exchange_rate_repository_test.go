@@ -0,0 +1,205 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var exchangeRateColumns = []string{
+	"id", "user_id", "from_currency", "to_currency", "rate", "rate_date", "created_at", "updated_at",
+}
+
+func TestExchangeRateRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewExchangeRateRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(exchangeRateColumns).
+					AddRow(uuid.New(), userID, "USD", "JPY", 148.25, "2024-01-15", time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, "EUR", "JPY", 161.5, "2024-02-01", time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT id, user_id, from_currency, to_currency, rate, rate_date::text, created_at, updated_at FROM exchange_rates WHERE user_id = \$1 ORDER BY rate_date ASC, from_currency ASC, to_currency ASC`).
+					WithArgs(userID).
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM exchange_rates WHERE user_id = \$1`).
+					WithArgs(userID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			rates, err := repo.GetAll(context.Background(), userID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, rates, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestExchangeRateRepository_GetByID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewExchangeRateRepository(db)
+	rateID := uuid.New()
+
+	rows := sqlmock.NewRows(exchangeRateColumns).
+		AddRow(rateID, uuid.New(), "USD", "JPY", 148.25, "2024-01-15", time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT (.+) FROM exchange_rates WHERE id = \$1`).
+		WithArgs(rateID).
+		WillReturnRows(rows)
+
+	rate, err := repo.GetByID(context.Background(), rateID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 148.25, rate.Rate)
+	assert.Equal(t, "2024-01-15", rate.RateDate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExchangeRateRepository_Create(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewExchangeRateRepository(db)
+	rate := &models.ExchangeRate{
+		ID: uuid.New(), UserID: uuid.New(), FromCurrency: "USD", ToCurrency: "JPY", Rate: 148.25,
+		RateDate: "2024-01-15", CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO exchange_rates \(id, user_id, from_currency, to_currency, rate, rate_date, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`).
+		WithArgs(rate.ID, rate.UserID, rate.FromCurrency, rate.ToCurrency, rate.Rate, rate.RateDate, rate.CreatedAt, rate.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), rate))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExchangeRateRepository_Update(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewExchangeRateRepository(db)
+	rate := &models.ExchangeRate{
+		ID: uuid.New(), FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, RateDate: "2024-01-15", UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`UPDATE exchange_rates SET from_currency = \$2, to_currency = \$3, rate = \$4, rate_date = \$5, updated_at = \$6 WHERE id = \$1`).
+		WithArgs(rate.ID, rate.FromCurrency, rate.ToCurrency, rate.Rate, rate.RateDate, rate.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Update(context.Background(), rate))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExchangeRateRepository_Delete(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewExchangeRateRepository(db)
+	rateID := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM exchange_rates WHERE id = \$1`).
+		WithArgs(rateID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Delete(context.Background(), rateID))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExchangeRateRepository_UpsertAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewExchangeRateRepository(db)
+	userID := uuid.New()
+	upsert := `INSERT INTO exchange_rates \(id, user_id, from_currency, to_currency, rate, rate_date, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\) ON CONFLICT \(user_id, from_currency, to_currency, rate_date\) DO UPDATE SET rate = EXCLUDED\.rate, updated_at = EXCLUDED\.updated_at`
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedError bool
+	}{
+		{
+			name: "successful upsert",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "USD", "JPY", 148.25, "2024-01-15", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "USD", "JPY", 149.1, "2024-01-16", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "rolled back on error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(upsert).
+					WithArgs(sqlmock.AnyArg(), userID, "USD", "JPY", 148.25, "2024-01-15", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rates := []models.ExchangeRate{
+				{ID: uuid.New(), UserID: userID, FromCurrency: "USD", ToCurrency: "JPY", Rate: 148.25, RateDate: "2024-01-15", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+				{ID: uuid.New(), UserID: userID, FromCurrency: "USD", ToCurrency: "JPY", Rate: 149.1, RateDate: "2024-01-16", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+			}
+
+			tt.setupMock(mock)
+
+			err := repo.UpsertAll(context.Background(), rates)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,16 +16,17 @@ func NewIncomeSourceRepository(db *sql.DB) *IncomeSourceRepository {
 	return &IncomeSourceRepository{db: db}
 }
 
-func (r *IncomeSourceRepository) GetAll(userID uuid.UUID) ([]models.IncomeSource, error) {
+func (r *IncomeSourceRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error) {
 	query := `
 		SELECT id, user_id, name, income_type, base_amount, bank_account, 
-		       payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at
+		       payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month,
+		       is_active, category_id, tags, currency, created_at, updated_at
 		FROM income_sources 
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return []models.IncomeSource{}, err
 	}
@@ -35,8 +37,10 @@ func (r *IncomeSourceRepository) GetAll(userID uuid.UUID) ([]models.IncomeSource
 		var source models.IncomeSource
 		err := rows.Scan(
 			&source.ID, &source.UserID, &source.Name, &source.IncomeType,
-			&source.BaseAmount, &source.BankAccount, &source.PaymentDay, &source.ScheduledDate,
-			&source.ScheduledYearMonth, &source.IsActive, &source.CreatedAt, &source.UpdatedAt,
+			&source.BaseAmount, &source.BankAccount, &source.PaymentDay, &source.PaymentMonths,
+			&source.RaiseRate, &source.BaseYear, &source.ScheduledDate,
+			&source.ScheduledYearMonth, &source.IsActive, &source.CategoryID, &source.Tags, &source.Currency,
+			&source.CreatedAt, &source.UpdatedAt,
 		)
 		if err != nil {
 			return []models.IncomeSource{}, err
@@ -47,19 +51,22 @@ func (r *IncomeSourceRepository) GetAll(userID uuid.UUID) ([]models.IncomeSource
 	return sources, nil
 }
 
-func (r *IncomeSourceRepository) GetByID(id uuid.UUID) (*models.IncomeSource, error) {
+func (r *IncomeSourceRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error) {
 	query := `
 		SELECT id, user_id, name, income_type, base_amount, bank_account, 
-		       payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at
+		       payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month,
+		       is_active, category_id, tags, currency, created_at, updated_at
 		FROM income_sources 
 		WHERE id = $1
 	`
 
 	var source models.IncomeSource
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&source.ID, &source.UserID, &source.Name, &source.IncomeType,
-		&source.BaseAmount, &source.BankAccount, &source.PaymentDay, &source.ScheduledDate,
-		&source.ScheduledYearMonth, &source.IsActive, &source.CreatedAt, &source.UpdatedAt,
+		&source.BaseAmount, &source.BankAccount, &source.PaymentDay, &source.PaymentMonths,
+		&source.RaiseRate, &source.BaseYear, &source.ScheduledDate,
+		&source.ScheduledYearMonth, &source.IsActive, &source.CategoryID, &source.Tags, &source.Currency,
+		&source.CreatedAt, &source.UpdatedAt,
 	)
 
 	if err != nil {
@@ -69,16 +76,17 @@ func (r *IncomeSourceRepository) GetByID(id uuid.UUID) (*models.IncomeSource, er
 	return &source, nil
 }
 
-func (r *IncomeSourceRepository) GetActiveByUserID(userID uuid.UUID) ([]models.IncomeSource, error) {
+func (r *IncomeSourceRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error) {
 	query := `
 		SELECT id, user_id, name, income_type, base_amount, bank_account, 
-		       payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at
+		       payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month,
+		       is_active, category_id, tags, currency, created_at, updated_at
 		FROM income_sources 
 		WHERE user_id = $1 AND is_active = true
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -89,8 +97,10 @@ func (r *IncomeSourceRepository) GetActiveByUserID(userID uuid.UUID) ([]models.I
 		var source models.IncomeSource
 		err := rows.Scan(
 			&source.ID, &source.UserID, &source.Name, &source.IncomeType,
-			&source.BaseAmount, &source.BankAccount, &source.PaymentDay, &source.ScheduledDate,
-			&source.ScheduledYearMonth, &source.IsActive, &source.CreatedAt, &source.UpdatedAt,
+			&source.BaseAmount, &source.BankAccount, &source.PaymentDay, &source.PaymentMonths,
+			&source.RaiseRate, &source.BaseYear, &source.ScheduledDate,
+			&source.ScheduledYearMonth, &source.IsActive, &source.CategoryID, &source.Tags, &source.Currency,
+			&source.CreatedAt, &source.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -101,42 +111,48 @@ func (r *IncomeSourceRepository) GetActiveByUserID(userID uuid.UUID) ([]models.I
 	return sources, nil
 }
 
-func (r *IncomeSourceRepository) Create(source *models.IncomeSource) error {
+func (r *IncomeSourceRepository) Create(ctx context.Context, source *models.IncomeSource) error {
 	query := `
 		INSERT INTO income_sources (id, user_id, name, income_type, base_amount, 
-		                           bank_account, payment_day, scheduled_date, scheduled_year_month, 
-		                           is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		                           bank_account, payment_day, payment_months, raise_rate, base_year,
+		                           scheduled_date, scheduled_year_month, is_active, category_id, tags,
+		                           currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		source.ID, source.UserID, source.Name, source.IncomeType,
-		source.BaseAmount, source.BankAccount, source.PaymentDay, source.ScheduledDate,
-		source.ScheduledYearMonth, source.IsActive, source.CreatedAt, source.UpdatedAt,
+		source.BaseAmount, source.BankAccount, source.PaymentDay, source.PaymentMonths,
+		source.RaiseRate, source.BaseYear, source.ScheduledDate,
+		source.ScheduledYearMonth, source.IsActive, source.CategoryID, source.Tags,
+		source.Currency, source.CreatedAt, source.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *IncomeSourceRepository) Update(source *models.IncomeSource) error {
+func (r *IncomeSourceRepository) Update(ctx context.Context, source *models.IncomeSource) error {
 	query := `
 		UPDATE income_sources 
 		SET name = $2, income_type = $3, base_amount = $4, bank_account = $5,
-		    payment_day = $6, scheduled_date = $7, scheduled_year_month = $8, is_active = $9, updated_at = $10
+		    payment_day = $6, payment_months = $7, raise_rate = $8, base_year = $9,
+		    scheduled_date = $10, scheduled_year_month = $11, is_active = $12, category_id = $13,
+		    tags = $14, currency = $15, updated_at = $16
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		source.ID, source.Name, source.IncomeType, source.BaseAmount,
-		source.BankAccount, source.PaymentDay, source.ScheduledDate, source.ScheduledYearMonth,
-		source.IsActive, source.UpdatedAt,
+		source.BankAccount, source.PaymentDay, source.PaymentMonths, source.RaiseRate,
+		source.BaseYear, source.ScheduledDate, source.ScheduledYearMonth,
+		source.IsActive, source.CategoryID, source.Tags, source.Currency, source.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *IncomeSourceRepository) Delete(id uuid.UUID) error {
+func (r *IncomeSourceRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM income_sources WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
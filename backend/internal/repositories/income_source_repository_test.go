@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -35,22 +36,26 @@ func TestIncomeSourceRepository_GetAll(t *testing.T) {
 				scheduledDate := "2024-12-25"
 				rows := sqlmock.NewRows([]string{
 					"id", "user_id", "name", "income_type", "base_amount", "bank_account",
-					"payment_day", "scheduled_date", "scheduled_year_month", "is_active", "created_at", "updated_at",
+					"payment_day", "payment_months", "raise_rate", "base_year", "scheduled_date", "scheduled_year_month", "is_active", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
 						uuid.New(), userID, "Salary", "monthly_fixed", int64(300000), bankAccountID,
-						&paymentDay, nil, nil, true, time.Now(), time.Now(),
+						&paymentDay, nil, nil, nil, nil, nil, true, nil, nil, "JPY", time.Now(), time.Now(),
 					).
 					AddRow(
 						uuid.New(), userID, "Bonus", "one_time", int64(100000), bankAccountID,
-						nil, &scheduledDate, nil, true, time.Now(), time.Now(),
+						nil, nil, nil, nil, &scheduledDate, nil, true, nil, nil, "JPY", time.Now(), time.Now(),
+					).
+					AddRow(
+						uuid.New(), userID, "Summer and winter bonus", "semi_annual", int64(500000), bankAccountID,
+						&paymentDay, "6,12", 2.5, 2025, nil, nil, true, nil, nil, "JPY", time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
-			expectedCount: 2,
+			expectedCount: 3,
 			expectedError: false,
 		},
 		{
@@ -59,10 +64,10 @@ func TestIncomeSourceRepository_GetAll(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
 					"id", "user_id", "name", "income_type", "base_amount", "bank_account",
-					"payment_day", "scheduled_date", "scheduled_year_month", "is_active", "created_at", "updated_at",
+					"payment_day", "payment_months", "raise_rate", "base_year", "scheduled_date", "scheduled_year_month", "is_active", "category_id", "tags", "currency", "created_at", "updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -73,7 +78,7 @@ func TestIncomeSourceRepository_GetAll(t *testing.T) {
 			name:   "database error",
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -86,7 +91,7 @@ func TestIncomeSourceRepository_GetAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			sources, err := repo.GetAll(tt.userID)
+			sources, err := repo.GetAll(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -123,14 +128,14 @@ func TestIncomeSourceRepository_GetByID(t *testing.T) {
 				paymentDay := 25
 				rows := sqlmock.NewRows([]string{
 					"id", "user_id", "name", "income_type", "base_amount", "bank_account",
-					"payment_day", "scheduled_date", "scheduled_year_month", "is_active", "created_at", "updated_at",
+					"payment_day", "payment_months", "raise_rate", "base_year", "scheduled_date", "scheduled_year_month", "is_active", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
 						sourceID, userID, "Salary", "monthly_fixed", int64(300000), bankAccountID,
-						&paymentDay, nil, nil, true, time.Now(), time.Now(),
+						&paymentDay, nil, nil, nil, nil, nil, true, nil, nil, "JPY", time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE id = \$1`).
 					WithArgs(sourceID).
 					WillReturnRows(rows)
 			},
@@ -141,7 +146,7 @@ func TestIncomeSourceRepository_GetByID(t *testing.T) {
 			name:     "income source not found",
 			sourceID: sourceID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE id = \$1`).
 					WithArgs(sourceID).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -154,7 +159,7 @@ func TestIncomeSourceRepository_GetByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			source, err := repo.GetByID(tt.sourceID)
+			source, err := repo.GetByID(context.Background(), tt.sourceID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -192,14 +197,14 @@ func TestIncomeSourceRepository_GetActiveByUserID(t *testing.T) {
 				paymentDay := 25
 				rows := sqlmock.NewRows([]string{
 					"id", "user_id", "name", "income_type", "base_amount", "bank_account",
-					"payment_day", "scheduled_date", "scheduled_year_month", "is_active", "created_at", "updated_at",
+					"payment_day", "payment_months", "raise_rate", "base_year", "scheduled_date", "scheduled_year_month", "is_active", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
 						uuid.New(), userID, "Salary", "monthly_fixed", int64(300000), bankAccountID,
-						&paymentDay, nil, nil, true, time.Now(), time.Now(),
+						&paymentDay, nil, nil, nil, nil, nil, true, nil, nil, "JPY", time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE user_id = \$1 AND is_active = true ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE user_id = \$1 AND is_active = true ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -212,10 +217,10 @@ func TestIncomeSourceRepository_GetActiveByUserID(t *testing.T) {
 			setupMock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
 					"id", "user_id", "name", "income_type", "base_amount", "bank_account",
-					"payment_day", "scheduled_date", "scheduled_year_month", "is_active", "created_at", "updated_at",
+					"payment_day", "payment_months", "raise_rate", "base_year", "scheduled_date", "scheduled_year_month", "is_active", "category_id", "tags", "currency", "created_at", "updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date::text, scheduled_year_month, is_active, created_at, updated_at FROM income_sources WHERE user_id = \$1 AND is_active = true ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date::text, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at FROM income_sources WHERE user_id = \$1 AND is_active = true ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -228,7 +233,7 @@ func TestIncomeSourceRepository_GetActiveByUserID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			sources, err := repo.GetActiveByUserID(tt.userID)
+			sources, err := repo.GetActiveByUserID(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -265,8 +270,8 @@ func TestIncomeSourceRepository_Create(t *testing.T) {
 			name:   "successful creation",
 			source: source,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO income_sources \(id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date, scheduled_year_month, is_active, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12\)`).
-					WithArgs(source.ID, source.UserID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO income_sources \(id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13, \$14, \$15, \$16, \$17, \$18\)`).
+					WithArgs(source.ID, source.UserID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.PaymentMonths, source.RaiseRate, source.BaseYear, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, source.CategoryID, source.Tags, source.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: false,
@@ -275,8 +280,8 @@ func TestIncomeSourceRepository_Create(t *testing.T) {
 			name:   "database error",
 			source: source,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO income_sources \(id, user_id, name, income_type, base_amount, bank_account, payment_day, scheduled_date, scheduled_year_month, is_active, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12\)`).
-					WithArgs(source.ID, source.UserID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO income_sources \(id, user_id, name, income_type, base_amount, bank_account, payment_day, payment_months, raise_rate, base_year, scheduled_date, scheduled_year_month, is_active, category_id, tags, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13, \$14, \$15, \$16, \$17, \$18\)`).
+					WithArgs(source.ID, source.UserID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.PaymentMonths, source.RaiseRate, source.BaseYear, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, source.CategoryID, source.Tags, source.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -287,7 +292,7 @@ func TestIncomeSourceRepository_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Create(tt.source)
+			err := repo.Create(context.Background(), tt.source)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -320,8 +325,8 @@ func TestIncomeSourceRepository_Update(t *testing.T) {
 			name:   "successful update",
 			source: source,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE income_sources SET name = \$2, income_type = \$3, base_amount = \$4, bank_account = \$5, payment_day = \$6, scheduled_date = \$7, scheduled_year_month = \$8, is_active = \$9, updated_at = \$10 WHERE id = \$1`).
-					WithArgs(source.ID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE income_sources SET name = \$2, income_type = \$3, base_amount = \$4, bank_account = \$5, payment_day = \$6, payment_months = \$7, raise_rate = \$8, base_year = \$9, scheduled_date = \$10, scheduled_year_month = \$11, is_active = \$12, category_id = \$13, tags = \$14, currency = \$15, updated_at = \$16 WHERE id = \$1`).
+					WithArgs(source.ID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.PaymentMonths, source.RaiseRate, source.BaseYear, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, source.CategoryID, source.Tags, source.Currency, sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: false,
@@ -330,8 +335,8 @@ func TestIncomeSourceRepository_Update(t *testing.T) {
 			name:   "database error",
 			source: source,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`UPDATE income_sources SET name = \$2, income_type = \$3, base_amount = \$4, bank_account = \$5, payment_day = \$6, scheduled_date = \$7, scheduled_year_month = \$8, is_active = \$9, updated_at = \$10 WHERE id = \$1`).
-					WithArgs(source.ID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, sqlmock.AnyArg()).
+				mock.ExpectExec(`UPDATE income_sources SET name = \$2, income_type = \$3, base_amount = \$4, bank_account = \$5, payment_day = \$6, payment_months = \$7, raise_rate = \$8, base_year = \$9, scheduled_date = \$10, scheduled_year_month = \$11, is_active = \$12, category_id = \$13, tags = \$14, currency = \$15, updated_at = \$16 WHERE id = \$1`).
+					WithArgs(source.ID, source.Name, source.IncomeType, source.BaseAmount, source.BankAccount, source.PaymentDay, source.PaymentMonths, source.RaiseRate, source.BaseYear, source.ScheduledDate, source.ScheduledYearMonth, source.IsActive, source.CategoryID, source.Tags, source.Currency, sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -342,7 +347,7 @@ func TestIncomeSourceRepository_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Update(tt.source)
+			err := repo.Update(context.Background(), tt.source)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -394,7 +399,7 @@ func TestIncomeSourceRepository_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Delete(tt.sourceID)
+			err := repo.Delete(context.Background(), tt.sourceID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type JobLockRepository struct {
+	db *sql.DB
+}
+
+func NewJobLockRepository(db *sql.DB) *JobLockRepository {
+	return &JobLockRepository{db: db}
+}
+
+// Acquire takes the lock of a job for the run scheduled at scheduledAt. The lock is taken only
+// when it is free (or its holder's lease has expired) and that run has not been taken yet, so
+// replicas that fire for the same schedule run the job once. Lease times use the database clock.
+func (r *JobLockRepository) Acquire(ctx context.Context, jobName, owner string, scheduledAt time.Time, ttl time.Duration) (bool, error) {
+	query := `
+		INSERT INTO job_locks (job_name, locked_by, locked_until, scheduled_at)
+		VALUES ($1, $2, NOW() + $3 * INTERVAL '1 second', $4)
+		ON CONFLICT (job_name) DO UPDATE
+		SET locked_by = EXCLUDED.locked_by, locked_until = EXCLUDED.locked_until, scheduled_at = EXCLUDED.scheduled_at
+		WHERE job_locks.locked_until < NOW() AND job_locks.scheduled_at < EXCLUDED.scheduled_at
+	`
+
+	result, err := r.db.ExecContext(ctx, query, jobName, owner, int64(ttl/time.Second), scheduledAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected == 1, nil
+}
+
+// Release ends the lease of a lock held by owner. The row is kept so the run cannot be taken again.
+func (r *JobLockRepository) Release(ctx context.Context, jobName, owner string) error {
+	query := `
+		UPDATE job_locks
+		SET locked_until = NOW()
+		WHERE job_name = $1 AND locked_by = $2
+	`
+
+	_, err := r.db.ExecContext(ctx, query, jobName, owner)
+	return err
+}
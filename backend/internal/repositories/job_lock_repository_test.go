@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobLockRepository_Acquire(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewJobLockRepository(db)
+	scheduledAt := time.Date(2025, 3, 28, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name: "lock acquired",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`INSERT INTO job_locks \(job_name, locked_by, locked_until, scheduled_at\) VALUES \(\$1, \$2, NOW\(\) \+ \$3 \* INTERVAL '1 second', \$4\) ON CONFLICT \(job_name\) DO UPDATE SET locked_by = EXCLUDED.locked_by, locked_until = EXCLUDED.locked_until, scheduled_at = EXCLUDED.scheduled_at WHERE job_locks.locked_until < NOW\(\) AND job_locks.scheduled_at < EXCLUDED.scheduled_at`).
+					WithArgs("recurring_payment_progress", "pod-1", int64(3600), scheduledAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expected: true,
+		},
+		{
+			name: "held by another replica",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`INSERT INTO job_locks`).
+					WithArgs("recurring_payment_progress", "pod-1", int64(3600), scheduledAt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expected: false,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`INSERT INTO job_locks`).
+					WithArgs("recurring_payment_progress", "pod-1", int64(3600), scheduledAt).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			acquired, err := repo.Acquire(context.Background(), "recurring_payment_progress", "pod-1", scheduledAt, time.Hour)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, acquired)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestJobLockRepository_Release(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewJobLockRepository(db)
+
+	mock.ExpectExec(`UPDATE job_locks SET locked_until = NOW\(\) WHERE job_name = \$1 AND locked_by = \$2`).
+		WithArgs("recurring_payment_progress", "pod-1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Release(context.Background(), "recurring_payment_progress", "pod-1"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+)
+
+type JobRunRepository struct {
+	db *sql.DB
+}
+
+func NewJobRunRepository(db *sql.DB) *JobRunRepository {
+	return &JobRunRepository{db: db}
+}
+
+func (r *JobRunRepository) Create(ctx context.Context, run *models.JobRun) error {
+	query := `
+		INSERT INTO job_runs (id, job_name, status, started_at, finished_at, summary, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		run.ID, run.JobName, run.Status, run.StartedAt, run.FinishedAt, run.Summary, run.Error,
+	)
+
+	return err
+}
+
+func (r *JobRunRepository) Update(ctx context.Context, run *models.JobRun) error {
+	query := `
+		UPDATE job_runs
+		SET status = $2, finished_at = $3, summary = $4, error = $5
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, run.ID, run.Status, run.FinishedAt, run.Summary, run.Error)
+	return err
+}
+
+// GetRecentByJobName returns the latest runs of a job, newest first
+func (r *JobRunRepository) GetRecentByJobName(ctx context.Context, jobName string, limit int) ([]models.JobRun, error) {
+	query := `
+		SELECT id, job_name, status, started_at, finished_at, summary, error
+		FROM job_runs
+		WHERE job_name = $1
+		ORDER BY started_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, jobName, limit)
+	if err != nil {
+		return []models.JobRun{}, err
+	}
+	defer rows.Close()
+
+	runs := make([]models.JobRun, 0)
+	for rows.Next() {
+		var run models.JobRun
+		err := rows.Scan(
+			&run.ID, &run.JobName, &run.Status, &run.StartedAt, &run.FinishedAt, &run.Summary, &run.Error,
+		)
+		if err != nil {
+			return []models.JobRun{}, err
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
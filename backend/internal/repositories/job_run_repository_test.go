@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobRunRepository_Create(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewJobRunRepository(db)
+	run := &models.JobRun{ID: uuid.New(), JobName: "recurring_payment_progress", Status: "running", StartedAt: time.Now()}
+
+	mock.ExpectExec(`INSERT INTO job_runs \(id, job_name, status, started_at, finished_at, summary, error\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`).
+		WithArgs(run.ID, run.JobName, run.Status, run.StartedAt, run.FinishedAt, run.Summary, run.Error).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), run))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRunRepository_Update(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewJobRunRepository(db)
+	finishedAt := time.Now()
+	message := "boom"
+	run := &models.JobRun{ID: uuid.New(), Status: "failed", FinishedAt: &finishedAt, Error: &message}
+
+	mock.ExpectExec(`UPDATE job_runs SET status = \$2, finished_at = \$3, summary = \$4, error = \$5 WHERE id = \$1`).
+		WithArgs(run.ID, run.Status, run.FinishedAt, run.Summary, run.Error).
+		WillReturnError(sql.ErrConnDone)
+
+	assert.Error(t, repo.Update(context.Background(), run))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRunRepository_GetRecentByJobName(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewJobRunRepository(db)
+	finishedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "job_name", "status", "started_at", "finished_at", "summary", "error"}).
+		AddRow(uuid.New(), "recurring_payment_progress", "succeeded", time.Now(), finishedAt, `{"checked":3}`, nil).
+		AddRow(uuid.New(), "recurring_payment_progress", "failed", time.Now(), finishedAt, "", "boom")
+
+	mock.ExpectQuery(`SELECT id, job_name, status, started_at, finished_at, summary, error FROM job_runs WHERE job_name = \$1 ORDER BY started_at DESC LIMIT \$2`).
+		WithArgs("recurring_payment_progress", 10).
+		WillReturnRows(rows)
+
+	runs, err := repo.GetRecentByJobName(context.Background(), "recurring_payment_progress", 10)
+
+	assert.NoError(t, err)
+	if assert.Len(t, runs, 2) {
+		assert.Nil(t, runs[0].Error)
+		assert.Equal(t, "boom", *runs[1].Error)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
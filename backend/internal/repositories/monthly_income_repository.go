@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,7 +16,7 @@ func NewMonthlyIncomeRepository(db *sql.DB) *MonthlyIncomeRepository {
 	return &MonthlyIncomeRepository{db: db}
 }
 
-func (r *MonthlyIncomeRepository) GetByIncomeSourceID(incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error) {
+func (r *MonthlyIncomeRepository) GetByIncomeSourceID(ctx context.Context, incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error) {
 	query := `
 		SELECT id, income_source_id, year_month, actual_amount, is_confirmed, note, created_at, updated_at
 		FROM monthly_income_records 
@@ -23,7 +24,7 @@ func (r *MonthlyIncomeRepository) GetByIncomeSourceID(incomeSourceID uuid.UUID)
 		ORDER BY year_month DESC
 	`
 
-	rows, err := r.db.Query(query, incomeSourceID)
+	rows, err := r.db.QueryContext(ctx, query, incomeSourceID)
 	if err != nil {
 		return []models.MonthlyIncomeRecord{}, err
 	}
@@ -46,7 +47,7 @@ func (r *MonthlyIncomeRepository) GetByIncomeSourceID(incomeSourceID uuid.UUID)
 	return records, nil
 }
 
-func (r *MonthlyIncomeRepository) GetByYearMonth(yearMonth string) ([]models.MonthlyIncomeRecord, error) {
+func (r *MonthlyIncomeRepository) GetByYearMonth(ctx context.Context, yearMonth string) ([]models.MonthlyIncomeRecord, error) {
 	query := `
 		SELECT id, income_source_id, year_month, actual_amount, is_confirmed, note, created_at, updated_at
 		FROM monthly_income_records 
@@ -54,7 +55,7 @@ func (r *MonthlyIncomeRepository) GetByYearMonth(yearMonth string) ([]models.Mon
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, yearMonth)
+	rows, err := r.db.QueryContext(ctx, query, yearMonth)
 	if err != nil {
 		return []models.MonthlyIncomeRecord{}, err
 	}
@@ -77,7 +78,7 @@ func (r *MonthlyIncomeRepository) GetByYearMonth(yearMonth string) ([]models.Mon
 	return records, nil
 }
 
-func (r *MonthlyIncomeRepository) GetByUserIDAndYearMonth(userID uuid.UUID, yearMonth string) ([]models.MonthlyIncomeRecord, error) {
+func (r *MonthlyIncomeRepository) GetByUserIDAndYearMonth(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.MonthlyIncomeRecord, error) {
 	query := `
 		SELECT mir.id, mir.income_source_id, mir.year_month, mir.actual_amount, mir.is_confirmed, mir.note, mir.created_at, mir.updated_at
 		FROM monthly_income_records mir
@@ -86,7 +87,7 @@ func (r *MonthlyIncomeRepository) GetByUserIDAndYearMonth(userID uuid.UUID, year
 		ORDER BY mir.created_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID, yearMonth)
+	rows, err := r.db.QueryContext(ctx, query, userID, yearMonth)
 	if err != nil {
 		return []models.MonthlyIncomeRecord{}, err
 	}
@@ -109,7 +110,7 @@ func (r *MonthlyIncomeRepository) GetByUserIDAndYearMonth(userID uuid.UUID, year
 	return records, nil
 }
 
-func (r *MonthlyIncomeRepository) GetByID(id uuid.UUID) (*models.MonthlyIncomeRecord, error) {
+func (r *MonthlyIncomeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.MonthlyIncomeRecord, error) {
 	query := `
 		SELECT id, income_source_id, year_month, actual_amount, is_confirmed, note, created_at, updated_at
 		FROM monthly_income_records 
@@ -117,7 +118,7 @@ func (r *MonthlyIncomeRepository) GetByID(id uuid.UUID) (*models.MonthlyIncomeRe
 	`
 
 	var record models.MonthlyIncomeRecord
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&record.ID, &record.IncomeSourceID, &record.YearMonth,
 		&record.ActualAmount, &record.IsConfirmed, &record.Note,
 		&record.CreatedAt, &record.UpdatedAt,
@@ -130,14 +131,14 @@ func (r *MonthlyIncomeRepository) GetByID(id uuid.UUID) (*models.MonthlyIncomeRe
 	return &record, nil
 }
 
-func (r *MonthlyIncomeRepository) Create(record *models.MonthlyIncomeRecord) error {
+func (r *MonthlyIncomeRepository) Create(ctx context.Context, record *models.MonthlyIncomeRecord) error {
 	query := `
 		INSERT INTO monthly_income_records (id, income_source_id, year_month, actual_amount, 
 		                                   is_confirmed, note, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		record.ID, record.IncomeSourceID, record.YearMonth, record.ActualAmount,
 		record.IsConfirmed, record.Note, record.CreatedAt, record.UpdatedAt,
 	)
@@ -145,14 +146,14 @@ func (r *MonthlyIncomeRepository) Create(record *models.MonthlyIncomeRecord) err
 	return err
 }
 
-func (r *MonthlyIncomeRepository) Update(record *models.MonthlyIncomeRecord) error {
+func (r *MonthlyIncomeRepository) Update(ctx context.Context, record *models.MonthlyIncomeRecord) error {
 	query := `
 		UPDATE monthly_income_records 
 		SET year_month = $2, actual_amount = $3, is_confirmed = $4, note = $5, updated_at = $6
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		record.ID, record.YearMonth, record.ActualAmount, record.IsConfirmed,
 		record.Note, record.UpdatedAt,
 	)
@@ -160,8 +161,8 @@ func (r *MonthlyIncomeRepository) Update(record *models.MonthlyIncomeRecord) err
 	return err
 }
 
-func (r *MonthlyIncomeRepository) Delete(id uuid.UUID) error {
+func (r *MonthlyIncomeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM monthly_income_records WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
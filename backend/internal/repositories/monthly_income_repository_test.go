@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 	"testing"
@@ -78,7 +79,7 @@ func TestMonthlyIncomeRepository_GetByIncomeSourceID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			records, err := repo.GetByIncomeSourceID(tt.incomeSourceID)
+			records, err := repo.GetByIncomeSourceID(context.Background(), tt.incomeSourceID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -150,7 +151,7 @@ func TestMonthlyIncomeRepository_GetByYearMonth(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			records, err := repo.GetByYearMonth(tt.yearMonth)
+			records, err := repo.GetByYearMonth(context.Background(), tt.yearMonth)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type MonthlyReportRepository struct {
+	db *sql.DB
+}
+
+func NewMonthlyReportRepository(db *sql.DB) *MonthlyReportRepository {
+	return &MonthlyReportRepository{db: db}
+}
+
+// GetByUserID returns the user's stored reports without their content, latest month first
+func (r *MonthlyReportRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error) {
+	query := `
+		SELECT id, user_id, year_month, octet_length(content), created_at, updated_at
+		FROM monthly_reports
+		WHERE user_id = $1
+		ORDER BY year_month DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return []models.StoredMonthlyReport{}, err
+	}
+	defer rows.Close()
+
+	reports := make([]models.StoredMonthlyReport, 0)
+	for rows.Next() {
+		var report models.StoredMonthlyReport
+		err := rows.Scan(
+			&report.ID, &report.UserID, &report.YearMonth, &report.Size,
+			&report.CreatedAt, &report.UpdatedAt,
+		)
+		if err != nil {
+			return []models.StoredMonthlyReport{}, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+func (r *MonthlyReportRepository) GetByUserIDAndYearMonth(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error) {
+	query := `
+		SELECT id, user_id, year_month, content, created_at, updated_at
+		FROM monthly_reports
+		WHERE user_id = $1 AND year_month = $2
+	`
+
+	var report models.StoredMonthlyReport
+	err := r.db.QueryRowContext(ctx, query, userID, yearMonth).Scan(
+		&report.ID, &report.UserID, &report.YearMonth, &report.Content,
+		&report.CreatedAt, &report.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	report.Size = len(report.Content)
+
+	return &report, nil
+}
+
+// Upsert saves the report, replacing the content of the user's report for the same month
+func (r *MonthlyReportRepository) Upsert(ctx context.Context, report *models.StoredMonthlyReport) error {
+	query := `
+		INSERT INTO monthly_reports (id, user_id, year_month, content, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, year_month)
+		DO UPDATE SET content = EXCLUDED.content, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query, report.ID, report.UserID, report.YearMonth, report.Content,
+		report.CreatedAt, report.UpdatedAt)
+	return err
+}
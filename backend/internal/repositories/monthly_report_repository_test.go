@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMonthlyReportRepository_GetByUserID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewMonthlyReportRepository(db)
+	userID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "year_month", "octet_length", "created_at", "updated_at"}).
+		AddRow(uuid.New(), userID, "2024-02", 2048, time.Now(), time.Now()).
+		AddRow(uuid.New(), userID, "2024-01", 1024, time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT id, user_id, year_month, octet_length\(content\), created_at, updated_at FROM monthly_reports WHERE user_id = \$1 ORDER BY year_month DESC`).
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	reports, err := repo.GetByUserID(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, "2024-02", reports[0].YearMonth)
+	assert.Equal(t, 2048, reports[0].Size)
+	assert.Nil(t, reports[0].Content)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMonthlyReportRepository_GetByUserIDAndYearMonth(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewMonthlyReportRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "report found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "year_month", "content", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "2024-01", []byte("%PDF-1.4"), time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT (.+) FROM monthly_reports WHERE user_id = \$1 AND year_month = \$2`).
+					WithArgs(userID, "2024-01").
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "no report for the month",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM monthly_reports WHERE user_id = \$1 AND year_month = \$2`).
+					WithArgs(userID, "2024-01").
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			report, err := repo.GetByUserIDAndYearMonth(context.Background(), userID, "2024-01")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, report)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, []byte("%PDF-1.4"), report.Content)
+				assert.Equal(t, 8, report.Size)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMonthlyReportRepository_Upsert(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewMonthlyReportRepository(db)
+	report := &models.StoredMonthlyReport{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		YearMonth: "2024-01",
+		Content:   []byte("%PDF-1.4"),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO monthly_reports (.+) ON CONFLICT \(user_id, year_month\) DO UPDATE SET content = EXCLUDED.content`).
+		WithArgs(report.ID, report.UserID, report.YearMonth, report.Content, report.CreatedAt, report.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Upsert(context.Background(), report))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
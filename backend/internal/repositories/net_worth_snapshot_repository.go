@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type NetWorthSnapshotRepository struct {
+	db *sql.DB
+}
+
+func NewNetWorthSnapshotRepository(db *sql.DB) *NetWorthSnapshotRepository {
+	return &NetWorthSnapshotRepository{db: db}
+}
+
+// GetByUserID returns the user's snapshots on or after the given date (YYYY-MM-DD), oldest first
+func (r *NetWorthSnapshotRepository) GetByUserID(ctx context.Context, userID uuid.UUID, from string) ([]models.NetWorthSnapshot, error) {
+	query := `
+		SELECT id, user_id, snapshot_date::text, currency, total_assets, total_liabilities, net_worth, created_at, updated_at
+		FROM net_worth_snapshots
+		WHERE user_id = $1 AND snapshot_date >= $2
+		ORDER BY snapshot_date ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, from)
+	if err != nil {
+		return []models.NetWorthSnapshot{}, err
+	}
+	defer rows.Close()
+
+	snapshots := make([]models.NetWorthSnapshot, 0)
+	for rows.Next() {
+		var snapshot models.NetWorthSnapshot
+		err := rows.Scan(
+			&snapshot.ID, &snapshot.UserID, &snapshot.SnapshotDate, &snapshot.Currency,
+			&snapshot.TotalAssets, &snapshot.TotalLiabilities, &snapshot.NetWorth,
+			&snapshot.CreatedAt, &snapshot.UpdatedAt,
+		)
+		if err != nil {
+			return []models.NetWorthSnapshot{}, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// Upsert saves a snapshot, replacing the user's snapshot of the same day
+func (r *NetWorthSnapshotRepository) Upsert(ctx context.Context, snapshot *models.NetWorthSnapshot) error {
+	query := `
+		INSERT INTO net_worth_snapshots (id, user_id, snapshot_date, currency, total_assets, total_liabilities, net_worth, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id, snapshot_date)
+		DO UPDATE SET currency = EXCLUDED.currency, total_assets = EXCLUDED.total_assets,
+			total_liabilities = EXCLUDED.total_liabilities, net_worth = EXCLUDED.net_worth, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		snapshot.ID, snapshot.UserID, snapshot.SnapshotDate, snapshot.Currency,
+		snapshot.TotalAssets, snapshot.TotalLiabilities, snapshot.NetWorth,
+		snapshot.CreatedAt, snapshot.UpdatedAt,
+	)
+
+	return err
+}
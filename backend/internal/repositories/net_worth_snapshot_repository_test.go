@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetWorthSnapshotRepository_GetByUserID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewNetWorthSnapshotRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "snapshot_date", "currency", "total_assets", "total_liabilities", "net_worth", "created_at", "updated_at"}).
+					AddRow(uuid.New(), userID, "2024-01-31", "JPY", int64(500000000), int64(300000000), int64(200000000), time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT id, user_id, snapshot_date::text, currency, total_assets, total_liabilities, net_worth, created_at, updated_at FROM net_worth_snapshots WHERE user_id = \$1 AND snapshot_date >= \$2 ORDER BY snapshot_date ASC`).
+					WithArgs(userID, "2024-01-01").
+					WillReturnRows(rows)
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM net_worth_snapshots`).
+					WithArgs(userID, "2024-01-01").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			snapshots, err := repo.GetByUserID(context.Background(), userID, "2024-01-01")
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, snapshots, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNetWorthSnapshotRepository_Upsert(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewNetWorthSnapshotRepository(db)
+	snapshot := &models.NetWorthSnapshot{
+		ID: uuid.New(), UserID: uuid.New(), SnapshotDate: "2024-01-31", Currency: "JPY",
+		TotalAssets: 500000000, TotalLiabilities: 300000000, NetWorth: 200000000,
+		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO net_worth_snapshots \(id, user_id, snapshot_date, currency, total_assets, total_liabilities, net_worth, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9\) ON CONFLICT \(user_id, snapshot_date\) DO UPDATE SET`).
+		WithArgs(snapshot.ID, snapshot.UserID, "2024-01-31", "JPY", int64(500000000), int64(300000000), int64(200000000), snapshot.CreatedAt, snapshot.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Upsert(context.Background(), snapshot))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type NotificationLogRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationLogRepository(db *sql.DB) *NotificationLogRepository {
+	return &NotificationLogRepository{db: db}
+}
+
+// GetByUserID returns the user's latest notifications, newest first
+func (r *NotificationLogRepository) GetByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]models.NotificationLog, error) {
+	query := `
+		SELECT id, user_id, channel, event_type, dedupe_key, recipient, subject, status, error, created_at, updated_at
+		FROM notification_logs
+		WHERE user_id = $1
+		ORDER BY updated_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, limit)
+	if err != nil {
+		return []models.NotificationLog{}, err
+	}
+	defer rows.Close()
+
+	logs := make([]models.NotificationLog, 0)
+	for rows.Next() {
+		var log models.NotificationLog
+		err := rows.Scan(
+			&log.ID, &log.UserID, &log.Channel, &log.EventType, &log.DedupeKey, &log.Recipient,
+			&log.Subject, &log.Status, &log.Error, &log.CreatedAt, &log.UpdatedAt,
+		)
+		if err != nil {
+			return []models.NotificationLog{}, err
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+// WasSent reports whether the event was sent to the user on the channel
+func (r *NotificationLogRepository) WasSent(ctx context.Context, userID uuid.UUID, channel, dedupeKey string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM notification_logs
+			WHERE user_id = $1 AND channel = $2 AND dedupe_key = $3 AND status = 'sent'
+		)
+	`
+
+	var sent bool
+	err := r.db.QueryRowContext(ctx, query, userID, channel, dedupeKey).Scan(&sent)
+	return sent, err
+}
+
+// Upsert records a delivery attempt, replacing the earlier attempt of the same event on the channel
+func (r *NotificationLogRepository) Upsert(ctx context.Context, log *models.NotificationLog) error {
+	query := `
+		INSERT INTO notification_logs (id, user_id, channel, event_type, dedupe_key, recipient, subject, status, error, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (user_id, channel, dedupe_key)
+		DO UPDATE SET recipient = EXCLUDED.recipient, subject = EXCLUDED.subject, status = EXCLUDED.status,
+			error = EXCLUDED.error, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query, log.ID, log.UserID, log.Channel, log.EventType, log.DedupeKey, log.Recipient,
+		log.Subject, log.Status, log.Error, log.CreatedAt, log.UpdatedAt)
+	return err
+}
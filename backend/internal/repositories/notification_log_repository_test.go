@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationLogRepository_GetByUserID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewNotificationLogRepository(db)
+	userID := uuid.New()
+	failure := "connection refused"
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "channel", "event_type", "dedupe_key", "recipient", "subject", "status", "error", "created_at", "updated_at"}).
+		AddRow(uuid.New(), userID, "email", "low_balance", "low_balance:2025-02-10", "user@example.com", "残高", "failed", &failure, time.Now(), time.Now()).
+		AddRow(uuid.New(), userID, "email", "weekly_digest", "weekly_digest:2025-W04", "user@example.com", "週次", "sent", nil, time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT (.+) FROM notification_logs WHERE user_id = \$1 ORDER BY updated_at DESC LIMIT \$2`).
+		WithArgs(userID, 50).
+		WillReturnRows(rows)
+
+	logs, err := repo.GetByUserID(context.Background(), userID, 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, logs, 2)
+	assert.Equal(t, "connection refused", *logs[0].Error)
+	assert.Nil(t, logs[1].Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationLogRepository_WasSent(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewNotificationLogRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectQuery(`SELECT EXISTS \( SELECT 1 FROM notification_logs WHERE user_id = \$1 AND channel = \$2 AND dedupe_key = \$3 AND status = 'sent' \)`).
+		WithArgs(userID, "email", "low_balance:2025-02-10").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	sent, err := repo.WasSent(context.Background(), userID, "email", "low_balance:2025-02-10")
+
+	assert.NoError(t, err)
+	assert.True(t, sent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationLogRepository_Upsert(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewNotificationLogRepository(db)
+	log := &models.NotificationLog{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Channel:   "email",
+		EventType: "low_balance",
+		DedupeKey: "low_balance:2025-02-10",
+		Recipient: "user@example.com",
+		Subject:   "残高",
+		Status:    "sent",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO notification_logs (.+) ON CONFLICT \(user_id, channel, dedupe_key\) DO UPDATE SET`).
+		WithArgs(log.ID, log.UserID, log.Channel, log.EventType, log.DedupeKey, log.Recipient, log.Subject, log.Status, log.Error, log.CreatedAt, log.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Upsert(context.Background(), log))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
@@ -15,17 +16,18 @@ func NewRecurringPaymentRepository(db *sql.DB) *RecurringPaymentRepository {
 	return &RecurringPaymentRepository{db: db}
 }
 
-func (r *RecurringPaymentRepository) GetAll(userID uuid.UUID) ([]models.RecurringPayment, error) {
+func (r *RecurringPaymentRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error) {
 	query := `
-		SELECT id, user_id, name, amount, payment_day, start_year_month, 
+		SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule,
 		       total_payments, remaining_payments, bank_account, is_active, 
-		       note, created_at, updated_at
+		       note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags,
+		       currency, created_at, updated_at
 		FROM recurring_payments 
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return []models.RecurringPayment{}, err
 	}
@@ -36,9 +38,10 @@ func (r *RecurringPaymentRepository) GetAll(userID uuid.UUID) ([]models.Recurrin
 		var payment models.RecurringPayment
 		err := rows.Scan(
 			&payment.ID, &payment.UserID, &payment.Name, &payment.Amount,
-			&payment.PaymentDay, &payment.StartYearMonth, &payment.TotalPayments,
+			&payment.PaymentDay, &payment.StartYearMonth, &payment.RecurrenceRule, &payment.TotalPayments,
 			&payment.RemainingPayments, &payment.BankAccount, &payment.IsActive,
-			&payment.Note, &payment.CreatedAt, &payment.UpdatedAt,
+			&payment.Note, &payment.LoanPrincipal, &payment.LoanAnnualRate, &payment.LoanRepaymentMethod,
+			&payment.CategoryID, &payment.Tags, &payment.Currency, &payment.CreatedAt, &payment.UpdatedAt,
 		)
 		if err != nil {
 			return []models.RecurringPayment{}, err
@@ -49,17 +52,18 @@ func (r *RecurringPaymentRepository) GetAll(userID uuid.UUID) ([]models.Recurrin
 	return payments, nil
 }
 
-func (r *RecurringPaymentRepository) GetActiveByUserID(userID uuid.UUID) ([]models.RecurringPayment, error) {
+func (r *RecurringPaymentRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error) {
 	query := `
-		SELECT id, user_id, name, amount, payment_day, start_year_month, 
+		SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule,
 		       total_payments, remaining_payments, bank_account, is_active, 
-		       note, created_at, updated_at
+		       note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags,
+		       currency, created_at, updated_at
 		FROM recurring_payments 
 		WHERE user_id = $1 AND is_active = true
 		ORDER BY payment_day ASC
 	`
 
-	rows, err := r.db.Query(query, userID)
+	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,9 +74,10 @@ func (r *RecurringPaymentRepository) GetActiveByUserID(userID uuid.UUID) ([]mode
 		var payment models.RecurringPayment
 		err := rows.Scan(
 			&payment.ID, &payment.UserID, &payment.Name, &payment.Amount,
-			&payment.PaymentDay, &payment.StartYearMonth, &payment.TotalPayments,
+			&payment.PaymentDay, &payment.StartYearMonth, &payment.RecurrenceRule, &payment.TotalPayments,
 			&payment.RemainingPayments, &payment.BankAccount, &payment.IsActive,
-			&payment.Note, &payment.CreatedAt, &payment.UpdatedAt,
+			&payment.Note, &payment.LoanPrincipal, &payment.LoanAnnualRate, &payment.LoanRepaymentMethod,
+			&payment.CategoryID, &payment.Tags, &payment.Currency, &payment.CreatedAt, &payment.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -83,21 +88,60 @@ func (r *RecurringPaymentRepository) GetActiveByUserID(userID uuid.UUID) ([]mode
 	return payments, nil
 }
 
-func (r *RecurringPaymentRepository) GetByID(id uuid.UUID) (*models.RecurringPayment, error) {
+// GetAllActive returns the active recurring payments of every user
+func (r *RecurringPaymentRepository) GetAllActive(ctx context.Context) ([]models.RecurringPayment, error) {
 	query := `
-		SELECT id, user_id, name, amount, payment_day, start_year_month, 
+		SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule,
 		       total_payments, remaining_payments, bank_account, is_active, 
-		       note, created_at, updated_at
+		       note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags,
+		       currency, created_at, updated_at
+		FROM recurring_payments 
+		WHERE is_active = true
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []models.RecurringPayment
+	for rows.Next() {
+		var payment models.RecurringPayment
+		err := rows.Scan(
+			&payment.ID, &payment.UserID, &payment.Name, &payment.Amount,
+			&payment.PaymentDay, &payment.StartYearMonth, &payment.RecurrenceRule, &payment.TotalPayments,
+			&payment.RemainingPayments, &payment.BankAccount, &payment.IsActive,
+			&payment.Note, &payment.LoanPrincipal, &payment.LoanAnnualRate, &payment.LoanRepaymentMethod,
+			&payment.CategoryID, &payment.Tags, &payment.Currency, &payment.CreatedAt, &payment.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+func (r *RecurringPaymentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error) {
+	query := `
+		SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule,
+		       total_payments, remaining_payments, bank_account, is_active, 
+		       note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags,
+		       currency, created_at, updated_at
 		FROM recurring_payments 
 		WHERE id = $1
 	`
 
 	var payment models.RecurringPayment
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&payment.ID, &payment.UserID, &payment.Name, &payment.Amount,
-		&payment.PaymentDay, &payment.StartYearMonth, &payment.TotalPayments,
+		&payment.PaymentDay, &payment.StartYearMonth, &payment.RecurrenceRule, &payment.TotalPayments,
 		&payment.RemainingPayments, &payment.BankAccount, &payment.IsActive,
-		&payment.Note, &payment.CreatedAt, &payment.UpdatedAt,
+		&payment.Note, &payment.LoanPrincipal, &payment.LoanAnnualRate, &payment.LoanRepaymentMethod,
+		&payment.CategoryID, &payment.Tags, &payment.Currency, &payment.CreatedAt, &payment.UpdatedAt,
 	)
 
 	if err != nil {
@@ -107,44 +151,60 @@ func (r *RecurringPaymentRepository) GetByID(id uuid.UUID) (*models.RecurringPay
 	return &payment, nil
 }
 
-func (r *RecurringPaymentRepository) Create(payment *models.RecurringPayment) error {
+func (r *RecurringPaymentRepository) Create(ctx context.Context, payment *models.RecurringPayment) error {
 	query := `
 		INSERT INTO recurring_payments (id, user_id, name, amount, payment_day, 
-		                               start_year_month, total_payments, remaining_payments, 
-		                               bank_account, is_active, note, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		                               start_year_month, recurrence_rule, total_payments, remaining_payments, 
+		                               bank_account, is_active, note, loan_principal, loan_annual_rate,
+		                               loan_repayment_method, category_id, tags, currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		payment.ID, payment.UserID, payment.Name, payment.Amount,
-		payment.PaymentDay, payment.StartYearMonth, payment.TotalPayments,
+		payment.PaymentDay, payment.StartYearMonth, payment.RecurrenceRule, payment.TotalPayments,
 		payment.RemainingPayments, payment.BankAccount, payment.IsActive,
-		payment.Note, payment.CreatedAt, payment.UpdatedAt,
+		payment.Note, payment.LoanPrincipal, payment.LoanAnnualRate, payment.LoanRepaymentMethod,
+		payment.CategoryID, payment.Tags, payment.Currency, payment.CreatedAt, payment.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *RecurringPaymentRepository) Update(payment *models.RecurringPayment) error {
+func (r *RecurringPaymentRepository) Update(ctx context.Context, payment *models.RecurringPayment) error {
 	query := `
 		UPDATE recurring_payments 
 		SET name = $2, amount = $3, payment_day = $4, start_year_month = $5,
-		    total_payments = $6, remaining_payments = $7, bank_account = $8,
-		    is_active = $9, note = $10, updated_at = $11
+		    recurrence_rule = $6, total_payments = $7, remaining_payments = $8, bank_account = $9,
+		    is_active = $10, note = $11, loan_principal = $12, loan_annual_rate = $13,
+		    loan_repayment_method = $14, category_id = $15, tags = $16, currency = $17, updated_at = $18
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		payment.ID, payment.Name, payment.Amount, payment.PaymentDay,
-		payment.StartYearMonth, payment.TotalPayments, payment.RemainingPayments,
-		payment.BankAccount, payment.IsActive, payment.Note, payment.UpdatedAt,
+		payment.StartYearMonth, payment.RecurrenceRule, payment.TotalPayments, payment.RemainingPayments,
+		payment.BankAccount, payment.IsActive, payment.Note, payment.LoanPrincipal,
+		payment.LoanAnnualRate, payment.LoanRepaymentMethod, payment.CategoryID, payment.Tags, payment.Currency, payment.UpdatedAt,
 	)
 
 	return err
 }
 
-func (r *RecurringPaymentRepository) Delete(id uuid.UUID) error {
+// UpdateProgress updates only the remaining payment count and active flag, leaving user edited fields untouched
+func (r *RecurringPaymentRepository) UpdateProgress(ctx context.Context, payment *models.RecurringPayment) error {
+	query := `
+		UPDATE recurring_payments 
+		SET remaining_payments = $2, is_active = $3, updated_at = $4
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, payment.ID, payment.RemainingPayments, payment.IsActive, payment.UpdatedAt)
+	return err
+}
+
+func (r *RecurringPaymentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM recurring_payments WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
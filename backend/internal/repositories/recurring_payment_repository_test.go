@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -34,26 +35,31 @@ func TestRecurringPaymentRepository_GetAll(t *testing.T) {
 				totalPayments := 12
 				remainingPayments := 8
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "amount", "payment_day", "start_year_month",
+					"id", "user_id", "name", "amount", "payment_day", "start_year_month", "recurrence_rule",
 					"total_payments", "remaining_payments", "bank_account", "is_active",
-					"note", "created_at", "updated_at",
+					"note", "loan_principal", "loan_annual_rate", "loan_repayment_method", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
-						uuid.New(), userID, "Monthly Rent", int64(120000), 1, "2024-01",
+						uuid.New(), userID, "Monthly Rent", int64(120000), 1, "2024-01", "FREQ=MONTHLY;BYMONTHDAY=1;COUNT=12",
 						&totalPayments, &remainingPayments, bankAccountID, true,
-						"Monthly rent payment", time.Now(), time.Now(),
+						"Monthly rent payment", nil, nil, nil, nil, nil, "JPY", time.Now(), time.Now(),
 					).
 					AddRow(
-						uuid.New(), userID, "Insurance", int64(8000), 15, "2024-01",
+						uuid.New(), userID, "Insurance", int64(8000), 15, "2024-01", "FREQ=YEARLY;BYMONTH=4;BYMONTHDAY=15",
 						nil, nil, bankAccountID, true,
-						"Monthly insurance", time.Now(), time.Now(),
+						"Monthly insurance", nil, nil, nil, uuid.New(), "insurance,family", "JPY", time.Now(), time.Now(),
+					).
+					AddRow(
+						uuid.New(), userID, "Car Loan", int64(45000), 27, "2024-01", "FREQ=MONTHLY;BYMONTHDAY=27;COUNT=60",
+						&totalPayments, nil, bankAccountID, true,
+						"", int64(2500000), "2.9", "equal_payment", nil, nil, "JPY", time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at FROM recurring_payments WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at FROM recurring_payments WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
-			expectedCount: 2,
+			expectedCount: 3,
 			expectedError: false,
 		},
 		{
@@ -61,12 +67,12 @@ func TestRecurringPaymentRepository_GetAll(t *testing.T) {
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "amount", "payment_day", "start_year_month",
+					"id", "user_id", "name", "amount", "payment_day", "start_year_month", "recurrence_rule",
 					"total_payments", "remaining_payments", "bank_account", "is_active",
-					"note", "created_at", "updated_at",
+					"note", "loan_principal", "loan_annual_rate", "loan_repayment_method", "category_id", "tags", "currency", "created_at", "updated_at",
 				})
 
-				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at FROM recurring_payments WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at FROM recurring_payments WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnRows(rows)
 			},
@@ -77,7 +83,7 @@ func TestRecurringPaymentRepository_GetAll(t *testing.T) {
 			name:   "database error",
 			userID: userID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at FROM recurring_payments WHERE user_id = \$1 ORDER BY created_at DESC`).
+				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at FROM recurring_payments WHERE user_id = \$1 ORDER BY created_at DESC`).
 					WithArgs(userID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -90,7 +96,7 @@ func TestRecurringPaymentRepository_GetAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			payments, err := repo.GetAll(tt.userID)
+			payments, err := repo.GetAll(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -125,17 +131,17 @@ func TestRecurringPaymentRepository_GetByID(t *testing.T) {
 			paymentID: paymentID,
 			setupMock: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{
-					"id", "user_id", "name", "amount", "payment_day", "start_year_month",
+					"id", "user_id", "name", "amount", "payment_day", "start_year_month", "recurrence_rule",
 					"total_payments", "remaining_payments", "bank_account", "is_active",
-					"note", "created_at", "updated_at",
+					"note", "loan_principal", "loan_annual_rate", "loan_repayment_method", "category_id", "tags", "currency", "created_at", "updated_at",
 				}).
 					AddRow(
-						paymentID, userID, "Monthly Rent", int64(120000), 1, "2024-01",
+						paymentID, userID, "Monthly Rent", int64(120000), 1, "2024-01", nil,
 						nil, nil, bankAccountID, true,
-						"Monthly rent payment", time.Now(), time.Now(),
+						"Monthly rent payment", nil, nil, nil, nil, nil, "JPY", time.Now(), time.Now(),
 					)
 
-				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at FROM recurring_payments WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at FROM recurring_payments WHERE id = \$1`).
 					WithArgs(paymentID).
 					WillReturnRows(rows)
 			},
@@ -146,7 +152,7 @@ func TestRecurringPaymentRepository_GetByID(t *testing.T) {
 			name:      "payment not found",
 			paymentID: paymentID,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at FROM recurring_payments WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at FROM recurring_payments WHERE id = \$1`).
 					WithArgs(paymentID).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -159,7 +165,7 @@ func TestRecurringPaymentRepository_GetByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			payment, err := repo.GetByID(tt.paymentID)
+			payment, err := repo.GetByID(context.Background(), tt.paymentID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -194,8 +200,8 @@ func TestRecurringPaymentRepository_Create(t *testing.T) {
 			name:    "successful creation",
 			payment: payment,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO recurring_payments \(id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\)`).
-					WithArgs(payment.ID, payment.UserID, payment.Name, payment.Amount, payment.PaymentDay, payment.StartYearMonth, payment.TotalPayments, payment.RemainingPayments, payment.BankAccount, payment.IsActive, payment.Note, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO recurring_payments \(id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13, \$14, \$15, \$16, \$17, \$18, \$19, \$20\)`).
+					WithArgs(payment.ID, payment.UserID, payment.Name, payment.Amount, payment.PaymentDay, payment.StartYearMonth, payment.RecurrenceRule, payment.TotalPayments, payment.RemainingPayments, payment.BankAccount, payment.IsActive, payment.Note, payment.LoanPrincipal, payment.LoanAnnualRate, payment.LoanRepaymentMethod, payment.CategoryID, payment.Tags, payment.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			expectedError: false,
@@ -204,8 +210,8 @@ func TestRecurringPaymentRepository_Create(t *testing.T) {
 			name:    "database error",
 			payment: payment,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`INSERT INTO recurring_payments \(id, user_id, name, amount, payment_day, start_year_month, total_payments, remaining_payments, bank_account, is_active, note, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\)`).
-					WithArgs(payment.ID, payment.UserID, payment.Name, payment.Amount, payment.PaymentDay, payment.StartYearMonth, payment.TotalPayments, payment.RemainingPayments, payment.BankAccount, payment.IsActive, payment.Note, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectExec(`INSERT INTO recurring_payments \(id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13, \$14, \$15, \$16, \$17, \$18, \$19, \$20\)`).
+					WithArgs(payment.ID, payment.UserID, payment.Name, payment.Amount, payment.PaymentDay, payment.StartYearMonth, payment.RecurrenceRule, payment.TotalPayments, payment.RemainingPayments, payment.BankAccount, payment.IsActive, payment.Note, payment.LoanPrincipal, payment.LoanAnnualRate, payment.LoanRepaymentMethod, payment.CategoryID, payment.Tags, payment.Currency, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(sql.ErrConnDone)
 			},
 			expectedError: true,
@@ -216,7 +222,7 @@ func TestRecurringPaymentRepository_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			err := repo.Create(tt.payment)
+			err := repo.Create(context.Background(), tt.payment)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -228,3 +234,47 @@ func TestRecurringPaymentRepository_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurringPaymentRepository_GetAllActive(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewRecurringPaymentRepository(db)
+	totalPayments := 12
+	remainingPayments := 8
+	rows := sqlmock.NewRows([]string{
+		"id", "user_id", "name", "amount", "payment_day", "start_year_month", "recurrence_rule",
+		"total_payments", "remaining_payments", "bank_account", "is_active",
+		"note", "loan_principal", "loan_annual_rate", "loan_repayment_method", "category_id", "tags", "currency", "created_at", "updated_at",
+	}).
+		AddRow(
+			uuid.New(), uuid.New(), "Phone", int64(5000), 27, "2024-01", "FREQ=MONTHLY;BYMONTHDAY=27;COUNT=12",
+			&totalPayments, &remainingPayments, uuid.New(), true,
+			"", nil, nil, nil, nil, nil, "JPY", time.Now(), time.Now(),
+		)
+
+	mock.ExpectQuery(`SELECT id, user_id, name, amount, payment_day, start_year_month, recurrence_rule, total_payments, remaining_payments, bank_account, is_active, note, loan_principal, loan_annual_rate, loan_repayment_method, category_id, tags, currency, created_at, updated_at FROM recurring_payments WHERE is_active = true ORDER BY created_at ASC`).
+		WillReturnRows(rows)
+
+	payments, err := repo.GetAllActive(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, payments, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecurringPaymentRepository_UpdateProgress(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewRecurringPaymentRepository(db)
+	remaining := 0
+	payment := &models.RecurringPayment{ID: uuid.New(), RemainingPayments: &remaining, IsActive: false, UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`UPDATE recurring_payments SET remaining_payments = \$2, is_active = \$3, updated_at = \$4 WHERE id = \$1`).
+		WithArgs(payment.ID, payment.RemainingPayments, payment.IsActive, payment.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.UpdateProgress(context.Background(), payment))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type RecurringTransferRepository struct {
+	db *sql.DB
+}
+
+func NewRecurringTransferRepository(db *sql.DB) *RecurringTransferRepository {
+	return &RecurringTransferRepository{db: db}
+}
+
+func (r *RecurringTransferRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error) {
+	query := `
+		SELECT id, user_id, name, from_account, to_account, transfer_type, amount,
+		       transfer_day, target_balance, is_active, note, created_at, updated_at
+		FROM recurring_transfers
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	return r.query(ctx, query, userID)
+}
+
+// GetActiveByUserID returns the active transfers in the order they were created, which is the
+// order the projection applies transfers due on the same day
+func (r *RecurringTransferRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error) {
+	query := `
+		SELECT id, user_id, name, from_account, to_account, transfer_type, amount,
+		       transfer_day, target_balance, is_active, note, created_at, updated_at
+		FROM recurring_transfers
+		WHERE user_id = $1 AND is_active = true
+		ORDER BY created_at ASC
+	`
+
+	return r.query(ctx, query, userID)
+}
+
+func (r *RecurringTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RecurringTransfer, error) {
+	query := `
+		SELECT id, user_id, name, from_account, to_account, transfer_type, amount,
+		       transfer_day, target_balance, is_active, note, created_at, updated_at
+		FROM recurring_transfers
+		WHERE id = $1
+	`
+
+	var transfer models.RecurringTransfer
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&transfer.ID, &transfer.UserID, &transfer.Name, &transfer.FromAccount, &transfer.ToAccount,
+		&transfer.TransferType, &transfer.Amount, &transfer.TransferDay, &transfer.TargetBalance,
+		&transfer.IsActive, &transfer.Note, &transfer.CreatedAt, &transfer.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+func (r *RecurringTransferRepository) Create(ctx context.Context, transfer *models.RecurringTransfer) error {
+	query := `
+		INSERT INTO recurring_transfers (id, user_id, name, from_account, to_account, transfer_type, amount,
+		                                 transfer_day, target_balance, is_active, note, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		transfer.ID, transfer.UserID, transfer.Name, transfer.FromAccount, transfer.ToAccount,
+		transfer.TransferType, transfer.Amount, transfer.TransferDay, transfer.TargetBalance,
+		transfer.IsActive, transfer.Note, transfer.CreatedAt, transfer.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *RecurringTransferRepository) Update(ctx context.Context, transfer *models.RecurringTransfer) error {
+	query := `
+		UPDATE recurring_transfers
+		SET name = $2, from_account = $3, to_account = $4, transfer_type = $5, amount = $6,
+		    transfer_day = $7, target_balance = $8, is_active = $9, note = $10, updated_at = $11
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		transfer.ID, transfer.Name, transfer.FromAccount, transfer.ToAccount, transfer.TransferType,
+		transfer.Amount, transfer.TransferDay, transfer.TargetBalance, transfer.IsActive,
+		transfer.Note, transfer.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *RecurringTransferRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM recurring_transfers WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *RecurringTransferRepository) query(ctx context.Context, query string, args ...interface{}) ([]models.RecurringTransfer, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []models.RecurringTransfer{}, err
+	}
+	defer rows.Close()
+
+	transfers := make([]models.RecurringTransfer, 0)
+	for rows.Next() {
+		var transfer models.RecurringTransfer
+		err := rows.Scan(
+			&transfer.ID, &transfer.UserID, &transfer.Name, &transfer.FromAccount, &transfer.ToAccount,
+			&transfer.TransferType, &transfer.Amount, &transfer.TransferDay, &transfer.TargetBalance,
+			&transfer.IsActive, &transfer.Note, &transfer.CreatedAt, &transfer.UpdatedAt,
+		)
+		if err != nil {
+			return []models.RecurringTransfer{}, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
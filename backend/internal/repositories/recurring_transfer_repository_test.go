@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var recurringTransferColumns = []string{
+	"id", "user_id", "name", "from_account", "to_account", "transfer_type", "amount",
+	"transfer_day", "target_balance", "is_active", "note", "created_at", "updated_at",
+}
+
+func TestRecurringTransferRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewRecurringTransferRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(recurringTransferColumns).
+					AddRow(uuid.New(), userID, "Savings", uuid.New(), uuid.New(), "fixed", int64(50000), 25, nil, true, "", time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, "Card account", uuid.New(), uuid.New(), "top_up", nil, nil, int64(100000), true, "", time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT id, user_id, name, from_account, to_account, transfer_type, amount, transfer_day, target_balance, is_active, note, created_at, updated_at FROM recurring_transfers WHERE user_id = \$1 ORDER BY created_at DESC`).
+					WithArgs(userID).
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM recurring_transfers WHERE user_id = \$1 ORDER BY created_at DESC`).
+					WithArgs(userID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			transfers, err := repo.GetAll(context.Background(), userID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, transfers, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecurringTransferRepository_GetActiveByUserID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewRecurringTransferRepository(db)
+	userID := uuid.New()
+
+	rows := sqlmock.NewRows(recurringTransferColumns).
+		AddRow(uuid.New(), userID, "Savings", uuid.New(), uuid.New(), "fixed", int64(50000), 25, nil, true, "", time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT (.+) FROM recurring_transfers WHERE user_id = \$1 AND is_active = true ORDER BY created_at ASC`).
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	transfers, err := repo.GetActiveByUserID(context.Background(), userID)
+
+	assert.NoError(t, err)
+	if assert.Len(t, transfers, 1) {
+		assert.Equal(t, int64(50000), *transfers[0].Amount)
+		assert.Nil(t, transfers[0].TargetBalance)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecurringTransferRepository_Create(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewRecurringTransferRepository(db)
+	target := int64(100000)
+	transfer := &models.RecurringTransfer{
+		ID: uuid.New(), UserID: uuid.New(), Name: "Card account", FromAccount: uuid.New(), ToAccount: uuid.New(),
+		TransferType: "top_up", TargetBalance: &target, IsActive: true, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO recurring_transfers \(id, user_id, name, from_account, to_account, transfer_type, amount, transfer_day, target_balance, is_active, note, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\)`).
+		WithArgs(transfer.ID, transfer.UserID, transfer.Name, transfer.FromAccount, transfer.ToAccount, transfer.TransferType,
+			transfer.Amount, transfer.TransferDay, transfer.TargetBalance, transfer.IsActive, transfer.Note, transfer.CreatedAt, transfer.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), transfer))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecurringTransferRepository_Update(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewRecurringTransferRepository(db)
+	amount := int64(60000)
+	day := 25
+	transfer := &models.RecurringTransfer{
+		ID: uuid.New(), Name: "Savings", FromAccount: uuid.New(), ToAccount: uuid.New(),
+		TransferType: "fixed", Amount: &amount, TransferDay: &day, IsActive: true, UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`UPDATE recurring_transfers SET name = \$2, from_account = \$3, to_account = \$4, transfer_type = \$5, amount = \$6, transfer_day = \$7, target_balance = \$8, is_active = \$9, note = \$10, updated_at = \$11 WHERE id = \$1`).
+		WithArgs(transfer.ID, transfer.Name, transfer.FromAccount, transfer.ToAccount, transfer.TransferType,
+			transfer.Amount, transfer.TransferDay, transfer.TargetBalance, transfer.IsActive, transfer.Note, transfer.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Update(context.Background(), transfer))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
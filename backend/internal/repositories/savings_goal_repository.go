@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type SavingsGoalRepository struct {
+	db *sql.DB
+}
+
+func NewSavingsGoalRepository(db *sql.DB) *SavingsGoalRepository {
+	return &SavingsGoalRepository{db: db}
+}
+
+func (r *SavingsGoalRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error) {
+	query := `
+		SELECT id, user_id, name, target_amount, target_year_month, bank_account,
+		       allocated_amount, is_reserved, is_active, note, created_at, updated_at
+		FROM savings_goals
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	return r.query(ctx, query, userID)
+}
+
+// GetActiveByUserID returns the active goals in the order they were created, which is the
+// order ring-fenced amounts are taken from an account that cannot cover all of them
+func (r *SavingsGoalRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error) {
+	query := `
+		SELECT id, user_id, name, target_amount, target_year_month, bank_account,
+		       allocated_amount, is_reserved, is_active, note, created_at, updated_at
+		FROM savings_goals
+		WHERE user_id = $1 AND is_active = true
+		ORDER BY created_at ASC
+	`
+
+	return r.query(ctx, query, userID)
+}
+
+func (r *SavingsGoalRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SavingsGoal, error) {
+	query := `
+		SELECT id, user_id, name, target_amount, target_year_month, bank_account,
+		       allocated_amount, is_reserved, is_active, note, created_at, updated_at
+		FROM savings_goals
+		WHERE id = $1
+	`
+
+	var goal models.SavingsGoal
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&goal.ID, &goal.UserID, &goal.Name, &goal.TargetAmount, &goal.TargetYearMonth, &goal.BankAccount,
+		&goal.AllocatedAmount, &goal.IsReserved, &goal.IsActive, &goal.Note, &goal.CreatedAt, &goal.UpdatedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &goal, nil
+}
+
+func (r *SavingsGoalRepository) Create(ctx context.Context, goal *models.SavingsGoal) error {
+	query := `
+		INSERT INTO savings_goals (id, user_id, name, target_amount, target_year_month, bank_account,
+		                           allocated_amount, is_reserved, is_active, note, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		goal.ID, goal.UserID, goal.Name, goal.TargetAmount, goal.TargetYearMonth, goal.BankAccount,
+		goal.AllocatedAmount, goal.IsReserved, goal.IsActive, goal.Note, goal.CreatedAt, goal.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *SavingsGoalRepository) Update(ctx context.Context, goal *models.SavingsGoal) error {
+	query := `
+		UPDATE savings_goals
+		SET name = $2, target_amount = $3, target_year_month = $4, bank_account = $5,
+		    allocated_amount = $6, is_reserved = $7, is_active = $8, note = $9, updated_at = $10
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		goal.ID, goal.Name, goal.TargetAmount, goal.TargetYearMonth, goal.BankAccount,
+		goal.AllocatedAmount, goal.IsReserved, goal.IsActive, goal.Note, goal.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *SavingsGoalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM savings_goals WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *SavingsGoalRepository) query(ctx context.Context, query string, args ...interface{}) ([]models.SavingsGoal, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []models.SavingsGoal{}, err
+	}
+	defer rows.Close()
+
+	goals := make([]models.SavingsGoal, 0)
+	for rows.Next() {
+		var goal models.SavingsGoal
+		err := rows.Scan(
+			&goal.ID, &goal.UserID, &goal.Name, &goal.TargetAmount, &goal.TargetYearMonth, &goal.BankAccount,
+			&goal.AllocatedAmount, &goal.IsReserved, &goal.IsActive, &goal.Note, &goal.CreatedAt, &goal.UpdatedAt,
+		)
+		if err != nil {
+			return []models.SavingsGoal{}, err
+		}
+		goals = append(goals, goal)
+	}
+
+	return goals, nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var savingsGoalColumns = []string{
+	"id", "user_id", "name", "target_amount", "target_year_month", "bank_account",
+	"allocated_amount", "is_reserved", "is_active", "note", "created_at", "updated_at",
+}
+
+func TestSavingsGoalRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewSavingsGoalRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(savingsGoalColumns).
+					AddRow(uuid.New(), userID, "Emergency fund", int64(1000000), "2027-03", uuid.New(), nil, true, true, "", time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, "Trip", int64(300000), nil, uuid.New(), int64(50000), false, true, "", time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT id, user_id, name, target_amount, target_year_month, bank_account, allocated_amount, is_reserved, is_active, note, created_at, updated_at FROM savings_goals WHERE user_id = \$1 ORDER BY created_at DESC`).
+					WithArgs(userID).
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM savings_goals WHERE user_id = \$1 ORDER BY created_at DESC`).
+					WithArgs(userID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			goals, err := repo.GetAll(context.Background(), userID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, goals, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSavingsGoalRepository_GetActiveByUserID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewSavingsGoalRepository(db)
+	userID := uuid.New()
+
+	rows := sqlmock.NewRows(savingsGoalColumns).
+		AddRow(uuid.New(), userID, "Trip", int64(300000), nil, uuid.New(), int64(50000), true, true, "", time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT (.+) FROM savings_goals WHERE user_id = \$1 AND is_active = true ORDER BY created_at ASC`).
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	goals, err := repo.GetActiveByUserID(context.Background(), userID)
+
+	assert.NoError(t, err)
+	if assert.Len(t, goals, 1) {
+		assert.Equal(t, int64(50000), *goals[0].AllocatedAmount)
+		assert.Nil(t, goals[0].TargetYearMonth)
+		assert.True(t, goals[0].IsReserved)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSavingsGoalRepository_Create(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewSavingsGoalRepository(db)
+	targetYearMonth := "2027-03"
+	goal := &models.SavingsGoal{
+		ID: uuid.New(), UserID: uuid.New(), Name: "Emergency fund", TargetAmount: 1000000, TargetYearMonth: &targetYearMonth,
+		BankAccount: uuid.New(), IsReserved: true, IsActive: true, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO savings_goals \(id, user_id, name, target_amount, target_year_month, bank_account, allocated_amount, is_reserved, is_active, note, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12\)`).
+		WithArgs(goal.ID, goal.UserID, goal.Name, goal.TargetAmount, goal.TargetYearMonth, goal.BankAccount,
+			goal.AllocatedAmount, goal.IsReserved, goal.IsActive, goal.Note, goal.CreatedAt, goal.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), goal))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSavingsGoalRepository_Update(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewSavingsGoalRepository(db)
+	allocated := int64(80000)
+	goal := &models.SavingsGoal{
+		ID: uuid.New(), Name: "Trip", TargetAmount: 300000, BankAccount: uuid.New(),
+		AllocatedAmount: &allocated, IsActive: true, UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`UPDATE savings_goals SET name = \$2, target_amount = \$3, target_year_month = \$4, bank_account = \$5, allocated_amount = \$6, is_reserved = \$7, is_active = \$8, note = \$9, updated_at = \$10 WHERE id = \$1`).
+		WithArgs(goal.ID, goal.Name, goal.TargetAmount, goal.TargetYearMonth, goal.BankAccount,
+			goal.AllocatedAmount, goal.IsReserved, goal.IsActive, goal.Note, goal.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Update(context.Background(), goal))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
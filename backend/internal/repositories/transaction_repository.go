@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type TransactionRepository struct {
+	db *sql.DB
+}
+
+func NewTransactionRepository(db *sql.DB) *TransactionRepository {
+	return &TransactionRepository{db: db}
+}
+
+func (r *TransactionRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error) {
+	query := `
+		SELECT id, user_id, category_id, transaction_date::text, amount, description,
+		       bank_account, credit_card, tags, created_at, updated_at
+		FROM transactions
+		WHERE user_id = $1
+		ORDER BY transaction_date DESC, created_at DESC
+	`
+
+	return r.query(ctx, query, userID)
+}
+
+// GetByYearMonth returns the transactions dated in the given month ("2024-01")
+func (r *TransactionRepository) GetByYearMonth(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.Transaction, error) {
+	query := `
+		SELECT id, user_id, category_id, transaction_date::text, amount, description,
+		       bank_account, credit_card, tags, created_at, updated_at
+		FROM transactions
+		WHERE user_id = $1 AND TO_CHAR(transaction_date, 'YYYY-MM') = $2
+		ORDER BY transaction_date DESC, created_at DESC
+	`
+
+	return r.query(ctx, query, userID, yearMonth)
+}
+
+func (r *TransactionRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error) {
+	query := `
+		SELECT id, user_id, category_id, transaction_date::text, amount, description,
+		       bank_account, credit_card, tags, created_at, updated_at
+		FROM transactions
+		WHERE id = $1
+	`
+
+	var transaction models.Transaction
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&transaction.ID, &transaction.UserID, &transaction.CategoryID, &transaction.TransactionDate,
+		&transaction.Amount, &transaction.Description, &transaction.BankAccount, &transaction.CreditCard,
+		&transaction.Tags, &transaction.CreatedAt, &transaction.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+func (r *TransactionRepository) Create(ctx context.Context, transaction *models.Transaction) error {
+	query := `
+		INSERT INTO transactions (id, user_id, category_id, transaction_date, amount, description,
+		                          bank_account, credit_card, tags, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		transaction.ID, transaction.UserID, transaction.CategoryID, transaction.TransactionDate,
+		transaction.Amount, transaction.Description, transaction.BankAccount, transaction.CreditCard,
+		transaction.Tags, transaction.CreatedAt, transaction.UpdatedAt,
+	)
+	return err
+}
+
+func (r *TransactionRepository) Update(ctx context.Context, transaction *models.Transaction) error {
+	query := `
+		UPDATE transactions
+		SET category_id = $2, transaction_date = $3, amount = $4, description = $5,
+		    bank_account = $6, credit_card = $7, tags = $8, updated_at = $9
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		transaction.ID, transaction.CategoryID, transaction.TransactionDate, transaction.Amount,
+		transaction.Description, transaction.BankAccount, transaction.CreditCard, transaction.Tags,
+		transaction.UpdatedAt,
+	)
+	return err
+}
+
+func (r *TransactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM transactions WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func (r *TransactionRepository) query(ctx context.Context, query string, args ...interface{}) ([]models.Transaction, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return []models.Transaction{}, err
+	}
+	defer rows.Close()
+
+	transactions := make([]models.Transaction, 0)
+	for rows.Next() {
+		var transaction models.Transaction
+		err := rows.Scan(
+			&transaction.ID, &transaction.UserID, &transaction.CategoryID, &transaction.TransactionDate,
+			&transaction.Amount, &transaction.Description, &transaction.BankAccount, &transaction.CreditCard,
+			&transaction.Tags, &transaction.CreatedAt, &transaction.UpdatedAt,
+		)
+		if err != nil {
+			return []models.Transaction{}, err
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
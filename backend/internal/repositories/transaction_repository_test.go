@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var transactionColumns = []string{
+	"id", "user_id", "category_id", "transaction_date", "amount", "description",
+	"bank_account", "credit_card", "tags", "created_at", "updated_at",
+}
+
+func TestTransactionRepository_GetByYearMonth(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewTransactionRepository(db)
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(transactionColumns).
+					AddRow(uuid.New(), userID, uuid.New(), "2024-12-20", int64(3200), "Supermarket", nil, uuid.New(), nil, time.Now(), time.Now()).
+					AddRow(uuid.New(), userID, nil, "2024-12-05", int64(1500), "Cafe", uuid.New(), nil, "work", time.Now(), time.Now())
+
+				mock.ExpectQuery(`SELECT id, user_id, category_id, transaction_date::text, amount, description, bank_account, credit_card, tags, created_at, updated_at FROM transactions WHERE user_id = \$1 AND TO_CHAR\(transaction_date, 'YYYY-MM'\) = \$2 ORDER BY transaction_date DESC, created_at DESC`).
+					WithArgs(userID, "2024-12").
+					WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM transactions WHERE user_id = \$1 AND (.+) = \$2`).
+					WithArgs(userID, "2024-12").
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			transactions, err := repo.GetByYearMonth(context.Background(), userID, "2024-12")
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, transactions, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTransactionRepository_CreateAndUpdate(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewTransactionRepository(db)
+	categoryID := uuid.New()
+	transaction := &models.Transaction{
+		ID: uuid.New(), UserID: uuid.New(), CategoryID: &categoryID, TransactionDate: "2024-12-20",
+		Amount: 3200, Description: "Supermarket", Tags: models.TagList{"family"}, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO transactions \(id, user_id, category_id, transaction_date, amount, description, bank_account, credit_card, tags, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`).
+		WithArgs(transaction.ID, transaction.UserID, transaction.CategoryID, transaction.TransactionDate, transaction.Amount,
+			transaction.Description, transaction.BankAccount, transaction.CreditCard, transaction.Tags, transaction.CreatedAt, transaction.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE transactions SET category_id = \$2, transaction_date = \$3, amount = \$4, description = \$5, bank_account = \$6, credit_card = \$7, tags = \$8, updated_at = \$9 WHERE id = \$1`).
+		WithArgs(transaction.ID, transaction.CategoryID, transaction.TransactionDate, transaction.Amount,
+			transaction.Description, transaction.BankAccount, transaction.CreditCard, transaction.Tags, transaction.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), transaction))
+	assert.NoError(t, repo.Update(context.Background(), transaction))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
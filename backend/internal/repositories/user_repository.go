@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"time"
@@ -16,14 +17,14 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	user := &models.User{}
 	query := `
 		SELECT id, email, name, picture, google_id, password, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
-	err := r.db.QueryRow(query, email).Scan(
+	err := r.db.QueryRowContext(ctx, query, email).Scan(
 		&user.ID,
 		&user.Email,
 		&user.Name,
@@ -39,14 +40,14 @@ func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
 	return user, nil
 }
 
-func (r *UserRepository) GetByGoogleID(googleID string) (*models.User, error) {
+func (r *UserRepository) GetByGoogleID(ctx context.Context, googleID string) (*models.User, error) {
 	user := &models.User{}
 	query := `
 		SELECT id, email, name, picture, google_id, password, created_at, updated_at
 		FROM users
 		WHERE google_id = $1
 	`
-	err := r.db.QueryRow(query, googleID).Scan(
+	err := r.db.QueryRowContext(ctx, query, googleID).Scan(
 		&user.ID,
 		&user.Email,
 		&user.Name,
@@ -62,14 +63,14 @@ func (r *UserRepository) GetByGoogleID(googleID string) (*models.User, error) {
 	return user, nil
 }
 
-func (r *UserRepository) GetByID(id uuid.UUID) (*models.User, error) {
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	user := &models.User{}
 	query := `
 		SELECT id, email, name, picture, google_id, password, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
 		&user.Email,
 		&user.Name,
@@ -85,7 +86,7 @@ func (r *UserRepository) GetByID(id uuid.UUID) (*models.User, error) {
 	return user, nil
 }
 
-func (r *UserRepository) Create(user *models.User) error {
+func (r *UserRepository) Create(ctx context.Context, user *models.User) error {
 	user.ID = uuid.New()
 	user.CreatedAt = time.Now()
 	user.UpdatedAt = time.Now()
@@ -94,7 +95,7 @@ func (r *UserRepository) Create(user *models.User) error {
 		INSERT INTO users (id, email, name, picture, google_id, password, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		user.ID,
 		user.Email,
 		user.Name,
@@ -107,7 +108,7 @@ func (r *UserRepository) Create(user *models.User) error {
 	return err
 }
 
-func (r *UserRepository) Update(user *models.User) error {
+func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 	user.UpdatedAt = time.Now()
 
 	query := `
@@ -115,7 +116,7 @@ func (r *UserRepository) Update(user *models.User) error {
 		SET email = $2, name = $3, picture = $4, google_id = $5, password = $6, updated_at = $7
 		WHERE id = $1
 	`
-	_, err := r.db.Exec(query,
+	_, err := r.db.ExecContext(ctx, query,
 		user.ID,
 		user.Email,
 		user.Name,
@@ -126,3 +127,25 @@ func (r *UserRepository) Update(user *models.User) error {
 	)
 	return err
 }
+
+// GetAllIDs returns the IDs of every user, for background jobs that work through all users
+func (r *UserRepository) GetAllIDs(ctx context.Context) ([]uuid.UUID, error) {
+	query := `SELECT id FROM users ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return []uuid.UUID{}, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return []uuid.UUID{}, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 	"testing"
@@ -62,7 +63,7 @@ func TestUserRepository_GetByEmail(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			user, err := repo.GetByEmail(tt.email)
+			user, err := repo.GetByEmail(context.Background(), tt.email)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -129,7 +130,7 @@ func TestUserRepository_GetByGoogleID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			user, err := repo.GetByGoogleID(tt.googleID)
+			user, err := repo.GetByGoogleID(context.Background(), tt.googleID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -195,7 +196,7 @@ func TestUserRepository_GetByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock(mock)
 
-			user, err := repo.GetByID(tt.userID)
+			user, err := repo.GetByID(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -247,7 +248,7 @@ func TestUserRepository_Create(t *testing.T) {
 			user := helpers.CreateTestUser()
 			tt.setupMock(mock)
 
-			err := repo.Create(user)
+			err := repo.Create(context.Background(), user)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -297,7 +298,7 @@ func TestUserRepository_Update(t *testing.T) {
 			user.Name = "Updated Name"
 			tt.setupMock(mock)
 
-			err := repo.Update(user)
+			err := repo.Update(context.Background(), user)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -309,3 +310,50 @@ func TestUserRepository_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRepository_GetAllIDs(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewUserRepository(db)
+
+	tests := []struct {
+		name          string
+		setupMock     func(sqlmock.Sqlmock)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "successful retrieval",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()).AddRow(uuid.New())
+				mock.ExpectQuery(`SELECT id FROM users ORDER BY created_at ASC`).WillReturnRows(rows)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id FROM users`).WillReturnError(sql.ErrConnDone)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock(mock)
+
+			ids, err := repo.GetAllIDs(context.Background())
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, ids, tt.expectedCount)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
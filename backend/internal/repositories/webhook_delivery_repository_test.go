@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var webhookDeliveryColumns = []string{"id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts", "response_status", "error", "next_attempt_at", "created_at", "updated_at"}
+
+func TestWebhookDeliveryRepository_GetByWebhookID(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewWebhookDeliveryRepository(db)
+	webhookID := uuid.New()
+	failure := "unexpected status 503 Service Unavailable"
+
+	rows := sqlmock.NewRows(webhookDeliveryColumns).
+		AddRow(uuid.New(), webhookID, uuid.New(), "alert.triggered", `{"type":"alert.triggered"}`, "pending", 1, 503, failure, time.Now(), time.Now(), time.Now()).
+		AddRow(uuid.New(), webhookID, uuid.New(), "test", `{"type":"test"}`, "succeeded", 1, 204, nil, nil, time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT (.+) FROM webhook_deliveries WHERE webhook_id = \$1 ORDER BY created_at DESC LIMIT \$2`).
+		WithArgs(webhookID, 50).
+		WillReturnRows(rows)
+
+	deliveries, err := repo.GetByWebhookID(context.Background(), webhookID, 50)
+
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+	assert.JSONEq(t, `{"type":"alert.triggered"}`, string(deliveries[0].Payload))
+	assert.Equal(t, 503, *deliveries[0].ResponseStatus)
+	assert.NotNil(t, deliveries[0].NextAttemptAt)
+	assert.Nil(t, deliveries[1].Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookDeliveryRepository_GetDue(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewWebhookDeliveryRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(`SELECT (.+) FROM webhook_deliveries WHERE status = 'pending' AND next_attempt_at <= \$1 ORDER BY next_attempt_at LIMIT \$2`).
+		WithArgs(now, 100).
+		WillReturnRows(sqlmock.NewRows(webhookDeliveryColumns))
+
+	deliveries, err := repo.GetDue(context.Background(), now, 100)
+
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookDeliveryRepository_CreateAndUpdate(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewWebhookDeliveryRepository(db)
+	now := time.Now()
+	delivery := &models.WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     uuid.New(),
+		EventID:       uuid.New(),
+		EventType:     "credit_card.created",
+		Payload:       []byte(`{"type":"credit_card.created"}`),
+		Status:        "pending",
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	mock.ExpectExec(`INSERT INTO webhook_deliveries`).
+		WithArgs(delivery.ID, delivery.WebhookID, delivery.EventID, delivery.EventType, `{"type":"credit_card.created"}`,
+			"pending", 0, nil, nil, delivery.NextAttemptAt, now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	assert.NoError(t, repo.Create(context.Background(), delivery))
+
+	status := 200
+	delivery.Status, delivery.Attempts, delivery.ResponseStatus, delivery.NextAttemptAt = "succeeded", 1, &status, nil
+	mock.ExpectExec(`UPDATE webhook_deliveries SET status = \$2, attempts = \$3, response_status = \$4, error = \$5, next_attempt_at = \$6, updated_at = \$7 WHERE id = \$1`).
+		WithArgs(delivery.ID, "succeeded", 1, delivery.ResponseStatus, nil, nil, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Update(context.Background(), delivery))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
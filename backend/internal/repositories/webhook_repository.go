@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Webhook, error) {
+	query := `
+		SELECT id, user_id, name, url, secret, events, is_active, created_at, updated_at
+		FROM webhooks
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return []models.Webhook{}, err
+	}
+	defer rows.Close()
+
+	webhooks := make([]models.Webhook, 0)
+	for rows.Next() {
+		var webhook models.Webhook
+		err := rows.Scan(
+			&webhook.ID, &webhook.UserID, &webhook.Name, &webhook.URL, &webhook.Secret,
+			&webhook.Events, &webhook.IsActive, &webhook.CreatedAt, &webhook.UpdatedAt,
+		)
+		if err != nil {
+			return []models.Webhook{}, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Webhook, error) {
+	query := `
+		SELECT id, user_id, name, url, secret, events, is_active, created_at, updated_at
+		FROM webhooks
+		WHERE id = $1
+	`
+
+	var webhook models.Webhook
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&webhook.ID, &webhook.UserID, &webhook.Name, &webhook.URL, &webhook.Secret,
+		&webhook.Events, &webhook.IsActive, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, webhook *models.Webhook) error {
+	query := `
+		INSERT INTO webhooks (id, user_id, name, url, secret, events, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		webhook.ID, webhook.UserID, webhook.Name, webhook.URL, webhook.Secret,
+		webhook.Events, webhook.IsActive, webhook.CreatedAt, webhook.UpdatedAt,
+	)
+	return err
+}
+
+func (r *WebhookRepository) Update(ctx context.Context, webhook *models.Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET name = $2, url = $3, secret = $4, events = $5, is_active = $6, updated_at = $7
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		webhook.ID, webhook.Name, webhook.URL, webhook.Secret,
+		webhook.Events, webhook.IsActive, webhook.UpdatedAt,
+	)
+	return err
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM webhooks WHERE id = $1`
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookRepository_GetAll(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewWebhookRepository(db)
+	userID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "url", "secret", "events", "is_active", "created_at", "updated_at"}).
+		AddRow(uuid.New(), userID, "Home Assistant", "http://ha.local/api/webhook/flow", "s3cret", "alert.triggered,card_monthly_total.confirmed", true, time.Now(), time.Now()).
+		AddRow(uuid.New(), userID, "Relay", "https://relay.example.com/hook", "s3cret", nil, false, time.Now(), time.Now())
+	mock.ExpectQuery(`SELECT (.+) FROM webhooks WHERE user_id = \$1 ORDER BY created_at`).
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	webhooks, err := repo.GetAll(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Len(t, webhooks, 2)
+	assert.Equal(t, models.TagList{"alert.triggered", "card_monthly_total.confirmed"}, webhooks[0].Events)
+	assert.Nil(t, webhooks[1].Events, "every event")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_Create(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewWebhookRepository(db)
+	webhook := &models.Webhook{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Name:      "Home Assistant",
+		URL:       "http://ha.local/api/webhook/flow",
+		Secret:    "s3cret",
+		Events:    models.TagList{"alert.triggered"},
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	mock.ExpectExec(`INSERT INTO webhooks`).
+		WithArgs(webhook.ID, webhook.UserID, webhook.Name, webhook.URL, webhook.Secret, "alert.triggered", webhook.IsActive, webhook.CreatedAt, webhook.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.Create(context.Background(), webhook))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_Update(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	repo := NewWebhookRepository(db)
+	webhook := &models.Webhook{ID: uuid.New(), Name: "Relay", URL: "https://relay.example.com/hook", Secret: "s3cret", UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`UPDATE webhooks SET name = \$2, url = \$3, secret = \$4, events = \$5, is_active = \$6, updated_at = \$7 WHERE id = \$1`).
+		WithArgs(webhook.ID, webhook.Name, webhook.URL, webhook.Secret, nil, false, webhook.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.Update(context.Background(), webhook))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,8 +1,10 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/repositories"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,27 +12,65 @@ import (
 
 type AppSettingService struct {
 	appSettingRepo *repositories.AppSettingRepository
+	hostGuard      HostGuardInterface // Checks the hosts of the chat webhook URLs
 }
 
-func NewAppSettingService(appSettingRepo *repositories.AppSettingRepository) *AppSettingService {
+func NewAppSettingService(appSettingRepo *repositories.AppSettingRepository, hostGuard HostGuardInterface) *AppSettingService {
 	return &AppSettingService{
 		appSettingRepo: appSettingRepo,
+		hostGuard:      hostGuard,
 	}
 }
 
-func (s *AppSettingService) GetSettings(userID uuid.UUID) ([]models.AppSetting, error) {
-	return s.appSettingRepo.GetByUserID(userID)
+func (s *AppSettingService) GetSettings(ctx context.Context, userID uuid.UUID) ([]models.AppSetting, error) {
+	ctx, span := tracer.Start(ctx, "AppSettingService.GetSettings")
+	defer span.End()
+
+	return s.appSettingRepo.GetByUserID(ctx, userID)
+}
+
+// GetSchema returns the definitions of every setting a user can store
+func (s *AppSettingService) GetSchema() []models.SettingDefinition {
+	return SettingSchema()
 }
 
-func (s *AppSettingService) UpdateSetting(userID uuid.UUID, key, value string) error {
-	setting := &models.AppSetting{
-		ID:        uuid.New(),
-		UserID:    userID,
-		Key:       key,
-		Value:     value,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+// UpdateSettings validates every setting against the registry and stores them in one
+// transaction. Nothing is stored when one of them is unknown or invalid, or when a chat webhook
+// URL points to the server's own network.
+func (s *AppSettingService) UpdateSettings(ctx context.Context, userID uuid.UUID, settings map[string]string) error {
+	ctx, span := tracer.Start(ctx, "AppSettingService.UpdateSettings")
+	defer span.End()
+
+	validated, err := ValidateSettings(settings)
+	if err != nil {
+		return err
+	}
+	for _, key := range []string{NotificationSlackWebhookURLSettingKey, NotificationDiscordWebhookURLSettingKey} {
+		if value := validated[key]; value != "" {
+			if err := checkURLHost(ctx, s.hostGuard, key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(validated))
+	for key := range validated {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	now := time.Now()
+	appSettings := make([]models.AppSetting, 0, len(keys))
+	for _, key := range keys {
+		appSettings = append(appSettings, models.AppSetting{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Key:       key,
+			Value:     validated[key],
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
 	}
 
-	return s.appSettingRepo.Upsert(setting)
+	return s.appSettingRepo.UpsertAll(ctx, appSettings)
 }
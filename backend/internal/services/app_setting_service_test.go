@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppSettingService_UpdateSettings_chatWebhookHosts(t *testing.T) {
+	tests := []struct {
+		name          string
+		settings      map[string]string
+		expectedField string
+	}{
+		{
+			name:          "slack on a local address",
+			settings:      map[string]string{NotificationSlackWebhookURLSettingKey: "http://169.254.169.254/latest/meta-data"},
+			expectedField: NotificationSlackWebhookURLSettingKey,
+		},
+		{
+			name:          "discord on an unknown host",
+			settings:      map[string]string{NotificationDiscordWebhookURLSettingKey: "https://missing.example.com/api/webhooks/1/token"},
+			expectedField: NotificationDiscordWebhookURLSettingKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Without a repository, storing the settings would panic
+			service := NewAppSettingService(nil, testHostGuard)
+
+			err := service.UpdateSettings(context.Background(), uuid.New(), tt.settings)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// Asset types
+const (
+	AssetTypeSecurities = "securities"
+	AssetTypeNISA       = "nisa"
+	AssetTypePension    = "pension"
+	AssetTypeProperty   = "property"
+	AssetTypeCrypto     = "crypto"
+	AssetTypeOther      = "other"
+)
+
+// assetTypes lists the asset types in the order they are reported
+var assetTypes = []string{AssetTypeSecurities, AssetTypeNISA, AssetTypePension, AssetTypeProperty, AssetTypeCrypto, AssetTypeOther}
+
+// maxAssetValuationImportRows limits the number of valuations in one CSV import
+const maxAssetValuationImportRows = 10000
+
+type AssetService struct {
+	assetRepo AssetRepositoryInterface
+}
+
+func NewAssetService(assetRepo AssetRepositoryInterface) *AssetService {
+	return &AssetService{
+		assetRepo: assetRepo,
+	}
+}
+
+func (s *AssetService) GetAssets(ctx context.Context, userID uuid.UUID) ([]models.Asset, error) {
+	ctx, span := tracer.Start(ctx, "AssetService.GetAssets")
+	defer span.End()
+
+	return s.assetRepo.GetAll(ctx, userID)
+}
+
+// GetAsset returns the user's asset, sql.ErrNoRows when the user has no such asset
+func (s *AssetService) GetAsset(ctx context.Context, userID, id uuid.UUID) (*models.Asset, error) {
+	ctx, span := tracer.Start(ctx, "AssetService.GetAsset")
+	defer span.End()
+
+	asset, err := s.assetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if asset.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return asset, nil
+}
+
+func (s *AssetService) CreateAsset(ctx context.Context, asset *models.Asset) error {
+	ctx, span := tracer.Start(ctx, "AssetService.CreateAsset")
+	defer span.End()
+
+	if err := normalizeAsset(asset); err != nil {
+		return err
+	}
+
+	asset.ID = uuid.New()
+	asset.CreatedAt = time.Now()
+	asset.UpdatedAt = time.Now()
+
+	return s.assetRepo.Create(ctx, asset)
+}
+
+func (s *AssetService) UpdateAsset(ctx context.Context, userID uuid.UUID, asset *models.Asset) error {
+	ctx, span := tracer.Start(ctx, "AssetService.UpdateAsset")
+	defer span.End()
+
+	existing, err := s.GetAsset(ctx, userID, asset.ID)
+	if err != nil {
+		return err
+	}
+	if err := normalizeAsset(asset); err != nil {
+		return err
+	}
+
+	asset.UserID = existing.UserID
+	asset.CreatedAt = existing.CreatedAt
+	asset.UpdatedAt = time.Now()
+	return s.assetRepo.Update(ctx, asset)
+}
+
+// DeleteAsset removes the user's asset with its valuations
+func (s *AssetService) DeleteAsset(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "AssetService.DeleteAsset")
+	defer span.End()
+
+	if _, err := s.GetAsset(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.assetRepo.Delete(ctx, id)
+}
+
+func (s *AssetService) GetAssetValuations(ctx context.Context, userID, assetID uuid.UUID) ([]models.AssetValuation, error) {
+	ctx, span := tracer.Start(ctx, "AssetService.GetAssetValuations")
+	defer span.End()
+
+	if _, err := s.GetAsset(ctx, userID, assetID); err != nil {
+		return nil, err
+	}
+	return s.assetRepo.GetValuations(ctx, assetID)
+}
+
+// CreateAssetValuation records the value of an asset on a day, replacing an earlier valuation of the same day
+func (s *AssetService) CreateAssetValuation(ctx context.Context, userID uuid.UUID, valuation *models.AssetValuation) error {
+	ctx, span := tracer.Start(ctx, "AssetService.CreateAssetValuation")
+	defer span.End()
+
+	if err := normalizeAssetValuation(valuation); err != nil {
+		return err
+	}
+	if _, err := s.GetAsset(ctx, userID, valuation.AssetID); err != nil {
+		return err
+	}
+
+	valuation.ID = uuid.New()
+	valuation.CreatedAt = time.Now()
+	valuation.UpdatedAt = time.Now()
+
+	valuations := []models.AssetValuation{*valuation}
+	if err := s.assetRepo.UpsertValuations(ctx, valuations); err != nil {
+		return err
+	}
+	*valuation = valuations[0]
+	return nil
+}
+
+// DeleteAssetValuation removes a valuation of the user's asset
+func (s *AssetService) DeleteAssetValuation(ctx context.Context, userID, assetID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "AssetService.DeleteAssetValuation")
+	defer span.End()
+
+	if _, err := s.GetAsset(ctx, userID, assetID); err != nil {
+		return err
+	}
+	return s.assetRepo.DeleteValuation(ctx, assetID, id)
+}
+
+// ImportAssetValuations stores the valuations of a CSV file with the columns date, asset and value,
+// e.g. "2024-01-31,NISA,1234567". The asset is given by name or ID and the value in units of the
+// asset's currency. A header line is skipped. The file is imported as a whole or not at all.
+func (s *AssetService) ImportAssetValuations(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.AssetValuationImportResult, error) {
+	ctx, span := tracer.Start(ctx, "AssetService.ImportAssetValuations")
+	defer span.End()
+
+	assets, err := s.assetRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	valuations, err := parseAssetValuationCSV(r, assets)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range valuations {
+		valuations[i].ID = uuid.New()
+		valuations[i].CreatedAt = now
+		valuations[i].UpdatedAt = now
+	}
+
+	if err := s.assetRepo.UpsertValuations(ctx, valuations); err != nil {
+		return nil, err
+	}
+	return &models.AssetValuationImportResult{Imported: len(valuations)}, nil
+}
+
+// normalizeAsset validates an asset and upper-cases its currency code
+func normalizeAsset(asset *models.Asset) error {
+	asset.Name = strings.TrimSpace(asset.Name)
+	if asset.Name == "" {
+		return NewValidationError("name", "is required")
+	}
+	if !slices.Contains(assetTypes, asset.AssetType) {
+		return NewValidationError("asset_type", "must be one of %s", strings.Join(assetTypes, ", "))
+	}
+	return normalizeCurrency("currency", &asset.Currency)
+}
+
+// normalizeAssetValuation validates a valuation
+func normalizeAssetValuation(valuation *models.AssetValuation) error {
+	if valuation.AssetID == uuid.Nil {
+		return NewValidationError("asset_id", "is required")
+	}
+	if _, err := time.Parse("2006-01-02", valuation.ValuationDate); err != nil {
+		return NewValidationError("valuation_date", "must be in YYYY-MM-DD format")
+	}
+	if valuation.Value < 0 {
+		return NewValidationError("value", "must not be negative")
+	}
+	return nil
+}
+
+// parseAssetValuationCSV reads and validates the valuations of an import file. Errors name the line
+// of the file they were found on.
+func parseAssetValuationCSV(r io.Reader, assets []models.Asset) ([]models.AssetValuation, error) {
+	assetIDs := make(map[string]uuid.UUID, 2*len(assets))
+	for _, asset := range assets {
+		assetIDs[strings.ToLower(asset.Name)] = asset.ID
+		assetIDs[asset.ID.String()] = asset.ID
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	valuations := make([]models.AssetValuation, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, NewValidationError("file", "line %d: %s", parseErr.Line, parseErr.Err)
+			}
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+		if len(valuations) == maxAssetValuationImportRows {
+			return nil, NewValidationError("file", "must not contain more than %d valuations", maxAssetValuationImportRows)
+		}
+
+		assetID, ok := assetIDs[strings.ToLower(strings.TrimSpace(record[1]))]
+		if !ok {
+			return nil, NewValidationError("file", "line %d: unknown asset %q", line, strings.TrimSpace(record[1]))
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, NewValidationError("file", "line %d: value must be a number", line)
+		}
+
+		valuation := models.AssetValuation{
+			AssetID:       assetID,
+			ValuationDate: strings.TrimSpace(record[0]),
+			Value:         int64(math.Round(value * 100)),
+		}
+		if err := normalizeAssetValuation(&valuation); err != nil {
+			return nil, NewValidationError("file", "line %d: %s", line, err)
+		}
+		valuations = append(valuations, valuation)
+	}
+
+	if len(valuations) == 0 {
+		return nil, NewValidationError("file", "contains no valuations")
+	}
+	return valuations, nil
+}
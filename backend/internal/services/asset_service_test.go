@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNormalizeAsset(t *testing.T) {
+	asset := models.Asset{Name: " NISA ", AssetType: AssetTypeNISA}
+	assert.NoError(t, normalizeAsset(&asset))
+	assert.Equal(t, "NISA", asset.Name)
+	assert.Equal(t, "JPY", asset.Currency)
+
+	invalid := []struct {
+		name  string
+		asset models.Asset
+		field string
+	}{
+		{name: "missing name", asset: models.Asset{AssetType: AssetTypeNISA}, field: "name"},
+		{name: "unknown type", asset: models.Asset{Name: "Gold", AssetType: "gold"}, field: "asset_type"},
+		{name: "bank accounts are not assets", asset: models.Asset{Name: "Savings", AssetType: AssetTypeBankAccount}, field: "asset_type"},
+		{name: "unknown currency", asset: models.Asset{Name: "Bitcoin", AssetType: AssetTypeCrypto, Currency: "BTC"}, field: "currency"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, normalizeAsset(&tt.asset), &validationErr) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestAssetService_CreateAssetValuation(t *testing.T) {
+	mockRepo := &mocks.MockAssetRepository{}
+	service := NewAssetService(mockRepo)
+	userID := uuid.New()
+	assetID := uuid.New()
+	storedID := uuid.New()
+
+	mockRepo.On("GetByID", mock.Anything, assetID).Return(&models.Asset{ID: assetID, UserID: userID}, nil)
+	mockRepo.On("UpsertValuations", mock.Anything, mock.MatchedBy(func(valuations []models.AssetValuation) bool {
+		return len(valuations) == 1 && valuations[0].AssetID == assetID && valuations[0].ID != uuid.Nil
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).([]models.AssetValuation)[0].ID = storedID
+	}).Return(nil)
+
+	valuation := models.AssetValuation{AssetID: assetID, ValuationDate: "2024-01-31", Value: 120000000}
+	assert.NoError(t, service.CreateAssetValuation(context.Background(), userID, &valuation))
+	assert.Equal(t, storedID, valuation.ID, "ID of the stored valuation")
+
+	negative := models.AssetValuation{AssetID: assetID, ValuationDate: "2024-01-31", Value: -1}
+	assert.Error(t, service.CreateAssetValuation(context.Background(), userID, &negative))
+
+	other := models.AssetValuation{AssetID: assetID, ValuationDate: "2024-01-31", Value: 100}
+	assert.ErrorIs(t, service.CreateAssetValuation(context.Background(), uuid.New(), &other), sql.ErrNoRows)
+	mockRepo.AssertNumberOfCalls(t, "UpsertValuations", 1)
+}
+
+func TestAssetService_GetAsset(t *testing.T) {
+	mockRepo := &mocks.MockAssetRepository{}
+	service := NewAssetService(mockRepo)
+	userID := uuid.New()
+	asset := &models.Asset{ID: uuid.New(), UserID: userID, Name: "NISA"}
+	mockRepo.On("GetByID", mock.Anything, asset.ID).Return(asset, nil)
+
+	found, err := service.GetAsset(context.Background(), userID, asset.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, asset, found)
+
+	_, err = service.GetAsset(context.Background(), uuid.New(), asset.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows, "another user's asset is not found")
+}
+
+func TestAssetService_DeleteAssetValuation(t *testing.T) {
+	mockRepo := &mocks.MockAssetRepository{}
+	service := NewAssetService(mockRepo)
+	userID := uuid.New()
+	assetID, valuationID := uuid.New(), uuid.New()
+	mockRepo.On("GetByID", mock.Anything, assetID).Return(&models.Asset{ID: assetID, UserID: userID}, nil)
+	mockRepo.On("DeleteValuation", mock.Anything, assetID, valuationID).Return(nil)
+
+	assert.ErrorIs(t, service.DeleteAssetValuation(context.Background(), uuid.New(), assetID, valuationID), sql.ErrNoRows)
+	mockRepo.AssertNotCalled(t, "DeleteValuation", mock.Anything, mock.Anything, mock.Anything)
+
+	assert.NoError(t, service.DeleteAssetValuation(context.Background(), userID, assetID, valuationID))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAssetService_ImportAssetValuations(t *testing.T) {
+	userID := uuid.New()
+	nisa := models.Asset{ID: uuid.New(), UserID: userID, Name: "NISA", AssetType: AssetTypeNISA, Currency: "JPY"}
+	stocks := models.Asset{ID: uuid.New(), UserID: userID, Name: "US Stocks", AssetType: AssetTypeSecurities, Currency: "USD"}
+
+	tests := []struct {
+		name          string
+		csv           string
+		setupMock     func(*mocks.MockAssetRepository)
+		expected      int
+		expectedError string
+	}{
+		{
+			name: "by name and ID with header",
+			csv:  "date,asset,value\n2024-01-31,nisa,1234567\n2024-01-31," + stocks.ID.String() + ",10500.25\n",
+			setupMock: func(m *mocks.MockAssetRepository) {
+				m.On("UpsertValuations", mock.Anything, mock.MatchedBy(func(valuations []models.AssetValuation) bool {
+					return len(valuations) == 2 &&
+						valuations[0].AssetID == nisa.ID && valuations[0].Value == 123456700 &&
+						valuations[1].AssetID == stocks.ID && valuations[1].Value == 1050025
+				})).Return(nil)
+			},
+			expected: 2,
+		},
+		{
+			name:          "unknown asset names the line",
+			csv:           "2024-01-31,NISA,1000\n2024-01-31,Gold,1000\n",
+			setupMock:     func(m *mocks.MockAssetRepository) {},
+			expectedError: `file: line 2: unknown asset "Gold"`,
+		},
+		{
+			name:          "invalid value names the line",
+			csv:           "2024-01-31,NISA,abc\n",
+			setupMock:     func(m *mocks.MockAssetRepository) {},
+			expectedError: "file: line 1: value must be a number",
+		},
+		{
+			name:          "invalid date names the line",
+			csv:           "2024-02-30,NISA,1000\n",
+			setupMock:     func(m *mocks.MockAssetRepository) {},
+			expectedError: "file: line 1: valuation_date: must be in YYYY-MM-DD format",
+		},
+		{
+			name:          "empty file",
+			csv:           "date,asset,value\n",
+			setupMock:     func(m *mocks.MockAssetRepository) {},
+			expectedError: "file: contains no valuations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockAssetRepository{}
+			service := NewAssetService(mockRepo)
+			mockRepo.On("GetAll", mock.Anything, userID).Return([]models.Asset{nisa, stocks}, nil)
+			tt.setupMock(mockRepo)
+
+			result, err := service.ImportAssetValuations(context.Background(), userID, strings.NewReader(tt.csv))
+
+			if tt.expectedError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.expectedError)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result.Imported)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -56,15 +56,18 @@ func (s *AuthService) GetGoogleAuthURL(state string) string {
 	return s.oauthConfig.AuthCodeURL(state, oauth2.AccessTypeOffline)
 }
 
-func (s *AuthService) HandleGoogleCallback(code string) (*models.User, string, error) {
+func (s *AuthService) HandleGoogleCallback(ctx context.Context, code string) (*models.User, string, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.HandleGoogleCallback")
+	defer span.End()
+
 	// Exchange code for token
-	token, err := s.oauthConfig.Exchange(context.Background(), code)
+	token, err := s.oauthConfig.Exchange(ctx, code)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to exchange token: %w", err)
 	}
 
 	// Get user info from Google
-	client := s.oauthConfig.Client(context.Background(), token)
+	client := s.oauthConfig.Client(ctx, token)
 	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to get user info: %w", err)
@@ -77,14 +80,14 @@ func (s *AuthService) HandleGoogleCallback(code string) (*models.User, string, e
 	}
 
 	// Check if user exists by Google ID first
-	user, err := s.userRepo.GetByGoogleID(googleUser.ID)
+	user, err := s.userRepo.GetByGoogleID(ctx, googleUser.ID)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, "", fmt.Errorf("failed to get user by google id: %w", err)
 	}
 
 	// If user doesn't exist by Google ID, check by email
 	if user == nil {
-		user, err = s.userRepo.GetByEmail(googleUser.Email)
+		user, err = s.userRepo.GetByEmail(ctx, googleUser.Email)
 		if err != nil && err != sql.ErrNoRows {
 			return nil, "", fmt.Errorf("failed to get user by email: %w", err)
 		}
@@ -94,7 +97,7 @@ func (s *AuthService) HandleGoogleCallback(code string) (*models.User, string, e
 			user.GoogleID = googleUser.ID
 			user.Name = googleUser.Name
 			user.Picture = googleUser.Picture
-			if err := s.userRepo.Update(user); err != nil {
+			if err := s.userRepo.Update(ctx, user); err != nil {
 				return nil, "", fmt.Errorf("failed to update user: %w", err)
 			}
 		}
@@ -108,12 +111,12 @@ func (s *AuthService) HandleGoogleCallback(code string) (*models.User, string, e
 			Picture:  googleUser.Picture,
 			GoogleID: googleUser.ID,
 		}
-		if err := s.userRepo.Create(user); err != nil {
+		if err := s.userRepo.Create(ctx, user); err != nil {
 			return nil, "", fmt.Errorf("failed to create user: %w", err)
 		}
 
 		// Verify user was created successfully by re-fetching
-		user, err = s.userRepo.GetByGoogleID(googleUser.ID)
+		user, err = s.userRepo.GetByGoogleID(ctx, googleUser.ID)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to verify user creation: %w", err)
 		}
@@ -161,14 +164,17 @@ func (s *AuthService) ValidateJWT(tokenString string) (*Claims, error) {
 	return nil, errors.New("invalid token")
 }
 
-func (s *AuthService) GetUserByID(userID string) (*models.User, error) {
+func (s *AuthService) GetUserByID(ctx context.Context, userID string) (*models.User, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.GetUserByID")
+	defer span.End()
+
 	// Parse UUID string directly
 	uid, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid user id: %w", err)
 	}
 
-	user, err := s.userRepo.GetByID(uid)
+	user, err := s.userRepo.GetByID(ctx, uid)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("user not found with id %s: %w", userID, err)
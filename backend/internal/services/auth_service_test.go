@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"database/sql"
 	"github.com/Soli0222/flow-sight/backend/internal/config"
 	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
@@ -77,7 +78,7 @@ func TestAuthService_GetUserByID(t *testing.T) {
 			userID: uuid.New().String(),
 			setupMock: func(m *mocks.MockUserRepository) {
 				user := helpers.CreateTestUser()
-				m.On("GetByID", mock.AnythingOfType("uuid.UUID")).Return(user, nil)
+				m.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).Return(user, nil)
 			},
 			expectedError: false,
 			expectedNil:   false,
@@ -86,7 +87,7 @@ func TestAuthService_GetUserByID(t *testing.T) {
 			name:   "user not found",
 			userID: uuid.New().String(),
 			setupMock: func(m *mocks.MockUserRepository) {
-				m.On("GetByID", mock.AnythingOfType("uuid.UUID")).Return(nil, sql.ErrNoRows)
+				m.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).Return(nil, sql.ErrNoRows)
 			},
 			expectedError: true,
 			expectedNil:   true,
@@ -104,7 +105,7 @@ func TestAuthService_GetUserByID(t *testing.T) {
 			name:   "repository error",
 			userID: uuid.New().String(),
 			setupMock: func(m *mocks.MockUserRepository) {
-				m.On("GetByID", mock.AnythingOfType("uuid.UUID")).Return(nil, assert.AnError)
+				m.On("GetByID", mock.Anything, mock.AnythingOfType("uuid.UUID")).Return(nil, assert.AnError)
 			},
 			expectedError: true,
 			expectedNil:   true,
@@ -116,7 +117,7 @@ func TestAuthService_GetUserByID(t *testing.T) {
 			mockRepo.ExpectedCalls = nil
 			tt.setupMock(mockRepo)
 
-			result, err := service.GetUserByID(tt.userID)
+			result, err := service.GetUserByID(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"time"
 
@@ -9,35 +10,89 @@ import (
 
 type BankAccountService struct {
 	bankAccountRepo BankAccountRepositoryInterface
+	publisher       EventPublisherInterface // nil when changes are not published
 }
 
-func NewBankAccountService(bankAccountRepo BankAccountRepositoryInterface) *BankAccountService {
+func NewBankAccountService(bankAccountRepo BankAccountRepositoryInterface, publisher EventPublisherInterface) *BankAccountService {
 	return &BankAccountService{
 		bankAccountRepo: bankAccountRepo,
+		publisher:       publisher,
 	}
 }
 
-func (s *BankAccountService) GetBankAccounts(userID uuid.UUID) ([]models.BankAccount, error) {
-	return s.bankAccountRepo.GetAll(userID)
+func (s *BankAccountService) GetBankAccounts(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error) {
+	ctx, span := tracer.Start(ctx, "BankAccountService.GetBankAccounts")
+	defer span.End()
+
+	return s.bankAccountRepo.GetAll(ctx, userID)
 }
 
-func (s *BankAccountService) GetBankAccount(id uuid.UUID) (*models.BankAccount, error) {
-	return s.bankAccountRepo.GetByID(id)
+func (s *BankAccountService) GetBankAccount(ctx context.Context, id uuid.UUID) (*models.BankAccount, error) {
+	ctx, span := tracer.Start(ctx, "BankAccountService.GetBankAccount")
+	defer span.End()
+
+	return s.bankAccountRepo.GetByID(ctx, id)
 }
 
-func (s *BankAccountService) CreateBankAccount(account *models.BankAccount) error {
+func (s *BankAccountService) CreateBankAccount(ctx context.Context, account *models.BankAccount) error {
+	ctx, span := tracer.Start(ctx, "BankAccountService.CreateBankAccount")
+	defer span.End()
+
+	if err := normalizeCurrency("currency", &account.Currency); err != nil {
+		return err
+	}
+
 	account.ID = uuid.New()
 	account.CreatedAt = time.Now()
 	account.UpdatedAt = time.Now()
 
-	return s.bankAccountRepo.Create(account)
+	if err := s.bankAccountRepo.Create(ctx, account); err != nil {
+		return err
+	}
+	publishEvent(ctx, s.publisher, account.UserID, entityEvent(EntityBankAccount, EntityCreated), account)
+	return nil
 }
 
-func (s *BankAccountService) UpdateBankAccount(account *models.BankAccount) error {
+func (s *BankAccountService) UpdateBankAccount(ctx context.Context, account *models.BankAccount) error {
+	ctx, span := tracer.Start(ctx, "BankAccountService.UpdateBankAccount")
+	defer span.End()
+
+	if err := normalizeCurrency("currency", &account.Currency); err != nil {
+		return err
+	}
+
 	account.UpdatedAt = time.Now()
-	return s.bankAccountRepo.Update(account)
+	if err := s.bankAccountRepo.Update(ctx, account); err != nil {
+		return err
+	}
+	if stored := s.storedForEvent(ctx, account.ID); stored != nil {
+		publishEvent(ctx, s.publisher, stored.UserID, entityEvent(EntityBankAccount, EntityUpdated), stored)
+	}
+	return nil
 }
 
-func (s *BankAccountService) DeleteBankAccount(id uuid.UUID) error {
-	return s.bankAccountRepo.Delete(id)
+func (s *BankAccountService) DeleteBankAccount(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "BankAccountService.DeleteBankAccount")
+	defer span.End()
+
+	deleted := s.storedForEvent(ctx, id)
+	if err := s.bankAccountRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if deleted != nil {
+		publishEvent(ctx, s.publisher, deleted.UserID, entityEvent(EntityBankAccount, EntityDeleted), deleted)
+	}
+	return nil
+}
+
+// storedForEvent returns the stored bank account an event is about, nil without a publisher
+func (s *BankAccountService) storedForEvent(ctx context.Context, id uuid.UUID) *models.BankAccount {
+	if s.publisher == nil {
+		return nil
+	}
+	account, err := s.bankAccountRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return account
 }
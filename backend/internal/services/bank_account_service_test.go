@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -13,7 +14,7 @@ import (
 
 func TestBankAccountService_GetBankAccounts(t *testing.T) {
 	mockRepo := &mocks.MockBankAccountRepository{}
-	service := NewBankAccountService(mockRepo)
+	service := NewBankAccountService(mockRepo, nil)
 	userID := uuid.New()
 
 	tests := []struct {
@@ -31,7 +32,7 @@ func TestBankAccountService_GetBankAccounts(t *testing.T) {
 					*helpers.CreateTestBankAccount(userID),
 					*helpers.CreateTestBankAccount(userID),
 				}
-				m.On("GetAll", userID).Return(accounts, nil)
+				m.On("GetAll", mock.Anything, userID).Return(accounts, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -40,7 +41,7 @@ func TestBankAccountService_GetBankAccounts(t *testing.T) {
 			name:   "empty result",
 			userID: userID,
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("GetAll", userID).Return([]models.BankAccount{}, nil)
+				m.On("GetAll", mock.Anything, userID).Return([]models.BankAccount{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -49,7 +50,7 @@ func TestBankAccountService_GetBankAccounts(t *testing.T) {
 			name:   "repository error",
 			userID: userID,
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("GetAll", userID).Return([]models.BankAccount{}, assert.AnError)
+				m.On("GetAll", mock.Anything, userID).Return([]models.BankAccount{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -64,7 +65,7 @@ func TestBankAccountService_GetBankAccounts(t *testing.T) {
 
 			tt.setupMock(mockRepo)
 
-			accounts, err := service.GetBankAccounts(tt.userID)
+			accounts, err := service.GetBankAccounts(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -80,7 +81,7 @@ func TestBankAccountService_GetBankAccounts(t *testing.T) {
 
 func TestBankAccountService_GetBankAccount(t *testing.T) {
 	mockRepo := &mocks.MockBankAccountRepository{}
-	service := NewBankAccountService(mockRepo)
+	service := NewBankAccountService(mockRepo, nil)
 	accountID := uuid.New()
 	userID := uuid.New()
 
@@ -97,7 +98,7 @@ func TestBankAccountService_GetBankAccount(t *testing.T) {
 			setupMock: func(m *mocks.MockBankAccountRepository) {
 				account := helpers.CreateTestBankAccount(userID)
 				account.ID = accountID
-				m.On("GetByID", accountID).Return(account, nil)
+				m.On("GetByID", mock.Anything, accountID).Return(account, nil)
 			},
 			expectedFound: true,
 			expectedError: false,
@@ -106,7 +107,7 @@ func TestBankAccountService_GetBankAccount(t *testing.T) {
 			name:      "account not found",
 			accountID: accountID,
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("GetByID", accountID).Return(nil, assert.AnError)
+				m.On("GetByID", mock.Anything, accountID).Return(nil, assert.AnError)
 			},
 			expectedFound: false,
 			expectedError: true,
@@ -121,7 +122,7 @@ func TestBankAccountService_GetBankAccount(t *testing.T) {
 
 			tt.setupMock(mockRepo)
 
-			account, err := service.GetBankAccount(tt.accountID)
+			account, err := service.GetBankAccount(context.Background(), tt.accountID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -139,7 +140,7 @@ func TestBankAccountService_GetBankAccount(t *testing.T) {
 
 func TestBankAccountService_CreateBankAccount(t *testing.T) {
 	mockRepo := &mocks.MockBankAccountRepository{}
-	service := NewBankAccountService(mockRepo)
+	service := NewBankAccountService(mockRepo, nil)
 	userID := uuid.New()
 
 	tests := []struct {
@@ -152,7 +153,7 @@ func TestBankAccountService_CreateBankAccount(t *testing.T) {
 			name:    "successful creation",
 			account: helpers.CreateTestBankAccount(userID),
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("Create", mock.AnythingOfType("*models.BankAccount")).Return(nil)
+				m.On("Create", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(nil)
 			},
 			expectedError: false,
 		},
@@ -160,7 +161,7 @@ func TestBankAccountService_CreateBankAccount(t *testing.T) {
 			name:    "repository error",
 			account: helpers.CreateTestBankAccount(userID),
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("Create", mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
+				m.On("Create", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -174,7 +175,7 @@ func TestBankAccountService_CreateBankAccount(t *testing.T) {
 
 			tt.setupMock(mockRepo)
 
-			err := service.CreateBankAccount(tt.account)
+			err := service.CreateBankAccount(context.Background(), tt.account)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -191,7 +192,7 @@ func TestBankAccountService_CreateBankAccount(t *testing.T) {
 
 func TestBankAccountService_UpdateBankAccount(t *testing.T) {
 	mockRepo := &mocks.MockBankAccountRepository{}
-	service := NewBankAccountService(mockRepo)
+	service := NewBankAccountService(mockRepo, nil)
 	userID := uuid.New()
 
 	tests := []struct {
@@ -204,7 +205,7 @@ func TestBankAccountService_UpdateBankAccount(t *testing.T) {
 			name:    "successful update",
 			account: helpers.CreateTestBankAccount(userID),
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("Update", mock.AnythingOfType("*models.BankAccount")).Return(nil)
+				m.On("Update", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(nil)
 			},
 			expectedError: false,
 		},
@@ -212,7 +213,7 @@ func TestBankAccountService_UpdateBankAccount(t *testing.T) {
 			name:    "repository error",
 			account: helpers.CreateTestBankAccount(userID),
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("Update", mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
+				m.On("Update", mock.Anything, mock.AnythingOfType("*models.BankAccount")).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -226,7 +227,7 @@ func TestBankAccountService_UpdateBankAccount(t *testing.T) {
 
 			tt.setupMock(mockRepo)
 
-			err := service.UpdateBankAccount(tt.account)
+			err := service.UpdateBankAccount(context.Background(), tt.account)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -241,7 +242,7 @@ func TestBankAccountService_UpdateBankAccount(t *testing.T) {
 
 func TestBankAccountService_DeleteBankAccount(t *testing.T) {
 	mockRepo := &mocks.MockBankAccountRepository{}
-	service := NewBankAccountService(mockRepo)
+	service := NewBankAccountService(mockRepo, nil)
 	accountID := uuid.New()
 
 	tests := []struct {
@@ -254,7 +255,7 @@ func TestBankAccountService_DeleteBankAccount(t *testing.T) {
 			name:      "successful deletion",
 			accountID: accountID,
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("Delete", accountID).Return(nil)
+				m.On("Delete", mock.Anything, accountID).Return(nil)
 			},
 			expectedError: false,
 		},
@@ -262,7 +263,7 @@ func TestBankAccountService_DeleteBankAccount(t *testing.T) {
 			name:      "repository error",
 			accountID: accountID,
 			setupMock: func(m *mocks.MockBankAccountRepository) {
-				m.On("Delete", accountID).Return(assert.AnError)
+				m.On("Delete", mock.Anything, accountID).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -276,7 +277,7 @@ func TestBankAccountService_DeleteBankAccount(t *testing.T) {
 
 			tt.setupMock(mockRepo)
 
-			err := service.DeleteBankAccount(tt.accountID)
+			err := service.DeleteBankAccount(context.Background(), tt.accountID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
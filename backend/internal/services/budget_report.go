@@ -0,0 +1,133 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// normalizeCategory validates a spending category
+func normalizeCategory(category *models.Category) error {
+	if category.Name == "" {
+		return NewValidationError("name", "is required")
+	}
+	if strings.Contains(category.Name, categoryPathSeparator) {
+		return NewValidationError("name", "must not contain %q", categoryPathSeparator)
+	}
+	return nil
+}
+
+// normalizeBudget validates a category budget
+func normalizeBudget(budget *models.Budget) error {
+	if budget.CategoryID == uuid.Nil {
+		return NewValidationError("category_id", "is required")
+	}
+	if budget.Amount < 0 {
+		return NewValidationError("amount", "must not be negative")
+	}
+	if budget.YearMonth != nil {
+		if _, err := time.Parse("2006-01", *budget.YearMonth); err != nil {
+			return NewValidationError("year_month", "must be in YYYY-MM format")
+		}
+	}
+	return nil
+}
+
+// normalizeTransaction validates a spending transaction
+func normalizeTransaction(transaction *models.Transaction) error {
+	if _, err := time.Parse("2006-01-02", transaction.TransactionDate); err != nil {
+		return NewValidationError("transaction_date", "must be in YYYY-MM-DD format")
+	}
+	if transaction.Amount == 0 {
+		return NewValidationError("amount", "must not be zero")
+	}
+	if transaction.BankAccount != nil && transaction.CreditCard != nil {
+		return NewValidationError("credit_card", "a transaction is paid from either a bank account or a credit card")
+	}
+
+	tags, err := normalizeTags(transaction.Tags)
+	if err != nil {
+		return err
+	}
+	transaction.Tags = tags
+	return nil
+}
+
+// budgetsForMonth returns the budget of each category in the given month. A budget for the month
+// overrides the category's default budget.
+func budgetsForMonth(budgets []models.Budget, yearMonth string) map[uuid.UUID]int64 {
+	amounts := make(map[uuid.UUID]int64)
+	for _, budget := range budgets {
+		if budget.YearMonth == nil {
+			if _, found := amounts[budget.CategoryID]; !found {
+				amounts[budget.CategoryID] = budget.Amount
+			}
+		}
+	}
+	for _, budget := range budgets {
+		if budget.YearMonth != nil && *budget.YearMonth == yearMonth {
+			amounts[budget.CategoryID] = budget.Amount
+		}
+	}
+	return amounts
+}
+
+// monthlyBudgetTotal returns the sum of every category's budget in the given month
+func monthlyBudgetTotal(budgets []models.Budget, yearMonth string) int64 {
+	total := int64(0)
+	for _, amount := range budgetsForMonth(budgets, yearMonth) {
+		total += amount
+	}
+	return total
+}
+
+// budgetReport compares the budgets of a month with the transactions dated in it. Categories
+// with neither a budget nor spending are left out.
+func budgetReport(categories []models.Category, budgets []models.Budget, transactions []models.Transaction, yearMonth string) *models.BudgetReport {
+	report := &models.BudgetReport{
+		YearMonth:  yearMonth,
+		Categories: make([]models.BudgetReportEntry, 0),
+	}
+
+	budgeted := budgetsForMonth(budgets, yearMonth)
+	actuals := make(map[uuid.UUID]int64)
+	for _, transaction := range transactions {
+		report.TotalActual += transaction.Amount
+		if transaction.CategoryID == nil {
+			report.UncategorizedActual += transaction.Amount
+			continue
+		}
+		actuals[*transaction.CategoryID] += transaction.Amount
+	}
+
+	for _, category := range categories {
+		budget, hasBudget := budgeted[category.ID]
+		actual, hasActual := actuals[category.ID]
+		if !hasBudget && !hasActual {
+			continue
+		}
+
+		entry := models.BudgetReportEntry{
+			CategoryID:   category.ID,
+			CategoryName: category.Name,
+			Budget:       budget,
+			Actual:       actual,
+			Remaining:    budget - actual,
+		}
+		if budget > 0 {
+			entry.UsageRate = math.Round(float64(actual)/float64(budget)*1000) / 10
+		}
+		report.TotalBudget += budget
+		report.Categories = append(report.Categories, entry)
+	}
+
+	sort.SliceStable(report.Categories, func(i, j int) bool {
+		return report.Categories[i].CategoryName < report.Categories[j].CategoryName
+	})
+	return report
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetsForMonth(t *testing.T) {
+	food, leisure := uuid.New(), uuid.New()
+	december := "2024-12"
+	budgets := []models.Budget{
+		{CategoryID: food, Amount: 50000},
+		{CategoryID: leisure, Amount: 20000},
+		{CategoryID: leisure, Amount: 60000, YearMonth: &december},
+	}
+
+	assert.Equal(t, map[uuid.UUID]int64{food: 50000, leisure: 20000}, budgetsForMonth(budgets, "2024-11"))
+	assert.Equal(t, map[uuid.UUID]int64{food: 50000, leisure: 60000}, budgetsForMonth(budgets, "2024-12"))
+	assert.Equal(t, int64(110000), monthlyBudgetTotal(budgets, "2024-12"))
+	assert.Equal(t, int64(0), monthlyBudgetTotal(nil, "2024-12"))
+}
+
+func TestBudgetReport(t *testing.T) {
+	food := models.Category{ID: uuid.New(), Name: "Food"}
+	leisure := models.Category{ID: uuid.New(), Name: "Leisure"}
+	gifts := models.Category{ID: uuid.New(), Name: "Gifts"}
+	unused := models.Category{ID: uuid.New(), Name: "Unused"}
+
+	budgets := []models.Budget{
+		{CategoryID: food.ID, Amount: 50000},
+		{CategoryID: leisure.ID, Amount: 20000},
+	}
+	transactions := []models.Transaction{
+		{CategoryID: &food.ID, Amount: 32000},
+		{CategoryID: &food.ID, Amount: -2000},
+		{CategoryID: &leisure.ID, Amount: 25000},
+		{CategoryID: &gifts.ID, Amount: 8000},
+		{Amount: 1500},
+	}
+
+	report := budgetReport([]models.Category{unused, leisure, gifts, food}, budgets, transactions, "2024-12")
+
+	assert.Equal(t, "2024-12", report.YearMonth)
+	assert.Equal(t, int64(70000), report.TotalBudget)
+	assert.Equal(t, int64(64500), report.TotalActual)
+	assert.Equal(t, int64(1500), report.UncategorizedActual)
+	require.Len(t, report.Categories, 3)
+
+	assert.Equal(t, "Food", report.Categories[0].CategoryName)
+	assert.Equal(t, int64(30000), report.Categories[0].Actual)
+	assert.Equal(t, int64(20000), report.Categories[0].Remaining)
+	assert.Equal(t, 60.0, report.Categories[0].UsageRate)
+
+	// Spending without a budget
+	assert.Equal(t, "Gifts", report.Categories[1].CategoryName)
+	assert.Equal(t, int64(-8000), report.Categories[1].Remaining)
+	assert.Equal(t, 0.0, report.Categories[1].UsageRate)
+
+	// Over budget
+	assert.Equal(t, int64(-5000), report.Categories[2].Remaining)
+	assert.Equal(t, 125.0, report.Categories[2].UsageRate)
+}
+
+func TestNormalizeTransaction(t *testing.T) {
+	bankAccount, creditCard := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		transaction models.Transaction
+		field       string
+	}{
+		{name: "valid", transaction: models.Transaction{TransactionDate: "2024-12-20", Amount: 3200, CreditCard: &creditCard}},
+		{name: "bad date", transaction: models.Transaction{TransactionDate: "2024/12/20", Amount: 3200}, field: "transaction_date"},
+		{name: "zero amount", transaction: models.Transaction{TransactionDate: "2024-12-20"}, field: "amount"},
+		{name: "two payment sources", transaction: models.Transaction{TransactionDate: "2024-12-20", Amount: 3200, BankAccount: &bankAccount, CreditCard: &creditCard}, field: "credit_card"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := normalizeTransaction(&tt.transaction)
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BudgetService struct {
+	categoryRepo      CategoryRepositoryInterface
+	budgetRepo        BudgetRepositoryInterface
+	transactionRepo   TransactionRepositoryInterface
+	bankAccountRepo   BankAccountRepositoryInterface
+	creditCardRepo    CreditCardRepositoryInterface
+	cashflowProjector CashflowProjectorInterface
+}
+
+func NewBudgetService(
+	categoryRepo CategoryRepositoryInterface,
+	budgetRepo BudgetRepositoryInterface,
+	transactionRepo TransactionRepositoryInterface,
+	bankAccountRepo BankAccountRepositoryInterface,
+	creditCardRepo CreditCardRepositoryInterface,
+	cashflowProjector CashflowProjectorInterface,
+) *BudgetService {
+	return &BudgetService{
+		categoryRepo:      categoryRepo,
+		budgetRepo:        budgetRepo,
+		transactionRepo:   transactionRepo,
+		bankAccountRepo:   bankAccountRepo,
+		creditCardRepo:    creditCardRepo,
+		cashflowProjector: cashflowProjector,
+	}
+}
+
+// Category methods
+func (s *BudgetService) GetCategories(ctx context.Context, userID uuid.UUID) ([]models.Category, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetCategories")
+	defer span.End()
+
+	return s.categoryRepo.GetAll(ctx, userID)
+}
+
+// GetCategory returns the user's category, sql.ErrNoRows when the user has no such category
+func (s *BudgetService) GetCategory(ctx context.Context, userID, id uuid.UUID) (*models.Category, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetCategory")
+	defer span.End()
+
+	category, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if category.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return category, nil
+}
+
+func (s *BudgetService) CreateCategory(ctx context.Context, category *models.Category) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.CreateCategory")
+	defer span.End()
+
+	if err := normalizeCategory(category); err != nil {
+		return err
+	}
+
+	category.ID = uuid.New()
+	if err := s.validateParent(ctx, category); err != nil {
+		return err
+	}
+
+	category.CreatedAt = time.Now()
+	category.UpdatedAt = time.Now()
+
+	return s.categoryRepo.Create(ctx, category)
+}
+
+func (s *BudgetService) UpdateCategory(ctx context.Context, userID uuid.UUID, category *models.Category) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.UpdateCategory")
+	defer span.End()
+
+	if err := normalizeCategory(category); err != nil {
+		return err
+	}
+
+	existing, err := s.GetCategory(ctx, userID, category.ID)
+	if err != nil {
+		return err
+	}
+	category.UserID = existing.UserID
+
+	if err := s.validateParent(ctx, category); err != nil {
+		return err
+	}
+
+	category.UpdatedAt = time.Now()
+	return s.categoryRepo.Update(ctx, category)
+}
+
+// validateParent checks the parent of a category against the user's other categories
+func (s *BudgetService) validateParent(ctx context.Context, category *models.Category) error {
+	if category.ParentID == nil {
+		return nil
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx, category.UserID)
+	if err != nil {
+		return err
+	}
+	return validateCategoryParent(category, categories)
+}
+
+// DeleteCategory removes the user's category
+func (s *BudgetService) DeleteCategory(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.DeleteCategory")
+	defer span.End()
+
+	if _, err := s.GetCategory(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.categoryRepo.Delete(ctx, id)
+}
+
+// Budget methods
+func (s *BudgetService) GetBudgets(ctx context.Context, userID uuid.UUID) ([]models.Budget, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetBudgets")
+	defer span.End()
+
+	return s.budgetRepo.GetAll(ctx, userID)
+}
+
+// GetBudget returns the user's budget, sql.ErrNoRows when the user has no such budget
+func (s *BudgetService) GetBudget(ctx context.Context, userID, id uuid.UUID) (*models.Budget, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetBudget")
+	defer span.End()
+
+	budget, err := s.budgetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if budget.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return budget, nil
+}
+
+func (s *BudgetService) CreateBudget(ctx context.Context, budget *models.Budget) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.CreateBudget")
+	defer span.End()
+
+	if err := normalizeBudget(budget); err != nil {
+		return err
+	}
+	if err := validateCategoryOwner(ctx, s.categoryRepo, budget.UserID, budget.CategoryID, "category_id"); err != nil {
+		return err
+	}
+
+	budget.ID = uuid.New()
+	budget.CreatedAt = time.Now()
+	budget.UpdatedAt = time.Now()
+
+	return s.budgetRepo.Create(ctx, budget)
+}
+
+func (s *BudgetService) UpdateBudget(ctx context.Context, userID uuid.UUID, budget *models.Budget) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.UpdateBudget")
+	defer span.End()
+
+	if err := normalizeBudget(budget); err != nil {
+		return err
+	}
+
+	existing, err := s.GetBudget(ctx, userID, budget.ID)
+	if err != nil {
+		return err
+	}
+	budget.UserID = existing.UserID
+
+	if err := validateCategoryOwner(ctx, s.categoryRepo, budget.UserID, budget.CategoryID, "category_id"); err != nil {
+		return err
+	}
+
+	budget.UpdatedAt = time.Now()
+	return s.budgetRepo.Update(ctx, budget)
+}
+
+// DeleteBudget removes the user's budget
+func (s *BudgetService) DeleteBudget(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.DeleteBudget")
+	defer span.End()
+
+	if _, err := s.GetBudget(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.budgetRepo.Delete(ctx, id)
+}
+
+// GetBudgetReport compares each category's budget for the month ("2024-01") with its transactions
+func (s *BudgetService) GetBudgetReport(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.BudgetReport, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetBudgetReport")
+	defer span.End()
+
+	if _, err := time.Parse("2006-01", yearMonth); err != nil {
+		return nil, NewValidationError("year_month", "must be in YYYY-MM format")
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := s.budgetRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.transactionRepo.GetByYearMonth(ctx, userID, yearMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	return budgetReport(categories, budgets, transactions, yearMonth), nil
+}
+
+// GetCategoryBreakdown aggregates the cashflow projection of the coming months per month and
+// category. With topLevel set, subcategories are rolled up into their top-level category.
+func (s *BudgetService) GetCategoryBreakdown(ctx context.Context, userID uuid.UUID, months int, topLevel bool) ([]models.CategoryBreakdown, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetCategoryBreakdown")
+	defer span.End()
+
+	categories, err := s.categoryRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projections, err := s.cashflowProjector.GetCashflowProjection(ctx, userID, months, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return categoryBreakdown(projections, categories, topLevel), nil
+}
+
+// Transaction methods
+func (s *BudgetService) GetTransactions(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.Transaction, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetTransactions")
+	defer span.End()
+
+	if yearMonth == "" {
+		return s.transactionRepo.GetAll(ctx, userID)
+	}
+	if _, err := time.Parse("2006-01", yearMonth); err != nil {
+		return nil, NewValidationError("year_month", "must be in YYYY-MM format")
+	}
+	return s.transactionRepo.GetByYearMonth(ctx, userID, yearMonth)
+}
+
+// GetTransaction returns the user's transaction, sql.ErrNoRows when the user has no such transaction
+func (s *BudgetService) GetTransaction(ctx context.Context, userID, id uuid.UUID) (*models.Transaction, error) {
+	ctx, span := tracer.Start(ctx, "BudgetService.GetTransaction")
+	defer span.End()
+
+	transaction, err := s.transactionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transaction.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return transaction, nil
+}
+
+func (s *BudgetService) CreateTransaction(ctx context.Context, transaction *models.Transaction) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.CreateTransaction")
+	defer span.End()
+
+	if err := normalizeTransaction(transaction); err != nil {
+		return err
+	}
+	if err := s.validateTransactionReferences(ctx, transaction); err != nil {
+		return err
+	}
+
+	transaction.ID = uuid.New()
+	transaction.CreatedAt = time.Now()
+	transaction.UpdatedAt = time.Now()
+
+	return s.transactionRepo.Create(ctx, transaction)
+}
+
+func (s *BudgetService) UpdateTransaction(ctx context.Context, userID uuid.UUID, transaction *models.Transaction) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.UpdateTransaction")
+	defer span.End()
+
+	if err := normalizeTransaction(transaction); err != nil {
+		return err
+	}
+
+	existing, err := s.GetTransaction(ctx, userID, transaction.ID)
+	if err != nil {
+		return err
+	}
+	transaction.UserID = existing.UserID
+
+	if err := s.validateTransactionReferences(ctx, transaction); err != nil {
+		return err
+	}
+
+	transaction.UpdatedAt = time.Now()
+	return s.transactionRepo.Update(ctx, transaction)
+}
+
+// validateTransactionReferences checks the category, account and card of a transaction against
+// those of its user
+func (s *BudgetService) validateTransactionReferences(ctx context.Context, transaction *models.Transaction) error {
+	if transaction.CategoryID != nil {
+		if err := validateCategoryOwner(ctx, s.categoryRepo, transaction.UserID, *transaction.CategoryID, "category_id"); err != nil {
+			return err
+		}
+	}
+	if transaction.BankAccount != nil {
+		if err := validateBankAccountOwner(ctx, s.bankAccountRepo, transaction.UserID, *transaction.BankAccount, "bank_account"); err != nil {
+			return err
+		}
+	}
+	if transaction.CreditCard != nil {
+		if err := validateCreditCardOwner(ctx, s.creditCardRepo, transaction.UserID, *transaction.CreditCard, "credit_card"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteTransaction removes the user's transaction
+func (s *BudgetService) DeleteTransaction(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "BudgetService.DeleteTransaction")
+	defer span.End()
+
+	if _, err := s.GetTransaction(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.transactionRepo.Delete(ctx, id)
+}
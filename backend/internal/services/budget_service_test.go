@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetService_CreateBudget(t *testing.T) {
+	month := "2024-13"
+	userID := uuid.New()
+	food := models.Category{ID: uuid.New(), UserID: userID, Name: "Food"}
+	foreign := models.Category{ID: uuid.New(), UserID: uuid.New(), Name: "Food"}
+
+	tests := []struct {
+		name          string
+		budget        models.Budget
+		setupMock     func(*mocks.MockBudgetRepository)
+		expectedError bool
+	}{
+		{
+			name:   "successful creation",
+			budget: models.Budget{UserID: userID, CategoryID: food.ID, Amount: 50000},
+			setupMock: func(m *mocks.MockBudgetRepository) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(budget *models.Budget) bool {
+					return budget.ID != uuid.Nil && !budget.CreatedAt.IsZero()
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "invalid month is not saved",
+			budget:        models.Budget{UserID: userID, CategoryID: food.ID, Amount: 50000, YearMonth: &month},
+			setupMock:     func(m *mocks.MockBudgetRepository) {},
+			expectedError: true,
+		},
+		{
+			name:          "category of another user is not saved",
+			budget:        models.Budget{UserID: userID, CategoryID: foreign.ID, Amount: 50000},
+			setupMock:     func(m *mocks.MockBudgetRepository) {},
+			expectedError: true,
+		},
+		{
+			name:          "missing category is not saved",
+			budget:        models.Budget{Amount: 50000},
+			setupMock:     func(m *mocks.MockBudgetRepository) {},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBudgetRepo := &mocks.MockBudgetRepository{}
+			mockCategoryRepo := &mocks.MockCategoryRepository{}
+			mockCategoryRepo.On("GetByID", mock.Anything, food.ID).Return(&food, nil).Maybe()
+			mockCategoryRepo.On("GetByID", mock.Anything, foreign.ID).Return(&foreign, nil).Maybe()
+			service := NewBudgetService(mockCategoryRepo, mockBudgetRepo, &mocks.MockTransactionRepository{}, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+			tt.setupMock(mockBudgetRepo)
+
+			err := service.CreateBudget(context.Background(), &tt.budget)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockBudgetRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBudgetService_CreateTransaction(t *testing.T) {
+	userID := uuid.New()
+	food := models.Category{ID: uuid.New(), UserID: userID}
+	account := models.BankAccount{ID: uuid.New(), UserID: userID}
+	card := models.CreditCard{ID: uuid.New(), UserID: userID}
+	foreignAccount := models.BankAccount{ID: uuid.New(), UserID: uuid.New()}
+	foreignCard := models.CreditCard{ID: uuid.New(), UserID: uuid.New()}
+	unknown := uuid.New()
+
+	tests := []struct {
+		name          string
+		transaction   models.Transaction
+		expectedField string
+	}{
+		{name: "own category and account", transaction: models.Transaction{CategoryID: &food.ID, BankAccount: &account.ID}},
+		{name: "own card", transaction: models.Transaction{CreditCard: &card.ID}},
+		{name: "no references", transaction: models.Transaction{}},
+		{name: "unknown category", transaction: models.Transaction{CategoryID: &unknown}, expectedField: "category_id"},
+		{name: "account of another user", transaction: models.Transaction{BankAccount: &foreignAccount.ID}, expectedField: "bank_account"},
+		{name: "card of another user", transaction: models.Transaction{CreditCard: &foreignCard.ID}, expectedField: "credit_card"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			categories := &mocks.MockCategoryRepository{}
+			categories.On("GetByID", mock.Anything, food.ID).Return(&food, nil).Maybe()
+			categories.On("GetByID", mock.Anything, unknown).Return(nil, sql.ErrNoRows).Maybe()
+			accounts := &mocks.MockBankAccountRepository{}
+			accounts.On("GetByID", mock.Anything, account.ID).Return(&account, nil).Maybe()
+			accounts.On("GetByID", mock.Anything, foreignAccount.ID).Return(&foreignAccount, nil).Maybe()
+			cards := &mocks.MockCreditCardRepository{}
+			cards.On("GetByID", mock.Anything, card.ID).Return(&card, nil).Maybe()
+			cards.On("GetByID", mock.Anything, foreignCard.ID).Return(&foreignCard, nil).Maybe()
+			transactions := &mocks.MockTransactionRepository{}
+			transactions.On("Create", mock.Anything, mock.AnythingOfType("*models.Transaction")).Return(nil).Maybe()
+			service := NewBudgetService(categories, &mocks.MockBudgetRepository{}, transactions, accounts, cards, &mocks.MockCashflowService{})
+
+			transaction := tt.transaction
+			transaction.UserID = userID
+			transaction.TransactionDate = "2024-12-01"
+			transaction.Amount = 1200
+			err := service.CreateTransaction(context.Background(), &transaction)
+
+			if tt.expectedField == "" {
+				require.NoError(t, err)
+				transactions.AssertCalled(t, "Create", mock.Anything, &transaction)
+				return
+			}
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+			transactions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestBudgetService_GetBudgetReport(t *testing.T) {
+	userID := uuid.New()
+	food := models.Category{ID: uuid.New(), UserID: userID, Name: "Food"}
+
+	tests := []struct {
+		name          string
+		yearMonth     string
+		setupMocks    func(*mocks.MockCategoryRepository, *mocks.MockBudgetRepository, *mocks.MockTransactionRepository)
+		expectedError bool
+	}{
+		{
+			name:      "successful report",
+			yearMonth: "2024-12",
+			setupMocks: func(categories *mocks.MockCategoryRepository, budgets *mocks.MockBudgetRepository, transactions *mocks.MockTransactionRepository) {
+				categories.On("GetAll", mock.Anything, userID).Return([]models.Category{food}, nil)
+				budgets.On("GetAll", mock.Anything, userID).Return([]models.Budget{{CategoryID: food.ID, Amount: 50000}}, nil)
+				transactions.On("GetByYearMonth", mock.Anything, userID, "2024-12").Return([]models.Transaction{{CategoryID: &food.ID, Amount: 12000}}, nil)
+			},
+		},
+		{
+			name:          "invalid month",
+			yearMonth:     "December",
+			setupMocks:    func(*mocks.MockCategoryRepository, *mocks.MockBudgetRepository, *mocks.MockTransactionRepository) {},
+			expectedError: true,
+		},
+		{
+			name:      "transaction repository error",
+			yearMonth: "2024-12",
+			setupMocks: func(categories *mocks.MockCategoryRepository, budgets *mocks.MockBudgetRepository, transactions *mocks.MockTransactionRepository) {
+				categories.On("GetAll", mock.Anything, userID).Return([]models.Category{food}, nil)
+				budgets.On("GetAll", mock.Anything, userID).Return([]models.Budget{}, nil)
+				transactions.On("GetByYearMonth", mock.Anything, userID, "2024-12").Return([]models.Transaction{}, assert.AnError)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := &mocks.MockCategoryRepository{}
+			mockBudgetRepo := &mocks.MockBudgetRepository{}
+			mockTransactionRepo := &mocks.MockTransactionRepository{}
+			service := NewBudgetService(mockCategoryRepo, mockBudgetRepo, mockTransactionRepo, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+			tt.setupMocks(mockCategoryRepo, mockBudgetRepo, mockTransactionRepo)
+
+			report, err := service.GetBudgetReport(context.Background(), userID, tt.yearMonth)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, report)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(38000), report.Categories[0].Remaining)
+			}
+			mockCategoryRepo.AssertExpectations(t)
+			mockBudgetRepo.AssertExpectations(t)
+			mockTransactionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBudgetService_UpdateCategory(t *testing.T) {
+	userID := uuid.New()
+	insurance := models.Category{ID: uuid.New(), UserID: userID, Name: "Insurance"}
+	life := models.Category{ID: uuid.New(), UserID: userID, ParentID: &insurance.ID, Name: "Life"}
+	foreignParent := models.Category{ID: uuid.New(), UserID: uuid.New(), Name: "Insurance"}
+
+	tests := []struct {
+		name          string
+		category      models.Category
+		setupMock     func(*mocks.MockCategoryRepository)
+		expectedError bool
+	}{
+		{
+			name:     "top-level category",
+			category: models.Category{ID: insurance.ID, Name: "Insurance"},
+			setupMock: func(m *mocks.MockCategoryRepository) {
+				m.On("GetByID", mock.Anything, insurance.ID).Return(&insurance, nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(category *models.Category) bool {
+					return category.UserID == userID
+				})).Return(nil)
+			},
+		},
+		{
+			name:     "parent of another user is not saved",
+			category: models.Category{ID: life.ID, ParentID: &foreignParent.ID, Name: "Life"},
+			setupMock: func(m *mocks.MockCategoryRepository) {
+				m.On("GetByID", mock.Anything, life.ID).Return(&life, nil)
+				m.On("GetAll", mock.Anything, userID).Return([]models.Category{insurance, life}, nil)
+			},
+			expectedError: true,
+		},
+		{
+			name:     "move under another category",
+			category: models.Category{ID: life.ID, ParentID: &insurance.ID, Name: "Life"},
+			setupMock: func(m *mocks.MockCategoryRepository) {
+				m.On("GetByID", mock.Anything, life.ID).Return(&life, nil)
+				m.On("GetAll", mock.Anything, userID).Return([]models.Category{insurance, life}, nil)
+				m.On("Update", mock.Anything, mock.MatchedBy(func(category *models.Category) bool {
+					return category.UserID == userID
+				})).Return(nil)
+			},
+		},
+		{
+			name:     "cycle is not saved",
+			category: models.Category{ID: insurance.ID, ParentID: &life.ID, Name: "Insurance"},
+			setupMock: func(m *mocks.MockCategoryRepository) {
+				m.On("GetByID", mock.Anything, insurance.ID).Return(&insurance, nil)
+				m.On("GetAll", mock.Anything, userID).Return([]models.Category{insurance, life}, nil)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := &mocks.MockCategoryRepository{}
+			service := NewBudgetService(mockCategoryRepo, &mocks.MockBudgetRepository{}, &mocks.MockTransactionRepository{}, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+			tt.setupMock(mockCategoryRepo)
+
+			err := service.UpdateCategory(context.Background(), userID, &tt.category)
+
+			if tt.expectedError {
+				var validationErr *ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockCategoryRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBudgetService_UpdateCategory_unknown(t *testing.T) {
+	mockCategoryRepo := &mocks.MockCategoryRepository{}
+	service := NewBudgetService(mockCategoryRepo, &mocks.MockBudgetRepository{}, &mocks.MockTransactionRepository{}, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+	category := &models.Category{ID: uuid.New(), Name: "Insurance"}
+	mockCategoryRepo.On("GetByID", mock.Anything, category.ID).Return(nil, sql.ErrNoRows)
+
+	err := service.UpdateCategory(context.Background(), uuid.New(), category)
+
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	mockCategoryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBudgetService_UpdateCategory_anotherUser(t *testing.T) {
+	mockCategoryRepo := &mocks.MockCategoryRepository{}
+	service := NewBudgetService(mockCategoryRepo, &mocks.MockBudgetRepository{}, &mocks.MockTransactionRepository{}, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+	category := &models.Category{ID: uuid.New(), Name: "Insurance"}
+	mockCategoryRepo.On("GetByID", mock.Anything, category.ID).Return(&models.Category{ID: category.ID, UserID: uuid.New(), Name: "Insurance"}, nil)
+
+	err := service.UpdateCategory(context.Background(), uuid.New(), category)
+
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	mockCategoryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBudgetService_DeleteBudget(t *testing.T) {
+	mockBudgetRepo := &mocks.MockBudgetRepository{}
+	service := NewBudgetService(&mocks.MockCategoryRepository{}, mockBudgetRepo, &mocks.MockTransactionRepository{}, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+	userID, budgetID := uuid.New(), uuid.New()
+
+	mockBudgetRepo.On("GetByID", mock.Anything, budgetID).Return(&models.Budget{ID: budgetID, UserID: userID}, nil)
+	mockBudgetRepo.On("Delete", mock.Anything, budgetID).Return(nil)
+
+	assert.ErrorIs(t, service.DeleteBudget(context.Background(), uuid.New(), budgetID), sql.ErrNoRows)
+	mockBudgetRepo.AssertNotCalled(t, "Delete", mock.Anything, budgetID)
+
+	assert.NoError(t, service.DeleteBudget(context.Background(), userID, budgetID))
+	mockBudgetRepo.AssertExpectations(t)
+}
+
+func TestBudgetService_GetTransaction(t *testing.T) {
+	mockTransactionRepo := &mocks.MockTransactionRepository{}
+	service := NewBudgetService(&mocks.MockCategoryRepository{}, &mocks.MockBudgetRepository{}, mockTransactionRepo, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, &mocks.MockCashflowService{})
+	userID := uuid.New()
+	transaction := &models.Transaction{ID: uuid.New(), UserID: userID, TransactionDate: "2024-12-20", Amount: 3200}
+	mockTransactionRepo.On("GetByID", mock.Anything, transaction.ID).Return(transaction, nil)
+
+	found, err := service.GetTransaction(context.Background(), userID, transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, transaction, found)
+
+	_, err = service.GetTransaction(context.Background(), uuid.New(), transaction.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows, "another user's transaction is not found")
+}
+
+func TestBudgetService_GetCategoryBreakdown(t *testing.T) {
+	userID := uuid.New()
+	insurance := models.Category{ID: uuid.New(), UserID: userID, Name: "Insurance"}
+
+	mockCategoryRepo := &mocks.MockCategoryRepository{}
+	mockCashflow := &mocks.MockCashflowService{}
+	service := NewBudgetService(mockCategoryRepo, &mocks.MockBudgetRepository{}, &mocks.MockTransactionRepository{}, &mocks.MockBankAccountRepository{}, &mocks.MockCreditCardRepository{}, mockCashflow)
+
+	mockCategoryRepo.On("GetAll", mock.Anything, userID).Return([]models.Category{insurance}, nil)
+	mockCashflow.On("GetCashflowProjection", mock.Anything, userID, 12, true).Return([]models.CashflowProjection{
+		{Date: "2025-01-27", Details: []models.CashflowProjectionDetail{{Type: "recurring_payment", Amount: 8000, CategoryID: &insurance.ID}}},
+	}, nil)
+
+	breakdowns, err := service.GetCategoryBreakdown(context.Background(), userID, 12, false)
+
+	assert.NoError(t, err)
+	if assert.Len(t, breakdowns, 1) {
+		assert.Equal(t, int64(8000), breakdowns[0].TotalExpense)
+		assert.Equal(t, "Insurance", breakdowns[0].Categories[0].CategoryName)
+	}
+	mockCategoryRepo.AssertExpectations(t)
+	mockCashflow.AssertExpectations(t)
+}
@@ -0,0 +1,192 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+	"github.com/Soli0222/flow-sight/backend/internal/ical"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// Setting keys of the reminders of the calendar feed
+const (
+	CalendarAlarmDaysSettingKey      = "calendar_alarm_days"
+	CalendarAlarmThresholdSettingKey = "calendar_alarm_threshold"
+)
+
+const (
+	// calendarFeedMonths is how many months of projected events the feed covers
+	calendarFeedMonths = 12
+	// calendarFeedRefresh is how often calendar apps are asked to reload the feed
+	calendarFeedRefresh = time.Hour
+	// maxCalendarAlarmDays is the earliest a reminder can go off before a debit
+	maxCalendarAlarmDays = 30
+	// calendarFeedTokenBytes is the length of a feed token before hex encoding
+	calendarFeedTokenBytes = 32
+)
+
+// calendarEventTypes are the projection items that become events
+var calendarEventTypes = map[string]bool{
+	"income":            true,
+	"recurring_payment": true,
+	"card_payment":      true,
+}
+
+// CalendarService manages the users' iCalendar feeds of projected payments and income
+type CalendarService struct {
+	calendarFeedRepo  CalendarFeedRepositoryInterface
+	appSettingRepo    AppSettingRepositoryInterface
+	cashflowProjector CashflowProjectorInterface
+}
+
+func NewCalendarService(calendarFeedRepo CalendarFeedRepositoryInterface, appSettingRepo AppSettingRepositoryInterface, cashflowProjector CashflowProjectorInterface) *CalendarService {
+	return &CalendarService{
+		calendarFeedRepo:  calendarFeedRepo,
+		appSettingRepo:    appSettingRepo,
+		cashflowProjector: cashflowProjector,
+	}
+}
+
+// GetCalendarFeed returns the user's feed, sql.ErrNoRows when the user has none
+func (s *CalendarService) GetCalendarFeed(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error) {
+	ctx, span := tracer.Start(ctx, "CalendarService.GetCalendarFeed")
+	defer span.End()
+
+	return s.calendarFeedRepo.GetByUserID(ctx, userID)
+}
+
+// CreateCalendarFeed gives the user a feed with a new secret token. The address of an existing
+// feed stops working.
+func (s *CalendarService) CreateCalendarFeed(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error) {
+	ctx, span := tracer.Start(ctx, "CalendarService.CreateCalendarFeed")
+	defer span.End()
+
+	token := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	feed := &models.CalendarFeed{
+		UserID:    userID,
+		Token:     hex.EncodeToString(token),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.calendarFeedRepo.Upsert(ctx, feed); err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
+
+// DeleteCalendarFeed turns the user's feed off
+func (s *CalendarService) DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "CalendarService.DeleteCalendarFeed")
+	defer span.End()
+
+	return s.calendarFeedRepo.Delete(ctx, userID)
+}
+
+// RenderCalendarFeed returns the iCalendar file of the feed with the token, built from the
+// current projection so it follows every change of the user's data. An unknown token returns
+// sql.ErrNoRows.
+func (s *CalendarService) RenderCalendarFeed(ctx context.Context, token string, now time.Time) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "CalendarService.RenderCalendarFeed")
+	defer span.End()
+
+	feed, err := s.calendarFeedRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.cashflowProjector.GetCashflowProjection(ctx, feed.UserID, calendarFeedMonths, true)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.appSettingRepo.GetByUserID(ctx, feed.UserID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(settings))
+	for _, setting := range settings {
+		values[setting.Key] = setting.Value
+	}
+	alarmDays, _ := strconv.Atoi(values[CalendarAlarmDaysSettingKey])
+	alarmThreshold, _ := strconv.ParseInt(values[CalendarAlarmThresholdSettingKey], 10, 64)
+
+	calendar := &ical.Calendar{
+		ProductID: "-//Flow Sight//Cashflow Projection//JA",
+		Name:      "Flow Sight 入出金予定",
+		Refresh:   calendarFeedRefresh,
+		Events:    calendarEvents(days, baseCurrency(ctx, s.appSettingRepo, feed.UserID), alarmDays, alarmThreshold),
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.Write(&buf, now); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// calendarEvents turns the income, recurring payments and card debits of projected days into
+// all-day events. With alarmDays set, debits of at least alarmThreshold get a reminder.
+func calendarEvents(days []models.CashflowProjection, code string, alarmDays int, alarmThreshold int64) []ical.Event {
+	events := make([]ical.Event, 0)
+	for _, day := range days {
+		date, err := time.Parse("2006-01-02", day.Date)
+		if err != nil {
+			continue
+		}
+
+		for i, detail := range day.Details {
+			if !calendarEventTypes[detail.Type] {
+				continue
+			}
+
+			sign := "-"
+			if detail.Type == "income" {
+				sign = "+"
+			}
+			event := ical.Event{
+				UID:         calendarEventUID(day.Date, detail, i),
+				Date:        date,
+				Summary:     detail.Description + " " + sign + currency.Format(detail.Amount, code),
+				Description: calendarEventDescription(detail),
+			}
+			if alarmDays > 0 && detail.Type != "income" && detail.Amount >= alarmThreshold {
+				event.Alarm = &ical.Alarm{DaysBefore: alarmDays, Description: event.Summary}
+			}
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// calendarEventUID identifies the event of an item on a day across refreshes of the feed
+func calendarEventUID(date string, detail models.CashflowProjectionDetail, index int) string {
+	source := strconv.Itoa(index)
+	if detail.SourceID != nil {
+		source = detail.SourceID.String()
+	}
+	return date + "-" + detail.Type + "-" + source + "@flow-sight"
+}
+
+func calendarEventDescription(detail models.CashflowProjectionDetail) string {
+	lines := make([]string, 0, 2)
+	if detail.OriginalAmount != nil {
+		lines = append(lines, "元の金額: "+currency.Format(*detail.OriginalAmount, detail.OriginalCurrency))
+	}
+	if detail.IsEstimated {
+		lines = append(lines, "金額は見込み")
+	}
+	return strings.Join(lines, "\n")
+}
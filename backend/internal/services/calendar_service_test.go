@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarEvents(t *testing.T) {
+	salaryID, rentID := uuid.New(), uuid.New()
+	days := []models.CashflowProjection{
+		{Date: "2025-01-25", Details: []models.CashflowProjectionDetail{
+			{Type: "income", Description: "給与", Amount: 30000000, SourceID: &salaryID},
+			{Type: "transfer", Description: "積立", Amount: 5000000},
+		}},
+		{Date: "2025-01-27", Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Description: "家賃", Amount: 8000000, SourceID: &rentID},
+			{Type: "card_payment", Description: "Visa", Amount: 500000, IsEstimated: true},
+			{Type: "living_cost", Description: "生活費", Amount: 10000000},
+		}},
+	}
+
+	events := calendarEvents(days, "JPY", 3, 5000000)
+
+	require.Len(t, events, 3, "transfers and living costs are not events")
+	assert.Equal(t, "給与 +¥300,000", events[0].Summary)
+	assert.Equal(t, "2025-01-25-income-"+salaryID.String()+"@flow-sight", events[0].UID)
+	assert.Nil(t, events[0].Alarm, "income has no reminder")
+
+	assert.Equal(t, "家賃 -¥80,000", events[1].Summary)
+	require.NotNil(t, events[1].Alarm)
+	assert.Equal(t, 3, events[1].Alarm.DaysBefore)
+
+	assert.Equal(t, "2025-01-27-card_payment-1@flow-sight", events[2].UID)
+	assert.Equal(t, "金額は見込み", events[2].Description)
+	assert.Nil(t, events[2].Alarm, "debits below the threshold have no reminder")
+
+	assert.Nil(t, calendarEvents(days, "JPY", 0, 0)[1].Alarm, "no reminders without alarm days")
+}
+
+func TestCalendarService_RenderCalendarFeed(t *testing.T) {
+	feedRepo := &mocks.MockCalendarFeedRepository{}
+	appSettingRepo := &mocks.MockAppSettingRepository{}
+	projector := &mocks.MockCashflowService{}
+	service := NewCalendarService(feedRepo, appSettingRepo, projector)
+
+	userID := uuid.New()
+	feedRepo.On("GetByToken", mock.Anything, "secret").Return(&models.CalendarFeed{UserID: userID, Token: "secret"}, nil)
+	feedRepo.On("GetByToken", mock.Anything, "unknown").Return(nil, sql.ErrNoRows)
+	projector.On("GetCashflowProjection", mock.Anything, userID, calendarFeedMonths, true).Return([]models.CashflowProjection{
+		{Date: "2025-01-27", Details: []models.CashflowProjectionDetail{{Type: "recurring_payment", Description: "家賃", Amount: 8000000}}},
+	}, nil)
+	appSettingRepo.On("GetByUserID", mock.Anything, userID).Return([]models.AppSetting{{Key: CalendarAlarmDaysSettingKey, Value: "2"}}, nil)
+	appSettingRepo.On("GetByKey", mock.Anything, userID, BaseCurrencySettingKey).Return(nil, sql.ErrNoRows)
+
+	feed, err := service.RenderCalendarFeed(context.Background(), "secret", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Contains(t, string(feed), "SUMMARY:家賃 -¥80\\,000\r\n")
+	assert.Contains(t, string(feed), "TRIGGER:-P2D\r\n")
+
+	_, err = service.RenderCalendarFeed(context.Background(), "unknown", time.Now())
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCalendarService_CreateCalendarFeed(t *testing.T) {
+	feedRepo := &mocks.MockCalendarFeedRepository{}
+	service := NewCalendarService(feedRepo, nil, nil)
+
+	userID := uuid.New()
+	feedRepo.On("Upsert", mock.Anything, mock.AnythingOfType("*models.CalendarFeed")).Return(nil)
+
+	first, err := service.CreateCalendarFeed(context.Background(), userID)
+	require.NoError(t, err)
+	second, err := service.CreateCalendarFeed(context.Background(), userID)
+	require.NoError(t, err)
+
+	assert.Equal(t, userID, first.UserID)
+	assert.Len(t, first.Token, 64)
+	assert.NotEqual(t, first.Token, second.Token, "a new feed gets a new token")
+}
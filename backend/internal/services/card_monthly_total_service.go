@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/repositories"
 	"time"
@@ -10,35 +11,102 @@ import (
 
 type CardMonthlyTotalService struct {
 	cardMonthlyTotalRepo *repositories.CardMonthlyTotalRepository
+	creditCardRepo       CreditCardRepositoryInterface
+	publisher            EventPublisherInterface // nil when changes are not published
 }
 
-func NewCardMonthlyTotalService(cardMonthlyTotalRepo *repositories.CardMonthlyTotalRepository) *CardMonthlyTotalService {
+func NewCardMonthlyTotalService(cardMonthlyTotalRepo *repositories.CardMonthlyTotalRepository, creditCardRepo CreditCardRepositoryInterface, publisher EventPublisherInterface) *CardMonthlyTotalService {
 	return &CardMonthlyTotalService{
 		cardMonthlyTotalRepo: cardMonthlyTotalRepo,
+		creditCardRepo:       creditCardRepo,
+		publisher:            publisher,
 	}
 }
 
-func (s *CardMonthlyTotalService) GetCardMonthlyTotals(creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error) {
-	return s.cardMonthlyTotalRepo.GetByCreditCardID(creditCardID)
+func (s *CardMonthlyTotalService) GetCardMonthlyTotals(ctx context.Context, creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error) {
+	ctx, span := tracer.Start(ctx, "CardMonthlyTotalService.GetCardMonthlyTotals")
+	defer span.End()
+
+	return s.cardMonthlyTotalRepo.GetByCreditCardID(ctx, creditCardID)
 }
 
-func (s *CardMonthlyTotalService) GetCardMonthlyTotal(id uuid.UUID) (*models.CardMonthlyTotal, error) {
-	return s.cardMonthlyTotalRepo.GetByID(id)
+func (s *CardMonthlyTotalService) GetCardMonthlyTotal(ctx context.Context, id uuid.UUID) (*models.CardMonthlyTotal, error) {
+	ctx, span := tracer.Start(ctx, "CardMonthlyTotalService.GetCardMonthlyTotal")
+	defer span.End()
+
+	return s.cardMonthlyTotalRepo.GetByID(ctx, id)
 }
 
-func (s *CardMonthlyTotalService) CreateCardMonthlyTotal(total *models.CardMonthlyTotal) error {
+func (s *CardMonthlyTotalService) CreateCardMonthlyTotal(ctx context.Context, total *models.CardMonthlyTotal) error {
+	ctx, span := tracer.Start(ctx, "CardMonthlyTotalService.CreateCardMonthlyTotal")
+	defer span.End()
+
 	total.ID = uuid.New()
 	total.CreatedAt = time.Now()
 	total.UpdatedAt = time.Now()
 
-	return s.cardMonthlyTotalRepo.Create(total)
+	if err := s.cardMonthlyTotalRepo.Create(ctx, total); err != nil {
+		return err
+	}
+	s.publishChange(ctx, EntityCreated, total, false)
+	return nil
 }
 
-func (s *CardMonthlyTotalService) UpdateCardMonthlyTotal(total *models.CardMonthlyTotal) error {
+func (s *CardMonthlyTotalService) UpdateCardMonthlyTotal(ctx context.Context, total *models.CardMonthlyTotal) error {
+	ctx, span := tracer.Start(ctx, "CardMonthlyTotalService.UpdateCardMonthlyTotal")
+	defer span.End()
+
+	previous := s.storedForEvent(ctx, total.ID)
+
 	total.UpdatedAt = time.Now()
-	return s.cardMonthlyTotalRepo.Update(total)
+	if err := s.cardMonthlyTotalRepo.Update(ctx, total); err != nil {
+		return err
+	}
+	if stored := s.storedForEvent(ctx, total.ID); stored != nil {
+		s.publishChange(ctx, EntityUpdated, stored, previous != nil && previous.IsConfirmed)
+	}
+	return nil
 }
 
-func (s *CardMonthlyTotalService) DeleteCardMonthlyTotal(id uuid.UUID) error {
-	return s.cardMonthlyTotalRepo.Delete(id)
+func (s *CardMonthlyTotalService) DeleteCardMonthlyTotal(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "CardMonthlyTotalService.DeleteCardMonthlyTotal")
+	defer span.End()
+
+	deleted := s.storedForEvent(ctx, id)
+	if err := s.cardMonthlyTotalRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if deleted != nil {
+		s.publishChange(ctx, EntityDeleted, deleted, true)
+	}
+	return nil
+}
+
+// storedForEvent returns the stored total an event is about, nil without a publisher
+func (s *CardMonthlyTotalService) storedForEvent(ctx context.Context, id uuid.UUID) *models.CardMonthlyTotal {
+	if s.publisher == nil {
+		return nil
+	}
+	total, err := s.cardMonthlyTotalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return total
+}
+
+// publishChange publishes a change of a total to the owner of its card. A total that became
+// confirmed with the change is also published as confirmed.
+func (s *CardMonthlyTotalService) publishChange(ctx context.Context, action string, total *models.CardMonthlyTotal, wasConfirmed bool) {
+	if s.publisher == nil {
+		return
+	}
+	creditCard, err := s.creditCardRepo.GetByID(ctx, total.CreditCardID)
+	if err != nil {
+		return
+	}
+
+	publishEvent(ctx, s.publisher, creditCard.UserID, entityEvent(EntityCardMonthlyTotal, action), total)
+	if total.IsConfirmed && !wasConfirmed {
+		publishEvent(ctx, s.publisher, creditCard.UserID, WebhookEventCardTotalConfirmed, total)
+	}
 }
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+)
+
+// Setting keys used to choose a card spend estimator.
+// CardEstimatorSettingKey sets the default for every card and
+// CardEstimatorSettingKey + ":" + <credit card id> overrides it for one card.
+const (
+	CardEstimatorSettingKey = "card_estimator"
+
+	CardEstimatorNone              = "none"
+	CardEstimatorTrailingAverage   = "trailing_average"
+	CardEstimatorSameMonthLastYear = "same_month_last_year"
+	CardEstimatorFixedBudget       = "fixed_budget"
+
+	defaultTrailingAverageMonths = 3
+)
+
+// CardSpendEstimator estimates card usage for a month that has no CardMonthlyTotal yet
+type CardSpendEstimator interface {
+	// Estimate returns the estimated usage for targetYearMonth ("2024-01") based on the
+	// card's recorded totals. The second return value is false when no estimate can be made.
+	Estimate(history []models.CardMonthlyTotal, targetYearMonth string) (int64, bool)
+}
+
+// CardEstimatorSettingKeyFor returns the per-card override key for an estimator setting
+func CardEstimatorSettingKeyFor(creditCardID string) string {
+	return CardEstimatorSettingKey + ":" + creditCardID
+}
+
+// ParseCardSpendEstimator builds an estimator from a setting value.
+// Supported values:
+//   - "none"                    no estimate (unrecorded months are treated as 0)
+//   - "trailing_average[:N]"    average of the N most recent recorded months (default 3)
+//   - "same_month_last_year"    amount recorded for the same calendar month in a previous year
+//   - "fixed_budget:AMOUNT"     a fixed monthly amount in cents
+func ParseCardSpendEstimator(value string) (CardSpendEstimator, error) {
+	method, param, hasParam := strings.Cut(strings.TrimSpace(value), ":")
+
+	switch method {
+	case "", CardEstimatorNone:
+		if hasParam {
+			return nil, fmt.Errorf("estimator %q does not take a parameter", CardEstimatorNone)
+		}
+		return noneEstimator{}, nil
+	case CardEstimatorTrailingAverage:
+		months := defaultTrailingAverageMonths
+		if hasParam {
+			n, err := strconv.Atoi(param)
+			if err != nil || n < 1 || n > 36 {
+				return nil, fmt.Errorf("trailing_average months must be between 1 and 36: %s", param)
+			}
+			months = n
+		}
+		return trailingAverageEstimator{months: months}, nil
+	case CardEstimatorSameMonthLastYear:
+		if hasParam {
+			return nil, fmt.Errorf("estimator %q does not take a parameter", CardEstimatorSameMonthLastYear)
+		}
+		return sameMonthLastYearEstimator{}, nil
+	case CardEstimatorFixedBudget:
+		if !hasParam {
+			return nil, fmt.Errorf("fixed_budget requires an amount, e.g. fixed_budget:50000")
+		}
+		amount, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || amount < 0 {
+			return nil, fmt.Errorf("fixed_budget amount must be a non-negative integer: %s", param)
+		}
+		return fixedBudgetEstimator{amount: amount}, nil
+	default:
+		return nil, fmt.Errorf("unknown card estimator: %s", method)
+	}
+}
+
+// noneEstimator never estimates, keeping the original behaviour of treating missing months as 0
+type noneEstimator struct{}
+
+func (noneEstimator) Estimate(_ []models.CardMonthlyTotal, _ string) (int64, bool) {
+	return 0, false
+}
+
+// trailingAverageEstimator averages the most recent recorded months before the target month
+type trailingAverageEstimator struct {
+	months int
+}
+
+func (e trailingAverageEstimator) Estimate(history []models.CardMonthlyTotal, targetYearMonth string) (int64, bool) {
+	previous := make([]models.CardMonthlyTotal, 0, len(history))
+	for _, total := range history {
+		if total.YearMonth < targetYearMonth {
+			previous = append(previous, total)
+		}
+	}
+	if len(previous) == 0 {
+		return 0, false
+	}
+
+	// Most recent first
+	sort.Slice(previous, func(i, j int) bool {
+		return previous[i].YearMonth > previous[j].YearMonth
+	})
+
+	count := e.months
+	if count > len(previous) {
+		count = len(previous)
+	}
+
+	sum := int64(0)
+	for _, total := range previous[:count] {
+		sum += total.TotalAmount
+	}
+
+	return sum / int64(count), true
+}
+
+// sameMonthLastYearEstimator uses the amount recorded for the same calendar month in the
+// closest previous year
+type sameMonthLastYearEstimator struct{}
+
+func (sameMonthLastYearEstimator) Estimate(history []models.CardMonthlyTotal, targetYearMonth string) (int64, bool) {
+	year, month, err := parseYearMonth(targetYearMonth)
+	if err != nil {
+		return 0, false
+	}
+	suffix := fmt.Sprintf("-%02d", month)
+
+	var best *models.CardMonthlyTotal
+	for i := range history {
+		total := &history[i]
+		if !strings.HasSuffix(total.YearMonth, suffix) || total.YearMonth >= targetYearMonth {
+			continue
+		}
+		if best == nil || total.YearMonth > best.YearMonth {
+			best = total
+		}
+	}
+	if best == nil {
+		return 0, false
+	}
+
+	// Ignore data older than a few years; spending habits change
+	bestYear, _, err := parseYearMonth(best.YearMonth)
+	if err != nil || year-bestYear > 3 {
+		return 0, false
+	}
+
+	return best.TotalAmount, true
+}
+
+// fixedBudgetEstimator always estimates the configured amount
+type fixedBudgetEstimator struct {
+	amount int64
+}
+
+func (e fixedBudgetEstimator) Estimate(_ []models.CardMonthlyTotal, _ string) (int64, bool) {
+	return e.amount, true
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func cardHistory(amounts map[string]int64) []models.CardMonthlyTotal {
+	history := make([]models.CardMonthlyTotal, 0, len(amounts))
+	for yearMonth, amount := range amounts {
+		history = append(history, models.CardMonthlyTotal{YearMonth: yearMonth, TotalAmount: amount})
+	}
+	return history
+}
+
+func TestParseCardSpendEstimator(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      CardSpendEstimator
+		expectedError bool
+	}{
+		{name: "empty value means none", value: "", expected: noneEstimator{}},
+		{name: "none", value: "none", expected: noneEstimator{}},
+		{name: "trailing average default", value: "trailing_average", expected: trailingAverageEstimator{months: 3}},
+		{name: "trailing average with months", value: "trailing_average:6", expected: trailingAverageEstimator{months: 6}},
+		{name: "trailing average invalid months", value: "trailing_average:0", expectedError: true},
+		{name: "trailing average non numeric", value: "trailing_average:abc", expectedError: true},
+		{name: "same month last year", value: "same_month_last_year", expected: sameMonthLastYearEstimator{}},
+		{name: "fixed budget", value: "fixed_budget:50000", expected: fixedBudgetEstimator{amount: 50000}},
+		{name: "fixed budget without amount", value: "fixed_budget", expectedError: true},
+		{name: "fixed budget negative", value: "fixed_budget:-1", expectedError: true},
+		{name: "unknown method", value: "median", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			estimator, err := ParseCardSpendEstimator(tt.value)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, estimator)
+			}
+		})
+	}
+}
+
+func TestCardSpendEstimators_Estimate(t *testing.T) {
+	history := cardHistory(map[string]int64{
+		"2023-12": 90000,
+		"2024-10": 30000,
+		"2024-11": 40000,
+		"2024-12": 80000,
+	})
+
+	tests := []struct {
+		name             string
+		estimator        CardSpendEstimator
+		history          []models.CardMonthlyTotal
+		target           string
+		expectedAmount   int64
+		expectedEstimate bool
+	}{
+		{
+			name:             "none never estimates",
+			estimator:        noneEstimator{},
+			history:          history,
+			target:           "2025-01",
+			expectedAmount:   0,
+			expectedEstimate: false,
+		},
+		{
+			name:             "trailing average of last 3 recorded months",
+			estimator:        trailingAverageEstimator{months: 3},
+			history:          history,
+			target:           "2025-01",
+			expectedAmount:   50000,
+			expectedEstimate: true,
+		},
+		{
+			name:             "trailing average with fewer records than window",
+			estimator:        trailingAverageEstimator{months: 12},
+			history:          history,
+			target:           "2024-11",
+			expectedAmount:   60000,
+			expectedEstimate: true,
+		},
+		{
+			name:             "trailing average ignores months after target",
+			estimator:        trailingAverageEstimator{months: 1},
+			history:          history,
+			target:           "2024-11",
+			expectedAmount:   30000,
+			expectedEstimate: true,
+		},
+		{
+			name:             "trailing average without history",
+			estimator:        trailingAverageEstimator{months: 3},
+			history:          nil,
+			target:           "2025-01",
+			expectedAmount:   0,
+			expectedEstimate: false,
+		},
+		{
+			name:             "same month last year",
+			estimator:        sameMonthLastYearEstimator{},
+			history:          history,
+			target:           "2025-12",
+			expectedAmount:   80000,
+			expectedEstimate: true,
+		},
+		{
+			name:             "same month from an older year",
+			estimator:        sameMonthLastYearEstimator{},
+			history:          cardHistory(map[string]int64{"2023-12": 90000}),
+			target:           "2025-12",
+			expectedAmount:   90000,
+			expectedEstimate: true,
+		},
+		{
+			name:             "same month too old",
+			estimator:        sameMonthLastYearEstimator{},
+			history:          cardHistory(map[string]int64{"2020-12": 90000}),
+			target:           "2025-12",
+			expectedAmount:   0,
+			expectedEstimate: false,
+		},
+		{
+			name:             "same month missing",
+			estimator:        sameMonthLastYearEstimator{},
+			history:          history,
+			target:           "2025-03",
+			expectedAmount:   0,
+			expectedEstimate: false,
+		},
+		{
+			name:             "fixed budget",
+			estimator:        fixedBudgetEstimator{amount: 45000},
+			history:          nil,
+			target:           "2030-01",
+			expectedAmount:   45000,
+			expectedEstimate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, ok := tt.estimator.Estimate(tt.history, tt.target)
+			assert.Equal(t, tt.expectedEstimate, ok)
+			assert.Equal(t, tt.expectedAmount, amount)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateProjection(t *testing.T) {
+	accountID := uuid.New()
+	days := []models.CashflowProjection{
+		{Date: "2025-01-30", Balance: 1000},
+		{Date: "2025-01-31", Income: 500, Balance: 1500, Details: []models.CashflowProjectionDetail{
+			{Type: "income", Amount: 500},
+			{Type: "transfer", Amount: 200},
+		}},
+		{Date: "2025-02-01", Balance: 1500},
+		{Date: "2025-02-02", Expense: 300, Balance: 1200, Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Amount: 100},
+			{Type: "card_payment", Amount: 200},
+		}},
+		{Date: "2025-02-03", Expense: 50, Balance: 1150, AccountBalances: []models.AccountBalance{{BankAccountID: accountID, Balance: 1150}}, Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Amount: 50},
+		}},
+	}
+
+	t.Run("month", func(t *testing.T) {
+		periods := aggregateProjection(days, GranularityMonth)
+
+		require.Len(t, periods, 2)
+		assert.Equal(t, models.CashflowPeriod{
+			StartDate: "2025-01-30", EndDate: "2025-01-31",
+			OpeningBalance: 1000, ClosingBalance: 1500,
+			Income:    500,
+			Subtotals: map[string]int64{"income": 500},
+		}, periods[0], "transfers are left out of the subtotals")
+		assert.Equal(t, "2025-02-01", periods[1].StartDate)
+		assert.Equal(t, "2025-02-03", periods[1].EndDate)
+		assert.Equal(t, int64(1500), periods[1].OpeningBalance)
+		assert.Equal(t, int64(1150), periods[1].ClosingBalance)
+		assert.Equal(t, int64(350), periods[1].Expense)
+		assert.Equal(t, map[string]int64{"recurring_payment": 150, "card_payment": 200}, periods[1].Subtotals)
+		assert.Equal(t, []models.AccountBalance{{BankAccountID: accountID, Balance: 1150}}, periods[1].AccountBalances)
+	})
+
+	t.Run("week", func(t *testing.T) {
+		// 2025-02-03 is a Monday
+		periods := aggregateProjection(days, GranularityWeek)
+
+		require.Len(t, periods, 2)
+		assert.Equal(t, "2025-01-30", periods[0].StartDate)
+		assert.Equal(t, "2025-02-02", periods[0].EndDate)
+		assert.Equal(t, int64(500), periods[0].Income)
+		assert.Equal(t, int64(300), periods[0].Expense)
+		assert.Equal(t, int64(1200), periods[0].ClosingBalance)
+		assert.Equal(t, "2025-02-03", periods[1].StartDate)
+		assert.Equal(t, int64(1200), periods[1].OpeningBalance)
+	})
+}
+
+func TestCashflowService_GetCashflowPeriods_InvalidGranularity(t *testing.T) {
+	service := &CashflowService{}
+
+	_, err := service.GetCashflowPeriods(context.Background(), uuid.New(), 12, "year", ProjectionOptions{})
+
+	var validationErr *ValidationError
+	if assert.ErrorAs(t, err, &validationErr) {
+		assert.Equal(t, "granularity", validationErr.Field)
+	}
+}
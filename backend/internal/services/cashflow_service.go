@@ -1,11 +1,13 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Soli0222/flow-sight/backend/internal/metrics"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/repositories"
 
@@ -13,13 +15,16 @@ import (
 )
 
 type CashflowService struct {
-	bankAccountRepo      *repositories.BankAccountRepository
-	incomeSourceRepo     *repositories.IncomeSourceRepository
-	monthlyIncomeRepo    *repositories.MonthlyIncomeRepository
-	recurringPaymentRepo *repositories.RecurringPaymentRepository
-	cardMonthlyTotalRepo *repositories.CardMonthlyTotalRepository
-	creditCardRepo       *repositories.CreditCardRepository
-	appSettingRepo       *repositories.AppSettingRepository
+	bankAccountRepo       *repositories.BankAccountRepository
+	incomeSourceRepo      *repositories.IncomeSourceRepository
+	monthlyIncomeRepo     *repositories.MonthlyIncomeRepository
+	recurringPaymentRepo  *repositories.RecurringPaymentRepository
+	cardMonthlyTotalRepo  *repositories.CardMonthlyTotalRepository
+	creditCardRepo        *repositories.CreditCardRepository
+	appSettingRepo        *repositories.AppSettingRepository
+	recurringTransferRepo *repositories.RecurringTransferRepository
+	budgetRepo            *repositories.BudgetRepository
+	exchangeRateRepo      *repositories.ExchangeRateRepository
 }
 
 func NewCashflowService(
@@ -30,65 +35,218 @@ func NewCashflowService(
 	cardMonthlyTotalRepo *repositories.CardMonthlyTotalRepository,
 	creditCardRepo *repositories.CreditCardRepository,
 	appSettingRepo *repositories.AppSettingRepository,
+	recurringTransferRepo *repositories.RecurringTransferRepository,
+	budgetRepo *repositories.BudgetRepository,
+	exchangeRateRepo *repositories.ExchangeRateRepository,
 ) *CashflowService {
 	return &CashflowService{
-		bankAccountRepo:      bankAccountRepo,
-		incomeSourceRepo:     incomeSourceRepo,
-		monthlyIncomeRepo:    monthlyIncomeRepo,
-		recurringPaymentRepo: recurringPaymentRepo,
-		cardMonthlyTotalRepo: cardMonthlyTotalRepo,
-		creditCardRepo:       creditCardRepo,
-		appSettingRepo:       appSettingRepo,
+		bankAccountRepo:       bankAccountRepo,
+		incomeSourceRepo:      incomeSourceRepo,
+		monthlyIncomeRepo:     monthlyIncomeRepo,
+		recurringPaymentRepo:  recurringPaymentRepo,
+		cardMonthlyTotalRepo:  cardMonthlyTotalRepo,
+		creditCardRepo:        creditCardRepo,
+		appSettingRepo:        appSettingRepo,
+		recurringTransferRepo: recurringTransferRepo,
+		budgetRepo:            budgetRepo,
+		exchangeRateRepo:      exchangeRateRepo,
 	}
 }
 
-func (s *CashflowService) GetCashflowProjection(userID uuid.UUID, months int, onlyChanges bool) ([]models.CashflowProjection, error) {
-	// Get initial balance from all bank accounts
-	bankAccounts, err := s.bankAccountRepo.GetAll(userID)
+// ProjectionOptions adjusts a cashflow projection for what-if simulations
+type ProjectionOptions struct {
+	// Prepayments are simulated lump-sum loan repayments
+	Prepayments []models.LoanPrepayment
+}
+
+func (s *CashflowService) GetCashflowProjection(ctx context.Context, userID uuid.UUID, months int, onlyChanges bool) ([]models.CashflowProjection, error) {
+	ctx, span := tracer.Start(ctx, "CashflowService.GetCashflowProjection")
+	defer span.End()
+
+	return s.GetCashflowProjectionWithOptions(ctx, userID, months, onlyChanges, ProjectionOptions{})
+}
+
+// GetCashflowProjectionWithOptions projects the daily balance like GetCashflowProjection with the
+// given simulation options applied. Totals and detail amounts are in the user's base currency,
+// account balances in the currency of each account.
+func (s *CashflowService) GetCashflowProjectionWithOptions(ctx context.Context, userID uuid.UUID, months int, onlyChanges bool, options ProjectionOptions) ([]models.CashflowProjection, error) {
+	ctx, span := tracer.Start(ctx, "CashflowService.GetCashflowProjectionWithOptions")
+	defer span.End()
+
+	inputs, err := s.loadProjectionInputs(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	totalBalance := int64(0)
-	for _, account := range bankAccounts {
-		totalBalance += account.Balance
+	startDate := time.Now()
+	currentMonth := time.Date(startDate.Year(), startDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	days, err := s.projectMonths(ctx, userID, inputs, currentMonth, months, startDate, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep only the days with changes if requested
+	if !onlyChanges {
+		return days, nil
+	}
+	projections := make([]models.CashflowProjection, 0)
+	for _, day := range days {
+		if len(day.Details) > 0 {
+			projections = append(projections, day)
+		}
+	}
+	return projections, nil
+}
+
+// projectionInputs holds the user's data a projection is computed from
+type projectionInputs struct {
+	bankAccounts      []models.BankAccount
+	incomeSources     []models.IncomeSource
+	recurringPayments []models.RecurringPayment
+	transfers         []models.RecurringTransfer
+	creditCards       []models.CreditCard
+	converter         *currencyConverter
+	livingCost        LivingCostModel
+	livingCostBase    func(yearMonth string) int64
+	cardEstimators    map[uuid.UUID]CardSpendEstimator
+}
+
+// loadProjectionInputs loads the active items of the user and makes sure every currency in use
+// can be converted to the base currency
+func (s *CashflowService) loadProjectionInputs(ctx context.Context, userID uuid.UUID) (*projectionInputs, error) {
+	// Get initial balance from all bank accounts
+	bankAccounts, err := s.bankAccountRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get active income sources
-	incomeSources, err := s.incomeSourceRepo.GetActiveByUserID(userID)
+	incomeSources, err := s.incomeSourceRepo.GetActiveByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get active recurring payments
-	recurringPayments, err := s.recurringPaymentRepo.GetActiveByUserID(userID)
+	recurringPayments, err := s.recurringPaymentRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get active transfers between accounts
+	transfers, err := s.recurringTransferRepo.GetActiveByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get credit cards (for card payments calculation)
-	creditCards, err := s.creditCardRepo.GetAll(userID)
+	creditCards, err := s.creditCardRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the exchange rates and make sure every currency in use can be converted
+	converter, err := s.getCurrencyConverter(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if err := converter.check(projectionCurrencies(bankAccounts, incomeSources, recurringPayments, creditCards)...); err != nil {
+		return nil, err
+	}
+
+	// Get the living-cost model and its monthly base amount
+	livingCost, livingCostBase := s.getLivingCostModel(ctx, userID)
+
+	return &projectionInputs{
+		bankAccounts:      bankAccounts,
+		incomeSources:     incomeSources,
+		recurringPayments: recurringPayments,
+		transfers:         transfers,
+		creditCards:       creditCards,
+		converter:         converter,
+		livingCost:        livingCost,
+		livingCostBase:    livingCostBase,
+		// Get card spend estimators for months without a recorded card total
+		cardEstimators: s.getCardSpendEstimators(ctx, userID, creditCards),
+	}, nil
+}
+
+// projectMonths returns one projection per day for the given number of months from firstMonth.
+// The balance starts from the current account balances converted on startDate, and the living
+// costs of a month depend on how many months it is after the month of startDate. A firstMonth
+// before the current month gives the scheduled flows of past months; their balances are not
+// meaningful.
+func (s *CashflowService) projectMonths(ctx context.Context, userID uuid.UUID, inputs *projectionInputs, firstMonth time.Time, months int, startDate time.Time, options ProjectionOptions) ([]models.CashflowProjection, error) {
+	defer func(started time.Time) { metrics.ObserveProjection(time.Since(started)) }(time.Now())
 
-	// Get minimum monthly expense setting
-	minimumMonthlyExpense := s.getMinimumMonthlyExpense(userID)
+	converter := inputs.converter
+	creditCards := inputs.creditCards
+
+	totalBalance := int64(0)
+	for _, account := range inputs.bankAccounts {
+		totalBalance += converter.toBase(account.Balance, account.Currency, startDate)
+	}
 
 	// Generate cashflow projection for the specified months
 	projections := make([]models.CashflowProjection, 0)
 	currentBalance := totalBalance
-	startDate := time.Now()
+	ledger := newAccountLedger(inputs.bankAccounts, converter)
 
-	for monthOffset := 0; monthOffset < months; monthOffset++ {
-		projectionMonth := startDate.AddDate(0, monthOffset, 0)
+	// Expand recurrence rules once for the whole projection period
+	periodStart := time.Date(firstMonth.Year(), firstMonth.Month(), 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, months, -1)
+	paymentSchedule, prepaymentSchedule, err := recurringPaymentSchedule(inputs.recurringPayments, options.Prepayments, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Months between the first month and the current month, for the living-cost offset
+	startOffset := (periodStart.Year()-startDate.Year())*12 + int(periodStart.Month()-startDate.Month())
+
+	for i := 0; i < months; i++ {
+		monthOffset := startOffset + i
+		projectionMonth := periodStart.AddDate(0, i, 0)
 		yearMonth := projectionMonth.Format("2006-01")
 
 		// Get days in this month
 		daysInMonth := time.Date(projectionMonth.Year(), projectionMonth.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
 
-		// Track monthly expense total for minimum expense calculation
-		monthlyExpenseTotal := int64(0)
+		// Card payments are calculated up front because living costs depend on the month's total
+		cardPayments := make(map[int][]models.CashflowProjectionDetail)
+		scheduledExpense := int64(0)
+		for _, creditCard := range creditCards {
+			if creditCard.PaymentDay < 1 || creditCard.PaymentDay > daysInMonth {
+				continue
+			}
+			// Calculate payment based on closing date and card usage
+			paymentAmount, plannedAmount, estimated := s.calculateCardPayment(ctx, creditCard, yearMonth, inputs.cardEstimators[creditCard.ID])
+			if paymentAmount > 0 {
+				detail := models.CashflowProjectionDetail{
+					Type:          "card_payment",
+					Description:   fmt.Sprintf("カード支払い: %s", creditCard.Name),
+					Amount:        paymentAmount,
+					IsEstimated:   estimated,
+					PlannedAmount: plannedAmount,
+					SourceID:      &creditCard.ID,
+					BankAccountID: &creditCard.BankAccount,
+					CategoryID:    creditCard.CategoryID,
+				}
+				paymentDate := time.Date(projectionMonth.Year(), projectionMonth.Month(), creditCard.PaymentDay, 0, 0, 0, 0, time.UTC)
+				converter.setBaseAmount(&detail, creditCard.Currency, paymentDate)
+				scheduledExpense += detail.Amount
+				cardPayments[creditCard.PaymentDay] = append(cardPayments[creditCard.PaymentDay], detail)
+			}
+		}
+
+		// Add the month's recurring payments. Simulated loan prepayments are one-off and not counted.
+		for day := 1; day <= daysInMonth; day++ {
+			date := time.Date(projectionMonth.Year(), projectionMonth.Month(), day, 0, 0, 0, 0, time.UTC)
+			for _, payment := range paymentSchedule[date.Format("2006-01-02")] {
+				scheduledExpense += converter.toBase(payment.Amount, payment.Currency, date)
+			}
+		}
+
+		livingCostAmount := inputs.livingCost.monthlyAmount(yearMonth, monthOffset, inputs.livingCostBase(yearMonth))
+		livingCostBookings := inputs.livingCost.bookings(livingCostAmount, scheduledExpense, daysInMonth)
 
 		// Process each day in the month
 		for day := 1; day <= daysInMonth; day++ {
@@ -97,53 +255,70 @@ func (s *CashflowService) GetCashflowProjection(userID uuid.UUID, months int, on
 			dayExpense := int64(0)
 			details := make([]models.CashflowProjectionDetail, 0)
 
+			// Amounts are converted from the currency of their item to the base currency
+			addIncome := func(detail models.CashflowProjectionDetail, code string) {
+				converter.setBaseAmount(&detail, code, currentDate)
+				dayIncome += detail.Amount
+				details = append(details, detail)
+			}
+			addExpense := func(detail models.CashflowProjectionDetail, code string) {
+				converter.setBaseAmount(&detail, code, currentDate)
+				dayExpense += detail.Amount
+				details = append(details, detail)
+			}
+
 			// Calculate income for this day
-			for _, incomeSource := range incomeSources {
-				if incomeSource.IncomeType == "monthly_fixed" {
+			for _, incomeSource := range inputs.incomeSources {
+				if isScheduledIncome(incomeSource) {
 					// Use payment_day if available, otherwise default to 25th
-					paymentDay := 25
-					if incomeSource.PaymentDay != nil {
-						paymentDay = *incomeSource.PaymentDay
-					}
+					paymentDay := incomePaymentDay(incomeSource, daysInMonth)
 
-					if day == paymentDay {
+					if day == paymentDay && incomePaysInMonth(incomeSource, currentDate.Month()) {
+						baseAmount := incomeAmountForYear(incomeSource, currentDate.Year())
 
 						// Check if there's a specific record for this month
-						records, err := s.monthlyIncomeRepo.GetByUserIDAndYearMonth(userID, yearMonth)
+						records, err := s.monthlyIncomeRepo.GetByUserIDAndYearMonth(ctx, userID, yearMonth)
 						if err == nil {
 							recordFound := false
 							for _, record := range records {
 								if record.IncomeSourceID == incomeSource.ID {
-									dayIncome += record.ActualAmount
-									details = append(details, models.CashflowProjectionDetail{
-										Type:        "income",
-										Description: fmt.Sprintf("収入: %s", incomeSource.Name),
-										Amount:      record.ActualAmount,
-									})
+									addIncome(models.CashflowProjectionDetail{
+										Type:          "income",
+										Description:   fmt.Sprintf("収入: %s", incomeSource.Name),
+										Amount:        record.ActualAmount,
+										PlannedAmount: &baseAmount,
+										SourceID:      &incomeSource.ID,
+										BankAccountID: &incomeSource.BankAccount,
+										CategoryID:    incomeSource.CategoryID,
+									}, incomeSource.Currency)
 									recordFound = true
 									break
 								}
 							}
 							// Use base amount if no specific record found
 							if !recordFound {
-								dayIncome += incomeSource.BaseAmount
-								details = append(details, models.CashflowProjectionDetail{
-									Type:        "income",
-									Description: fmt.Sprintf("収入: %s", incomeSource.Name),
-									Amount:      incomeSource.BaseAmount,
-								})
+								addIncome(models.CashflowProjectionDetail{
+									Type:          "income",
+									Description:   fmt.Sprintf("収入: %s", incomeSource.Name),
+									Amount:        baseAmount,
+									SourceID:      &incomeSource.ID,
+									BankAccountID: &incomeSource.BankAccount,
+									CategoryID:    incomeSource.CategoryID,
+								}, incomeSource.Currency)
 							}
 						} else {
 							// Use base amount if query failed
-							dayIncome += incomeSource.BaseAmount
-							details = append(details, models.CashflowProjectionDetail{
-								Type:        "income",
-								Description: fmt.Sprintf("収入: %s", incomeSource.Name),
-								Amount:      incomeSource.BaseAmount,
-							})
+							addIncome(models.CashflowProjectionDetail{
+								Type:          "income",
+								Description:   fmt.Sprintf("収入: %s", incomeSource.Name),
+								Amount:        baseAmount,
+								SourceID:      &incomeSource.ID,
+								BankAccountID: &incomeSource.BankAccount,
+								CategoryID:    incomeSource.CategoryID,
+							}, incomeSource.Currency)
 						}
 					}
-				} else if incomeSource.IncomeType == "one_time" {
+				} else if incomeSource.IncomeType == IncomeTypeOneTime {
 					// Check if this is the scheduled date for one-time income
 					if incomeSource.ScheduledDate != nil {
 
@@ -169,201 +344,259 @@ func (s *CashflowService) GetCashflowProjection(userID uuid.UUID, months int, on
 						if scheduledDate.Year() == currentDate.Year() &&
 							scheduledDate.Month() == currentDate.Month() &&
 							scheduledDate.Day() == currentDate.Day() {
-							dayIncome += incomeSource.BaseAmount
-							details = append(details, models.CashflowProjectionDetail{
-								Type:        "income",
-								Description: fmt.Sprintf("臨時収入: %s", incomeSource.Name),
-								Amount:      incomeSource.BaseAmount,
-							})
+							addIncome(models.CashflowProjectionDetail{
+								Type:          "income",
+								Description:   fmt.Sprintf("臨時収入: %s", incomeSource.Name),
+								Amount:        incomeSource.BaseAmount,
+								SourceID:      &incomeSource.ID,
+								BankAccountID: &incomeSource.BankAccount,
+								CategoryID:    incomeSource.CategoryID,
+							}, incomeSource.Currency)
 						}
 					} else if incomeSource.ScheduledYearMonth != nil && *incomeSource.ScheduledYearMonth == yearMonth {
 						// Fallback to first day of month for backward compatibility
 						if day == 1 {
-							dayIncome += incomeSource.BaseAmount
-							details = append(details, models.CashflowProjectionDetail{
-								Type:        "income",
-								Description: fmt.Sprintf("臨時収入: %s", incomeSource.Name),
-								Amount:      incomeSource.BaseAmount,
-							})
+							addIncome(models.CashflowProjectionDetail{
+								Type:          "income",
+								Description:   fmt.Sprintf("臨時収入: %s", incomeSource.Name),
+								Amount:        incomeSource.BaseAmount,
+								SourceID:      &incomeSource.ID,
+								BankAccountID: &incomeSource.BankAccount,
+								CategoryID:    incomeSource.CategoryID,
+							}, incomeSource.Currency)
 						}
 					}
 				}
 			}
 
-			// Calculate recurring payments for this day
-			for _, payment := range recurringPayments {
-				if payment.PaymentDay == day {
-					// Check if this payment should be applied in this month
-					shouldApplyPayment := s.shouldApplyRecurringPayment(payment, yearMonth)
-
-					if shouldApplyPayment {
-						dayExpense += payment.Amount
-						monthlyExpenseTotal += payment.Amount
-						details = append(details, models.CashflowProjectionDetail{
-							Type:        "recurring_payment",
-							Description: fmt.Sprintf("固定支出: %s", payment.Name),
-							Amount:      payment.Amount,
-						})
-					}
-				}
+			// Calculate recurring payments due on this day
+			for _, payment := range paymentSchedule[currentDate.Format("2006-01-02")] {
+				addExpense(models.CashflowProjectionDetail{
+					Type:          "recurring_payment",
+					Description:   fmt.Sprintf("固定支出: %s", payment.Name),
+					Amount:        payment.Amount,
+					SourceID:      &payment.ID,
+					BankAccountID: &payment.BankAccount,
+					CategoryID:    payment.CategoryID,
+				}, payment.Currency)
 			}
 
-			// Calculate card payments for this day
-			for _, creditCard := range creditCards {
-				if creditCard.PaymentDay == day {
-					// Calculate payment based on closing date and card usage
-					paymentAmount := s.calculateCardPayment(creditCard, yearMonth)
-					if paymentAmount > 0 {
-						dayExpense += paymentAmount
-						monthlyExpenseTotal += paymentAmount
-						details = append(details, models.CashflowProjectionDetail{
-							Type:        "card_payment",
-							Description: fmt.Sprintf("カード支払い: %s", creditCard.Name),
-							Amount:      paymentAmount,
-						})
-					}
-				}
+			// Simulated loan prepayments
+			for _, prepayment := range prepaymentSchedule[currentDate.Format("2006-01-02")] {
+				addExpense(models.CashflowProjectionDetail{
+					Type:          "loan_prepayment",
+					Description:   fmt.Sprintf("繰上返済: %s", prepayment.payment.Name),
+					Amount:        prepayment.amount,
+					SourceID:      &prepayment.payment.ID,
+					BankAccountID: &prepayment.payment.BankAccount,
+					CategoryID:    prepayment.payment.CategoryID,
+				}, prepayment.payment.Currency)
 			}
 
-			// Check for minimum monthly expense on the 26th day of 3rd month onwards
-			if monthOffset >= 2 && day == 26 && minimumMonthlyExpense > 0 {
-				if monthlyExpenseTotal < minimumMonthlyExpense {
-					shortfall := minimumMonthlyExpense - monthlyExpenseTotal
-					dayExpense += shortfall
-					monthlyExpenseTotal += shortfall
-					details = append(details, models.CashflowProjectionDetail{
-						Type:        "recurring_payment",
-						Description: "最低月支出調整",
-						Amount:      shortfall,
-					})
-				}
+			// Card payments due on this day, already in the base currency
+			for _, detail := range cardPayments[day] {
+				dayExpense += detail.Amount
+				details = append(details, detail)
+			}
+
+			// Living costs booked on this day, in the base currency
+			if amount := livingCostBookings[day]; amount > 0 {
+				dayExpense += amount
+				details = append(details, models.CashflowProjectionDetail{
+					Type:          "living_cost",
+					Description:   "生活費",
+					Amount:        amount,
+					BankAccountID: ledger.primary,
+				})
 			}
 
 			// Update balance
 			currentBalance = currentBalance + dayIncome - dayExpense
 
-			// Create projection for this day only if there are changes or if onlyChanges is false
-			if !onlyChanges || dayIncome > 0 || dayExpense > 0 {
-				projection := models.CashflowProjection{
-					Date:    currentDate.Format("2006-01-02"),
-					Income:  dayIncome,
-					Expense: dayExpense,
-					Balance: currentBalance,
-					Details: details,
-				}
-
-				projections = append(projections, projection)
+			// Update account balances, then move money between accounts. Transfers do not change the total.
+			for _, detail := range details {
+				ledger.book(detail, currentDate)
 			}
+			details = append(details, applyTransfers(ledger, inputs.transfers, currentDate)...)
+
+			projections = append(projections, models.CashflowProjection{
+				Date:            currentDate.Format("2006-01-02"),
+				Income:          dayIncome,
+				Expense:         dayExpense,
+				Balance:         currentBalance,
+				AccountBalances: ledger.snapshot(),
+				Details:         details,
+			})
 		}
 	}
 
 	return projections, nil
 }
 
-// getMinimumMonthlyExpense retrieves the minimum monthly expense setting for a user
-func (s *CashflowService) getMinimumMonthlyExpense(userID uuid.UUID) int64 {
-	settings, err := s.appSettingRepo.GetByUserID(userID)
+// GetBaseCurrency returns the currency projected totals are reported in
+func (s *CashflowService) GetBaseCurrency(ctx context.Context, userID uuid.UUID) string {
+	ctx, span := tracer.Start(ctx, "CashflowService.GetBaseCurrency")
+	defer span.End()
+
+	return baseCurrency(ctx, s.appSettingRepo, userID)
+}
+
+// getCurrencyConverter returns a converter to the user's base currency with the user's exchange rates
+func (s *CashflowService) getCurrencyConverter(ctx context.Context, userID uuid.UUID) (*currencyConverter, error) {
+	return loadCurrencyConverter(ctx, s.appSettingRepo, s.exchangeRateRepo, userID)
+}
+
+// projectionCurrencies returns the currencies of the accounts and items of a projection
+func projectionCurrencies(bankAccounts []models.BankAccount, incomeSources []models.IncomeSource, recurringPayments []models.RecurringPayment, creditCards []models.CreditCard) []string {
+	codes := make([]string, 0, len(bankAccounts)+len(incomeSources)+len(recurringPayments)+len(creditCards))
+	for _, account := range bankAccounts {
+		codes = append(codes, account.Currency)
+	}
+	for _, source := range incomeSources {
+		codes = append(codes, source.Currency)
+	}
+	for _, payment := range recurringPayments {
+		codes = append(codes, payment.Currency)
+	}
+	for _, card := range creditCards {
+		codes = append(codes, card.Currency)
+	}
+	return codes
+}
+
+// getLivingCostModel returns the user's living-cost model and the base amount of each month
+// ("2024-01") before inflation: the amount setting, or the sum of the month's budgets when the
+// budgets are chosen as the source. Living costs are left out when the settings cannot be read.
+func (s *CashflowService) getLivingCostModel(ctx context.Context, userID uuid.UUID) (LivingCostModel, func(yearMonth string) int64) {
+	none := func(string) int64 { return 0 }
+
+	settings, err := s.appSettingRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		return 0
+		return DefaultLivingCostModel(), none
 	}
 
+	values := make(map[string]string, len(settings))
 	for _, setting := range settings {
-		if setting.Key == "minimum_monthly_expense" {
-			amount, err := strconv.ParseInt(setting.Value, 10, 64)
-			if err != nil {
-				return 0
-			}
-			return amount
+		values[setting.Key] = setting.Value
+	}
+	model := parseLivingCostModel(values)
+
+	if model.Source == LivingCostSourceBudgets {
+		budgets, err := s.budgetRepo.GetAll(ctx, userID)
+		if err != nil {
+			return model, none
+		}
+		return model, func(yearMonth string) int64 {
+			return monthlyBudgetTotal(budgets, yearMonth)
 		}
 	}
 
-	return 0
+	amount := model.Amount
+	return model, func(string) int64 { return amount }
 }
 
-func (s *CashflowService) calculateCardPayment(creditCard models.CreditCard, currentYearMonth string) int64 {
-	// Parse year and month
-	parts := strings.Split(currentYearMonth, "-")
-	if len(parts) != 2 {
-		return 0
-	}
+// getCardSpendEstimators resolves the estimator for each credit card from the user's settings.
+// A non-empty per-card setting overrides the user-wide default; invalid values fall back to no estimate.
+func (s *CashflowService) getCardSpendEstimators(ctx context.Context, userID uuid.UUID, creditCards []models.CreditCard) map[uuid.UUID]CardSpendEstimator {
+	estimators := make(map[uuid.UUID]CardSpendEstimator, len(creditCards))
 
-	year, err := strconv.Atoi(parts[0])
+	settings, err := s.appSettingRepo.GetByUserID(ctx, userID)
 	if err != nil {
-		return 0
+		return estimators
 	}
 
-	month, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return 0
+	values := make(map[string]string, len(settings))
+	for _, setting := range settings {
+		values[setting.Key] = setting.Value
 	}
 
-	// Calculate which month's usage should be paid
-	// For example, if closing day is 15 and payment day is 10:
-	// - Usage from 16th of previous month to 15th of current month is paid on 10th of next month
+	for _, creditCard := range creditCards {
+		value, ok := values[CardEstimatorSettingKeyFor(creditCard.ID.String())]
+		if !ok || value == "" {
+			value = values[CardEstimatorSettingKey]
+		}
 
-	var targetYearMonth string
-	if creditCard.ClosingDay != nil {
-		// If current date is before closing day, we pay for previous month's usage
-		// If current date is after closing day, we pay for current month's usage
-		// For simplicity, let's assume we pay previous month's usage
-		prevMonth := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
-		targetYearMonth = prevMonth.Format("2006-01")
-	} else {
-		// For credit cards without closing day, use current month
-		targetYearMonth = currentYearMonth
+		estimator, err := ParseCardSpendEstimator(value)
+		if err != nil {
+			continue
+		}
+		estimators[creditCard.ID] = estimator
+	}
+
+	return estimators
+}
+
+// calculateCardPayment returns the card payment due in currentYearMonth and whether the
+// amount is an estimate rather than a recorded CardMonthlyTotal. For a recorded total it also
+// returns what the estimator would have estimated, nil without an estimate.
+func (s *CashflowService) calculateCardPayment(ctx context.Context, creditCard models.CreditCard, currentYearMonth string, estimator CardSpendEstimator) (int64, *int64, bool) {
+	targetYearMonth, err := cardStatementYearMonth(creditCard, currentYearMonth)
+	if err != nil {
+		return 0, nil, false
 	}
 
 	// Get card usage for the target month
-	totals, err := s.cardMonthlyTotalRepo.GetByCreditCardID(creditCard.ID)
+	totals, err := s.cardMonthlyTotalRepo.GetByCreditCardID(ctx, creditCard.ID)
 	if err != nil {
-		return 0
+		return 0, nil, false
+	}
+
+	// Estimators only look at the months before the target, so the recorded total does not
+	// affect its own estimate
+	var estimate *int64
+	if estimator != nil {
+		if amount, ok := estimator.Estimate(totals, targetYearMonth); ok {
+			estimate = &amount
+		}
 	}
 
 	for _, total := range totals {
 		if total.YearMonth == targetYearMonth {
-			return total.TotalAmount
+			return total.TotalAmount, estimate, false
 		}
 	}
 
-	return 0
-}
-
-// shouldApplyRecurringPayment determines if a recurring payment should be applied in the given month
-func (s *CashflowService) shouldApplyRecurringPayment(payment models.RecurringPayment, targetYearMonth string) bool {
-	// If payment is not active, don't apply
-	if !payment.IsActive {
-		return false
+	// No statement recorded yet, fall back to the card's estimator
+	if estimate == nil {
+		return 0, nil, false
 	}
 
-	// Parse start year-month and target year-month
-	startYear, startMonth, err := parseYearMonth(payment.StartYearMonth)
+	return *estimate, nil, true
+}
+
+// cardStatementYearMonth returns the usage month that is paid by the card payment in paymentYearMonth
+func cardStatementYearMonth(creditCard models.CreditCard, paymentYearMonth string) (string, error) {
+	year, month, err := parseYearMonth(paymentYearMonth)
 	if err != nil {
-		return false
+		return "", err
 	}
 
-	targetYear, targetMonth, err := parseYearMonth(targetYearMonth)
-	if err != nil {
-		return false
+	// Calculate which month's usage should be paid
+	// For example, if closing day is 15 and payment day is 10:
+	// - Usage from 16th of previous month to 15th of current month is paid on 10th of next month
+	if creditCard.ClosingDay != nil {
+		// If current date is before closing day, we pay for previous month's usage
+		// If current date is after closing day, we pay for current month's usage
+		// For simplicity, let's assume we pay previous month's usage
+		prevMonth := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+		return prevMonth.Format("2006-01"), nil
 	}
 
-	// Calculate months elapsed since start
-	monthsElapsed := (targetYear-startYear)*12 + (targetMonth - startMonth)
+	// For credit cards without closing day, use current month
+	return paymentYearMonth, nil
+}
 
-	// If target month is before start month, don't apply
-	if monthsElapsed < 0 {
+// shouldApplyRecurringPayment determines if a recurring payment is due at least once in the given month
+func (s *CashflowService) shouldApplyRecurringPayment(payment models.RecurringPayment, targetYearMonth string) bool {
+	targetYear, targetMonth, err := parseYearMonth(targetYearMonth)
+	if err != nil {
 		return false
 	}
 
-	// If no total payments specified (infinite payments), apply if active
-	if payment.TotalPayments == nil || *payment.TotalPayments == 0 {
-		return true
-	}
-
-	// Calculate current payment number (1-based)
-	currentPaymentNumber := monthsElapsed + 1
+	monthStart := time.Date(targetYear, time.Month(targetMonth), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, -1)
 
-	// Check if we haven't exceeded the total payment count
-	return currentPaymentNumber <= *payment.TotalPayments
+	return len(recurringPaymentOccurrences(payment, monthStart, monthEnd)) > 0
 }
 
 // parseYearMonth parses a year-month string like "2024-01" into year and month integers
@@ -1,12 +1,19 @@
 package services
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/repositories"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCashflowService_shouldApplyRecurringPayment(t *testing.T) {
@@ -141,6 +148,73 @@ func TestCashflowService_shouldApplyRecurringPayment(t *testing.T) {
 			expectedResult:  false,
 			description:     "Inactive payments should not apply",
 		},
+		{
+			name: "yearly rule in its month - should apply",
+			payment: models.RecurringPayment{
+				ID:             userID,
+				UserID:         userID,
+				Name:           "Car Tax",
+				Amount:         34500,
+				PaymentDay:     31,
+				StartYearMonth: "2024-01",
+				RecurrenceRule: func() *string { s := "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=31"; return &s }(),
+				BankAccount:    bankAccountID,
+				IsActive:       true,
+			},
+			targetYearMonth: "2025-05",
+			expectedResult:  true,
+			description:     "Yearly payments should apply in their month",
+		},
+		{
+			name: "yearly rule outside its month - should not apply",
+			payment: models.RecurringPayment{
+				ID:             userID,
+				UserID:         userID,
+				Name:           "Car Tax",
+				Amount:         34500,
+				PaymentDay:     31,
+				StartYearMonth: "2024-01",
+				RecurrenceRule: func() *string { s := "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=31"; return &s }(),
+				BankAccount:    bankAccountID,
+				IsActive:       true,
+			},
+			targetYearMonth: "2025-06",
+			expectedResult:  false,
+			description:     "Yearly payments should not apply in other months",
+		},
+		{
+			name: "quarterly rule - skipped month",
+			payment: models.RecurringPayment{
+				ID:             userID,
+				UserID:         userID,
+				Name:           "Quarterly Fee",
+				Amount:         12000,
+				PaymentDay:     10,
+				StartYearMonth: "2024-01",
+				RecurrenceRule: func() *string { s := "FREQ=MONTHLY;INTERVAL=3"; return &s }(),
+				BankAccount:    bankAccountID,
+				IsActive:       true,
+			},
+			targetYearMonth: "2024-05",
+			expectedResult:  false,
+			description:     "Quarterly payments should skip the months in between",
+		},
+		{
+			name: "payment day 31 in a short month - should apply",
+			payment: models.RecurringPayment{
+				ID:             userID,
+				UserID:         userID,
+				Name:           "Month End Payment",
+				Amount:         10000,
+				PaymentDay:     31,
+				StartYearMonth: "2024-01",
+				BankAccount:    bankAccountID,
+				IsActive:       true,
+			},
+			targetYearMonth: "2024-02",
+			expectedResult:  true,
+			description:     "Payment day 31 should fall on the last day of shorter months",
+		},
 	}
 
 	for _, tt := range tests {
@@ -210,3 +284,240 @@ func TestParseYearMonth(t *testing.T) {
 		})
 	}
 }
+
+func TestCashflowService_getLivingCostModel(t *testing.T) {
+	userID := uuid.New()
+	settingColumns := []string{"id", "user_id", "key", "value", "created_at", "updated_at"}
+
+	tests := []struct {
+		name     string
+		settings map[string]string
+		budgets  bool
+		mode     string
+		expected map[string]int64
+	}{
+		{
+			name:     "fixed setting",
+			settings: map[string]string{LivingCostAmountSettingKey: "150000", LivingCostModeSettingKey: LivingCostModeAdditional},
+			mode:     LivingCostModeAdditional,
+			expected: map[string]int64{"2024-11": 150000, "2024-12": 150000},
+		},
+		{
+			name:     "invalid setting",
+			settings: map[string]string{LivingCostAmountSettingKey: "a lot", LivingCostModeSettingKey: "sometimes"},
+			mode:     LivingCostModeShortfall,
+			expected: map[string]int64{"2024-12": 0},
+		},
+		{
+			name:     "budgets replace the setting",
+			settings: map[string]string{LivingCostAmountSettingKey: "150000", LivingCostSourceSettingKey: LivingCostSourceBudgets},
+			budgets:  true,
+			mode:     LivingCostModeShortfall,
+			expected: map[string]int64{"2024-11": 70000, "2024-12": 110000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := helpers.SetupMockDB(t)
+			defer helpers.TeardownMockDB(db)
+
+			rows := sqlmock.NewRows(settingColumns)
+			for key, value := range tt.settings {
+				rows.AddRow(uuid.New(), userID, key, value, time.Now(), time.Now())
+			}
+			mock.ExpectQuery(`SELECT (.+) FROM app_settings WHERE user_id = \$1`).WithArgs(userID).WillReturnRows(rows)
+
+			if tt.budgets {
+				food, leisure := uuid.New(), uuid.New()
+				mock.ExpectQuery(`SELECT (.+) FROM budgets WHERE user_id = \$1`).WithArgs(userID).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "user_id", "category_id", "amount", "year_month", "created_at", "updated_at"}).
+						AddRow(uuid.New(), userID, food, int64(50000), nil, time.Now(), time.Now()).
+						AddRow(uuid.New(), userID, leisure, int64(20000), nil, time.Now(), time.Now()).
+						AddRow(uuid.New(), userID, leisure, int64(60000), "2024-12", time.Now(), time.Now()))
+			}
+
+			service := &CashflowService{
+				appSettingRepo: repositories.NewAppSettingRepository(db),
+				budgetRepo:     repositories.NewBudgetRepository(db),
+			}
+			model, base := service.getLivingCostModel(context.Background(), userID)
+
+			assert.Equal(t, tt.mode, model.Mode)
+			for yearMonth, expected := range tt.expected {
+				assert.Equal(t, expected, base(yearMonth), yearMonth)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCashflowService_getCurrencyConverter(t *testing.T) {
+	userID := uuid.New()
+	settingColumns := []string{"id", "user_id", "key", "value", "created_at", "updated_at"}
+	rateColumns := []string{"id", "user_id", "from_currency", "to_currency", "rate", "rate_date", "created_at", "updated_at"}
+	usd, unknown := "USD", "XXX"
+
+	tests := []struct {
+		name     string
+		setting  *string
+		expected string
+	}{
+		{name: "base currency setting", setting: &usd, expected: "USD"},
+		{name: "unknown currency falls back to yen", setting: &unknown, expected: "JPY"},
+		{name: "no setting", expected: "JPY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := helpers.SetupMockDB(t)
+			defer helpers.TeardownMockDB(db)
+
+			query := mock.ExpectQuery(`SELECT (.+) FROM app_settings WHERE user_id = \$1 AND key = \$2`).WithArgs(userID, BaseCurrencySettingKey)
+			if tt.setting != nil {
+				query.WillReturnRows(sqlmock.NewRows(settingColumns).AddRow(uuid.New(), userID, BaseCurrencySettingKey, *tt.setting, time.Now(), time.Now()))
+			} else {
+				query.WillReturnError(sql.ErrNoRows)
+			}
+			mock.ExpectQuery(`SELECT (.+) FROM exchange_rates WHERE user_id = \$1`).WithArgs(userID).WillReturnRows(
+				sqlmock.NewRows(rateColumns).AddRow(uuid.New(), userID, "USD", "JPY", 150.0, "2024-01-01", time.Now(), time.Now()))
+
+			service := &CashflowService{
+				appSettingRepo:   repositories.NewAppSettingRepository(db),
+				exchangeRateRepo: repositories.NewExchangeRateRepository(db),
+			}
+			converter, err := service.getCurrencyConverter(context.Background(), userID)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, converter.base)
+			assert.NoError(t, converter.check("USD", "JPY"))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCashflowService_calculateCardPayment(t *testing.T) {
+	closingDay := 15
+	creditCard := models.CreditCard{ID: uuid.New(), ClosingDay: &closingDay, PaymentDay: 10}
+	totalColumns := []string{"id", "credit_card_id", "year_month", "total_amount", "is_confirmed", "created_at", "updated_at"}
+	estimator := trailingAverageEstimator{months: 2}
+	estimate := int64(30000)
+
+	tests := []struct {
+		name              string
+		paymentYearMonth  string
+		expectedAmount    int64
+		expectedPlanned   *int64
+		expectedEstimated bool
+	}{
+		{name: "recorded total with the estimate it replaces", paymentYearMonth: "2024-04", expectedAmount: 50000, expectedPlanned: &estimate},
+		{name: "estimated without a recorded total", paymentYearMonth: "2024-05", expectedAmount: 45000, expectedEstimated: true},
+		{name: "recorded total without history", paymentYearMonth: "2024-02", expectedAmount: 20000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := helpers.SetupMockDB(t)
+			defer helpers.TeardownMockDB(db)
+
+			mock.ExpectQuery(`SELECT (.+) FROM card_monthly_totals WHERE credit_card_id = \$1`).WithArgs(creditCard.ID).WillReturnRows(
+				sqlmock.NewRows(totalColumns).
+					AddRow(uuid.New(), creditCard.ID, "2024-03", int64(50000), true, time.Now(), time.Now()).
+					AddRow(uuid.New(), creditCard.ID, "2024-02", int64(40000), true, time.Now(), time.Now()).
+					AddRow(uuid.New(), creditCard.ID, "2024-01", int64(20000), true, time.Now(), time.Now()))
+
+			service := &CashflowService{cardMonthlyTotalRepo: repositories.NewCardMonthlyTotalRepository(db)}
+			amount, planned, estimated := service.calculateCardPayment(context.Background(), creditCard, tt.paymentYearMonth, estimator)
+
+			assert.Equal(t, tt.expectedAmount, amount)
+			assert.Equal(t, tt.expectedPlanned, planned)
+			assert.Equal(t, tt.expectedEstimated, estimated)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCashflowService_GetCashflowProjection_CardEstimates(t *testing.T) {
+	db, mock := helpers.SetupMockDB(t)
+	defer helpers.TeardownMockDB(db)
+
+	userID, accountID := uuid.New(), uuid.New()
+	closingDay := 15
+	overridden := models.CreditCard{ID: uuid.New(), Name: "Override", ClosingDay: &closingDay, PaymentDay: 10}
+	defaulted := models.CreditCard{ID: uuid.New(), Name: "Default", ClosingDay: &closingDay, PaymentDay: 10}
+
+	now := time.Now()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	previousMonth := currentMonth.AddDate(0, -1, 0).Format("2006-01")
+
+	settingColumns := []string{"id", "user_id", "key", "value", "created_at", "updated_at"}
+	settings := func() *sqlmock.Rows {
+		return sqlmock.NewRows(settingColumns).
+			AddRow(uuid.New(), userID, CardEstimatorSettingKey, CardEstimatorTrailingAverage, now, now).
+			AddRow(uuid.New(), userID, CardEstimatorSettingKeyFor(overridden.ID.String()), "fixed_budget:30000", now, now)
+	}
+	totalColumns := []string{"id", "credit_card_id", "year_month", "total_amount", "is_confirmed", "created_at", "updated_at"}
+	totals := func(card models.CreditCard, amount int64) *sqlmock.Rows {
+		return sqlmock.NewRows(totalColumns).AddRow(uuid.New(), card.ID, previousMonth, amount, true, now, now)
+	}
+
+	mock.ExpectQuery(`FROM bank_accounts`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`FROM income_sources`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`FROM recurring_payments`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`FROM recurring_transfers`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	cardRows := sqlmock.NewRows([]string{"id", "user_id", "name", "closing_day", "payment_day", "bank_account", "category_id", "tags", "currency", "created_at", "updated_at"})
+	for _, card := range []models.CreditCard{overridden, defaulted} {
+		cardRows.AddRow(card.ID, userID, card.Name, closingDay, 10, accountID, nil, nil, "JPY", now, now)
+	}
+	mock.ExpectQuery(`FROM credit_cards`).WithArgs(userID).WillReturnRows(cardRows)
+	mock.ExpectQuery(`FROM app_settings WHERE user_id = \$1 AND key = \$2`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`FROM exchange_rates`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`FROM app_settings WHERE user_id = \$1`).WithArgs(userID).WillReturnRows(settings())
+	mock.ExpectQuery(`FROM app_settings WHERE user_id = \$1`).WithArgs(userID).WillReturnRows(settings())
+	// Both months look up the totals of both cards. Only the previous month is recorded, so
+	// the current month pays a recorded statement and the next month an estimate.
+	for i := 0; i < 2; i++ {
+		mock.ExpectQuery(`FROM card_monthly_totals`).WithArgs(overridden.ID).WillReturnRows(totals(overridden, 50000))
+		mock.ExpectQuery(`FROM card_monthly_totals`).WithArgs(defaulted.ID).WillReturnRows(totals(defaulted, 40000))
+	}
+
+	service := NewCashflowService(
+		repositories.NewBankAccountRepository(db),
+		repositories.NewIncomeSourceRepository(db),
+		repositories.NewMonthlyIncomeRepository(db),
+		repositories.NewRecurringPaymentRepository(db),
+		repositories.NewCardMonthlyTotalRepository(db),
+		repositories.NewCreditCardRepository(db),
+		repositories.NewAppSettingRepository(db),
+		repositories.NewRecurringTransferRepository(db),
+		repositories.NewBudgetRepository(db),
+		repositories.NewExchangeRateRepository(db),
+	)
+	projections, err := service.GetCashflowProjection(context.Background(), userID, 2, true)
+	require.NoError(t, err)
+
+	payments := make(map[string]models.CashflowProjectionDetail)
+	for _, projection := range projections {
+		for _, detail := range projection.Details {
+			if detail.Type == "card_payment" {
+				payments[projection.Date[:7]+" "+detail.Description] = detail
+			}
+		}
+	}
+	require.Len(t, payments, 4)
+
+	current, next := currentMonth.Format("2006-01"), currentMonth.AddDate(0, 1, 0).Format("2006-01")
+	recorded := payments[current+" カード支払い: Override"]
+	assert.Equal(t, int64(50000), recorded.Amount)
+	assert.False(t, recorded.IsEstimated, "a recorded statement is not an estimate")
+
+	override := payments[next+" カード支払い: Override"]
+	assert.Equal(t, int64(30000), override.Amount, "the per-card setting overrides the user-wide one")
+	assert.True(t, override.IsEstimated)
+
+	userWide := payments[next+" カード支払い: Default"]
+	assert.Equal(t, int64(40000), userWide.Amount, "trailing average of the recorded months")
+	assert.True(t, userWide.IsEstimated)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
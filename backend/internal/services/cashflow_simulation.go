@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultSimulationCount = 1000
+	MaxSimulationCount     = 5000
+
+	// Number of most recent records used to estimate the variance of an item
+	simulationHistoryLimit = 24
+)
+
+// variableFlow is a single dated cash movement whose amount varies between simulations
+type variableFlow struct {
+	dayIndex int     // Index into the daily baseline projection
+	baseline int64   // Amount already included in the baseline balance
+	mean     float64 // Centre of the sampled distribution
+	stdDev   float64 // Standard deviation of the sampled distribution
+	sign     int64   // +1 for income, -1 for expense
+}
+
+// GetProbabilisticProjection runs a Monte Carlo simulation on top of the deterministic projection.
+// Card spend and variable income are sampled from the spread of their recorded history, so the
+// result is a band of balances per day rather than a single line. The same seed always produces
+// the same result.
+func (s *CashflowService) GetProbabilisticProjection(ctx context.Context, userID uuid.UUID, months int, simulations int, seed int64) (*models.ProbabilisticProjection, error) {
+	ctx, span := tracer.Start(ctx, "CashflowService.GetProbabilisticProjection")
+	defer span.End()
+
+	baseline, err := s.GetCashflowProjection(ctx, userID, months, false)
+	if err != nil {
+		return nil, err
+	}
+
+	flows, err := s.buildVariableFlows(ctx, userID, baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	return simulateProjection(baseline, flows, simulations, seed), nil
+}
+
+// buildVariableFlows finds the projected items that have a recorded history and are not yet fixed.
+// The history is in the currency of the item and is converted to the base currency of the projection.
+func (s *CashflowService) buildVariableFlows(ctx context.Context, userID uuid.UUID, baseline []models.CashflowProjection) ([]variableFlow, error) {
+	flows := make([]variableFlow, 0)
+	if len(baseline) == 0 {
+		return flows, nil
+	}
+
+	converter, err := s.getCurrencyConverter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dayIndex := make(map[string]int, len(baseline))
+	for i, day := range baseline {
+		dayIndex[day.Date] = i
+	}
+
+	creditCards, err := s.creditCardRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, creditCard := range creditCards {
+		totals, err := s.cardMonthlyTotalRepo.GetByCreditCardID(ctx, creditCard.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts := make([]int64, 0, len(totals))
+		recorded := make(map[string]bool, len(totals))
+		for _, total := range totals {
+			amounts = append(amounts, total.TotalAmount)
+			recorded[total.YearMonth] = true
+		}
+		mean, stdDev, ok := historyStats(amounts)
+		if !ok {
+			continue
+		}
+
+		for i, day := range baseline {
+			date, err := time.Parse("2006-01-02", day.Date)
+			if err != nil || date.Day() != creditCard.PaymentDay {
+				continue
+			}
+
+			statementYearMonth, err := cardStatementYearMonth(creditCard, date.Format("2006-01"))
+			if err != nil || recorded[statementYearMonth] {
+				// A recorded statement is a known amount
+				continue
+			}
+
+			rate := converter.baseRate(creditCard.Currency, date)
+			flow := variableFlow{dayIndex: i, mean: mean * rate, stdDev: stdDev * rate, sign: -1}
+			if detail := findDetail(day.Details, "card_payment", creditCard.ID); detail != nil {
+				flow.baseline = detail.Amount
+				flow.mean = float64(detail.Amount)
+			}
+			flows = append(flows, flow)
+		}
+	}
+
+	incomeSources, err := s.incomeSourceRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, source := range incomeSources {
+		records, err := s.monthlyIncomeRepo.GetByIncomeSourceID(ctx, source.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts := make([]int64, 0, len(records))
+		recorded := make(map[string]bool, len(records))
+		for _, record := range records {
+			amounts = append(amounts, record.ActualAmount)
+			recorded[record.YearMonth] = true
+		}
+		_, stdDev, ok := historyStats(amounts)
+		if !ok {
+			continue
+		}
+
+		for _, day := range baseline {
+			if recorded[day.Date[:7]] {
+				continue
+			}
+			detail := findDetail(day.Details, "income", source.ID)
+			if detail == nil {
+				continue
+			}
+			date, err := time.Parse("2006-01-02", day.Date)
+			if err != nil {
+				continue
+			}
+			flows = append(flows, variableFlow{
+				dayIndex: dayIndex[day.Date],
+				baseline: detail.Amount,
+				mean:     float64(detail.Amount),
+				stdDev:   stdDev * converter.baseRate(source.Currency, date),
+				sign:     1,
+			})
+		}
+	}
+
+	return flows, nil
+}
+
+// simulateProjection samples every variable flow for each simulation run and aggregates the
+// resulting daily balances into percentile bands
+func simulateProjection(baseline []models.CashflowProjection, flows []variableFlow, simulations int, seed int64) *models.ProbabilisticProjection {
+	if simulations <= 0 {
+		simulations = DefaultSimulationCount
+	}
+	if simulations > MaxSimulationCount {
+		simulations = MaxSimulationCount
+	}
+
+	result := &models.ProbabilisticProjection{
+		Simulations: simulations,
+		Seed:        seed,
+		Days:        make([]models.ProjectionBand, len(baseline)),
+	}
+	if len(baseline) == 0 {
+		return result
+	}
+
+	flowsByDay := make([][]variableFlow, len(baseline))
+	for _, flow := range flows {
+		flowsByDay[flow.dayIndex] = append(flowsByDay[flow.dayIndex], flow)
+	}
+
+	// The runs advance one day at a time, so only the running state of each run and the
+	// balances of the current day are kept rather than every balance of every run
+	rng := rand.New(rand.NewSource(seed))
+	deltas := make([]int64, simulations)
+	wentNegative := make([]bool, simulations)
+	values := make([]int64, simulations)
+
+	for i, day := range baseline {
+		for run := 0; run < simulations; run++ {
+			for _, flow := range flowsByDay[i] {
+				sampled := int64(math.Round(flow.mean + rng.NormFloat64()*flow.stdDev))
+				if sampled < 0 {
+					sampled = 0
+				}
+				deltas[run] += flow.sign * (sampled - flow.baseline)
+			}
+
+			balance := day.Balance + deltas[run]
+			values[run] = balance
+			if balance < 0 {
+				wentNegative[run] = true
+			}
+		}
+
+		sort.Slice(values, func(a, b int) bool { return values[a] < values[b] })
+
+		negative := sort.Search(len(values), func(n int) bool { return values[n] >= 0 })
+
+		result.Days[i] = models.ProjectionBand{
+			Date:                day.Date,
+			P10:                 percentile(values, 0.10),
+			P50:                 percentile(values, 0.50),
+			P90:                 percentile(values, 0.90),
+			ProbabilityNegative: float64(negative) / float64(simulations),
+		}
+	}
+
+	runsWentNegative := 0
+	for _, negative := range wentNegative {
+		if negative {
+			runsWentNegative++
+		}
+	}
+
+	result.ProbabilityNegative = float64(runsWentNegative) / float64(simulations)
+	return result
+}
+
+// historyStats returns the mean and sample standard deviation of the most recent amounts.
+// At least two records are needed to say anything about variance.
+func historyStats(amounts []int64) (float64, float64, bool) {
+	if len(amounts) > simulationHistoryLimit {
+		amounts = amounts[:simulationHistoryLimit]
+	}
+	if len(amounts) < 2 {
+		return 0, 0, false
+	}
+
+	sum := 0.0
+	for _, amount := range amounts {
+		sum += float64(amount)
+	}
+	mean := sum / float64(len(amounts))
+
+	squares := 0.0
+	for _, amount := range amounts {
+		diff := float64(amount) - mean
+		squares += diff * diff
+	}
+
+	return mean, math.Sqrt(squares / float64(len(amounts)-1)), true
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []int64, p float64) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// findDetail returns the projection detail of the given type that comes from sourceID
+func findDetail(details []models.CashflowProjectionDetail, detailType string, sourceID uuid.UUID) *models.CashflowProjectionDetail {
+	for i := range details {
+		if details[i].Type == detailType && details[i].SourceID != nil && *details[i].SourceID == sourceID {
+			return &details[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func simulationBaseline(balances ...int64) []models.CashflowProjection {
+	baseline := make([]models.CashflowProjection, len(balances))
+	for i, balance := range balances {
+		baseline[i] = models.CashflowProjection{
+			Date:    fmt.Sprintf("2025-01-%02d", i+1),
+			Balance: balance,
+		}
+	}
+	return baseline
+}
+
+func TestSimulateProjection_NoVariableFlows(t *testing.T) {
+	baseline := simulationBaseline(1000, 500, -200)
+
+	result := simulateProjection(baseline, nil, 100, 1)
+
+	assert.Equal(t, 100, result.Simulations)
+	assert.Len(t, result.Days, 3)
+	for i, day := range result.Days {
+		assert.Equal(t, baseline[i].Balance, day.P10)
+		assert.Equal(t, baseline[i].Balance, day.P50)
+		assert.Equal(t, baseline[i].Balance, day.P90)
+	}
+	assert.Equal(t, 0.0, result.Days[0].ProbabilityNegative)
+	assert.Equal(t, 1.0, result.Days[2].ProbabilityNegative)
+	assert.Equal(t, 1.0, result.ProbabilityNegative)
+}
+
+func TestSimulateProjection_ReproducibleWithSeed(t *testing.T) {
+	baseline := simulationBaseline(100000, 100000, 100000, 100000)
+	flows := []variableFlow{
+		{dayIndex: 1, baseline: 50000, mean: 50000, stdDev: 20000, sign: -1},
+		{dayIndex: 2, baseline: 30000, mean: 30000, stdDev: 5000, sign: 1},
+	}
+
+	first := simulateProjection(baseline, flows, 500, 42)
+	second := simulateProjection(baseline, flows, 500, 42)
+	other := simulateProjection(baseline, flows, 500, 7)
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first.Days, other.Days)
+}
+
+func TestSimulateProjection_Bands(t *testing.T) {
+	baseline := simulationBaseline(60000, 10000, 10000)
+	flows := []variableFlow{
+		{dayIndex: 1, baseline: 50000, mean: 50000, stdDev: 20000, sign: -1},
+	}
+
+	result := simulateProjection(baseline, flows, 2000, 42)
+
+	// Before the variable expense every run has the same balance
+	assert.Equal(t, int64(60000), result.Days[0].P10)
+	assert.Equal(t, int64(60000), result.Days[0].P90)
+
+	// After it the bands spread around the baseline
+	day := result.Days[1]
+	assert.Less(t, day.P10, day.P50)
+	assert.Less(t, day.P50, day.P90)
+	assert.InDelta(t, 10000, day.P50, 2000)
+	assert.InDelta(t, 0.31, day.ProbabilityNegative, 0.05)
+	assert.Equal(t, result.Days[1].P50, result.Days[2].P50)
+	assert.InDelta(t, day.ProbabilityNegative, result.ProbabilityNegative, 0.0001)
+}
+
+func TestSimulateProjection_SimulationLimits(t *testing.T) {
+	baseline := simulationBaseline(1000)
+
+	assert.Equal(t, DefaultSimulationCount, simulateProjection(baseline, nil, 0, 1).Simulations)
+	assert.Equal(t, MaxSimulationCount, simulateProjection(baseline, nil, MaxSimulationCount+1, 1).Simulations)
+}
+
+func TestHistoryStats(t *testing.T) {
+	mean, stdDev, ok := historyStats([]int64{10000, 20000, 30000})
+	assert.True(t, ok)
+	assert.Equal(t, 20000.0, mean)
+	assert.Equal(t, 10000.0, stdDev)
+
+	_, _, ok = historyStats([]int64{10000})
+	assert.False(t, ok)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	assert.Equal(t, int64(1), percentile(values, 0.10))
+	assert.Equal(t, int64(5), percentile(values, 0.50))
+	assert.Equal(t, int64(9), percentile(values, 0.90))
+	assert.Equal(t, int64(0), percentile(nil, 0.50))
+}
+
+func TestFindDetail(t *testing.T) {
+	cardID := uuid.New()
+	otherID := uuid.New()
+	details := []models.CashflowProjectionDetail{
+		{Type: "card_payment", Amount: 100, SourceID: &otherID},
+		{Type: "card_payment", Amount: 200, SourceID: &cardID},
+		{Type: "recurring_payment", Amount: 300},
+	}
+
+	detail := findDetail(details, "card_payment", cardID)
+	if assert.NotNil(t, detail) {
+		assert.Equal(t, int64(200), detail.Amount)
+	}
+	assert.Nil(t, findDetail(details, "income", cardID))
+}
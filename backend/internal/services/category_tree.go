@@ -0,0 +1,212 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxTagLength is the maximum number of characters in a tag
+	maxTagLength = 50
+
+	// categoryPathSeparator joins the names of nested categories, e.g. "Insurance / Life"
+	categoryPathSeparator = " / "
+)
+
+// normalizeTags trims the tags and drops empty and duplicate ones. Duplicates are compared case
+// insensitively and the first spelling is kept.
+func normalizeTags(tags models.TagList) (models.TagList, error) {
+	normalized := make(models.TagList, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if strings.Contains(tag, ",") {
+			return nil, NewValidationError("tags", "must not contain commas")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, NewValidationError("tags", "must be at most %d characters", maxTagLength)
+		}
+
+		key := strings.ToLower(tag)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	return normalized, nil
+}
+
+// validateCategoryParent checks that the parent of a category is one of the user's categories
+// and that attaching the category to it does not create a cycle
+func validateCategoryParent(category *models.Category, categories []models.Category) error {
+	if category.ParentID == nil {
+		return nil
+	}
+	if *category.ParentID == category.ID {
+		return NewValidationError("parent_id", "must not be the category itself")
+	}
+
+	parents := make(map[uuid.UUID]*uuid.UUID, len(categories))
+	for _, c := range categories {
+		parents[c.ID] = c.ParentID
+	}
+	if _, found := parents[*category.ParentID]; !found {
+		return NewValidationError("parent_id", "must be one of your categories")
+	}
+
+	// Walk up from the new parent; reaching the category means it would become its own ancestor
+	visited := make(map[uuid.UUID]bool)
+	for id := category.ParentID; id != nil && !visited[*id]; id = parents[*id] {
+		if *id == category.ID {
+			return NewValidationError("parent_id", "must not be a subcategory of the category")
+		}
+		visited[*id] = true
+	}
+	return nil
+}
+
+// categoryNode is a category resolved against the rest of the tree
+type categoryNode struct {
+	category models.Category
+	path     string    // Names from the top-level category down, e.g. "Insurance / Life"
+	rootID   uuid.UUID // Top-level ancestor; the category itself when it has no parent
+}
+
+// categoryTree resolves the full path and top-level ancestor of every category. A parent that
+// is missing or part of a cycle ends the walk, so broken data still yields a usable tree.
+func categoryTree(categories []models.Category) map[uuid.UUID]categoryNode {
+	byID := make(map[uuid.UUID]models.Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	tree := make(map[uuid.UUID]categoryNode, len(categories))
+	for _, category := range categories {
+		names := []string{category.Name}
+		rootID := category.ID
+		visited := map[uuid.UUID]bool{category.ID: true}
+		for parentID := category.ParentID; parentID != nil && !visited[*parentID]; {
+			parent, found := byID[*parentID]
+			if !found {
+				break
+			}
+			visited[parent.ID] = true
+			names = append([]string{parent.Name}, names...)
+			rootID = parent.ID
+			parentID = parent.ParentID
+		}
+
+		tree[category.ID] = categoryNode{
+			category: category,
+			path:     strings.Join(names, categoryPathSeparator),
+			rootID:   rootID,
+		}
+	}
+	return tree
+}
+
+// categoryBreakdown sums the income and expenses of the projection per month and category.
+// Transfers move money between the user's own accounts and are left out. With topLevel set,
+// amounts of subcategories are added to their top-level category.
+func categoryBreakdown(projections []models.CashflowProjection, categories []models.Category, topLevel bool) []models.CategoryBreakdown {
+	tree := categoryTree(categories)
+
+	type monthTotals struct {
+		breakdown models.CategoryBreakdown
+		entries   map[uuid.UUID]*models.CategoryBreakdownEntry
+	}
+	months := make(map[string]*monthTotals)
+	var order []string
+
+	for _, projection := range projections {
+		date, err := time.Parse("2006-01-02", projection.Date)
+		if err != nil {
+			continue
+		}
+		yearMonth := date.Format("2006-01")
+
+		totals, found := months[yearMonth]
+		if !found {
+			totals = &monthTotals{
+				breakdown: models.CategoryBreakdown{YearMonth: yearMonth},
+				entries:   make(map[uuid.UUID]*models.CategoryBreakdownEntry),
+			}
+			months[yearMonth] = totals
+			order = append(order, yearMonth)
+		}
+
+		for _, detail := range projection.Details {
+			if detail.Type == "transfer" {
+				continue
+			}
+
+			// Uncategorized items and categories that no longer exist are collected under uuid.Nil
+			key := uuid.Nil
+			node, categorized := categoryNode{}, false
+			if detail.CategoryID != nil {
+				node, categorized = tree[*detail.CategoryID]
+			}
+			if categorized {
+				if topLevel {
+					node = tree[node.rootID]
+				}
+				key = node.category.ID
+			}
+
+			entry, found := totals.entries[key]
+			if !found {
+				entry = &models.CategoryBreakdownEntry{}
+				if categorized {
+					id := node.category.ID
+					entry.CategoryID = &id
+					entry.ParentID = node.category.ParentID
+					entry.CategoryName = node.path
+				}
+				totals.entries[key] = entry
+			}
+
+			if detail.Type == "income" {
+				entry.Income += detail.Amount
+				totals.breakdown.TotalIncome += detail.Amount
+			} else {
+				entry.Expense += detail.Amount
+				totals.breakdown.TotalExpense += detail.Amount
+			}
+		}
+	}
+
+	sort.Strings(order)
+	breakdowns := make([]models.CategoryBreakdown, 0, len(order))
+	for _, yearMonth := range order {
+		totals := months[yearMonth]
+		entries := make([]models.CategoryBreakdownEntry, 0, len(totals.entries))
+		for _, entry := range totals.entries {
+			entries = append(entries, *entry)
+		}
+		// Categories by path with uncategorized items last
+		sort.Slice(entries, func(i, j int) bool {
+			if (entries[i].CategoryID == nil) != (entries[j].CategoryID == nil) {
+				return entries[j].CategoryID == nil
+			}
+			return entries[i].CategoryName < entries[j].CategoryName
+		})
+
+		totals.breakdown.Categories = entries
+		breakdowns = append(breakdowns, totals.breakdown)
+	}
+	return breakdowns
+}
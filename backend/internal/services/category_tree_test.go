@@ -0,0 +1,111 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := normalizeTags(models.TagList{" Family ", "", "tax", "family"})
+	require.NoError(t, err)
+	assert.Equal(t, models.TagList{"Family", "tax"}, tags)
+
+	tags, err = normalizeTags(models.TagList{" "})
+	require.NoError(t, err)
+	assert.Nil(t, tags)
+
+	var validationErr *ValidationError
+	_, err = normalizeTags(models.TagList{"a,b"})
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestValidateCategoryParent(t *testing.T) {
+	insurance := models.Category{ID: uuid.New(), Name: "Insurance"}
+	life := models.Category{ID: uuid.New(), ParentID: &insurance.ID, Name: "Life"}
+	term := models.Category{ID: uuid.New(), ParentID: &life.ID, Name: "Term"}
+	categories := []models.Category{insurance, life, term}
+	otherUser := uuid.New()
+
+	tests := []struct {
+		name     string
+		category models.Category
+		valid    bool
+	}{
+		{name: "no parent", category: models.Category{ID: insurance.ID}, valid: true},
+		{name: "existing parent", category: models.Category{ID: uuid.New(), ParentID: &term.ID}, valid: true},
+		{name: "itself", category: models.Category{ID: insurance.ID, ParentID: &insurance.ID}},
+		{name: "own descendant", category: models.Category{ID: insurance.ID, ParentID: &term.ID}},
+		{name: "unknown parent", category: models.Category{ID: uuid.New(), ParentID: &otherUser}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCategoryParent(&tt.category, categories)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				var validationErr *ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+			}
+		})
+	}
+}
+
+func TestCategoryBreakdown(t *testing.T) {
+	insurance := models.Category{ID: uuid.New(), Name: "Insurance"}
+	life := models.Category{ID: uuid.New(), ParentID: &insurance.ID, Name: "Life"}
+	car := models.Category{ID: uuid.New(), ParentID: &insurance.ID, Name: "Car"}
+	salary := models.Category{ID: uuid.New(), Name: "Salary"}
+	categories := []models.Category{insurance, life, car, salary}
+	deleted := uuid.New()
+
+	projections := []models.CashflowProjection{
+		{Date: "2025-01-25", Details: []models.CashflowProjectionDetail{
+			{Type: "income", Amount: 300000, CategoryID: &salary.ID},
+			{Type: "transfer", Amount: 50000},
+		}},
+		{Date: "2025-01-27", Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Amount: 8000, CategoryID: &life.ID},
+			{Type: "recurring_payment", Amount: 5000, CategoryID: &car.ID},
+			{Type: "card_payment", Amount: 40000},
+			{Type: "recurring_payment", Amount: 1000, CategoryID: &deleted},
+		}},
+		{Date: "2025-02-27", Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Amount: 8000, CategoryID: &life.ID},
+		}},
+	}
+
+	t.Run("per category", func(t *testing.T) {
+		breakdowns := categoryBreakdown(projections, categories, false)
+
+		require.Len(t, breakdowns, 2)
+		january := breakdowns[0]
+		assert.Equal(t, "2025-01", january.YearMonth)
+		assert.Equal(t, int64(300000), january.TotalIncome)
+		assert.Equal(t, int64(54000), january.TotalExpense)
+
+		require.Len(t, january.Categories, 4)
+		assert.Equal(t, "Insurance / Car", january.Categories[0].CategoryName)
+		assert.Equal(t, "Insurance / Life", january.Categories[1].CategoryName)
+		assert.Equal(t, &insurance.ID, january.Categories[1].ParentID)
+		assert.Equal(t, int64(300000), january.Categories[2].Income)
+		// Uncategorized items and unknown categories come last
+		assert.Nil(t, january.Categories[3].CategoryID)
+		assert.Equal(t, int64(41000), january.Categories[3].Expense)
+
+		assert.Equal(t, "2025-02", breakdowns[1].YearMonth)
+		assert.Len(t, breakdowns[1].Categories, 1)
+	})
+
+	t.Run("top-level categories", func(t *testing.T) {
+		breakdowns := categoryBreakdown(projections, categories, true)
+
+		require.Len(t, breakdowns[0].Categories, 3)
+		assert.Equal(t, "Insurance", breakdowns[0].Categories[0].CategoryName)
+		assert.Equal(t, int64(13000), breakdowns[0].Categories[0].Expense)
+	})
+}
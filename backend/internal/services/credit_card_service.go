@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"time"
 
@@ -9,35 +10,113 @@ import (
 
 type CreditCardService struct {
 	creditCardRepo CreditCardRepositoryInterface
+	categoryRepo   CategoryRepositoryInterface
+	publisher      EventPublisherInterface // nil when changes are not published
 }
 
-func NewCreditCardService(creditCardRepo CreditCardRepositoryInterface) *CreditCardService {
+func NewCreditCardService(creditCardRepo CreditCardRepositoryInterface, categoryRepo CategoryRepositoryInterface, publisher EventPublisherInterface) *CreditCardService {
 	return &CreditCardService{
 		creditCardRepo: creditCardRepo,
+		categoryRepo:   categoryRepo,
+		publisher:      publisher,
 	}
 }
 
-func (s *CreditCardService) GetCreditCards(userID uuid.UUID) ([]models.CreditCard, error) {
-	return s.creditCardRepo.GetAll(userID)
+func (s *CreditCardService) GetCreditCards(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error) {
+	ctx, span := tracer.Start(ctx, "CreditCardService.GetCreditCards")
+	defer span.End()
+
+	return s.creditCardRepo.GetAll(ctx, userID)
 }
 
-func (s *CreditCardService) GetCreditCard(id uuid.UUID) (*models.CreditCard, error) {
-	return s.creditCardRepo.GetByID(id)
+func (s *CreditCardService) GetCreditCard(ctx context.Context, id uuid.UUID) (*models.CreditCard, error) {
+	ctx, span := tracer.Start(ctx, "CreditCardService.GetCreditCard")
+	defer span.End()
+
+	return s.creditCardRepo.GetByID(ctx, id)
 }
 
-func (s *CreditCardService) CreateCreditCard(creditCard *models.CreditCard) error {
+func (s *CreditCardService) CreateCreditCard(ctx context.Context, creditCard *models.CreditCard) error {
+	ctx, span := tracer.Start(ctx, "CreditCardService.CreateCreditCard")
+	defer span.End()
+
+	if err := normalizeCreditCard(creditCard); err != nil {
+		return err
+	}
+	if err := validateItemCategory(ctx, s.categoryRepo, creditCard.UserID, creditCard.CategoryID); err != nil {
+		return err
+	}
+
 	creditCard.ID = uuid.New()
 	creditCard.CreatedAt = time.Now()
 	creditCard.UpdatedAt = time.Now()
 
-	return s.creditCardRepo.Create(creditCard)
+	if err := s.creditCardRepo.Create(ctx, creditCard); err != nil {
+		return err
+	}
+	publishEvent(ctx, s.publisher, creditCard.UserID, entityEvent(EntityCreditCard, EntityCreated), creditCard)
+	return nil
 }
 
-func (s *CreditCardService) UpdateCreditCard(creditCard *models.CreditCard) error {
+func (s *CreditCardService) UpdateCreditCard(ctx context.Context, creditCard *models.CreditCard) error {
+	ctx, span := tracer.Start(ctx, "CreditCardService.UpdateCreditCard")
+	defer span.End()
+
+	if err := normalizeCreditCard(creditCard); err != nil {
+		return err
+	}
+	if creditCard.CategoryID != nil {
+		existing, err := s.creditCardRepo.GetByID(ctx, creditCard.ID)
+		if err != nil {
+			return err
+		}
+		if err := validateItemCategory(ctx, s.categoryRepo, existing.UserID, creditCard.CategoryID); err != nil {
+			return err
+		}
+	}
+
 	creditCard.UpdatedAt = time.Now()
-	return s.creditCardRepo.Update(creditCard)
+	if err := s.creditCardRepo.Update(ctx, creditCard); err != nil {
+		return err
+	}
+	if stored := s.storedForEvent(ctx, creditCard.ID); stored != nil {
+		publishEvent(ctx, s.publisher, stored.UserID, entityEvent(EntityCreditCard, EntityUpdated), stored)
+	}
+	return nil
+}
+
+func (s *CreditCardService) DeleteCreditCard(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "CreditCardService.DeleteCreditCard")
+	defer span.End()
+
+	deleted := s.storedForEvent(ctx, id)
+	if err := s.creditCardRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if deleted != nil {
+		publishEvent(ctx, s.publisher, deleted.UserID, entityEvent(EntityCreditCard, EntityDeleted), deleted)
+	}
+	return nil
 }
 
-func (s *CreditCardService) DeleteCreditCard(id uuid.UUID) error {
-	return s.creditCardRepo.Delete(id)
+// storedForEvent returns the stored credit card an event is about, nil without a publisher
+func (s *CreditCardService) storedForEvent(ctx context.Context, id uuid.UUID) *models.CreditCard {
+	if s.publisher == nil {
+		return nil
+	}
+	creditCard, err := s.creditCardRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return creditCard
+}
+
+// normalizeCreditCard validates the tags and currency of a credit card
+func normalizeCreditCard(creditCard *models.CreditCard) error {
+	tags, err := normalizeTags(creditCard.Tags)
+	if err != nil {
+		return err
+	}
+	creditCard.Tags = tags
+	return normalizeCurrency("currency", &creditCard.Currency)
 }
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
@@ -9,11 +10,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreditCardService_GetCreditCards(t *testing.T) {
 	mockRepo := &mocks.MockCreditCardRepository{}
-	service := NewCreditCardService(mockRepo)
+	service := NewCreditCardService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	userID := uuid.New()
 	bankAccountID := uuid.New()
 
@@ -32,7 +34,7 @@ func TestCreditCardService_GetCreditCards(t *testing.T) {
 					*helpers.CreateTestCreditCard(userID, bankAccountID),
 					*helpers.CreateTestCreditCard(userID, bankAccountID),
 				}
-				m.On("GetAll", userID).Return(creditCards, nil)
+				m.On("GetAll", mock.Anything, userID).Return(creditCards, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -41,7 +43,7 @@ func TestCreditCardService_GetCreditCards(t *testing.T) {
 			name:   "empty result",
 			userID: userID,
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("GetAll", userID).Return([]models.CreditCard{}, nil)
+				m.On("GetAll", mock.Anything, userID).Return([]models.CreditCard{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -50,7 +52,7 @@ func TestCreditCardService_GetCreditCards(t *testing.T) {
 			name:   "repository error",
 			userID: userID,
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("GetAll", userID).Return([]models.CreditCard{}, assert.AnError)
+				m.On("GetAll", mock.Anything, userID).Return([]models.CreditCard{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -62,7 +64,7 @@ func TestCreditCardService_GetCreditCards(t *testing.T) {
 			mockRepo.ExpectedCalls = nil
 			tt.setupMock(mockRepo)
 
-			result, err := service.GetCreditCards(tt.userID)
+			result, err := service.GetCreditCards(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -78,7 +80,7 @@ func TestCreditCardService_GetCreditCards(t *testing.T) {
 
 func TestCreditCardService_GetCreditCard(t *testing.T) {
 	mockRepo := &mocks.MockCreditCardRepository{}
-	service := NewCreditCardService(mockRepo)
+	service := NewCreditCardService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	creditCardID := uuid.New()
 	userID := uuid.New()
 	bankAccountID := uuid.New()
@@ -96,7 +98,7 @@ func TestCreditCardService_GetCreditCard(t *testing.T) {
 			setupMock: func(m *mocks.MockCreditCardRepository) {
 				creditCard := helpers.CreateTestCreditCard(userID, bankAccountID)
 				creditCard.ID = creditCardID
-				m.On("GetByID", creditCardID).Return(creditCard, nil)
+				m.On("GetByID", mock.Anything, creditCardID).Return(creditCard, nil)
 			},
 			expectedNil:   false,
 			expectedError: false,
@@ -105,7 +107,7 @@ func TestCreditCardService_GetCreditCard(t *testing.T) {
 			name:         "credit card not found",
 			creditCardID: creditCardID,
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("GetByID", creditCardID).Return(nil, assert.AnError)
+				m.On("GetByID", mock.Anything, creditCardID).Return(nil, assert.AnError)
 			},
 			expectedNil:   true,
 			expectedError: true,
@@ -117,7 +119,7 @@ func TestCreditCardService_GetCreditCard(t *testing.T) {
 			mockRepo.ExpectedCalls = nil
 			tt.setupMock(mockRepo)
 
-			result, err := service.GetCreditCard(tt.creditCardID)
+			result, err := service.GetCreditCard(context.Background(), tt.creditCardID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -139,7 +141,7 @@ func TestCreditCardService_GetCreditCard(t *testing.T) {
 
 func TestCreditCardService_CreateCreditCard(t *testing.T) {
 	mockRepo := &mocks.MockCreditCardRepository{}
-	service := NewCreditCardService(mockRepo)
+	service := NewCreditCardService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	userID := uuid.New()
 	bankAccountID := uuid.New()
 
@@ -153,7 +155,7 @@ func TestCreditCardService_CreateCreditCard(t *testing.T) {
 			name:       "successful creation",
 			creditCard: helpers.CreateTestCreditCard(userID, bankAccountID),
 			setupMock: func(m *mocks.MockCreditCardRepository, cc *models.CreditCard) {
-				m.On("Create", mock.MatchedBy(func(card *models.CreditCard) bool {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(card *models.CreditCard) bool {
 					return card.UserID == userID && card.Name == cc.Name
 				})).Return(nil)
 			},
@@ -163,7 +165,7 @@ func TestCreditCardService_CreateCreditCard(t *testing.T) {
 			name:       "repository error",
 			creditCard: helpers.CreateTestCreditCard(userID, bankAccountID),
 			setupMock: func(m *mocks.MockCreditCardRepository, cc *models.CreditCard) {
-				m.On("Create", mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
+				m.On("Create", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -175,7 +177,7 @@ func TestCreditCardService_CreateCreditCard(t *testing.T) {
 			originalID := tt.creditCard.ID
 			tt.setupMock(mockRepo, tt.creditCard)
 
-			err := service.CreateCreditCard(tt.creditCard)
+			err := service.CreateCreditCard(context.Background(), tt.creditCard)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -194,9 +196,49 @@ func TestCreditCardService_CreateCreditCard(t *testing.T) {
 	}
 }
 
+func TestCreditCardService_CategoryOwnership(t *testing.T) {
+	userID := uuid.New()
+	own := models.Category{ID: uuid.New(), UserID: userID}
+	foreign := models.Category{ID: uuid.New(), UserID: uuid.New()}
+
+	mockRepo := &mocks.MockCreditCardRepository{}
+	categoryRepo := &mocks.MockCategoryRepository{}
+	categoryRepo.On("GetByID", mock.Anything, own.ID).Return(&own, nil)
+	categoryRepo.On("GetByID", mock.Anything, foreign.ID).Return(&foreign, nil)
+	service := NewCreditCardService(mockRepo, categoryRepo, nil)
+
+	t.Run("create with a category of another user", func(t *testing.T) {
+		creditCard := helpers.CreateTestCreditCard(userID, uuid.New())
+		creditCard.CategoryID = &foreign.ID
+
+		err := service.CreateCreditCard(context.Background(), creditCard)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "category_id", validationErr.Field)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update checks the category against the stored owner", func(t *testing.T) {
+		stored := helpers.CreateTestCreditCard(userID, uuid.New())
+		mockRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(nil).Once()
+
+		update := *stored
+		update.UserID = uuid.Nil
+		update.CategoryID = &own.ID
+		require.NoError(t, service.UpdateCreditCard(context.Background(), &update))
+
+		update.CategoryID = &foreign.ID
+		var validationErr *ValidationError
+		assert.ErrorAs(t, service.UpdateCreditCard(context.Background(), &update), &validationErr)
+		mockRepo.AssertNumberOfCalls(t, "Update", 1)
+	})
+}
+
 func TestCreditCardService_UpdateCreditCard(t *testing.T) {
 	mockRepo := &mocks.MockCreditCardRepository{}
-	service := NewCreditCardService(mockRepo)
+	service := NewCreditCardService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	userID := uuid.New()
 	bankAccountID := uuid.New()
 
@@ -210,7 +252,7 @@ func TestCreditCardService_UpdateCreditCard(t *testing.T) {
 			name:       "successful update",
 			creditCard: helpers.CreateTestCreditCard(userID, bankAccountID),
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("Update", mock.AnythingOfType("*models.CreditCard")).Return(nil)
+				m.On("Update", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(nil)
 			},
 			expectedError: false,
 		},
@@ -218,7 +260,7 @@ func TestCreditCardService_UpdateCreditCard(t *testing.T) {
 			name:       "repository error",
 			creditCard: helpers.CreateTestCreditCard(userID, bankAccountID),
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("Update", mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
+				m.On("Update", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -230,7 +272,7 @@ func TestCreditCardService_UpdateCreditCard(t *testing.T) {
 			originalUpdatedAt := tt.creditCard.UpdatedAt
 			tt.setupMock(mockRepo)
 
-			err := service.UpdateCreditCard(tt.creditCard)
+			err := service.UpdateCreditCard(context.Background(), tt.creditCard)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -247,7 +289,7 @@ func TestCreditCardService_UpdateCreditCard(t *testing.T) {
 
 func TestCreditCardService_DeleteCreditCard(t *testing.T) {
 	mockRepo := &mocks.MockCreditCardRepository{}
-	service := NewCreditCardService(mockRepo)
+	service := NewCreditCardService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	creditCardID := uuid.New()
 
 	tests := []struct {
@@ -260,7 +302,7 @@ func TestCreditCardService_DeleteCreditCard(t *testing.T) {
 			name:         "successful deletion",
 			creditCardID: creditCardID,
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("Delete", creditCardID).Return(nil)
+				m.On("Delete", mock.Anything, creditCardID).Return(nil)
 			},
 			expectedError: false,
 		},
@@ -268,7 +310,7 @@ func TestCreditCardService_DeleteCreditCard(t *testing.T) {
 			name:         "repository error",
 			creditCardID: creditCardID,
 			setupMock: func(m *mocks.MockCreditCardRepository) {
-				m.On("Delete", creditCardID).Return(assert.AnError)
+				m.On("Delete", mock.Anything, creditCardID).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -279,7 +321,7 @@ func TestCreditCardService_DeleteCreditCard(t *testing.T) {
 			mockRepo.ExpectedCalls = nil
 			tt.setupMock(mockRepo)
 
-			err := service.DeleteCreditCard(tt.creditCardID)
+			err := service.DeleteCreditCard(context.Background(), tt.creditCardID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// BaseCurrencySettingKey is the setting key of the currency totals are reported in
+const BaseCurrencySettingKey = "base_currency"
+
+// normalizeCurrency upper-cases a currency code, defaulting an empty code to yen, and rejects
+// unsupported currencies
+func normalizeCurrency(field string, code *string) error {
+	*code = strings.ToUpper(strings.TrimSpace(*code))
+	if *code == "" {
+		*code = currency.Default
+	}
+	if _, ok := currency.Lookup(*code); !ok {
+		return NewValidationError(field, "must be one of %s", strings.Join(currency.Codes(), ", "))
+	}
+	return nil
+}
+
+// baseCurrency returns the user's base currency, falling back to yen when the setting cannot be
+// read
+func baseCurrency(ctx context.Context, appSettingRepo AppSettingRepositoryInterface, userID uuid.UUID) string {
+	if setting, err := appSettingRepo.GetByKey(ctx, userID, BaseCurrencySettingKey); err == nil {
+		if _, ok := currency.Lookup(setting.Value); ok {
+			return setting.Value
+		}
+	}
+	return currency.Default
+}
+
+// loadCurrencyConverter returns a converter to the user's base currency with the user's exchange
+// rates
+func loadCurrencyConverter(ctx context.Context, appSettingRepo AppSettingRepositoryInterface, exchangeRateRepo ExchangeRateRepositoryInterface, userID uuid.UUID) (*currencyConverter, error) {
+	base := baseCurrency(ctx, appSettingRepo, userID)
+	rates, err := exchangeRateRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return newCurrencyConverter(base, rates), nil
+}
+
+// datedRate is an exchange rate valid from its date until the next rate of the same pair
+type datedRate struct {
+	date time.Time
+	rate float64
+}
+
+// currencyConverter converts amounts between currencies with the user's exchange rates
+type currencyConverter struct {
+	base  string
+	rates map[[2]string][]datedRate // By currency pair, oldest first
+}
+
+func newCurrencyConverter(base string, exchangeRates []models.ExchangeRate) *currencyConverter {
+	converter := &currencyConverter{base: base, rates: make(map[[2]string][]datedRate)}
+	for _, exchangeRate := range exchangeRates {
+		date, err := time.Parse("2006-01-02", exchangeRate.RateDate)
+		if err != nil || exchangeRate.Rate <= 0 {
+			continue
+		}
+		pair := [2]string{exchangeRate.FromCurrency, exchangeRate.ToCurrency}
+		converter.rates[pair] = append(converter.rates[pair], datedRate{date: date, rate: exchangeRate.Rate})
+	}
+	for _, rates := range converter.rates {
+		sort.SliceStable(rates, func(i, j int) bool { return rates[i].date.Before(rates[j].date) })
+	}
+	return converter
+}
+
+// code returns the currency of an amount, an empty code meaning the base currency
+func (c *currencyConverter) code(code string) string {
+	if code == "" {
+		return c.base
+	}
+	return code
+}
+
+// rate returns the number of units of to per unit of from on the given date. A pair without a
+// rate of its own uses the inverse rate or goes through the base currency. The latest rate on or
+// before the date is used, and the earliest rate for dates before the first one.
+func (c *currencyConverter) rate(from, to string, date time.Time) (float64, bool) {
+	from, to = c.code(from), c.code(to)
+	if from == to {
+		return 1, true
+	}
+	if rate, ok := c.pairRate(from, to, date); ok {
+		return rate, true
+	}
+	if rate, ok := c.pairRate(to, from, date); ok {
+		return 1 / rate, true
+	}
+	if from == c.base || to == c.base {
+		return 0, false
+	}
+
+	toBase, ok := c.rate(from, c.base, date)
+	if !ok {
+		return 0, false
+	}
+	fromBase, ok := c.rate(c.base, to, date)
+	if !ok {
+		return 0, false
+	}
+	return toBase * fromBase, true
+}
+
+func (c *currencyConverter) pairRate(from, to string, date time.Time) (float64, bool) {
+	rates := c.rates[[2]string{from, to}]
+	if len(rates) == 0 {
+		return 0, false
+	}
+
+	// Index of the first rate after the date
+	i := sort.Search(len(rates), func(i int) bool { return rates[i].date.After(date) })
+	if i == 0 {
+		return rates[0].rate, true
+	}
+	return rates[i-1].rate, true
+}
+
+// convert converts an amount on the given date and rounds it to the minor unit of the target
+// currency. Amounts without a rate are returned unchanged; check reports them up front.
+func (c *currencyConverter) convert(amount int64, from, to string, date time.Time) int64 {
+	from, to = c.code(from), c.code(to)
+	if from == to {
+		return amount
+	}
+	rate, ok := c.rate(from, to, date)
+	if !ok {
+		return amount
+	}
+	return currency.Convert(amount, rate, to)
+}
+
+// toBase converts an amount to the base currency
+func (c *currencyConverter) toBase(amount int64, from string, date time.Time) int64 {
+	return c.convert(amount, from, c.base, date)
+}
+
+// baseRate returns the rate from a currency to the base currency, 1 when there is none
+func (c *currencyConverter) baseRate(code string, date time.Time) float64 {
+	rate, ok := c.rate(code, c.base, date)
+	if !ok {
+		return 1
+	}
+	return rate
+}
+
+// setBaseAmount converts the amount of a projection detail from the given currency to the base
+// currency. The amount in a foreign currency is kept as the original amount.
+func (c *currencyConverter) setBaseAmount(detail *models.CashflowProjectionDetail, code string, date time.Time) {
+	code = c.code(code)
+	if code == c.base {
+		return
+	}
+	original := detail.Amount
+	detail.OriginalAmount = &original
+	detail.OriginalCurrency = code
+	detail.Amount = c.toBase(original, code, date)
+	if detail.PlannedAmount != nil {
+		planned := c.toBase(*detail.PlannedAmount, code, date)
+		detail.PlannedAmount = &planned
+	}
+}
+
+// check returns a ValidationError for the first currency that cannot be converted to the base currency
+func (c *currencyConverter) check(codes ...string) error {
+	for _, code := range codes {
+		if _, ok := c.rate(code, c.base, time.Time{}); !ok {
+			return NewValidationError("currency", "no exchange rate from %s to %s", code, c.base)
+		}
+	}
+	return nil
+}
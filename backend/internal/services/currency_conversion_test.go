@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCurrency(t *testing.T) {
+	code := " usd "
+	assert.NoError(t, normalizeCurrency("currency", &code))
+	assert.Equal(t, "USD", code)
+
+	code = ""
+	assert.NoError(t, normalizeCurrency("currency", &code))
+	assert.Equal(t, "JPY", code)
+
+	code = "XXX"
+	var validationErr *ValidationError
+	if assert.ErrorAs(t, normalizeCurrency("currency", &code), &validationErr) {
+		assert.Equal(t, "currency", validationErr.Field)
+	}
+}
+
+func TestCurrencyConverter_rate(t *testing.T) {
+	converter := newCurrencyConverter("JPY", []models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, RateDate: "2024-02-01"},
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 140, RateDate: "2024-01-01"},
+		{FromCurrency: "JPY", ToCurrency: "EUR", Rate: 0.00625, RateDate: "2024-01-01"},
+	})
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		date     string
+		expected float64
+		found    bool
+	}{
+		{name: "same currency", from: "USD", to: "USD", date: "2024-01-15", expected: 1, found: true},
+		{name: "empty code is the base currency", from: "", to: "JPY", date: "2024-01-15", expected: 1, found: true},
+		{name: "latest rate on or before the date", from: "USD", to: "JPY", date: "2024-01-31", expected: 140, found: true},
+		{name: "rate of the day", from: "USD", to: "JPY", date: "2024-02-01", expected: 150, found: true},
+		{name: "earliest rate before the first date", from: "USD", to: "JPY", date: "2023-06-01", expected: 140, found: true},
+		{name: "inverse rate", from: "EUR", to: "JPY", date: "2024-01-15", expected: 160, found: true},
+		{name: "cross rate through the base currency", from: "USD", to: "EUR", date: "2024-02-15", expected: 0.9375, found: true},
+		{name: "no rate", from: "GBP", to: "JPY", date: "2024-01-15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, found := converter.rate(tt.from, tt.to, date(tt.date))
+			assert.Equal(t, tt.found, found)
+			assert.InDelta(t, tt.expected, rate, 1e-9)
+		})
+	}
+}
+
+func TestCurrencyConverter_convert(t *testing.T) {
+	converter := newCurrencyConverter("JPY", []models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150.123, RateDate: "2024-01-01"},
+	})
+	date := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, int64(18533600), converter.toBase(123456, "USD", date), "rounded to whole yen")
+	assert.Equal(t, int64(66612), converter.convert(10000000, "JPY", "USD", date))
+	assert.Equal(t, int64(123456), converter.convert(123456, "GBP", "JPY", date), "unchanged without a rate")
+
+	detail := models.CashflowProjectionDetail{Amount: 100000}
+	converter.setBaseAmount(&detail, "USD", date)
+	assert.Equal(t, int64(15012300), detail.Amount)
+	assert.Equal(t, int64(100000), *detail.OriginalAmount)
+	assert.Equal(t, "USD", detail.OriginalCurrency)
+
+	detail = models.CashflowProjectionDetail{Amount: 100000}
+	converter.setBaseAmount(&detail, "JPY", date)
+	assert.Equal(t, int64(100000), detail.Amount)
+	assert.Nil(t, detail.OriginalAmount)
+}
+
+func TestCurrencyConverter_check(t *testing.T) {
+	converter := newCurrencyConverter("JPY", []models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, RateDate: "2024-01-01"},
+	})
+
+	assert.NoError(t, converter.check("JPY", "", "USD"))
+
+	var validationErr *ValidationError
+	if assert.ErrorAs(t, converter.check("USD", "EUR"), &validationErr) {
+		assert.Equal(t, "no exchange rate from EUR to JPY", validationErr.Message)
+	}
+}
@@ -1,163 +1,286 @@
 package services
 
 import (
+	"context"
+	"math"
+	"time"
+
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/repositories"
-	"time"
 
 	"github.com/google/uuid"
 )
 
+// Dashboard periods
+const (
+	DefaultUpcomingDays = 30
+	MaxUpcomingDays     = 366
+
+	dashboardTrendMonths   = 12 // Months of income and expense trends, including the current month
+	dashboardOutlookMonths = 12 // Months from the current month searched for the lowest balance and averaged for the runway
+	recentActivityLimit    = 5
+)
+
 type DashboardService struct {
-	bankAccountRepo      *repositories.BankAccountRepository
-	creditCardRepo       *repositories.CreditCardRepository
-	incomeSourceRepo     *repositories.IncomeSourceRepository
-	monthlyIncomeRepo    *repositories.MonthlyIncomeRepository
-	recurringPaymentRepo *repositories.RecurringPaymentRepository
-	cashflowService      *CashflowService
+	bankAccountRepo *repositories.BankAccountRepository
+	savingsGoalRepo *repositories.SavingsGoalRepository
+	cashflowService *CashflowService
+	netWorthService *NetWorthService
 }
 
 func NewDashboardService(
 	bankAccountRepo *repositories.BankAccountRepository,
-	creditCardRepo *repositories.CreditCardRepository,
-	incomeSourceRepo *repositories.IncomeSourceRepository,
-	monthlyIncomeRepo *repositories.MonthlyIncomeRepository,
-	recurringPaymentRepo *repositories.RecurringPaymentRepository,
+	savingsGoalRepo *repositories.SavingsGoalRepository,
 	cashflowService *CashflowService,
+	netWorthService *NetWorthService,
 ) *DashboardService {
 	return &DashboardService{
-		bankAccountRepo:      bankAccountRepo,
-		creditCardRepo:       creditCardRepo,
-		incomeSourceRepo:     incomeSourceRepo,
-		monthlyIncomeRepo:    monthlyIncomeRepo,
-		recurringPaymentRepo: recurringPaymentRepo,
-		cashflowService:      cashflowService,
+		bankAccountRepo: bankAccountRepo,
+		savingsGoalRepo: savingsGoalRepo,
+		cashflowService: cashflowService,
+		netWorthService: netWorthService,
 	}
 }
 
-func (s *DashboardService) GetDashboardSummary(userID uuid.UUID) (*models.DashboardSummary, error) {
+// GetDashboardSummary returns the user's totals in the base currency. Amounts in other
+// currencies are converted at the latest exchange rate. The month's income and expense are
+// those of the cashflow projection, or zero when the projection fails.
+func (s *DashboardService) GetDashboardSummary(ctx context.Context, userID uuid.UUID) (*models.DashboardSummary, error) {
+	ctx, span := tracer.Start(ctx, "DashboardService.GetDashboardSummary")
+	defer span.End()
+
+	converter, err := s.cashflowService.getCurrencyConverter(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	currentTime := time.Now()
+
 	// Get total balance from all bank accounts
-	bankAccounts, err := s.bankAccountRepo.GetAll(userID)
+	bankAccounts, err := s.bankAccountRepo.GetAll(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := converter.check(projectionCurrencies(bankAccounts, nil, nil, nil)...); err != nil {
+		return nil, err
+	}
+
 	totalBalance := int64(0)
 	for _, account := range bankAccounts {
-		totalBalance += account.Balance
+		totalBalance += converter.toBase(account.Balance, account.Currency, currentTime)
 	}
 
-	// Get credit cards count
-	creditCards, err := s.creditCardRepo.GetAll(userID)
+	// Exclude the balance set aside for savings goals from the available balance
+	savingsGoals, err := s.savingsGoalRepo.GetActiveByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	reservedAmount := convertedReservedGoalAmount(savingsGoals, bankAccounts, converter, currentTime)
 
-	// Calculate total assets (bank accounts + credit cards)
-	totalAssets := len(bankAccounts) + len(creditCards)
+	// Total assets include investments and other assets, liabilities are the loan balances
+	netWorth, err := s.netWorthService.GetNetWorth(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get current month string (YYYY-MM)
-	currentTime := time.Now()
-	currentYearMonth := currentTime.Format("2006-01")
+	// The current month of the projection gives the month's income, expense and activities
+	currentMonth, err := s.cashflowService.GetCashflowProjection(ctx, userID, 1, true)
+	if err != nil {
+		span.RecordError(err)
+		currentMonth = nil
+	}
+	monthlyIncome, monthlyExpense, activities := monthActivity(currentMonth)
+
+	return &models.DashboardSummary{
+		Currency:         converter.base,
+		TotalBalance:     totalBalance,
+		ReservedAmount:   reservedAmount,
+		AvailableBalance: totalBalance - reservedAmount,
+		MonthlyIncome:    monthlyIncome,
+		MonthlyExpense:   monthlyExpense,
+		TotalAssets:      netWorth.TotalAssets,
+		TotalLiabilities: netWorth.TotalLiabilities,
+		NetWorth:         netWorth.NetWorth,
+		RecentActivities: activities,
+	}, nil
+}
 
-	// Calculate monthly income and expense
-	monthlyIncome, monthlyExpense, err := s.calculateMonthlyIncomeExpense(userID, currentYearMonth)
+// monthActivity sums the income and expense of the month's projected days and returns the first
+// days with income or expense as the recent activities
+func monthActivity(days []models.CashflowProjection) (income, expense int64, activities []models.CashflowProjection) {
+	activities = make([]models.CashflowProjection, 0)
+	for _, day := range days {
+		income += day.Income
+		expense += day.Expense
+		if (day.Income > 0 || day.Expense > 0) && len(activities) < recentActivityLimit {
+			activities = append(activities, day)
+		}
+	}
+	return income, expense, activities
+}
+
+// GetDashboardOverview returns the income and expense trends of the last 12 months, the items due
+// in the next upcomingDays days, the lowest projected balance and the runway. Everything is
+// computed by the cashflow projection, so the figures match the projection.
+func (s *DashboardService) GetDashboardOverview(ctx context.Context, userID uuid.UUID, upcomingDays int) (*models.DashboardOverview, error) {
+	ctx, span := tracer.Start(ctx, "DashboardService.GetDashboardOverview")
+	defer span.End()
+
+	if upcomingDays < 1 || upcomingDays > MaxUpcomingDays {
+		return nil, NewValidationError("upcoming_days", "must be between 1 and %d", MaxUpcomingDays)
+	}
+
+	inputs, err := s.cashflowService.loadProjectionInputs(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get recent cashflow activities
-	recentActivities, err := s.cashflowService.GetCashflowProjection(userID, 1, true)
+	today := time.Now()
+	currentMonth := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	trendDays, err := s.cashflowService.projectMonths(ctx, userID, inputs, currentMonth.AddDate(0, 1-dashboardTrendMonths, 0), dashboardTrendMonths, today, ProjectionOptions{})
 	if err != nil {
-		// If cashflow fails, continue with empty activities
-		recentActivities = make([]models.CashflowProjection, 0)
+		return nil, err
 	}
 
-	// Ensure recentActivities is not nil
-	if recentActivities == nil {
-		recentActivities = make([]models.CashflowProjection, 0)
+	// Project far enough to cover both the outlook and the upcoming days
+	lastUpcoming := today.AddDate(0, 0, upcomingDays)
+	outlookMonths := max(dashboardOutlookMonths, (lastUpcoming.Year()-today.Year())*12+int(lastUpcoming.Month()-today.Month())+1)
+	outlookDays, err := s.cashflowService.projectMonths(ctx, userID, inputs, currentMonth, outlookMonths, today, ProjectionOptions{})
+	if err != nil {
+		return nil, err
 	}
 
-	// Filter recent activities to only current month and limit to 5
-	var filteredActivities []models.CashflowProjection
-	for _, activity := range recentActivities {
-		if len(activity.Date) >= 7 && activity.Date[:7] == currentYearMonth {
-			if activity.Income > 0 || activity.Expense > 0 {
-				filteredActivities = append(filteredActivities, activity)
-				if len(filteredActivities) >= 5 {
-					break
-				}
-			}
+	balance := int64(0)
+	for _, account := range inputs.bankAccounts {
+		balance += inputs.converter.toBase(account.Balance, account.Currency, today)
+	}
+
+	overview := &models.DashboardOverview{
+		Currency:     inputs.converter.base,
+		Trends:       monthlyTrends(trendDays),
+		Upcoming:     upcomingItems(outlookDays, today, upcomingDays),
+		RunwayMonths: runwayMonths(balance, outlookDays[:daysInMonths(currentMonth, dashboardOutlookMonths)]),
+	}
+	overview.LowestBalance, overview.LowestBalanceDate = lowestBalance(outlookDays, today, today.AddDate(0, dashboardOutlookMonths, 0))
+	return overview, nil
+}
+
+// monthlyTrends sums daily projections by month and adds the change from the month before
+func monthlyTrends(days []models.CashflowProjection) []models.MonthlyTrend {
+	trends := make([]models.MonthlyTrend, 0)
+	for _, day := range days {
+		yearMonth := day.Date[:7]
+		if len(trends) == 0 || trends[len(trends)-1].YearMonth != yearMonth {
+			trends = append(trends, models.MonthlyTrend{YearMonth: yearMonth})
 		}
+		trend := &trends[len(trends)-1]
+		trend.Income += day.Income
+		trend.Expense += day.Expense
+		trend.Net = trend.Income - trend.Expense
 	}
 
-	// Ensure filteredActivities is not nil
-	if filteredActivities == nil {
-		filteredActivities = make([]models.CashflowProjection, 0)
+	for i := 1; i < len(trends); i++ {
+		trends[i].IncomeChange = percentChange(trends[i-1].Income, trends[i].Income)
+		trends[i].ExpenseChange = percentChange(trends[i-1].Expense, trends[i].Expense)
 	}
+	return trends
+}
 
-	return &models.DashboardSummary{
-		TotalBalance:     totalBalance,
-		MonthlyIncome:    monthlyIncome,
-		MonthlyExpense:   monthlyExpense,
-		TotalAssets:      totalAssets,
-		RecentActivities: filteredActivities,
-	}, nil
+// percentChange returns the change from previous to current in percent, rounded to one decimal.
+// There is no change relative to a previous amount of 0.
+func percentChange(previous, current int64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	change := math.Round(float64(current-previous)/math.Abs(float64(previous))*1000) / 10
+	return &change
 }
 
-func (s *DashboardService) calculateMonthlyIncomeExpense(userID uuid.UUID, yearMonth string) (int64, int64, error) {
-	var totalIncome int64 = 0
-	var totalExpense int64 = 0
+// upcomingItems returns the incomes and expenses of the days from today within the given number
+// of days. Transfers only move money between accounts and are left out.
+func upcomingItems(days []models.CashflowProjection, today time.Time, upcomingDays int) []models.UpcomingItem {
+	from := today.Format("2006-01-02")
+	until := today.AddDate(0, 0, upcomingDays).Format("2006-01-02")
 
-	// Get active income sources
-	incomeSources, err := s.incomeSourceRepo.GetActiveByUserID(userID)
-	if err != nil {
-		return 0, 0, err
-	}
-
-	// Calculate monthly income
-	for _, source := range incomeSources {
-		if source.IncomeType == "monthly_fixed" {
-			// Check if there's a specific record for this month
-			records, err := s.monthlyIncomeRepo.GetByUserIDAndYearMonth(userID, yearMonth)
-			if err == nil {
-				recordFound := false
-				for _, record := range records {
-					if record.IncomeSourceID == source.ID {
-						totalIncome += record.ActualAmount
-						recordFound = true
-						break
-					}
-				}
-				// Use base amount if no specific record found
-				if !recordFound {
-					totalIncome += source.BaseAmount
-				}
-			} else {
-				// Use base amount if query failed
-				totalIncome += source.BaseAmount
-			}
-		} else if source.IncomeType == "one_time" {
-			// Check if this one-time income is scheduled for current month
-			if source.ScheduledYearMonth != nil && *source.ScheduledYearMonth == yearMonth {
-				totalIncome += source.BaseAmount
+	items := make([]models.UpcomingItem, 0)
+	for _, day := range days {
+		if day.Date < from || day.Date >= until {
+			continue
+		}
+		for _, detail := range day.Details {
+			if detail.Type == "transfer" {
+				continue
 			}
+			items = append(items, models.UpcomingItem{Date: day.Date, CashflowProjectionDetail: detail})
 		}
 	}
+	return items
+}
 
-	// Get active recurring payments
-	recurringPayments, err := s.recurringPaymentRepo.GetActiveByUserID(userID)
-	if err != nil {
-		return totalIncome, 0, err
-	}
+// lowestBalance returns the lowest projected balance of the days from today until the given date
+// and the first day it is reached
+func lowestBalance(days []models.CashflowProjection, today, until time.Time) (int64, string) {
+	from, to := today.Format("2006-01-02"), until.Format("2006-01-02")
 
-	// Calculate monthly expense from recurring payments
-	for _, payment := range recurringPayments {
-		// Check if this payment is still active (for loans with remaining payments)
-		if payment.RemainingPayments == nil || *payment.RemainingPayments > 0 {
-			totalExpense += payment.Amount
+	lowest, lowestDate := int64(0), ""
+	for _, day := range days {
+		if day.Date < from || day.Date >= to {
+			continue
 		}
+		if lowestDate == "" || day.Balance < lowest {
+			lowest, lowestDate = day.Balance, day.Date
+		}
+	}
+	return lowest, lowestDate
+}
+
+// runwayMonths returns how many months the balance covers the average monthly expense of the
+// given days without any income, rounded to one decimal. There is no runway without expenses.
+func runwayMonths(balance int64, days []models.CashflowProjection) *float64 {
+	months := make(map[string]bool)
+	expense := int64(0)
+	for _, day := range days {
+		months[day.Date[:7]] = true
+		expense += day.Expense
+	}
+	if expense <= 0 {
+		return nil
+	}
+
+	runway := 0.0
+	if balance > 0 {
+		average := float64(expense) / float64(len(months))
+		runway = math.Round(float64(balance)/average*10) / 10
 	}
+	return &runway
+}
 
-	return totalIncome, totalExpense, nil
+// daysInMonths returns the number of days in the given number of months from the first of month
+func daysInMonths(month time.Time, months int) int {
+	return int(month.AddDate(0, months, 0).Sub(month).Hours() / 24)
+}
+
+// convertedReservedGoalAmount returns the amount reserved for savings goals in the base currency.
+// Goals are held in the currency of their account, so the reservation is computed per currency.
+func convertedReservedGoalAmount(goals []models.SavingsGoal, accounts []models.BankAccount, converter *currencyConverter, date time.Time) int64 {
+	accountsByCurrency := make(map[string][]models.BankAccount)
+	accountCurrencies := make(map[uuid.UUID]string, len(accounts))
+	for _, account := range accounts {
+		code := converter.code(account.Currency)
+		accountsByCurrency[code] = append(accountsByCurrency[code], account)
+		accountCurrencies[account.ID] = code
+	}
+
+	reserved := int64(0)
+	for code, currencyAccounts := range accountsByCurrency {
+		currencyGoals := make([]models.SavingsGoal, 0)
+		for _, goal := range goals {
+			if accountCurrencies[goal.BankAccount] == code {
+				currencyGoals = append(currencyGoals, goal)
+			}
+		}
+		reserved += converter.toBase(reservedGoalAmount(currencyGoals, accountBalances(currencyAccounts)), code, date)
+	}
+	return reserved
 }
@@ -1,19 +1,23 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockDashboardService は DashboardService のシンプルなモック
 type MockDashboardService struct {
 	bankAccountRepo      BankAccountRepositoryInterface
-	creditCardRepo       CreditCardRepositoryInterface
+	assetRepo            AssetRepositoryInterface
 	incomeSourceRepo     IncomeSourceRepositoryInterface
 	monthlyIncomeRepo    MonthlyIncomeRepositoryInterface
 	recurringPaymentRepo RecurringPaymentRepositoryInterface
@@ -22,7 +26,7 @@ type MockDashboardService struct {
 
 func NewMockDashboardService(
 	bankAccountRepo BankAccountRepositoryInterface,
-	creditCardRepo CreditCardRepositoryInterface,
+	assetRepo AssetRepositoryInterface,
 	incomeSourceRepo IncomeSourceRepositoryInterface,
 	monthlyIncomeRepo MonthlyIncomeRepositoryInterface,
 	recurringPaymentRepo RecurringPaymentRepositoryInterface,
@@ -30,7 +34,7 @@ func NewMockDashboardService(
 ) *MockDashboardService {
 	return &MockDashboardService{
 		bankAccountRepo:      bankAccountRepo,
-		creditCardRepo:       creditCardRepo,
+		assetRepo:            assetRepo,
 		incomeSourceRepo:     incomeSourceRepo,
 		monthlyIncomeRepo:    monthlyIncomeRepo,
 		recurringPaymentRepo: recurringPaymentRepo,
@@ -40,7 +44,7 @@ func NewMockDashboardService(
 
 func (s *MockDashboardService) GetDashboardSummary(userID uuid.UUID) (*models.DashboardSummary, error) {
 	// Get total balance from all bank accounts
-	bankAccounts, err := s.bankAccountRepo.GetAll(userID)
+	bankAccounts, err := s.bankAccountRepo.GetAll(context.Background(), userID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,17 +54,21 @@ func (s *MockDashboardService) GetDashboardSummary(userID uuid.UUID) (*models.Da
 		totalBalance += account.Balance
 	}
 
-	// Get credit cards count
-	creditCards, err := s.creditCardRepo.GetAll(userID)
+	// Add the latest valuations of the other assets
+	assets, err := s.assetRepo.GetAll(context.Background(), userID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate total assets (bank accounts + credit cards)
-	totalAssets := len(bankAccounts) + len(creditCards)
+	totalAssets := totalBalance
+	for _, asset := range assets {
+		if asset.CurrentValue != nil {
+			totalAssets += *asset.CurrentValue
+		}
+	}
 
 	// Get recent cashflow activities
-	recentActivities, err := s.cashflowService.GetCashflowProjection(userID, 1, true)
+	recentActivities, err := s.cashflowService.GetCashflowProjection(context.Background(), userID, 1, true)
 	if err != nil {
 		recentActivities = make([]models.CashflowProjection, 0)
 	}
@@ -70,13 +78,14 @@ func (s *MockDashboardService) GetDashboardSummary(userID uuid.UUID) (*models.Da
 		MonthlyIncome:    100000, // Fixed for test
 		MonthlyExpense:   50000,  // Fixed for test
 		TotalAssets:      totalAssets,
+		NetWorth:         totalAssets,
 		RecentActivities: recentActivities,
 	}, nil
 }
 
 func TestDashboardService_GetDashboardSummary(t *testing.T) {
 	mockBankAccountRepo := &mocks.MockBankAccountRepository{}
-	mockCreditCardRepo := &mocks.MockCreditCardRepository{}
+	mockAssetRepo := &mocks.MockAssetRepository{}
 	mockIncomeSourceRepo := &mocks.MockIncomeSourceRepository{}
 	mockMonthlyIncomeRepo := &mocks.MockMonthlyIncomeRepository{}
 	mockRecurringPaymentRepo := &mocks.MockRecurringPaymentRepository{}
@@ -84,7 +93,7 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 
 	service := NewMockDashboardService(
 		mockBankAccountRepo,
-		mockCreditCardRepo,
+		mockAssetRepo,
 		mockIncomeSourceRepo,
 		mockMonthlyIncomeRepo,
 		mockRecurringPaymentRepo,
@@ -92,7 +101,7 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 	)
 
 	userID := uuid.New()
-	bankAccountID := uuid.New()
+	stockValue := int64(500000)
 
 	tests := []struct {
 		name          string
@@ -111,13 +120,14 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 				}
 				bankAccounts[0].Balance = 100000 // 1000.00
 				bankAccounts[1].Balance = 200000 // 2000.00
-				mockBankAccountRepo.On("GetAll", userID).Return(bankAccounts, nil)
+				mockBankAccountRepo.On("GetAll", mock.Anything, userID).Return(bankAccounts, nil)
 
-				// Setup credit cards
-				creditCards := []models.CreditCard{
-					*helpers.CreateTestCreditCard(userID, bankAccountID),
+				// Setup assets
+				assets := []models.Asset{
+					{UserID: userID, Name: "NISA", AssetType: "nisa", CurrentValue: &stockValue},
+					{UserID: userID, Name: "Pension", AssetType: "pension"},
 				}
-				mockCreditCardRepo.On("GetAll", userID).Return(creditCards, nil)
+				mockAssetRepo.On("GetAll", mock.Anything, userID).Return(assets, nil)
 
 				// Setup cashflow activities
 				activities := []models.CashflowProjection{
@@ -127,7 +137,7 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 						Expense: 0,
 					},
 				}
-				mockCashflowService.On("GetCashflowProjection", userID, 1, true).Return(activities, nil)
+				mockCashflowService.On("GetCashflowProjection", mock.Anything, userID, 1, true).Return(activities, nil)
 			},
 			expectedError: false,
 		},
@@ -135,22 +145,22 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 			name:   "bank account repository error",
 			userID: userID,
 			setupMocks: func() {
-				mockBankAccountRepo.On("GetAll", userID).Return([]models.BankAccount{}, assert.AnError)
+				mockBankAccountRepo.On("GetAll", mock.Anything, userID).Return([]models.BankAccount{}, assert.AnError)
 			},
 			expectedError: true,
 		},
 		{
-			name:   "credit card repository error",
+			name:   "asset repository error",
 			userID: userID,
 			setupMocks: func() {
 				// Setup successful bank accounts
 				bankAccounts := []models.BankAccount{
 					*helpers.CreateTestBankAccount(userID),
 				}
-				mockBankAccountRepo.On("GetAll", userID).Return(bankAccounts, nil)
+				mockBankAccountRepo.On("GetAll", mock.Anything, userID).Return(bankAccounts, nil)
 
-				// Setup error for credit cards
-				mockCreditCardRepo.On("GetAll", userID).Return([]models.CreditCard{}, assert.AnError)
+				// Setup error for assets
+				mockAssetRepo.On("GetAll", mock.Anything, userID).Return([]models.Asset{}, assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -160,7 +170,7 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Reset mocks
 			mockBankAccountRepo.ExpectedCalls = nil
-			mockCreditCardRepo.ExpectedCalls = nil
+			mockAssetRepo.ExpectedCalls = nil
 			mockCashflowService.ExpectedCalls = nil
 
 			tt.setupMocks()
@@ -176,13 +186,13 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 
 				// Verify structure
 				assert.GreaterOrEqual(t, result.TotalBalance, int64(0))
-				assert.GreaterOrEqual(t, result.TotalAssets, 0)
+				assert.GreaterOrEqual(t, result.TotalAssets, result.TotalBalance)
 				assert.NotNil(t, result.RecentActivities)
 
 				// Verify specific values for successful case
 				if tt.name == "successful dashboard summary" {
 					assert.Equal(t, int64(300000), result.TotalBalance) // 1000 + 2000
-					assert.Equal(t, 3, result.TotalAssets)              // 2 bank accounts + 1 credit card
+					assert.Equal(t, int64(800000), result.TotalAssets)  // Bank balances + NISA valuation
 					assert.Equal(t, int64(100000), result.MonthlyIncome)
 					assert.Equal(t, int64(50000), result.MonthlyExpense)
 				}
@@ -190,8 +200,128 @@ func TestDashboardService_GetDashboardSummary(t *testing.T) {
 
 			// Verify all expectations were met
 			mockBankAccountRepo.AssertExpectations(t)
-			mockCreditCardRepo.AssertExpectations(t)
+			mockAssetRepo.AssertExpectations(t)
 			mockCashflowService.AssertExpectations(t)
 		})
 	}
 }
+
+func TestCashflowService_projectMonths(t *testing.T) {
+	accountID := uuid.New()
+	model := DefaultLivingCostModel()
+	model.Mode = LivingCostModeAdditional
+	inputs := &projectionInputs{
+		bankAccounts: []models.BankAccount{{ID: accountID, Balance: 1000000, Currency: "JPY"}},
+		recurringPayments: []models.RecurringPayment{
+			{ID: uuid.New(), Name: "Rent", Amount: 80000, PaymentDay: 10, StartYearMonth: "2024-01", BankAccount: accountID, IsActive: true},
+		},
+		converter:      newCurrencyConverter("JPY", nil),
+		livingCost:     model,
+		livingCostBase: func(string) int64 { return 50000 },
+	}
+	today := time.Date(2025, 3, 15, 9, 0, 0, 0, time.Local)
+
+	// Two past months, the current month and two future months
+	days, err := (&CashflowService{}).projectMonths(context.Background(), uuid.New(), inputs, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 5, today, ProjectionOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2025-01-01", days[0].Date)
+	assert.Equal(t, "2025-05-31", days[len(days)-1].Date)
+
+	trends := monthlyTrends(days)
+	require.Len(t, trends, 5)
+	// Living costs start two months after the current month
+	assert.Equal(t, []int64{80000, 80000, 80000, 80000, 130000}, []int64{trends[0].Expense, trends[1].Expense, trends[2].Expense, trends[3].Expense, trends[4].Expense})
+}
+
+func TestMonthActivity(t *testing.T) {
+	days := []models.CashflowProjection{
+		{Date: "2025-03-10", Expense: 80000},
+		{Date: "2025-03-20", Balance: 920000},
+		{Date: "2025-03-25", Income: 300000},
+	}
+
+	income, expense, activities := monthActivity(days)
+	assert.Equal(t, int64(300000), income)
+	assert.Equal(t, int64(80000), expense)
+	require.Len(t, activities, 2, "days without income or expense are not activities")
+	assert.Equal(t, []string{"2025-03-10", "2025-03-25"}, []string{activities[0].Date, activities[1].Date})
+
+	// Without a projection the month has no figures
+	income, expense, activities = monthActivity(nil)
+	assert.Zero(t, income)
+	assert.Zero(t, expense)
+	assert.NotNil(t, activities)
+	assert.Empty(t, activities)
+}
+
+func TestMonthlyTrends(t *testing.T) {
+	days := []models.CashflowProjection{
+		{Date: "2025-01-10", Income: 300000, Expense: 100000},
+		{Date: "2025-01-25", Expense: 100000},
+		{Date: "2025-02-10", Income: 330000, Expense: 250000},
+		{Date: "2025-03-10", Expense: 50000},
+		{Date: "2025-04-10", Income: 100000},
+	}
+
+	trends := monthlyTrends(days)
+
+	require.Len(t, trends, 4)
+	assert.Equal(t, models.MonthlyTrend{YearMonth: "2025-01", Income: 300000, Expense: 200000, Net: 100000}, trends[0])
+	assert.Equal(t, 10.0, *trends[1].IncomeChange)
+	assert.Equal(t, 25.0, *trends[1].ExpenseChange)
+	assert.Equal(t, int64(80000), trends[1].Net)
+	assert.Equal(t, -100.0, *trends[2].IncomeChange)
+	assert.Equal(t, -80.0, *trends[2].ExpenseChange)
+	assert.Nil(t, trends[3].IncomeChange, "no change from a month without income")
+	assert.Equal(t, -100.0, *trends[3].ExpenseChange)
+}
+
+func TestUpcomingItems(t *testing.T) {
+	today := time.Date(2025, 3, 15, 9, 0, 0, 0, time.Local)
+	days := []models.CashflowProjection{
+		{Date: "2025-03-14", Details: []models.CashflowProjectionDetail{{Type: "income", Amount: 1}}},
+		{Date: "2025-03-15", Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Amount: 2},
+			{Type: "transfer", Amount: 3},
+		}},
+		{Date: "2025-03-20"},
+		{Date: "2025-03-21", Details: []models.CashflowProjectionDetail{{Type: "card_payment", Amount: 4}}},
+		{Date: "2025-03-22", Details: []models.CashflowProjectionDetail{{Type: "income", Amount: 5}}},
+	}
+
+	items := upcomingItems(days, today, 7)
+
+	require.Len(t, items, 2)
+	assert.Equal(t, "2025-03-15", items[0].Date)
+	assert.Equal(t, "recurring_payment", items[0].Type)
+	assert.Equal(t, "2025-03-21", items[1].Date)
+	assert.Equal(t, int64(4), items[1].Amount)
+}
+
+func TestLowestBalance(t *testing.T) {
+	today := time.Date(2025, 3, 15, 9, 0, 0, 0, time.Local)
+	days := []models.CashflowProjection{
+		{Date: "2025-03-01", Balance: -500},
+		{Date: "2025-03-15", Balance: 300},
+		{Date: "2025-04-01", Balance: 100},
+		{Date: "2025-05-01", Balance: 100},
+		{Date: "2025-06-01", Balance: -100},
+	}
+
+	balance, date := lowestBalance(days, today, time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local))
+
+	assert.Equal(t, int64(100), balance)
+	assert.Equal(t, "2025-04-01", date, "first day of the lowest balance, days before today and from the end date are left out")
+}
+
+func TestRunwayMonths(t *testing.T) {
+	days := []models.CashflowProjection{
+		{Date: "2025-03-10", Income: 300000, Expense: 100000},
+		{Date: "2025-04-10", Expense: 200000},
+	}
+
+	assert.Equal(t, 4.0, *runwayMonths(600000, days), "average expense of 150000 a month")
+	assert.Equal(t, 0.0, *runwayMonths(-1, days))
+	assert.Nil(t, runwayMonths(600000, []models.CashflowProjection{{Date: "2025-03-10", Income: 1}}))
+}
@@ -0,0 +1,21 @@
+package services
+
+import "fmt"
+
+// ValidationError reports input that the client has to fix, as opposed to a storage failure
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// NewValidationError creates a ValidationError for the given field
+func NewValidationError(field, format string, args ...any) error {
+	return &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// maxExchangeRateImportRows limits the number of rates in one CSV import
+const maxExchangeRateImportRows = 10000
+
+type ExchangeRateService struct {
+	exchangeRateRepo ExchangeRateRepositoryInterface
+}
+
+func NewExchangeRateService(exchangeRateRepo ExchangeRateRepositoryInterface) *ExchangeRateService {
+	return &ExchangeRateService{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+func (s *ExchangeRateService) GetExchangeRates(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error) {
+	ctx, span := tracer.Start(ctx, "ExchangeRateService.GetExchangeRates")
+	defer span.End()
+
+	return s.exchangeRateRepo.GetAll(ctx, userID)
+}
+
+func (s *ExchangeRateService) GetExchangeRate(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error) {
+	ctx, span := tracer.Start(ctx, "ExchangeRateService.GetExchangeRate")
+	defer span.End()
+
+	return s.exchangeRateRepo.GetByID(ctx, id)
+}
+
+func (s *ExchangeRateService) CreateExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	ctx, span := tracer.Start(ctx, "ExchangeRateService.CreateExchangeRate")
+	defer span.End()
+
+	if err := normalizeExchangeRate(rate); err != nil {
+		return err
+	}
+
+	rate.ID = uuid.New()
+	rate.CreatedAt = time.Now()
+	rate.UpdatedAt = time.Now()
+
+	return s.exchangeRateRepo.Create(ctx, rate)
+}
+
+func (s *ExchangeRateService) UpdateExchangeRate(ctx context.Context, rate *models.ExchangeRate) error {
+	ctx, span := tracer.Start(ctx, "ExchangeRateService.UpdateExchangeRate")
+	defer span.End()
+
+	if err := normalizeExchangeRate(rate); err != nil {
+		return err
+	}
+
+	rate.UpdatedAt = time.Now()
+	return s.exchangeRateRepo.Update(ctx, rate)
+}
+
+func (s *ExchangeRateService) DeleteExchangeRate(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "ExchangeRateService.DeleteExchangeRate")
+	defer span.End()
+
+	return s.exchangeRateRepo.Delete(ctx, id)
+}
+
+// ImportExchangeRates stores the rates of a CSV file with the columns date, from, to and rate,
+// e.g. "2024-01-15,USD,JPY,148.25". A header line is skipped. The file is imported as a whole or
+// not at all, and a rate replaces the one of the same currency pair and date.
+func (s *ExchangeRateService) ImportExchangeRates(ctx context.Context, userID uuid.UUID, r io.Reader) (*models.ExchangeRateImportResult, error) {
+	ctx, span := tracer.Start(ctx, "ExchangeRateService.ImportExchangeRates")
+	defer span.End()
+
+	rates, err := parseExchangeRateCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range rates {
+		rates[i].ID = uuid.New()
+		rates[i].UserID = userID
+		rates[i].CreatedAt = now
+		rates[i].UpdatedAt = now
+	}
+
+	if err := s.exchangeRateRepo.UpsertAll(ctx, rates); err != nil {
+		return nil, err
+	}
+	return &models.ExchangeRateImportResult{Imported: len(rates)}, nil
+}
+
+// normalizeExchangeRate validates a rate and upper-cases its currency codes
+func normalizeExchangeRate(rate *models.ExchangeRate) error {
+	if strings.TrimSpace(rate.FromCurrency) == "" {
+		return NewValidationError("from_currency", "is required")
+	}
+	if err := normalizeCurrency("from_currency", &rate.FromCurrency); err != nil {
+		return err
+	}
+	if strings.TrimSpace(rate.ToCurrency) == "" {
+		return NewValidationError("to_currency", "is required")
+	}
+	if err := normalizeCurrency("to_currency", &rate.ToCurrency); err != nil {
+		return err
+	}
+	if rate.FromCurrency == rate.ToCurrency {
+		return NewValidationError("to_currency", "must be different from from_currency")
+	}
+	if math.IsNaN(rate.Rate) || math.IsInf(rate.Rate, 0) || rate.Rate <= 0 {
+		return NewValidationError("rate", "must be positive")
+	}
+	if _, err := time.Parse("2006-01-02", rate.RateDate); err != nil {
+		return NewValidationError("rate_date", "must be in YYYY-MM-DD format")
+	}
+	return nil
+}
+
+// parseExchangeRateCSV reads and validates the rates of an import file. Errors name the line of
+// the file they were found on.
+func parseExchangeRateCSV(r io.Reader) ([]models.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	rates := make([]models.ExchangeRate, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, NewValidationError("file", "line %d: %s", parseErr.Line, parseErr.Err)
+			}
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+		if len(rates) == maxExchangeRateImportRows {
+			return nil, NewValidationError("file", "must not contain more than %d rates", maxExchangeRateImportRows)
+		}
+
+		rate := models.ExchangeRate{
+			RateDate:     strings.TrimSpace(record[0]),
+			FromCurrency: record[1],
+			ToCurrency:   record[2],
+		}
+		rate.Rate, err = strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+		if err != nil {
+			return nil, NewValidationError("file", "line %d: rate must be a number", line)
+		}
+		if err := normalizeExchangeRate(&rate); err != nil {
+			return nil, NewValidationError("file", "line %d: %s", line, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	if len(rates) == 0 {
+		return nil, NewValidationError("file", "contains no exchange rates")
+	}
+	return rates, nil
+}
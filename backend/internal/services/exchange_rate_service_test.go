@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNormalizeExchangeRate(t *testing.T) {
+	rate := models.ExchangeRate{FromCurrency: "usd", ToCurrency: " jpy", Rate: 148.25, RateDate: "2024-01-15"}
+	assert.NoError(t, normalizeExchangeRate(&rate))
+	assert.Equal(t, "USD", rate.FromCurrency)
+	assert.Equal(t, "JPY", rate.ToCurrency)
+
+	invalid := []struct {
+		name  string
+		rate  models.ExchangeRate
+		field string
+	}{
+		{name: "missing source currency", rate: models.ExchangeRate{ToCurrency: "JPY", Rate: 1, RateDate: "2024-01-15"}, field: "from_currency"},
+		{name: "unknown currency", rate: models.ExchangeRate{FromCurrency: "USD", ToCurrency: "XXX", Rate: 1, RateDate: "2024-01-15"}, field: "to_currency"},
+		{name: "same currency", rate: models.ExchangeRate{FromCurrency: "USD", ToCurrency: "usd", Rate: 1, RateDate: "2024-01-15"}, field: "to_currency"},
+		{name: "zero rate", rate: models.ExchangeRate{FromCurrency: "USD", ToCurrency: "JPY", RateDate: "2024-01-15"}, field: "rate"},
+		{name: "invalid date", rate: models.ExchangeRate{FromCurrency: "USD", ToCurrency: "JPY", Rate: 1, RateDate: "2024-13-01"}, field: "rate_date"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, normalizeExchangeRate(&tt.rate), &validationErr) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestExchangeRateService_CreateExchangeRate(t *testing.T) {
+	mockRepo := &mocks.MockExchangeRateRepository{}
+	service := NewExchangeRateService(mockRepo)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(rate *models.ExchangeRate) bool {
+		return rate.ID != uuid.Nil && !rate.CreatedAt.IsZero() && rate.FromCurrency == "USD"
+	})).Return(nil)
+
+	rate := models.ExchangeRate{FromCurrency: "usd", ToCurrency: "JPY", Rate: 148.25, RateDate: "2024-01-15"}
+	assert.NoError(t, service.CreateExchangeRate(context.Background(), &rate))
+	mockRepo.AssertExpectations(t)
+
+	invalid := models.ExchangeRate{FromCurrency: "USD", ToCurrency: "USD", Rate: 1, RateDate: "2024-01-15"}
+	assert.Error(t, service.CreateExchangeRate(context.Background(), &invalid))
+	mockRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestExchangeRateService_ImportExchangeRates(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		csv           string
+		setupMock     func(*mocks.MockExchangeRateRepository)
+		expected      int
+		expectedError string
+	}{
+		{
+			name: "with header",
+			csv:  "date,from,to,rate\n2024-01-15,USD,JPY,148.25\n2024-01-16, usd, jpy, 149.1\n",
+			setupMock: func(m *mocks.MockExchangeRateRepository) {
+				m.On("UpsertAll", mock.Anything, mock.MatchedBy(func(rates []models.ExchangeRate) bool {
+					return len(rates) == 2 && rates[1].FromCurrency == "USD" && rates[1].Rate == 149.1 &&
+						rates[0].UserID == userID && rates[0].ID != uuid.Nil
+				})).Return(nil)
+			},
+			expected: 2,
+		},
+		{
+			name: "without header",
+			csv:  "2024-01-15,EUR,JPY,161.5",
+			setupMock: func(m *mocks.MockExchangeRateRepository) {
+				m.On("UpsertAll", mock.Anything, mock.AnythingOfType("[]models.ExchangeRate")).Return(nil)
+			},
+			expected: 1,
+		},
+		{
+			name:          "invalid rate names the line",
+			csv:           "date,from,to,rate\n2024-01-15,USD,JPY,148.25\n2024-01-16,USD,JPY,abc\n",
+			setupMock:     func(m *mocks.MockExchangeRateRepository) {},
+			expectedError: "file: line 3: rate must be a number",
+		},
+		{
+			name:          "invalid currency names the line",
+			csv:           "2024-01-15,USD,XYZ,148.25\n",
+			setupMock:     func(m *mocks.MockExchangeRateRepository) {},
+			expectedError: "file: line 1: to_currency: must be one of",
+		},
+		{
+			name:          "wrong number of columns",
+			csv:           "2024-01-15,USD,JPY\n",
+			setupMock:     func(m *mocks.MockExchangeRateRepository) {},
+			expectedError: "file: line 1: wrong number of fields",
+		},
+		{
+			name:          "empty file",
+			csv:           "date,from,to,rate\n",
+			setupMock:     func(m *mocks.MockExchangeRateRepository) {},
+			expectedError: "file: contains no exchange rates",
+		},
+		{
+			name: "repository error",
+			csv:  "2024-01-15,USD,JPY,148.25\n",
+			setupMock: func(m *mocks.MockExchangeRateRepository) {
+				m.On("UpsertAll", mock.Anything, mock.AnythingOfType("[]models.ExchangeRate")).Return(assert.AnError)
+			},
+			expectedError: assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockExchangeRateRepository{}
+			service := NewExchangeRateService(mockRepo)
+			tt.setupMock(mockRepo)
+
+			result, err := service.ImportExchangeRates(context.Background(), userID, strings.NewReader(tt.csv))
+
+			if tt.expectedError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.expectedError)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result.Imported)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
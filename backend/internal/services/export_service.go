@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Soli0222/flow-sight/backend/internal/export"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// ExportService builds the tables of the CSV and Excel exports of the user's data
+type ExportService struct {
+	bankAccountRepo      BankAccountRepositoryInterface
+	creditCardRepo       CreditCardRepositoryInterface
+	cardMonthlyTotalRepo CardMonthlyTotalRepositoryInterface
+	incomeSourceRepo     IncomeSourceRepositoryInterface
+	recurringPaymentRepo RecurringPaymentRepositoryInterface
+}
+
+func NewExportService(
+	bankAccountRepo BankAccountRepositoryInterface,
+	creditCardRepo CreditCardRepositoryInterface,
+	cardMonthlyTotalRepo CardMonthlyTotalRepositoryInterface,
+	incomeSourceRepo IncomeSourceRepositoryInterface,
+	recurringPaymentRepo RecurringPaymentRepositoryInterface,
+) *ExportService {
+	return &ExportService{
+		bankAccountRepo:      bankAccountRepo,
+		creditCardRepo:       creditCardRepo,
+		cardMonthlyTotalRepo: cardMonthlyTotalRepo,
+		incomeSourceRepo:     incomeSourceRepo,
+		recurringPaymentRepo: recurringPaymentRepo,
+	}
+}
+
+// ExportBankAccounts returns the user's bank accounts as a table
+func (s *ExportService) ExportBankAccounts(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ExportService.ExportBankAccounts")
+	defer span.End()
+
+	accounts, err := s.bankAccountRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &export.Table{Name: "銀行口座", Headers: []string{"名前", "通貨", "残高"}}
+	for _, account := range accounts {
+		table.AddRow(account.Name, account.Currency, export.Amount(account.Balance, account.Currency, unit))
+	}
+	return table, nil
+}
+
+// ExportCreditCards returns the user's credit cards as a table. Cards have no amounts, so the
+// unit is unused.
+func (s *ExportService) ExportCreditCards(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ExportService.ExportCreditCards")
+	defer span.End()
+
+	cards, err := s.creditCardRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	accountNames, err := s.bankAccountNames(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &export.Table{Name: "クレジットカード", Headers: []string{"名前", "締め日", "支払日", "引き落とし口座", "通貨", "タグ"}}
+	for _, card := range cards {
+		table.AddRow(card.Name, optionalInt(card.ClosingDay), card.PaymentDay, accountNames[card.BankAccount], card.Currency, strings.Join(card.Tags, ", "))
+	}
+	return table, nil
+}
+
+// ExportCardMonthlyTotals returns the monthly statement totals of every card of the user as a
+// table, by card and oldest month first
+func (s *ExportService) ExportCardMonthlyTotals(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ExportService.ExportCardMonthlyTotals")
+	defer span.End()
+
+	cards, err := s.creditCardRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &export.Table{Name: "カード月次利用額", Headers: []string{"カード", "年月", "利用額", "通貨", "確定"}}
+	for _, card := range cards {
+		totals, err := s.cardMonthlyTotalRepo.GetByCreditCardID(ctx, card.ID)
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(totals, func(i, j int) bool { return totals[i].YearMonth < totals[j].YearMonth })
+		for _, total := range totals {
+			table.AddRow(card.Name, total.YearMonth, export.Amount(total.TotalAmount, card.Currency, unit), card.Currency, yesNo(total.IsConfirmed))
+		}
+	}
+	return table, nil
+}
+
+// ExportIncomeSources returns the user's income sources as a table
+func (s *ExportService) ExportIncomeSources(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ExportService.ExportIncomeSources")
+	defer span.End()
+
+	sources, err := s.incomeSourceRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	accountNames, err := s.bankAccountNames(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &export.Table{Name: "収入源", Headers: []string{"名前", "種類", "金額", "通貨", "入金口座", "入金日", "入金月", "予定日", "昇給率(%)", "有効", "タグ"}}
+	for _, source := range sources {
+		var raiseRate any
+		if source.RaiseRate != nil {
+			raiseRate = export.Number(strconv.FormatFloat(*source.RaiseRate, 'f', -1, 64))
+		}
+		var scheduledDate any
+		if source.ScheduledDate != nil {
+			scheduledDate = *source.ScheduledDate
+		}
+		table.AddRow(
+			source.Name,
+			source.IncomeType,
+			export.Amount(source.BaseAmount, source.Currency, unit),
+			source.Currency,
+			accountNames[source.BankAccount],
+			optionalInt(source.PaymentDay),
+			joinMonths(source.PaymentMonths),
+			scheduledDate,
+			raiseRate,
+			yesNo(source.IsActive),
+			strings.Join(source.Tags, ", "),
+		)
+	}
+	return table, nil
+}
+
+// ExportRecurringPayments returns the user's recurring payments and loans as a table
+func (s *ExportService) ExportRecurringPayments(ctx context.Context, userID uuid.UUID, unit export.Unit) (*export.Table, error) {
+	ctx, span := tracer.Start(ctx, "ExportService.ExportRecurringPayments")
+	defer span.End()
+
+	payments, err := s.recurringPaymentRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	accountNames, err := s.bankAccountNames(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &export.Table{Name: "固定支出", Headers: []string{"名前", "金額", "通貨", "支払日", "開始年月", "繰り返し", "総回数", "残り回数", "引き落とし口座", "有効", "メモ", "タグ"}}
+	for _, payment := range payments {
+		var rule any
+		if payment.RecurrenceRule != nil {
+			rule = *payment.RecurrenceRule
+		}
+		table.AddRow(
+			payment.Name,
+			export.Amount(payment.Amount, payment.Currency, unit),
+			payment.Currency,
+			payment.PaymentDay,
+			payment.StartYearMonth,
+			rule,
+			optionalInt(payment.TotalPayments),
+			optionalInt(payment.RemainingPayments),
+			accountNames[payment.BankAccount],
+			yesNo(payment.IsActive),
+			payment.Note,
+			strings.Join(payment.Tags, ", "),
+		)
+	}
+	return table, nil
+}
+
+// bankAccountNames returns the names of the user's bank accounts by ID
+func (s *ExportService) bankAccountNames(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]string, error) {
+	accounts, err := s.bankAccountRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string, len(accounts))
+	for _, account := range accounts {
+		names[account.ID] = account.Name
+	}
+	return names, nil
+}
+
+// CashflowProjectionTable returns projected days as a table, one row per day with the day's items
+// in the last column. Amounts are in the base currency.
+func CashflowProjectionTable(days []models.CashflowProjection, baseCurrency string, unit export.Unit) *export.Table {
+	table := &export.Table{Name: "キャッシュフロー予測", Headers: []string{"日付", "収入", "支出", "残高", "明細"}}
+	for _, day := range days {
+		items := make([]string, 0, len(day.Details))
+		for _, detail := range day.Details {
+			items = append(items, detail.Description+" "+string(export.Amount(detail.Amount, baseCurrency, unit)))
+		}
+		table.AddRow(
+			day.Date,
+			export.Amount(day.Income, baseCurrency, unit),
+			export.Amount(day.Expense, baseCurrency, unit),
+			export.Amount(day.Balance, baseCurrency, unit),
+			strings.Join(items, " / "),
+		)
+	}
+	return table
+}
+
+// periodSubtotalColumns are the detail types exported as subtotal columns of a period, with their
+// headers
+var periodSubtotalColumns = []struct {
+	detailType string
+	header     string
+}{
+	{"income", "収入源"},
+	{"recurring_payment", "固定支出"},
+	{"card_payment", "カード支払い"},
+	{"loan_prepayment", "繰上返済"},
+	{"living_cost", "生活費"},
+}
+
+// CashflowPeriodTable returns weekly or monthly projection periods as a table. Amounts are in the
+// base currency.
+func CashflowPeriodTable(periods []models.CashflowPeriod, baseCurrency string, unit export.Unit) *export.Table {
+	table := &export.Table{Name: "キャッシュフロー予測", Headers: []string{"開始日", "終了日", "期首残高", "収入", "支出", "期末残高"}}
+	for _, column := range periodSubtotalColumns {
+		table.Headers = append(table.Headers, column.header)
+	}
+
+	for _, period := range periods {
+		row := []any{
+			period.StartDate,
+			period.EndDate,
+			export.Amount(period.OpeningBalance, baseCurrency, unit),
+			export.Amount(period.Income, baseCurrency, unit),
+			export.Amount(period.Expense, baseCurrency, unit),
+			export.Amount(period.ClosingBalance, baseCurrency, unit),
+		}
+		for _, column := range periodSubtotalColumns {
+			row = append(row, export.Amount(period.Subtotals[column.detailType], baseCurrency, unit))
+		}
+		table.AddRow(row...)
+	}
+	return table
+}
+
+// optionalInt returns the value of an optional number as a cell, nil for an empty cell
+func optionalInt(n *int) any {
+	if n == nil {
+		return nil
+	}
+	return *n
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "はい"
+	}
+	return "いいえ"
+}
+
+func joinMonths(months models.MonthList) string {
+	parts := make([]string, len(months))
+	for i, month := range months {
+		parts[i] = strconv.Itoa(month)
+	}
+	return strings.Join(parts, ", ")
+}
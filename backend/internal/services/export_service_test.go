@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/export"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportService_ExportRecurringPayments(t *testing.T) {
+	bankAccountRepo := &mocks.MockBankAccountRepository{}
+	recurringPaymentRepo := &mocks.MockRecurringPaymentRepository{}
+	service := NewExportService(bankAccountRepo, nil, nil, nil, recurringPaymentRepo)
+
+	userID, accountID := uuid.New(), uuid.New()
+	totalPayments := 35
+	bankAccountRepo.On("GetAll", mock.Anything, userID).Return([]models.BankAccount{{ID: accountID, Name: "メイン"}}, nil)
+	recurringPaymentRepo.On("GetAll", mock.Anything, userID).Return([]models.RecurringPayment{{
+		Name: "Car Loan", Amount: 3000000, Currency: "JPY", PaymentDay: 27, StartYearMonth: "2024-01",
+		TotalPayments: &totalPayments, BankAccount: accountID, IsActive: true, Tags: models.TagList{"car", "loan"},
+	}}, nil)
+
+	table, err := service.ExportRecurringPayments(context.Background(), userID, export.Yen)
+
+	require.NoError(t, err)
+	require.Len(t, table.Rows, 1)
+	assert.Len(t, table.Rows[0], len(table.Headers))
+	assert.Equal(t, []any{
+		"Car Loan", export.Number("30000"), "JPY", 27, "2024-01", nil, 35, nil, "メイン", "はい", "", "car, loan",
+	}, table.Rows[0])
+}
+
+func TestExportService_ExportCardMonthlyTotals(t *testing.T) {
+	creditCardRepo := &mocks.MockCreditCardRepository{}
+	cardMonthlyTotalRepo := &mocks.MockCardMonthlyTotalRepository{}
+	service := NewExportService(nil, creditCardRepo, cardMonthlyTotalRepo, nil, nil)
+
+	userID, cardID := uuid.New(), uuid.New()
+	creditCardRepo.On("GetAll", mock.Anything, userID).Return([]models.CreditCard{{ID: cardID, Name: "Visa", Currency: "USD"}}, nil)
+	cardMonthlyTotalRepo.On("GetByCreditCardID", mock.Anything, cardID).Return([]models.CardMonthlyTotal{
+		{YearMonth: "2024-02", TotalAmount: 1250},
+		{YearMonth: "2024-01", TotalAmount: 100, IsConfirmed: true},
+	}, nil)
+
+	table, err := service.ExportCardMonthlyTotals(context.Background(), userID, export.Minor)
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{
+		{"Visa", "2024-01", export.Number("100"), "USD", "はい"},
+		{"Visa", "2024-02", export.Number("1250"), "USD", "いいえ"},
+	}, table.Rows, "oldest month first")
+}
+
+func TestCashflowPeriodTable(t *testing.T) {
+	periods := []models.CashflowPeriod{{
+		StartDate: "2025-01-01", EndDate: "2025-01-31",
+		OpeningBalance: 100000, ClosingBalance: 150000, Income: 80000, Expense: 30000,
+		Subtotals: map[string]int64{"income": 80000, "card_payment": 30000},
+	}}
+
+	table := CashflowPeriodTable(periods, "JPY", export.Yen)
+
+	assert.Equal(t, []string{"開始日", "終了日", "期首残高", "収入", "支出", "期末残高", "収入源", "固定支出", "カード支払い", "繰上返済", "生活費"}, table.Headers)
+	assert.Equal(t, []any{
+		"2025-01-01", "2025-01-31",
+		export.Number("1000"), export.Number("800"), export.Number("300"), export.Number("1500"),
+		export.Number("800"), export.Number("0"), export.Number("300"), export.Number("0"), export.Number("0"),
+	}, table.Rows[0])
+}
+
+func TestCashflowProjectionTable(t *testing.T) {
+	days := []models.CashflowProjection{{
+		Date: "2025-01-25", Income: 30000000, Balance: 50000000,
+		Details: []models.CashflowProjectionDetail{{Type: "income", Description: "給与", Amount: 30000000}},
+	}}
+
+	table := CashflowProjectionTable(days, "JPY", export.Yen)
+
+	assert.Equal(t, []any{
+		"2025-01-25", export.Number("300000"), export.Number("0"), export.Number("500000"), "給与 300000",
+	}, table.Rows[0])
+}
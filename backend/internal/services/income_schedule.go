@@ -0,0 +1,156 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+)
+
+// Income types
+const (
+	IncomeTypeMonthlyFixed = "monthly_fixed"
+	IncomeTypeOneTime      = "one_time"
+	IncomeTypeSemiAnnual   = "semi_annual"   // Twice a year, e.g. summer and winter bonuses
+	IncomeTypeCustomMonths = "custom_months" // Any set of months
+	IncomeTypeAnnual       = "annual"        // Once a year
+)
+
+const (
+	defaultIncomePaymentDay = 25
+	maxRaiseRate            = 100.0
+)
+
+// isScheduledIncome reports whether the income repeats on a payment day in its payment months
+func isScheduledIncome(source models.IncomeSource) bool {
+	switch source.IncomeType {
+	case IncomeTypeMonthlyFixed, IncomeTypeSemiAnnual, IncomeTypeCustomMonths, IncomeTypeAnnual:
+		return true
+	}
+	return false
+}
+
+// incomePaysInMonth reports whether scheduled income is paid in the given month
+func incomePaysInMonth(source models.IncomeSource, month time.Month) bool {
+	if source.IncomeType == IncomeTypeMonthlyFixed {
+		return true
+	}
+	for _, m := range source.PaymentMonths {
+		if m == int(month) {
+			return true
+		}
+	}
+	return false
+}
+
+// incomePaymentDay returns the day scheduled income is paid in a month with daysInMonth days.
+// A payment day past the end of the month falls on the last day, as with recurring payments.
+func incomePaymentDay(source models.IncomeSource, daysInMonth int) int {
+	paymentDay := defaultIncomePaymentDay
+	if source.PaymentDay != nil {
+		paymentDay = *source.PaymentDay
+	}
+	if paymentDay > daysInMonth {
+		paymentDay = daysInMonth
+	}
+	return paymentDay
+}
+
+// incomeAmountForYear applies the yearly raise to the base amount.
+// BaseAmount is the amount in BaseYear (or the year the source was created) and the raise
+// compounds once per year after that. Years before the base year use the base amount.
+func incomeAmountForYear(source models.IncomeSource, year int) int64 {
+	if source.RaiseRate == nil || *source.RaiseRate == 0 {
+		return source.BaseAmount
+	}
+
+	baseYear := source.CreatedAt.Year()
+	if source.BaseYear != nil {
+		baseYear = *source.BaseYear
+	}
+	years := year - baseYear
+	if years <= 0 {
+		return source.BaseAmount
+	}
+
+	factor := math.Pow(1+*source.RaiseRate/100, float64(years))
+	return int64(math.Round(float64(source.BaseAmount) * factor))
+}
+
+// normalizeIncomeSource validates the schedule fields of an income source and fills in defaults
+func normalizeIncomeSource(source *models.IncomeSource) error {
+	tags, err := normalizeTags(source.Tags)
+	if err != nil {
+		return err
+	}
+	source.Tags = tags
+
+	if err := normalizeCurrency("currency", &source.Currency); err != nil {
+		return err
+	}
+	if source.BaseAmount < 0 {
+		return NewValidationError("base_amount", "must not be negative")
+	}
+	if source.PaymentDay != nil && (*source.PaymentDay < 1 || *source.PaymentDay > 31) {
+		return NewValidationError("payment_day", "must be between 1 and 31")
+	}
+
+	switch source.IncomeType {
+	case IncomeTypeMonthlyFixed, IncomeTypeOneTime:
+		if len(source.PaymentMonths) > 0 {
+			return NewValidationError("payment_months", "is not supported for %s income", source.IncomeType)
+		}
+	case IncomeTypeSemiAnnual, IncomeTypeCustomMonths, IncomeTypeAnnual:
+		months, err := normalizePaymentMonths(source.PaymentMonths)
+		if err != nil {
+			return err
+		}
+		switch {
+		case source.IncomeType == IncomeTypeSemiAnnual && len(months) != 2:
+			return NewValidationError("payment_months", "semi_annual income requires exactly 2 months")
+		case source.IncomeType == IncomeTypeAnnual && len(months) != 1:
+			return NewValidationError("payment_months", "annual income requires exactly 1 month")
+		case len(months) == 0:
+			return NewValidationError("payment_months", "at least one month is required")
+		}
+		source.PaymentMonths = months
+	default:
+		return NewValidationError("income_type", "unsupported income type: %s", source.IncomeType)
+	}
+
+	if source.RaiseRate != nil {
+		if source.IncomeType == IncomeTypeOneTime {
+			return NewValidationError("raise_rate", "is not supported for one_time income")
+		}
+		if *source.RaiseRate <= -maxRaiseRate || *source.RaiseRate > maxRaiseRate {
+			return NewValidationError("raise_rate", "must be greater than -100 and at most 100")
+		}
+		if source.BaseYear == nil {
+			baseYear := time.Now().Year()
+			source.BaseYear = &baseYear
+		}
+	}
+	if source.BaseYear != nil && (*source.BaseYear < 1900 || *source.BaseYear > 2200) {
+		return NewValidationError("base_year", "must be between 1900 and 2200")
+	}
+
+	return nil
+}
+
+// normalizePaymentMonths sorts the months and rejects out of range or duplicate values
+func normalizePaymentMonths(months models.MonthList) (models.MonthList, error) {
+	normalized := make(models.MonthList, len(months))
+	copy(normalized, months)
+	sort.Ints(normalized)
+
+	for i, month := range normalized {
+		if month < 1 || month > 12 {
+			return nil, NewValidationError("payment_months", "month must be between 1 and 12: %d", month)
+		}
+		if i > 0 && normalized[i-1] == month {
+			return nil, NewValidationError("payment_months", "duplicate month: %d", month)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeIncomeSource(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	floatPtr := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name           string
+		source         models.IncomeSource
+		expectedMonths models.MonthList
+		expectedError  string
+	}{
+		{name: "monthly fixed", source: models.IncomeSource{IncomeType: "monthly_fixed", BaseAmount: 300000, PaymentDay: intPtr(25)}},
+		{name: "one time", source: models.IncomeSource{IncomeType: "one_time", BaseAmount: 100000}},
+		{
+			name:           "semi annual months are sorted",
+			source:         models.IncomeSource{IncomeType: "semi_annual", BaseAmount: 500000, PaymentMonths: models.MonthList{12, 6}},
+			expectedMonths: models.MonthList{6, 12},
+		},
+		{
+			name:           "custom months",
+			source:         models.IncomeSource{IncomeType: "custom_months", BaseAmount: 10000, PaymentMonths: models.MonthList{3, 6, 9, 12}},
+			expectedMonths: models.MonthList{3, 6, 9, 12},
+		},
+		{
+			name:           "annual with raise",
+			source:         models.IncomeSource{IncomeType: "annual", BaseAmount: 200000, PaymentMonths: models.MonthList{4}, RaiseRate: floatPtr(2.5), BaseYear: intPtr(2025)},
+			expectedMonths: models.MonthList{4},
+		},
+		{name: "unknown type", source: models.IncomeSource{IncomeType: "weekly"}, expectedError: "income_type"},
+		{name: "negative amount", source: models.IncomeSource{IncomeType: "monthly_fixed", BaseAmount: -1}, expectedError: "base_amount"},
+		{name: "invalid payment day", source: models.IncomeSource{IncomeType: "monthly_fixed", PaymentDay: intPtr(32)}, expectedError: "payment_day"},
+		{name: "months on monthly income", source: models.IncomeSource{IncomeType: "monthly_fixed", PaymentMonths: models.MonthList{6}}, expectedError: "payment_months"},
+		{name: "semi annual with one month", source: models.IncomeSource{IncomeType: "semi_annual", PaymentMonths: models.MonthList{6}}, expectedError: "payment_months"},
+		{name: "annual with two months", source: models.IncomeSource{IncomeType: "annual", PaymentMonths: models.MonthList{6, 12}}, expectedError: "payment_months"},
+		{name: "custom months empty", source: models.IncomeSource{IncomeType: "custom_months"}, expectedError: "payment_months"},
+		{name: "month out of range", source: models.IncomeSource{IncomeType: "custom_months", PaymentMonths: models.MonthList{0, 13}}, expectedError: "payment_months"},
+		{name: "duplicate month", source: models.IncomeSource{IncomeType: "custom_months", PaymentMonths: models.MonthList{6, 6}}, expectedError: "payment_months"},
+		{name: "raise on one time", source: models.IncomeSource{IncomeType: "one_time", RaiseRate: floatPtr(1)}, expectedError: "raise_rate"},
+		{name: "raise out of range", source: models.IncomeSource{IncomeType: "monthly_fixed", RaiseRate: floatPtr(150)}, expectedError: "raise_rate"},
+		{name: "invalid base year", source: models.IncomeSource{IncomeType: "monthly_fixed", BaseYear: intPtr(10)}, expectedError: "base_year"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := tt.source
+			err := normalizeIncomeSource(&source)
+
+			if tt.expectedError != "" {
+				var validationErr *ValidationError
+				if assert.ErrorAs(t, err, &validationErr) {
+					assert.Equal(t, tt.expectedError, validationErr.Field)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMonths, source.PaymentMonths)
+		})
+	}
+}
+
+func TestNormalizeIncomeSource_DefaultsBaseYear(t *testing.T) {
+	rate := 3.0
+	source := models.IncomeSource{IncomeType: "monthly_fixed", RaiseRate: &rate}
+
+	assert.NoError(t, normalizeIncomeSource(&source))
+	if assert.NotNil(t, source.BaseYear) {
+		assert.Equal(t, time.Now().Year(), *source.BaseYear)
+	}
+}
+
+func TestIncomePaysInMonth(t *testing.T) {
+	monthly := models.IncomeSource{IncomeType: "monthly_fixed"}
+	bonus := models.IncomeSource{IncomeType: "semi_annual", PaymentMonths: models.MonthList{6, 12}}
+
+	assert.True(t, incomePaysInMonth(monthly, time.March))
+	assert.True(t, incomePaysInMonth(bonus, time.June))
+	assert.True(t, incomePaysInMonth(bonus, time.December))
+	assert.False(t, incomePaysInMonth(bonus, time.July))
+}
+
+func TestIncomePaymentDay(t *testing.T) {
+	day := 31
+	assert.Equal(t, 25, incomePaymentDay(models.IncomeSource{}, 30))
+	assert.Equal(t, 31, incomePaymentDay(models.IncomeSource{PaymentDay: &day}, 31))
+	assert.Equal(t, 28, incomePaymentDay(models.IncomeSource{PaymentDay: &day}, 28))
+}
+
+func TestIncomeAmountForYear(t *testing.T) {
+	rate := 10.0
+	baseYear := 2025
+	source := models.IncomeSource{BaseAmount: 100000, RaiseRate: &rate, BaseYear: &baseYear}
+
+	assert.Equal(t, int64(100000), incomeAmountForYear(source, 2024))
+	assert.Equal(t, int64(100000), incomeAmountForYear(source, 2025))
+	assert.Equal(t, int64(110000), incomeAmountForYear(source, 2026))
+	assert.Equal(t, int64(121000), incomeAmountForYear(source, 2027))
+
+	// Without a base year the creation year is used
+	source.BaseYear = nil
+	source.CreatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, int64(110000), incomeAmountForYear(source, 2027))
+
+	// Without a raise the base amount is used every year
+	assert.Equal(t, int64(100000), incomeAmountForYear(models.IncomeSource{BaseAmount: 100000}, 2030))
+}
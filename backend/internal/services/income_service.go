@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/repositories"
 	"time"
@@ -11,63 +12,146 @@ import (
 type IncomeService struct {
 	incomeSourceRepo  *repositories.IncomeSourceRepository
 	monthlyIncomeRepo *repositories.MonthlyIncomeRepository
+	categoryRepo      CategoryRepositoryInterface
+	publisher         EventPublisherInterface // nil when changes are not published
 }
 
-func NewIncomeService(incomeSourceRepo *repositories.IncomeSourceRepository, monthlyIncomeRepo *repositories.MonthlyIncomeRepository) *IncomeService {
+func NewIncomeService(incomeSourceRepo *repositories.IncomeSourceRepository, monthlyIncomeRepo *repositories.MonthlyIncomeRepository, categoryRepo CategoryRepositoryInterface, publisher EventPublisherInterface) *IncomeService {
 	return &IncomeService{
 		incomeSourceRepo:  incomeSourceRepo,
 		monthlyIncomeRepo: monthlyIncomeRepo,
+		categoryRepo:      categoryRepo,
+		publisher:         publisher,
 	}
 }
 
 // Income Source methods
-func (s *IncomeService) GetIncomeSources(userID uuid.UUID) ([]models.IncomeSource, error) {
-	return s.incomeSourceRepo.GetAll(userID)
+func (s *IncomeService) GetIncomeSources(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error) {
+	ctx, span := tracer.Start(ctx, "IncomeService.GetIncomeSources")
+	defer span.End()
+
+	return s.incomeSourceRepo.GetAll(ctx, userID)
 }
 
-func (s *IncomeService) GetIncomeSource(id uuid.UUID) (*models.IncomeSource, error) {
-	return s.incomeSourceRepo.GetByID(id)
+func (s *IncomeService) GetIncomeSource(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error) {
+	ctx, span := tracer.Start(ctx, "IncomeService.GetIncomeSource")
+	defer span.End()
+
+	return s.incomeSourceRepo.GetByID(ctx, id)
 }
 
-func (s *IncomeService) CreateIncomeSource(source *models.IncomeSource) error {
+func (s *IncomeService) CreateIncomeSource(ctx context.Context, source *models.IncomeSource) error {
+	ctx, span := tracer.Start(ctx, "IncomeService.CreateIncomeSource")
+	defer span.End()
+
+	if err := normalizeIncomeSource(source); err != nil {
+		return err
+	}
+	if err := validateItemCategory(ctx, s.categoryRepo, source.UserID, source.CategoryID); err != nil {
+		return err
+	}
+
 	source.ID = uuid.New()
 	source.CreatedAt = time.Now()
 	source.UpdatedAt = time.Now()
 
-	return s.incomeSourceRepo.Create(source)
+	if err := s.incomeSourceRepo.Create(ctx, source); err != nil {
+		return err
+	}
+	publishEvent(ctx, s.publisher, source.UserID, entityEvent(EntityIncomeSource, EntityCreated), source)
+	return nil
 }
 
-func (s *IncomeService) UpdateIncomeSource(source *models.IncomeSource) error {
+func (s *IncomeService) UpdateIncomeSource(ctx context.Context, source *models.IncomeSource) error {
+	ctx, span := tracer.Start(ctx, "IncomeService.UpdateIncomeSource")
+	defer span.End()
+
+	if err := normalizeIncomeSource(source); err != nil {
+		return err
+	}
+	if source.CategoryID != nil {
+		existing, err := s.incomeSourceRepo.GetByID(ctx, source.ID)
+		if err != nil {
+			return err
+		}
+		if err := validateItemCategory(ctx, s.categoryRepo, existing.UserID, source.CategoryID); err != nil {
+			return err
+		}
+	}
+
 	source.UpdatedAt = time.Now()
-	return s.incomeSourceRepo.Update(source)
+	if err := s.incomeSourceRepo.Update(ctx, source); err != nil {
+		return err
+	}
+	if stored := s.storedForEvent(ctx, source.ID); stored != nil {
+		publishEvent(ctx, s.publisher, stored.UserID, entityEvent(EntityIncomeSource, EntityUpdated), stored)
+	}
+	return nil
 }
 
-func (s *IncomeService) DeleteIncomeSource(id uuid.UUID) error {
-	return s.incomeSourceRepo.Delete(id)
+func (s *IncomeService) DeleteIncomeSource(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "IncomeService.DeleteIncomeSource")
+	defer span.End()
+
+	deleted := s.storedForEvent(ctx, id)
+	if err := s.incomeSourceRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if deleted != nil {
+		publishEvent(ctx, s.publisher, deleted.UserID, entityEvent(EntityIncomeSource, EntityDeleted), deleted)
+	}
+	return nil
+}
+
+// storedForEvent returns the stored income source an event is about, nil without a publisher
+func (s *IncomeService) storedForEvent(ctx context.Context, id uuid.UUID) *models.IncomeSource {
+	if s.publisher == nil {
+		return nil
+	}
+	source, err := s.incomeSourceRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return source
 }
 
 // Monthly Income Record methods
-func (s *IncomeService) GetMonthlyIncomeRecords(incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error) {
-	return s.monthlyIncomeRepo.GetByIncomeSourceID(incomeSourceID)
+func (s *IncomeService) GetMonthlyIncomeRecords(ctx context.Context, incomeSourceID uuid.UUID) ([]models.MonthlyIncomeRecord, error) {
+	ctx, span := tracer.Start(ctx, "IncomeService.GetMonthlyIncomeRecords")
+	defer span.End()
+
+	return s.monthlyIncomeRepo.GetByIncomeSourceID(ctx, incomeSourceID)
 }
 
-func (s *IncomeService) GetMonthlyIncomeRecord(id uuid.UUID) (*models.MonthlyIncomeRecord, error) {
-	return s.monthlyIncomeRepo.GetByID(id)
+func (s *IncomeService) GetMonthlyIncomeRecord(ctx context.Context, id uuid.UUID) (*models.MonthlyIncomeRecord, error) {
+	ctx, span := tracer.Start(ctx, "IncomeService.GetMonthlyIncomeRecord")
+	defer span.End()
+
+	return s.monthlyIncomeRepo.GetByID(ctx, id)
 }
 
-func (s *IncomeService) CreateMonthlyIncomeRecord(record *models.MonthlyIncomeRecord) error {
+func (s *IncomeService) CreateMonthlyIncomeRecord(ctx context.Context, record *models.MonthlyIncomeRecord) error {
+	ctx, span := tracer.Start(ctx, "IncomeService.CreateMonthlyIncomeRecord")
+	defer span.End()
+
 	record.ID = uuid.New()
 	record.CreatedAt = time.Now()
 	record.UpdatedAt = time.Now()
 
-	return s.monthlyIncomeRepo.Create(record)
+	return s.monthlyIncomeRepo.Create(ctx, record)
 }
 
-func (s *IncomeService) UpdateMonthlyIncomeRecord(record *models.MonthlyIncomeRecord) error {
+func (s *IncomeService) UpdateMonthlyIncomeRecord(ctx context.Context, record *models.MonthlyIncomeRecord) error {
+	ctx, span := tracer.Start(ctx, "IncomeService.UpdateMonthlyIncomeRecord")
+	defer span.End()
+
 	record.UpdatedAt = time.Now()
-	return s.monthlyIncomeRepo.Update(record)
+	return s.monthlyIncomeRepo.Update(ctx, record)
 }
 
-func (s *IncomeService) DeleteMonthlyIncomeRecord(id uuid.UUID) error {
-	return s.monthlyIncomeRepo.Delete(id)
+func (s *IncomeService) DeleteMonthlyIncomeRecord(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "IncomeService.DeleteMonthlyIncomeRecord")
+	defer span.End()
+
+	return s.monthlyIncomeRepo.Delete(ctx, id)
 }
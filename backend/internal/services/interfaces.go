@@ -1,55 +1,62 @@
 package services
 
 import (
+	"context"
+	"time"
+
 	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/webhook"
 
 	"github.com/google/uuid"
 )
 
 // BankAccountRepositoryInterface defines the interface for bank account repository
 type BankAccountRepositoryInterface interface {
-	GetAll(userID uuid.UUID) ([]models.BankAccount, error)
-	GetByID(id uuid.UUID) (*models.BankAccount, error)
-	Create(account *models.BankAccount) error
-	Update(account *models.BankAccount) error
-	Delete(id uuid.UUID) error
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.BankAccount, error)
+	Create(ctx context.Context, account *models.BankAccount) error
+	Update(ctx context.Context, account *models.BankAccount) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // CreditCardRepositoryInterface defines the interface for credit card repository
 type CreditCardRepositoryInterface interface {
-	GetAll(userID uuid.UUID) ([]models.CreditCard, error)
-	GetByID(id uuid.UUID) (*models.CreditCard, error)
-	Create(creditCard *models.CreditCard) error
-	Update(creditCard *models.CreditCard) error
-	Delete(id uuid.UUID) error
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.CreditCard, error)
+	Create(ctx context.Context, creditCard *models.CreditCard) error
+	Update(ctx context.Context, creditCard *models.CreditCard) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // RecurringPaymentRepositoryInterface defines the interface for recurring payment repository
 type RecurringPaymentRepositoryInterface interface {
-	GetAll(userID uuid.UUID) ([]models.RecurringPayment, error)
-	GetByID(id uuid.UUID) (*models.RecurringPayment, error)
-	Create(payment *models.RecurringPayment) error
-	Update(payment *models.RecurringPayment) error
-	Delete(id uuid.UUID) error
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error)
+	GetAllActive(ctx context.Context) ([]models.RecurringPayment, error)
+	Create(ctx context.Context, payment *models.RecurringPayment) error
+	Update(ctx context.Context, payment *models.RecurringPayment) error
+	UpdateProgress(ctx context.Context, payment *models.RecurringPayment) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // UserRepositoryInterface defines the interface for user repository
 type UserRepositoryInterface interface {
-	GetByID(id uuid.UUID) (*models.User, error)
-	GetByEmail(email string) (*models.User, error)
-	GetByGoogleID(googleID string) (*models.User, error)
-	Create(user *models.User) error
-	Update(user *models.User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetByGoogleID(ctx context.Context, googleID string) (*models.User, error)
+	Create(ctx context.Context, user *models.User) error
+	Update(ctx context.Context, user *models.User) error
+	GetAllIDs(ctx context.Context) ([]uuid.UUID, error)
 }
 
 // IncomeSourceRepositoryInterface defines the interface for income source repository
 type IncomeSourceRepositoryInterface interface {
-	GetAll(userID uuid.UUID) ([]models.IncomeSource, error)
-	GetByID(id uuid.UUID) (*models.IncomeSource, error)
-	GetActiveByUserID(userID uuid.UUID) ([]models.IncomeSource, error)
-	Create(incomeSource *models.IncomeSource) error
-	Update(incomeSource *models.IncomeSource) error
-	Delete(id uuid.UUID) error
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error)
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error)
+	Create(ctx context.Context, incomeSource *models.IncomeSource) error
+	Update(ctx context.Context, incomeSource *models.IncomeSource) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // MonthlyIncomeRepositoryInterface defines the interface for monthly income repository
@@ -60,17 +67,155 @@ type MonthlyIncomeRepositoryInterface interface {
 
 // AppSettingRepositoryInterface defines the interface for app setting repository
 type AppSettingRepositoryInterface interface {
-	GetByUserID(userID uuid.UUID) ([]models.AppSetting, error)
-	GetByKey(userID uuid.UUID, key string) (*models.AppSetting, error)
-	Upsert(setting *models.AppSetting) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.AppSetting, error)
+	GetByKey(ctx context.Context, userID uuid.UUID, key string) (*models.AppSetting, error)
+	Upsert(ctx context.Context, setting *models.AppSetting) error
+	UpsertAll(ctx context.Context, settings []models.AppSetting) error
 }
 
 // CardMonthlyTotalRepositoryInterface defines the interface for card monthly total repository
 type CardMonthlyTotalRepositoryInterface interface {
-	GetByCreditCardID(creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error)
-	GetByYearMonth(yearMonth string) ([]models.CardMonthlyTotal, error)
-	GetByID(id uuid.UUID) (*models.CardMonthlyTotal, error)
-	Create(total *models.CardMonthlyTotal) error
-	Update(total *models.CardMonthlyTotal) error
-	Delete(id uuid.UUID) error
+	GetByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error)
+	GetByYearMonth(ctx context.Context, yearMonth string) ([]models.CardMonthlyTotal, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.CardMonthlyTotal, error)
+	Create(ctx context.Context, total *models.CardMonthlyTotal) error
+	Update(ctx context.Context, total *models.CardMonthlyTotal) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// RecurringTransferRepositoryInterface defines the interface for recurring transfer repository
+type RecurringTransferRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.RecurringTransfer, error)
+	Create(ctx context.Context, transfer *models.RecurringTransfer) error
+	Update(ctx context.Context, transfer *models.RecurringTransfer) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// SavingsGoalRepositoryInterface defines the interface for savings goal repository
+type SavingsGoalRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error)
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.SavingsGoal, error)
+	Create(ctx context.Context, goal *models.SavingsGoal) error
+	Update(ctx context.Context, goal *models.SavingsGoal) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// CashflowProjectorInterface defines the interface for the cashflow projection
+type CashflowProjectorInterface interface {
+	GetCashflowProjection(ctx context.Context, userID uuid.UUID, months int, onlyChanges bool) ([]models.CashflowProjection, error)
+}
+
+// CategoryRepositoryInterface defines the interface for category repository
+type CategoryRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.Category, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Category, error)
+	Create(ctx context.Context, category *models.Category) error
+	Update(ctx context.Context, category *models.Category) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// BudgetRepositoryInterface defines the interface for budget repository
+type BudgetRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.Budget, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Budget, error)
+	Create(ctx context.Context, budget *models.Budget) error
+	Update(ctx context.Context, budget *models.Budget) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// TransactionRepositoryInterface defines the interface for transaction repository
+type TransactionRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.Transaction, error)
+	GetByYearMonth(ctx context.Context, userID uuid.UUID, yearMonth string) ([]models.Transaction, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Transaction, error)
+	Create(ctx context.Context, transaction *models.Transaction) error
+	Update(ctx context.Context, transaction *models.Transaction) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ExchangeRateRepositoryInterface defines the interface for exchange rate repository
+type ExchangeRateRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error)
+	Create(ctx context.Context, rate *models.ExchangeRate) error
+	Update(ctx context.Context, rate *models.ExchangeRate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	UpsertAll(ctx context.Context, rates []models.ExchangeRate) error
+}
+
+// AssetRepositoryInterface defines the interface for asset repository
+type AssetRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.Asset, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Asset, error)
+	Create(ctx context.Context, asset *models.Asset) error
+	Update(ctx context.Context, asset *models.Asset) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetValuations(ctx context.Context, assetID uuid.UUID) ([]models.AssetValuation, error)
+	UpsertValuations(ctx context.Context, valuations []models.AssetValuation) error
+	DeleteValuation(ctx context.Context, assetID, id uuid.UUID) error
+}
+
+// NetWorthSnapshotRepositoryInterface defines the interface for net worth snapshot repository
+type NetWorthSnapshotRepositoryInterface interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID, from string) ([]models.NetWorthSnapshot, error)
+	Upsert(ctx context.Context, snapshot *models.NetWorthSnapshot) error
+}
+
+// CalendarFeedRepositoryInterface defines the interface for calendar feed repository
+type CalendarFeedRepositoryInterface interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*models.CalendarFeed, error)
+	GetByToken(ctx context.Context, token string) (*models.CalendarFeed, error)
+	Upsert(ctx context.Context, feed *models.CalendarFeed) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+// MonthlyReportRepositoryInterface defines the interface for monthly report repository
+type MonthlyReportRepositoryInterface interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error)
+	GetByUserIDAndYearMonth(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error)
+	Upsert(ctx context.Context, report *models.StoredMonthlyReport) error
+}
+
+// NotificationLogRepositoryInterface defines the interface for notification log repository
+type NotificationLogRepositoryInterface interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]models.NotificationLog, error)
+	WasSent(ctx context.Context, userID uuid.UUID, channel, dedupeKey string) (bool, error)
+	Upsert(ctx context.Context, log *models.NotificationLog) error
+}
+
+// WebhookRepositoryInterface defines the interface for webhook repository
+type WebhookRepositoryInterface interface {
+	GetAll(ctx context.Context, userID uuid.UUID) ([]models.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Webhook, error)
+	Create(ctx context.Context, webhook *models.Webhook) error
+	Update(ctx context.Context, webhook *models.Webhook) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WebhookDeliveryRepositoryInterface defines the interface for webhook delivery repository
+type WebhookDeliveryRepositoryInterface interface {
+	GetByWebhookID(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error)
+	GetDue(ctx context.Context, now time.Time, limit int) ([]models.WebhookDelivery, error)
+	Create(ctx context.Context, delivery *models.WebhookDelivery) error
+	Update(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// WebhookSenderInterface defines the interface for posting webhook deliveries
+type WebhookSenderInterface interface {
+	Send(ctx context.Context, request webhook.Request) (int, error)
+}
+
+// HostGuardInterface defines the interface for checking the hosts of user-configured URLs.
+// CheckHost returns netguard.ErrBlocked for hosts on the server's own network and
+// netguard.ErrUnresolvable for unknown hosts.
+type HostGuardInterface interface {
+	CheckHost(ctx context.Context, host string) error
+}
+
+// EventPublisherInterface defines the interface for publishing events of a user's data.
+// Publish returns how many deliveries the event was queued for.
+type EventPublisherInterface interface {
+	Publish(ctx context.Context, userID uuid.UUID, eventType string, data any) (int, error)
 }
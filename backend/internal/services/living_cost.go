@@ -0,0 +1,220 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Setting keys of the living-cost model. Living costs are the day-to-day spending that is not
+// registered as a recurring payment or card statement, such as groceries paid in cash.
+const (
+	LivingCostAmountSettingKey        = "living_cost_amount"
+	LivingCostSourceSettingKey        = "living_cost_source"
+	LivingCostModeSettingKey          = "living_cost_mode"
+	LivingCostStartOffsetSettingKey   = "living_cost_start_offset"
+	LivingCostBookingSettingKey       = "living_cost_booking"
+	LivingCostInflationRateSettingKey = "living_cost_inflation_rate"
+	LivingCostOverridesSettingKey     = "living_cost_overrides"
+
+	// LivingCostSourceSetting uses LivingCostAmountSettingKey, LivingCostSourceBudgets the sum of the month's category budgets
+	LivingCostSourceSetting = "setting"
+	LivingCostSourceBudgets = "budgets"
+
+	// LivingCostModeShortfall books only the part not covered by the month's recurring and card payments,
+	// LivingCostModeAdditional books the whole amount on top of them
+	LivingCostModeShortfall  = "shortfall"
+	LivingCostModeAdditional = "additional"
+
+	// LivingCostBookingSpread spreads the amount evenly over every day of the month; "day:N" books it on day N
+	LivingCostBookingSpread    = "spread"
+	livingCostBookingDayPrefix = "day:"
+
+	defaultLivingCostStartOffset = 2
+	defaultLivingCostBookingDay  = 26
+	maxLivingCostStartOffset     = 120
+	maxLivingCostInflationRate   = 100.0
+)
+
+// livingCostSettingKeys lists every setting of the living-cost model
+var livingCostSettingKeys = []string{
+	LivingCostAmountSettingKey,
+	LivingCostSourceSettingKey,
+	LivingCostModeSettingKey,
+	LivingCostStartOffsetSettingKey,
+	LivingCostBookingSettingKey,
+	LivingCostInflationRateSettingKey,
+	LivingCostOverridesSettingKey,
+}
+
+// LivingCostModel describes how living costs are added to the cashflow projection
+type LivingCostModel struct {
+	Amount        int64            // Monthly amount with the "setting" source
+	Source        string           // "setting" or "budgets"
+	Mode          string           // "shortfall" or "additional"
+	StartOffset   int              // Months after the current month before living costs are booked
+	BookingDay    int              // Day the amount is booked on (clamped to the month end); 0 spreads it over the month
+	InflationRate float64          // Yearly increase in percent, compounded monthly from the current month
+	Overrides     map[string]int64 // Amount of specific months ("2024-01"), used as is
+}
+
+// DefaultLivingCostModel returns the model used for settings that are not set
+func DefaultLivingCostModel() LivingCostModel {
+	return LivingCostModel{
+		Source:      LivingCostSourceSetting,
+		Mode:        LivingCostModeShortfall,
+		StartOffset: defaultLivingCostStartOffset,
+		BookingDay:  defaultLivingCostBookingDay,
+	}
+}
+
+// parseLivingCostModel builds the model from the user's settings. Invalid values keep their
+// default so that a broken setting does not break the projection.
+func parseLivingCostModel(values map[string]string) LivingCostModel {
+	model := DefaultLivingCostModel()
+	for _, key := range livingCostSettingKeys {
+		if value, ok := values[key]; ok {
+			_ = model.apply(key, value)
+		}
+	}
+	return model
+}
+
+// apply parses a setting into the model. The model is left unchanged when the value is invalid.
+func (m *LivingCostModel) apply(key, value string) error {
+	value = strings.TrimSpace(value)
+
+	switch key {
+	case LivingCostAmountSettingKey:
+		amount, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || amount < 0 {
+			return NewValidationError(key, "must be a non-negative integer")
+		}
+		m.Amount = amount
+	case LivingCostSourceSettingKey:
+		if value != LivingCostSourceSetting && value != LivingCostSourceBudgets {
+			return NewValidationError(key, "must be %q or %q", LivingCostSourceSetting, LivingCostSourceBudgets)
+		}
+		m.Source = value
+	case LivingCostModeSettingKey:
+		if value != LivingCostModeShortfall && value != LivingCostModeAdditional {
+			return NewValidationError(key, "must be %q or %q", LivingCostModeShortfall, LivingCostModeAdditional)
+		}
+		m.Mode = value
+	case LivingCostStartOffsetSettingKey:
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 || offset > maxLivingCostStartOffset {
+			return NewValidationError(key, "must be between 0 and %d", maxLivingCostStartOffset)
+		}
+		m.StartOffset = offset
+	case LivingCostBookingSettingKey:
+		day, err := parseLivingCostBooking(value)
+		if err != nil {
+			return err
+		}
+		m.BookingDay = day
+	case LivingCostInflationRateSettingKey:
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(rate) || rate < 0 || rate > maxLivingCostInflationRate {
+			return NewValidationError(key, "must be between 0 and %g", maxLivingCostInflationRate)
+		}
+		m.InflationRate = rate
+	case LivingCostOverridesSettingKey:
+		overrides, err := parseLivingCostOverrides(value)
+		if err != nil {
+			return err
+		}
+		m.Overrides = overrides
+	}
+	return nil
+}
+
+// parseLivingCostBooking parses "spread" or "day:N" into the booking day, 0 meaning spread
+func parseLivingCostBooking(value string) (int, error) {
+	if value == LivingCostBookingSpread {
+		return 0, nil
+	}
+
+	dayStr, found := strings.CutPrefix(value, livingCostBookingDayPrefix)
+	day, err := strconv.Atoi(dayStr)
+	if !found || err != nil || day < 1 || day > 31 {
+		return 0, NewValidationError(LivingCostBookingSettingKey, "must be %q or \"day:N\" with N between 1 and 31", LivingCostBookingSpread)
+	}
+	return day, nil
+}
+
+// parseLivingCostOverrides parses a comma separated list of month amounts such as "2024-08:400000,2024-12:0"
+func parseLivingCostOverrides(value string) (map[string]int64, error) {
+	overrides := make(map[string]int64)
+	if value == "" {
+		return overrides, nil
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		yearMonth, amountStr, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			return nil, NewValidationError(LivingCostOverridesSettingKey, "%q must be in YYYY-MM:amount format", part)
+		}
+		if _, err := time.Parse("2006-01", yearMonth); err != nil {
+			return nil, NewValidationError(LivingCostOverridesSettingKey, "%q must be in YYYY-MM:amount format", part)
+		}
+		amount, err := strconv.ParseInt(amountStr, 10, 64)
+		if err != nil || amount < 0 {
+			return nil, NewValidationError(LivingCostOverridesSettingKey, "amount of %s must be a non-negative integer", yearMonth)
+		}
+		if _, duplicate := overrides[yearMonth]; duplicate {
+			return nil, NewValidationError(LivingCostOverridesSettingKey, "%s is given more than once", yearMonth)
+		}
+		overrides[yearMonth] = amount
+	}
+	return overrides, nil
+}
+
+// monthlyAmount returns the living costs of the month monthOffset months after the current month.
+// base is the amount before inflation: the setting or the month's budgets. An override replaces
+// the amount even before the start offset.
+func (m LivingCostModel) monthlyAmount(yearMonth string, monthOffset int, base int64) int64 {
+	if amount, ok := m.Overrides[yearMonth]; ok {
+		return amount
+	}
+	if monthOffset < m.StartOffset || base <= 0 {
+		return 0
+	}
+	if m.InflationRate == 0 {
+		return base
+	}
+
+	factor := math.Pow(1+m.InflationRate/100, float64(monthOffset)/12)
+	return int64(math.Round(float64(base) * factor))
+}
+
+// bookings splits the living costs of a month into amounts per day of the month. In shortfall
+// mode only the part not covered by scheduledExpense, the month's recurring and card payments,
+// is booked. A spread amount puts the remainder of the division on the first days.
+func (m LivingCostModel) bookings(amount, scheduledExpense int64, daysInMonth int) map[int]int64 {
+	if m.Mode == LivingCostModeShortfall {
+		amount -= scheduledExpense
+	}
+	if amount <= 0 {
+		return nil
+	}
+
+	if m.BookingDay > 0 {
+		return map[int]int64{min(m.BookingDay, daysInMonth): amount}
+	}
+
+	perDay := amount / int64(daysInMonth)
+	remainder := amount % int64(daysInMonth)
+	bookings := make(map[int]int64, daysInMonth)
+	for day := 1; day <= daysInMonth; day++ {
+		share := perDay
+		if int64(day) <= remainder {
+			share++
+		}
+		if share > 0 {
+			bookings[day] = share
+		}
+	}
+	return bookings
+}
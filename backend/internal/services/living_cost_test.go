@@ -0,0 +1,71 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLivingCostModel(t *testing.T) {
+	model := parseLivingCostModel(map[string]string{
+		LivingCostAmountSettingKey:        "150000",
+		LivingCostModeSettingKey:          LivingCostModeAdditional,
+		LivingCostBookingSettingKey:       "spread",
+		LivingCostInflationRateSettingKey: "3",
+		LivingCostOverridesSettingKey:     "2024-08:400000",
+		LivingCostStartOffsetSettingKey:   "never",
+	})
+
+	assert.Equal(t, int64(150000), model.Amount)
+	assert.Equal(t, LivingCostSourceSetting, model.Source)
+	assert.Equal(t, LivingCostModeAdditional, model.Mode)
+	assert.Equal(t, 0, model.BookingDay)
+	assert.Equal(t, 3.0, model.InflationRate)
+	assert.Equal(t, map[string]int64{"2024-08": 400000}, model.Overrides)
+	// Invalid values keep the default
+	assert.Equal(t, defaultLivingCostStartOffset, model.StartOffset)
+}
+
+func TestLivingCostModel_monthlyAmount(t *testing.T) {
+	model := DefaultLivingCostModel()
+	model.Overrides = map[string]int64{"2024-01": 50000, "2024-06": 0}
+
+	assert.Equal(t, int64(0), model.monthlyAmount("2023-12", 1, 100000), "before the start offset")
+	assert.Equal(t, int64(50000), model.monthlyAmount("2024-01", 1, 100000), "override before the start offset")
+	assert.Equal(t, int64(100000), model.monthlyAmount("2024-02", 2, 100000))
+	assert.Equal(t, int64(0), model.monthlyAmount("2024-06", 6, 100000), "override to zero")
+	assert.Equal(t, int64(0), model.monthlyAmount("2024-07", 7, 0), "no base amount")
+
+	model.InflationRate = 10
+	assert.Equal(t, int64(110000), model.monthlyAmount("2024-12", 12, 100000))
+	assert.Equal(t, int64(104881), model.monthlyAmount("2024-05", 6, 100000), "compounded monthly")
+	assert.Equal(t, int64(50000), model.monthlyAmount("2024-01", 1, 100000), "overrides are not inflated")
+}
+
+func TestLivingCostModel_bookings(t *testing.T) {
+	model := DefaultLivingCostModel()
+
+	assert.Equal(t, map[int]int64{26: 30000}, model.bookings(100000, 70000, 31), "shortfall")
+	assert.Nil(t, model.bookings(100000, 120000, 31), "covered by the scheduled expense")
+
+	model.BookingDay = 31
+	assert.Equal(t, map[int]int64{28: 30000}, model.bookings(100000, 70000, 28), "clamped to the month end")
+
+	model.Mode = LivingCostModeAdditional
+	assert.Equal(t, map[int]int64{30: 100000}, model.bookings(100000, 70000, 30), "additional")
+
+	model.BookingDay = 0
+	bookings := model.bookings(100, 0, 30)
+	assert.Len(t, bookings, 30)
+	assert.Equal(t, int64(4), bookings[1])
+	assert.Equal(t, int64(4), bookings[10])
+	assert.Equal(t, int64(3), bookings[11])
+
+	total := int64(0)
+	for _, amount := range bookings {
+		total += amount
+	}
+	assert.Equal(t, int64(100), total)
+
+	assert.Len(t, model.bookings(10, 0, 30), 10, "days without a share are left out")
+}
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/loan"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+// scheduledPrepayment is a simulated lump-sum loan repayment due on a projection date
+type scheduledPrepayment struct {
+	payment models.RecurringPayment
+	amount  int64
+}
+
+// isLoan reports whether a recurring payment is an amortized loan
+func isLoan(payment models.RecurringPayment) bool {
+	return payment.LoanPrincipal != nil
+}
+
+// recurringPaymentLoan returns the loan terms of a payment. The term is TotalPayments.
+func recurringPaymentLoan(payment models.RecurringPayment) loan.Loan {
+	l := loan.Loan{Method: loan.EqualPayment}
+	if payment.LoanPrincipal != nil {
+		l.Principal = *payment.LoanPrincipal
+	}
+	if payment.LoanAnnualRate != nil {
+		l.AnnualRate = *payment.LoanAnnualRate
+	}
+	if payment.TotalPayments != nil {
+		l.Term = *payment.TotalPayments
+	}
+	if payment.LoanRepaymentMethod != nil {
+		l.Method = loan.Method(*payment.LoanRepaymentMethod)
+	}
+	return l
+}
+
+// loanInstallmentDates returns the due date of every instalment of a loan
+func loanInstallmentDates(payment models.RecurringPayment, term int) ([]time.Time, error) {
+	rule, dtstart, err := recurringPaymentRule(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := rule.Between(dtstart, dtstart, dtstart.AddDate(0, term*rule.Interval, 0))
+	if len(dates) > term {
+		dates = dates[:term]
+	}
+	return dates, nil
+}
+
+// loanPrepayments converts the prepayment for a loan into an instalment based prepayment.
+// A prepayment is applied right after the last instalment due on or before its date, and
+// only one prepayment per loan can be simulated at a time.
+func loanPrepayments(payment models.RecurringPayment, dates []time.Time, prepayments []models.LoanPrepayment) ([]loan.Prepayment, error) {
+	converted := make([]loan.Prepayment, 0)
+	for _, prepayment := range prepayments {
+		if prepayment.RecurringPaymentID != payment.ID {
+			continue
+		}
+		if len(converted) > 0 {
+			return nil, NewValidationError("prepayment", "only one prepayment per loan can be simulated")
+		}
+
+		date, err := time.Parse("2006-01-02", prepayment.Date)
+		if err != nil {
+			return nil, NewValidationError("prepayment_date", "must be in YYYY-MM-DD format")
+		}
+		if prepayment.Amount <= 0 {
+			return nil, NewValidationError("prepayment_amount", "must be positive")
+		}
+		mode, err := loan.ParsePrepaymentMode(prepayment.Mode)
+		if err != nil {
+			return nil, NewValidationError("prepayment_mode", "%s", err.Error())
+		}
+
+		after := 0
+		for _, due := range dates {
+			if due.After(date) {
+				break
+			}
+			after++
+		}
+		if after == 0 || after >= len(dates) {
+			return nil, NewValidationError("prepayment_date", "must be between the first and the last instalment")
+		}
+
+		converted = append(converted, loan.Prepayment{AfterInstallment: after, Amount: prepayment.Amount, Mode: mode})
+	}
+	return converted, nil
+}
+
+// loanAmortization returns the dated amortization schedule of a loan with the given prepayments.
+// The remaining principal and payments are as of today and do not include simulated prepayments.
+func loanAmortization(payment models.RecurringPayment, prepayments []models.LoanPrepayment, today time.Time) (*models.AmortizationSchedule, error) {
+	l := recurringPaymentLoan(payment)
+	if err := l.Validate(); err != nil {
+		return nil, NewValidationError("loan", "%s", err.Error())
+	}
+
+	dates, err := loanInstallmentDates(payment, l.Term)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err := loanPrepayments(payment, dates, prepayments)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := l.Schedule(nil)
+	if err != nil {
+		return nil, err
+	}
+	installments := base
+	if len(converted) > 0 {
+		if installments, err = l.Schedule(converted); err != nil {
+			return nil, NewValidationError("prepayment", "%s", err.Error())
+		}
+	}
+
+	schedule := &models.AmortizationSchedule{
+		RecurringPaymentID: payment.ID,
+		Principal:          l.Principal,
+		AnnualRate:         l.AnnualRate,
+		RepaymentMethod:    string(l.Method),
+		TotalPayments:      len(installments),
+		TotalInterest:      loan.TotalInterest(installments),
+		InterestSaved:      loan.TotalInterest(base) - loan.TotalInterest(installments),
+		Entries:            make([]models.AmortizationEntry, len(installments)),
+	}
+	for i, installment := range installments {
+		schedule.Entries[i] = models.AmortizationEntry{
+			Number:             installment.Number,
+			Payment:            installment.Payment,
+			Principal:          installment.Principal,
+			Interest:           installment.Interest,
+			Prepayment:         installment.Prepayment,
+			RemainingPrincipal: installment.RemainingPrincipal,
+		}
+		if i < len(dates) {
+			schedule.Entries[i].Date = dates[i].Format("2006-01-02")
+		}
+	}
+
+	schedule.RemainingPrincipal, schedule.RemainingPayments = loanRemaining(l.Principal, base, dates, today)
+	return schedule, nil
+}
+
+// loanRemaining returns the principal and number of instalments left after the instalments due by today
+func loanRemaining(principal int64, installments []loan.Installment, dates []time.Time, today time.Time) (int64, int) {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+	remaining := principal
+	paid := 0
+	for i, installment := range installments {
+		if i >= len(dates) || dates[i].After(today) {
+			break
+		}
+		remaining = installment.RemainingPrincipal
+		paid++
+	}
+	return remaining, len(installments) - paid
+}
+
+// loanPaymentSchedule maps the due dates of a loan within the range to the instalment amount, and
+// the dates of prepayments to their amount. A prepayment is due on its own date.
+func loanPaymentSchedule(payment models.RecurringPayment, prepayments []models.LoanPrepayment, from, to time.Time) (map[string]int64, map[string]int64, error) {
+	schedule, err := loanAmortization(payment, prepayments, from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fromKey, toKey := from.Format("2006-01-02"), to.Format("2006-01-02")
+	installments := make(map[string]int64)
+	for _, entry := range schedule.Entries {
+		if entry.Date >= fromKey && entry.Date <= toKey {
+			installments[entry.Date] += entry.Payment
+		}
+	}
+
+	// The prepayment is capped at the outstanding balance by the amortization schedule
+	prepaid := make(map[string]int64)
+	for _, prepayment := range prepayments {
+		if prepayment.RecurringPaymentID != payment.ID || prepayment.Date < fromKey || prepayment.Date > toKey {
+			continue
+		}
+		for _, entry := range schedule.Entries {
+			if entry.Prepayment > 0 {
+				prepaid[prepayment.Date] = entry.Prepayment
+			}
+		}
+	}
+
+	return installments, prepaid, nil
+}
+
+// loanInstallmentInMonth returns the instalment of a loan due in the given month
+func loanInstallmentInMonth(payment models.RecurringPayment, year int, month time.Month) (int64, bool) {
+	monthStart := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	installments, _, err := loanPaymentSchedule(payment, nil, monthStart, monthStart.AddDate(0, 1, -1))
+	if err != nil || len(installments) == 0 {
+		return 0, false
+	}
+
+	total := int64(0)
+	for _, amount := range installments {
+		total += amount
+	}
+	return total, true
+}
+
+// withRemainingPrincipal fills in the remaining principal of a loan as of today
+func withRemainingPrincipal(payment *models.RecurringPayment, today time.Time) {
+	if !isLoan(*payment) {
+		return
+	}
+	schedule, err := loanAmortization(*payment, nil, today)
+	if err != nil {
+		return
+	}
+	payment.RemainingPrincipal = &schedule.RemainingPrincipal
+}
+
+// normalizeLoan validates the loan terms of a payment and sets its amount to the first instalment.
+// Loans are repaid monthly, so the recurrence rule must be a plain monthly rule.
+func normalizeLoan(payment *models.RecurringPayment) error {
+	if payment.LoanPrincipal == nil {
+		if payment.LoanAnnualRate != nil || payment.LoanRepaymentMethod != nil {
+			return NewValidationError("loan_principal", "is required for a loan")
+		}
+		return nil
+	}
+
+	if payment.TotalPayments == nil || *payment.TotalPayments <= 0 {
+		return NewValidationError("total_payments", "is required for a loan")
+	}
+	if payment.LoanRepaymentMethod == nil || *payment.LoanRepaymentMethod == "" {
+		method := string(loan.EqualPayment)
+		payment.LoanRepaymentMethod = &method
+	}
+	if _, err := loan.ParseMethod(*payment.LoanRepaymentMethod); err != nil {
+		return NewValidationError("loan_repayment_method", "must be equal_payment or equal_principal")
+	}
+	if payment.RecurrenceRule != nil {
+		rule, err := recurrence.Parse(*payment.RecurrenceRule)
+		if err != nil || rule.Freq != recurrence.Monthly || rule.Interval != 1 || len(rule.ByMonth) > 0 {
+			return NewValidationError("recurrence_rule", "a loan must be repaid monthly")
+		}
+	}
+
+	l := recurringPaymentLoan(*payment)
+	if err := l.Validate(); err != nil {
+		return NewValidationError("loan", "%s", err.Error())
+	}
+	installments, err := l.Schedule(nil)
+	if err != nil {
+		return NewValidationError("loan", "%s", err.Error())
+	}
+	payment.Amount = installments[0].Payment
+	return nil
+}
+
+// findRecurringPayment returns the payment with the given ID
+func findRecurringPayment(payments []models.RecurringPayment, id uuid.UUID) *models.RecurringPayment {
+	for i := range payments {
+		if payments[i].ID == id {
+			return &payments[i]
+		}
+	}
+	return nil
+}
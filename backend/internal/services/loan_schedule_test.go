@@ -0,0 +1,166 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLoanPayment(method string) models.RecurringPayment {
+	principal := int64(1200000)
+	rate := 12.0
+	term := 12
+	rule := "FREQ=MONTHLY;BYMONTHDAY=27;COUNT=12"
+	return models.RecurringPayment{
+		ID:                  uuid.New(),
+		Name:                "Car Loan",
+		PaymentDay:          27,
+		StartYearMonth:      "2025-01",
+		RecurrenceRule:      &rule,
+		TotalPayments:       &term,
+		IsActive:            true,
+		LoanPrincipal:       &principal,
+		LoanAnnualRate:      &rate,
+		LoanRepaymentMethod: &method,
+	}
+}
+
+func TestNormalizeLoan(t *testing.T) {
+	t.Run("equal payment sets the instalment amount", func(t *testing.T) {
+		payment := testLoanPayment("equal_payment")
+		require.NoError(t, normalizeRecurringPayment(&payment))
+		assert.Equal(t, int64(106619), payment.Amount)
+	})
+
+	t.Run("equal principal sets the first instalment amount", func(t *testing.T) {
+		payment := testLoanPayment("equal_principal")
+		require.NoError(t, normalizeRecurringPayment(&payment))
+		assert.Equal(t, int64(112000), payment.Amount)
+	})
+
+	t.Run("method defaults to equal payment", func(t *testing.T) {
+		payment := testLoanPayment("")
+		require.NoError(t, normalizeRecurringPayment(&payment))
+		assert.Equal(t, "equal_payment", *payment.LoanRepaymentMethod)
+	})
+
+	t.Run("not a loan", func(t *testing.T) {
+		payment := models.RecurringPayment{Amount: 5000, PaymentDay: 1, StartYearMonth: "2025-01"}
+		require.NoError(t, normalizeRecurringPayment(&payment))
+		assert.Equal(t, int64(5000), payment.Amount)
+	})
+
+	invalid := []struct {
+		name   string
+		modify func(*models.RecurringPayment)
+		field  string
+	}{
+		{name: "rate without principal", modify: func(p *models.RecurringPayment) { p.LoanPrincipal = nil }, field: "loan_principal"},
+		{name: "no term", modify: func(p *models.RecurringPayment) { p.TotalPayments = nil; p.RecurrenceRule = nil }, field: "total_payments"},
+		{name: "unknown method", modify: func(p *models.RecurringPayment) { m := "balloon"; p.LoanRepaymentMethod = &m }, field: "loan_repayment_method"},
+		{name: "not monthly", modify: func(p *models.RecurringPayment) { r := "FREQ=YEARLY;COUNT=12"; p.RecurrenceRule = &r }, field: "recurrence_rule"},
+		{name: "negative principal", modify: func(p *models.RecurringPayment) { v := int64(-1); p.LoanPrincipal = &v }, field: "loan"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			payment := testLoanPayment("equal_payment")
+			tt.modify(&payment)
+
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, normalizeRecurringPayment(&payment), &validationErr) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestLoanAmortization(t *testing.T) {
+	payment := testLoanPayment("equal_principal")
+
+	schedule, err := loanAmortization(payment, nil, time.Date(2025, 3, 27, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	require.Len(t, schedule.Entries, 12)
+	assert.Equal(t, "2025-01-27", schedule.Entries[0].Date)
+	assert.Equal(t, "2025-12-27", schedule.Entries[11].Date)
+	assert.Equal(t, int64(78000), schedule.TotalInterest)
+	assert.Equal(t, int64(900000), schedule.RemainingPrincipal)
+	assert.Equal(t, 9, schedule.RemainingPayments)
+	assert.Equal(t, int64(0), schedule.InterestSaved)
+}
+
+func TestLoanAmortization_Prepayment(t *testing.T) {
+	payment := testLoanPayment("equal_payment")
+	today := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	schedule, err := loanAmortization(payment, []models.LoanPrepayment{
+		{RecurringPaymentID: payment.ID, Date: "2025-04-01", Amount: 300000},
+	}, today)
+	require.NoError(t, err)
+
+	assert.Less(t, len(schedule.Entries), 12)
+	assert.Equal(t, int64(300000), schedule.Entries[2].Prepayment)
+	assert.Greater(t, schedule.InterestSaved, int64(0))
+	// Simulated prepayments do not change the current balance
+	assert.Equal(t, int64(1200000), schedule.RemainingPrincipal)
+	assert.Equal(t, 12, schedule.RemainingPayments)
+
+	invalid := []models.LoanPrepayment{
+		{RecurringPaymentID: payment.ID, Date: "2025/04/01", Amount: 300000},
+		{RecurringPaymentID: payment.ID, Date: "2025-01-01", Amount: 300000},
+		{RecurringPaymentID: payment.ID, Date: "2026-01-01", Amount: 300000},
+		{RecurringPaymentID: payment.ID, Date: "2025-04-01", Amount: 0},
+		{RecurringPaymentID: payment.ID, Date: "2025-04-01", Amount: 300000, Mode: "skip"},
+	}
+	for _, prepayment := range invalid {
+		_, err := loanAmortization(payment, []models.LoanPrepayment{prepayment}, today)
+		var validationErr *ValidationError
+		assert.ErrorAs(t, err, &validationErr, prepayment)
+	}
+}
+
+func TestRecurringPaymentSchedule_Loans(t *testing.T) {
+	loanPayment := testLoanPayment("equal_principal")
+	rent := models.RecurringPayment{ID: uuid.New(), Name: "Rent", Amount: 80000, PaymentDay: 27, StartYearMonth: "2025-01", IsActive: true}
+	payments := []models.RecurringPayment{loanPayment, rent}
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	schedule, prepayments, err := recurringPaymentSchedule(payments, nil, from, to)
+	require.NoError(t, err)
+	assert.Empty(t, prepayments)
+	require.Len(t, schedule["2025-03-27"], 2)
+	assert.Equal(t, int64(110000), schedule["2025-03-27"][0].Amount)
+	assert.Equal(t, int64(80000), schedule["2025-03-27"][1].Amount)
+	assert.Equal(t, int64(109000), schedule["2025-04-27"][0].Amount)
+
+	schedule, prepayments, err = recurringPaymentSchedule(payments, []models.LoanPrepayment{
+		{RecurringPaymentID: loanPayment.ID, Date: "2025-04-01", Amount: 600000, Mode: "reduce_payment"},
+	}, from, to)
+	require.NoError(t, err)
+	require.Len(t, prepayments["2025-04-01"], 1)
+	assert.Equal(t, int64(600000), prepayments["2025-04-01"][0].amount)
+	assert.Less(t, schedule["2025-04-27"][0].Amount, int64(109000))
+
+	_, _, err = recurringPaymentSchedule(payments, []models.LoanPrepayment{
+		{RecurringPaymentID: rent.ID, Date: "2025-04-01", Amount: 600000},
+	}, from, to)
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestLoanInstallmentInMonth(t *testing.T) {
+	payment := testLoanPayment("equal_principal")
+
+	amount, ok := loanInstallmentInMonth(payment, 2025, time.January)
+	assert.True(t, ok)
+	assert.Equal(t, int64(112000), amount)
+
+	_, ok = loanInstallmentInMonth(payment, 2026, time.January)
+	assert.False(t, ok)
+}
@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAppSettingRepository は AppSettingRepositoryInterface のモック
+type MockAppSettingRepository struct {
+	mock.Mock
+}
+
+func (m *MockAppSettingRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.AppSetting, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.AppSetting), args.Error(1)
+}
+
+func (m *MockAppSettingRepository) GetByKey(ctx context.Context, userID uuid.UUID, key string) (*models.AppSetting, error) {
+	args := m.Called(ctx, userID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.AppSetting), args.Error(1)
+}
+
+func (m *MockAppSettingRepository) Upsert(ctx context.Context, setting *models.AppSetting) error {
+	args := m.Called(ctx, setting)
+	return args.Error(0)
+}
+
+func (m *MockAppSettingRepository) UpsertAll(ctx context.Context, settings []models.AppSetting) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
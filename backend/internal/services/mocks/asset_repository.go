@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAssetRepository は AssetRepositoryInterface のモック
+type MockAssetRepository struct {
+	mock.Mock
+}
+
+func (m *MockAssetRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Asset, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.Asset), args.Error(1)
+}
+
+func (m *MockAssetRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Asset, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Asset), args.Error(1)
+}
+
+func (m *MockAssetRepository) Create(ctx context.Context, asset *models.Asset) error {
+	args := m.Called(ctx, asset)
+	return args.Error(0)
+}
+
+func (m *MockAssetRepository) Update(ctx context.Context, asset *models.Asset) error {
+	args := m.Called(ctx, asset)
+	return args.Error(0)
+}
+
+func (m *MockAssetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAssetRepository) GetValuations(ctx context.Context, assetID uuid.UUID) ([]models.AssetValuation, error) {
+	args := m.Called(ctx, assetID)
+	return args.Get(0).([]models.AssetValuation), args.Error(1)
+}
+
+func (m *MockAssetRepository) UpsertValuations(ctx context.Context, valuations []models.AssetValuation) error {
+	args := m.Called(ctx, valuations)
+	return args.Error(0)
+}
+
+func (m *MockAssetRepository) DeleteValuation(ctx context.Context, assetID, id uuid.UUID) error {
+	args := m.Called(ctx, assetID, id)
+	return args.Error(0)
+}
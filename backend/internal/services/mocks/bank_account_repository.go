@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
 	"github.com/google/uuid"
@@ -12,30 +13,30 @@ type MockBankAccountRepository struct {
 	mock.Mock
 }
 
-func (m *MockBankAccountRepository) GetAll(userID uuid.UUID) ([]models.BankAccount, error) {
-	args := m.Called(userID)
+func (m *MockBankAccountRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.BankAccount, error) {
+	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.BankAccount), args.Error(1)
 }
 
-func (m *MockBankAccountRepository) GetByID(id uuid.UUID) (*models.BankAccount, error) {
-	args := m.Called(id)
+func (m *MockBankAccountRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.BankAccount, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*models.BankAccount), args.Error(1)
 }
 
-func (m *MockBankAccountRepository) Create(account *models.BankAccount) error {
-	args := m.Called(account)
+func (m *MockBankAccountRepository) Create(ctx context.Context, account *models.BankAccount) error {
+	args := m.Called(ctx, account)
 	return args.Error(0)
 }
 
-func (m *MockBankAccountRepository) Update(account *models.BankAccount) error {
-	args := m.Called(account)
+func (m *MockBankAccountRepository) Update(ctx context.Context, account *models.BankAccount) error {
+	args := m.Called(ctx, account)
 	return args.Error(0)
 }
 
-func (m *MockBankAccountRepository) Delete(id uuid.UUID) error {
-	args := m.Called(id)
+func (m *MockBankAccountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBudgetRepository は BudgetRepositoryInterface のモック
+type MockBudgetRepository struct {
+	mock.Mock
+}
+
+func (m *MockBudgetRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.Budget, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Budget, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) Create(ctx context.Context, budget *models.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) Update(ctx context.Context, budget *models.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
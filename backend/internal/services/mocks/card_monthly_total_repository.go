@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCardMonthlyTotalRepository は CardMonthlyTotalRepositoryInterface のモック
+type MockCardMonthlyTotalRepository struct {
+	mock.Mock
+}
+
+func (m *MockCardMonthlyTotalRepository) GetByCreditCardID(ctx context.Context, creditCardID uuid.UUID) ([]models.CardMonthlyTotal, error) {
+	args := m.Called(ctx, creditCardID)
+	return args.Get(0).([]models.CardMonthlyTotal), args.Error(1)
+}
+
+func (m *MockCardMonthlyTotalRepository) GetByYearMonth(ctx context.Context, yearMonth string) ([]models.CardMonthlyTotal, error) {
+	args := m.Called(ctx, yearMonth)
+	return args.Get(0).([]models.CardMonthlyTotal), args.Error(1)
+}
+
+func (m *MockCardMonthlyTotalRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CardMonthlyTotal, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CardMonthlyTotal), args.Error(1)
+}
+
+func (m *MockCardMonthlyTotalRepository) Create(ctx context.Context, total *models.CardMonthlyTotal) error {
+	args := m.Called(ctx, total)
+	return args.Error(0)
+}
+
+func (m *MockCardMonthlyTotalRepository) Update(ctx context.Context, total *models.CardMonthlyTotal) error {
+	args := m.Called(ctx, total)
+	return args.Error(0)
+}
+
+func (m *MockCardMonthlyTotalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
 	"github.com/google/uuid"
@@ -12,7 +13,7 @@ type MockCashflowService struct {
 	mock.Mock
 }
 
-func (m *MockCashflowService) GetCashflowProjection(userID uuid.UUID, months int, includeConfirmed bool) ([]models.CashflowProjection, error) {
-	args := m.Called(userID, months, includeConfirmed)
+func (m *MockCashflowService) GetCashflowProjection(ctx context.Context, userID uuid.UUID, months int, includeConfirmed bool) ([]models.CashflowProjection, error) {
+	args := m.Called(ctx, userID, months, includeConfirmed)
 	return args.Get(0).([]models.CashflowProjection), args.Error(1)
 }
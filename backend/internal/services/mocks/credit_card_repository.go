@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
 	"github.com/google/uuid"
@@ -12,30 +13,30 @@ type MockCreditCardRepository struct {
 	mock.Mock
 }
 
-func (m *MockCreditCardRepository) GetAll(userID uuid.UUID) ([]models.CreditCard, error) {
-	args := m.Called(userID)
+func (m *MockCreditCardRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.CreditCard, error) {
+	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.CreditCard), args.Error(1)
 }
 
-func (m *MockCreditCardRepository) GetByID(id uuid.UUID) (*models.CreditCard, error) {
-	args := m.Called(id)
+func (m *MockCreditCardRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CreditCard, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*models.CreditCard), args.Error(1)
 }
 
-func (m *MockCreditCardRepository) Create(creditCard *models.CreditCard) error {
-	args := m.Called(creditCard)
+func (m *MockCreditCardRepository) Create(ctx context.Context, creditCard *models.CreditCard) error {
+	args := m.Called(ctx, creditCard)
 	return args.Error(0)
 }
 
-func (m *MockCreditCardRepository) Update(creditCard *models.CreditCard) error {
-	args := m.Called(creditCard)
+func (m *MockCreditCardRepository) Update(ctx context.Context, creditCard *models.CreditCard) error {
+	args := m.Called(ctx, creditCard)
 	return args.Error(0)
 }
 
-func (m *MockCreditCardRepository) Delete(id uuid.UUID) error {
-	args := m.Called(id)
+func (m *MockCreditCardRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
@@ -0,0 +1,17 @@
+package mocks
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEventPublisher は EventPublisherInterface のモック
+type MockEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockEventPublisher) Publish(ctx context.Context, userID uuid.UUID, eventType string, data any) (int, error) {
+	args := m.Called(ctx, userID, eventType, data)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockExchangeRateRepository は ExchangeRateRepositoryInterface のモック
+type MockExchangeRateRepository struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.ExchangeRate, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ExchangeRate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateRepository) Create(ctx context.Context, rate *models.ExchangeRate) error {
+	args := m.Called(ctx, rate)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) Update(ctx context.Context, rate *models.ExchangeRate) error {
+	args := m.Called(ctx, rate)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockExchangeRateRepository) UpsertAll(ctx context.Context, rates []models.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
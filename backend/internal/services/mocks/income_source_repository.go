@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
 	"github.com/google/uuid"
@@ -12,35 +13,35 @@ type MockIncomeSourceRepository struct {
 	mock.Mock
 }
 
-func (m *MockIncomeSourceRepository) GetAll(userID uuid.UUID) ([]models.IncomeSource, error) {
-	args := m.Called(userID)
+func (m *MockIncomeSourceRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error) {
+	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.IncomeSource), args.Error(1)
 }
 
-func (m *MockIncomeSourceRepository) GetByID(id uuid.UUID) (*models.IncomeSource, error) {
-	args := m.Called(id)
+func (m *MockIncomeSourceRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.IncomeSource, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*models.IncomeSource), args.Error(1)
 }
 
-func (m *MockIncomeSourceRepository) GetActiveByUserID(userID uuid.UUID) ([]models.IncomeSource, error) {
-	args := m.Called(userID)
+func (m *MockIncomeSourceRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.IncomeSource, error) {
+	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.IncomeSource), args.Error(1)
 }
 
-func (m *MockIncomeSourceRepository) Create(incomeSource *models.IncomeSource) error {
-	args := m.Called(incomeSource)
+func (m *MockIncomeSourceRepository) Create(ctx context.Context, incomeSource *models.IncomeSource) error {
+	args := m.Called(ctx, incomeSource)
 	return args.Error(0)
 }
 
-func (m *MockIncomeSourceRepository) Update(incomeSource *models.IncomeSource) error {
-	args := m.Called(incomeSource)
+func (m *MockIncomeSourceRepository) Update(ctx context.Context, incomeSource *models.IncomeSource) error {
+	args := m.Called(ctx, incomeSource)
 	return args.Error(0)
 }
 
-func (m *MockIncomeSourceRepository) Delete(id uuid.UUID) error {
-	args := m.Called(id)
+func (m *MockIncomeSourceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
@@ -0,0 +1,32 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMonthlyReportRepository は MonthlyReportRepositoryInterface のモック
+type MockMonthlyReportRepository struct {
+	mock.Mock
+}
+
+func (m *MockMonthlyReportRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.StoredMonthlyReport), args.Error(1)
+}
+
+func (m *MockMonthlyReportRepository) GetByUserIDAndYearMonth(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error) {
+	args := m.Called(ctx, userID, yearMonth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.StoredMonthlyReport), args.Error(1)
+}
+
+func (m *MockMonthlyReportRepository) Upsert(ctx context.Context, report *models.StoredMonthlyReport) error {
+	args := m.Called(ctx, report)
+	return args.Error(0)
+}
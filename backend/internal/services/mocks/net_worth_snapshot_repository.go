@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNetWorthSnapshotRepository は NetWorthSnapshotRepositoryInterface のモック
+type MockNetWorthSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *MockNetWorthSnapshotRepository) GetByUserID(ctx context.Context, userID uuid.UUID, from string) ([]models.NetWorthSnapshot, error) {
+	args := m.Called(ctx, userID, from)
+	return args.Get(0).([]models.NetWorthSnapshot), args.Error(1)
+}
+
+func (m *MockNetWorthSnapshotRepository) Upsert(ctx context.Context, snapshot *models.NetWorthSnapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
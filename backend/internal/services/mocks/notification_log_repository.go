@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNotificationLogRepository は NotificationLogRepositoryInterface のモック
+type MockNotificationLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationLogRepository) GetByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]models.NotificationLog, error) {
+	args := m.Called(ctx, userID, limit)
+	return args.Get(0).([]models.NotificationLog), args.Error(1)
+}
+
+func (m *MockNotificationLogRepository) WasSent(ctx context.Context, userID uuid.UUID, channel, dedupeKey string) (bool, error) {
+	args := m.Called(ctx, userID, channel, dedupeKey)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationLogRepository) Upsert(ctx context.Context, log *models.NotificationLog) error {
+	args := m.Called(ctx, log)
+	return args.Error(0)
+}
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 
 	"github.com/google/uuid"
@@ -12,31 +13,41 @@ type MockRecurringPaymentRepository struct {
 	mock.Mock
 }
 
-func (m *MockRecurringPaymentRepository) GetAll(userID uuid.UUID) ([]models.RecurringPayment, error) {
-	args := m.Called(userID)
+func (m *MockRecurringPaymentRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error) {
+	args := m.Called(ctx, userID)
 	return args.Get(0).([]models.RecurringPayment), args.Error(1)
 }
 
-func (m *MockRecurringPaymentRepository) GetByID(id uuid.UUID) (*models.RecurringPayment, error) {
-	args := m.Called(id)
+func (m *MockRecurringPaymentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*models.RecurringPayment), args.Error(1)
 }
 
-func (m *MockRecurringPaymentRepository) Create(payment *models.RecurringPayment) error {
-	args := m.Called(payment)
+func (m *MockRecurringPaymentRepository) GetAllActive(ctx context.Context) ([]models.RecurringPayment, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.RecurringPayment), args.Error(1)
+}
+
+func (m *MockRecurringPaymentRepository) Create(ctx context.Context, payment *models.RecurringPayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockRecurringPaymentRepository) Update(ctx context.Context, payment *models.RecurringPayment) error {
+	args := m.Called(ctx, payment)
 	return args.Error(0)
 }
 
-func (m *MockRecurringPaymentRepository) Update(payment *models.RecurringPayment) error {
-	args := m.Called(payment)
+func (m *MockRecurringPaymentRepository) UpdateProgress(ctx context.Context, payment *models.RecurringPayment) error {
+	args := m.Called(ctx, payment)
 	return args.Error(0)
 }
 
-func (m *MockRecurringPaymentRepository) Delete(id uuid.UUID) error {
-	args := m.Called(id)
+func (m *MockRecurringPaymentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
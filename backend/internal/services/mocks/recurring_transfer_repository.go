@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRecurringTransferRepository は RecurringTransferRepositoryInterface のモック
+type MockRecurringTransferRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecurringTransferRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.RecurringTransfer), args.Error(1)
+}
+
+func (m *MockRecurringTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RecurringTransfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RecurringTransfer), args.Error(1)
+}
+
+func (m *MockRecurringTransferRepository) Create(ctx context.Context, transfer *models.RecurringTransfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+func (m *MockRecurringTransferRepository) Update(ctx context.Context, transfer *models.RecurringTransfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+func (m *MockRecurringTransferRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
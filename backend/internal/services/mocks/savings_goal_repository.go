@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSavingsGoalRepository は SavingsGoalRepositoryInterface のモック
+type MockSavingsGoalRepository struct {
+	mock.Mock
+}
+
+func (m *MockSavingsGoalRepository) GetAll(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.SavingsGoal), args.Error(1)
+}
+
+func (m *MockSavingsGoalRepository) GetActiveByUserID(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]models.SavingsGoal), args.Error(1)
+}
+
+func (m *MockSavingsGoalRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SavingsGoal, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.SavingsGoal), args.Error(1)
+}
+
+func (m *MockSavingsGoalRepository) Create(ctx context.Context, goal *models.SavingsGoal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockSavingsGoalRepository) Update(ctx context.Context, goal *models.SavingsGoal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockSavingsGoalRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
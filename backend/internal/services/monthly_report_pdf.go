@@ -0,0 +1,306 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/pdf"
+)
+
+// Layout of the monthly report, in points
+const (
+	reportMargin     = 40.0
+	reportRowHeight  = 16.0
+	reportChartWidth = pdf.A4Width - 2*reportMargin
+	reportChartPlot  = 180.0 // Height of the plot area of the balance chart
+)
+
+var (
+	reportGray      = pdf.Color{R: 0.45, G: 0.45, B: 0.45}
+	reportLightGray = pdf.Color{R: 0.92, G: 0.92, B: 0.92}
+	reportGridGray  = pdf.Color{R: 0.8, G: 0.8, B: 0.8}
+	reportBlue      = pdf.Color{R: 0.15, G: 0.39, B: 0.92}
+	reportRed       = pdf.Color{R: 0.86, G: 0.15, B: 0.15}
+)
+
+// reportColumn is a column of a table in the report
+type reportColumn struct {
+	title string
+	width float64
+	align pdf.Align
+}
+
+// renderMonthlyReport lays the report out on A4 pages in the font: the summary and the balance
+// chart first, then the tables of the month's items
+func renderMonthlyReport(report *models.MonthlyReport, now time.Time, font *pdf.Font) ([]byte, error) {
+	month, err := time.Parse("2006-01", report.YearMonth)
+	if err != nil {
+		return nil, err
+	}
+	title := fmt.Sprintf("月次レポート %d年%d月", month.Year(), int(month.Month()))
+	money := func(amount int64) string {
+		return currency.Format(amount, report.Currency)
+	}
+
+	l := &reportLayout{doc: pdf.New(title, now, font), font: font}
+	l.newPage()
+
+	l.page.Text(reportMargin, l.y-18, title, pdf.TextStyle{Size: 18})
+	l.page.Text(pdf.A4Width-reportMargin, l.y-18, "作成日 "+now.Format("2006-01-02"), pdf.TextStyle{Size: 9, Color: reportGray, Align: pdf.AlignRight})
+	l.y -= 40
+
+	l.heading("概要")
+	net := report.Income - report.Expense
+	l.table([]reportColumn{
+		{title: "月初残高", width: 103, align: pdf.AlignRight},
+		{title: "収入", width: 103, align: pdf.AlignRight},
+		{title: "支出", width: 103, align: pdf.AlignRight},
+		{title: "収支", width: 103, align: pdf.AlignRight},
+		{title: "月末残高", width: 103, align: pdf.AlignRight},
+	}, [][]string{{money(report.OpeningBalance), money(report.Income), money(report.Expense), signed(net, report.Currency), money(report.ClosingBalance)}})
+
+	l.heading("今後12ヶ月の残高予測")
+	l.balanceChart(report.Outlook, money)
+
+	l.heading("収入")
+	rows := make([][]string, 0, len(report.Inflows))
+	for _, item := range report.Inflows {
+		rows = append(rows, []string{item.Date, item.Description, money(item.Amount)})
+	}
+	l.table([]reportColumn{
+		{title: "日付", width: 80},
+		{title: "内容", width: 325},
+		{title: "金額", width: 110, align: pdf.AlignRight},
+	}, rows)
+
+	l.heading("支出")
+	rows = make([][]string, 0, len(report.Outflows))
+	for _, item := range report.Outflows {
+		rows = append(rows, []string{item.Date, item.Description, money(item.Amount), estimatedNote(item.IsEstimated)})
+	}
+	l.table([]reportColumn{
+		{title: "日付", width: 80},
+		{title: "内容", width: 275},
+		{title: "金額", width: 110, align: pdf.AlignRight},
+		{title: "備考", width: 50, align: pdf.AlignCenter},
+	}, rows)
+
+	l.heading("カード明細")
+	rows = make([][]string, 0, len(report.CardStatements))
+	for _, statement := range report.CardStatements {
+		rows = append(rows, []string{statement.Name, statement.StatementMonth, statement.PaymentDate, money(statement.Amount), estimatedNote(statement.IsEstimated)})
+	}
+	l.table([]reportColumn{
+		{title: "カード", width: 175},
+		{title: "利用月", width: 70},
+		{title: "支払日", width: 110},
+		{title: "金額", width: 110, align: pdf.AlignRight},
+		{title: "備考", width: 50, align: pdf.AlignCenter},
+	}, rows)
+
+	l.heading("予定との差異")
+	rows = make([][]string, 0, len(report.Variances))
+	for _, variance := range report.Variances {
+		rows = append(rows, []string{variance.Date, variance.Description, money(variance.Planned), money(variance.Actual), signed(variance.Difference, report.Currency)})
+	}
+	l.table([]reportColumn{
+		{title: "日付", width: 80},
+		{title: "内容", width: 175},
+		{title: "予定", width: 85, align: pdf.AlignRight},
+		{title: "実績", width: 85, align: pdf.AlignRight},
+		{title: "差異", width: 90, align: pdf.AlignRight},
+	}, rows)
+
+	var buf bytes.Buffer
+	if err := l.doc.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// signed formats an amount with a plus sign when it is positive
+func signed(amount int64, code string) string {
+	if amount > 0 {
+		return "+" + currency.Format(amount, code)
+	}
+	return currency.Format(amount, code)
+}
+
+func estimatedNote(estimated bool) string {
+	if estimated {
+		return "見込み"
+	}
+	return ""
+}
+
+// reportLayout places blocks from the top of a page down and starts a new page when a block
+// does not fit
+type reportLayout struct {
+	doc  *pdf.Document
+	font *pdf.Font
+	page *pdf.Page
+	y    float64 // Top of the next block
+}
+
+func (l *reportLayout) newPage() {
+	l.page = l.doc.AddPage()
+	l.y = l.page.Height - reportMargin
+}
+
+// ensure starts a new page unless height fits below the current position
+func (l *reportLayout) ensure(height float64) {
+	if l.y-height < reportMargin {
+		l.newPage()
+	}
+}
+
+// heading writes a section title, kept on the same page as the first table row
+func (l *reportLayout) heading(text string) {
+	l.ensure(28 + 2*reportRowHeight)
+	l.y -= 14
+	l.page.Text(reportMargin, l.y, text, pdf.TextStyle{Size: 13})
+	l.y -= 6
+	l.page.Line(reportMargin, l.y, pdf.A4Width-reportMargin, l.y, pdf.LineStyle{Width: 0.5, Color: reportGray})
+	l.y -= 8
+}
+
+// table writes rows under a shaded header row. The header is repeated on every page the table
+// continues on; a table without rows says so.
+func (l *reportLayout) table(columns []reportColumn, rows [][]string) {
+	header := func() {
+		l.page.FillRect(reportMargin, l.y-reportRowHeight, pdf.A4Width-2*reportMargin, reportRowHeight, reportLightGray)
+		titles := make([]string, len(columns))
+		for i, column := range columns {
+			titles[i] = column.title
+		}
+		l.row(columns, titles, reportGray)
+	}
+
+	header()
+	if len(rows) == 0 {
+		l.page.Text(reportMargin+4, l.y-11, "該当なし", pdf.TextStyle{Size: 9, Color: reportGray})
+		l.y -= reportRowHeight
+	}
+	for _, row := range rows {
+		if l.y-reportRowHeight < reportMargin {
+			l.newPage()
+			header()
+		}
+		l.row(columns, row, pdf.Color{})
+	}
+	l.y -= 10
+}
+
+// row writes the cells of a table row, cutting text that does not fit its column
+func (l *reportLayout) row(columns []reportColumn, cells []string, color pdf.Color) {
+	const size, padding = 9.0, 4.0
+	x := reportMargin
+	for i, column := range columns {
+		if i < len(cells) {
+			text := fitText(l.font, cells[i], column.width-2*padding, size)
+			textX := x + padding
+			switch column.align {
+			case pdf.AlignCenter:
+				textX = x + column.width/2
+			case pdf.AlignRight:
+				textX = x + column.width - padding
+			}
+			l.page.Text(textX, l.y-11, text, pdf.TextStyle{Size: size, Color: color, Align: column.align})
+		}
+		x += column.width
+	}
+	l.y -= reportRowHeight
+	l.page.Line(reportMargin, l.y, pdf.A4Width-reportMargin, l.y, pdf.LineStyle{Width: 0.3, Color: reportGridGray})
+}
+
+// fitText cuts text to the width in the font, ending it with an ellipsis
+func fitText(font *pdf.Font, text string, width, size float64) string {
+	if font.TextWidth(text, size) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && font.TextWidth(string(runes)+"…", size) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
+// balanceChart draws the month-end balances as a line over a grid of amounts. Negative balances
+// are marked in red under a dashed zero line.
+func (l *reportLayout) balanceChart(balances []models.MonthlyBalance, money func(int64) string) {
+	const labelWidth, labelHeight = 80.0, 30.0
+	l.ensure(reportChartPlot + labelHeight)
+	if len(balances) == 0 {
+		l.page.Text(reportMargin+4, l.y-11, "該当なし", pdf.TextStyle{Size: 9, Color: reportGray})
+		l.y -= reportRowHeight + 10
+		return
+	}
+
+	low, high := balances[0].Balance, balances[0].Balance
+	for _, balance := range balances {
+		low = min(low, balance.Balance)
+		high = max(high, balance.Balance)
+	}
+	low, high = min(low, 0), max(high, 0)
+	step := chartStep(high - low)
+	low = int64(math.Floor(float64(low)/float64(step))) * step
+	high = int64(math.Ceil(float64(high)/float64(step))) * step
+	if high == low {
+		high = low + step
+	}
+
+	left := reportMargin + labelWidth
+	width := reportChartWidth - labelWidth
+	top := l.y - 6
+	bottom := top - reportChartPlot
+	yOf := func(amount int64) float64 {
+		return bottom + float64(amount-low)/float64(high-low)*reportChartPlot
+	}
+	xOf := func(i int) float64 {
+		if len(balances) == 1 {
+			return left + width/2
+		}
+		return left + 10 + float64(i)*(width-20)/float64(len(balances)-1)
+	}
+
+	for amount := low; amount <= high; amount += step {
+		y := yOf(amount)
+		l.page.Line(left, y, left+width, y, pdf.LineStyle{Width: 0.3, Color: reportGridGray, Dashed: amount == 0 && low < 0})
+		l.page.Text(left-4, y-3, money(amount), pdf.TextStyle{Size: 7, Color: reportGray, Align: pdf.AlignRight})
+	}
+
+	points := make([]pdf.Point, len(balances))
+	for i, balance := range balances {
+		points[i] = pdf.Point{X: xOf(i), Y: yOf(balance.Balance)}
+		l.page.Text(points[i].X, bottom-12, balance.YearMonth[2:], pdf.TextStyle{Size: 7, Color: reportGray, Align: pdf.AlignCenter})
+	}
+	l.page.Polyline(points, pdf.LineStyle{Width: 1.5, Color: reportBlue})
+	for i, balance := range balances {
+		color := reportBlue
+		if balance.Balance < 0 {
+			color = reportRed
+		}
+		l.page.FillRect(points[i].X-2, points[i].Y-2, 4, 4, color)
+	}
+
+	l.y = bottom - labelHeight
+}
+
+// chartStep returns a round grid interval that divides span into at most five steps
+func chartStep(span int64) int64 {
+	if span <= 0 {
+		return 100
+	}
+	magnitude := int64(math.Pow(10, math.Floor(math.Log10(float64(span)/5))))
+	magnitude = max(magnitude, 1)
+	for _, factor := range []int64{1, 2, 5, 10} {
+		if span/(magnitude*factor) <= 5 {
+			return magnitude * factor
+		}
+	}
+	return magnitude * 10
+}
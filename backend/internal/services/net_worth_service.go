@@ -0,0 +1,207 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// AssetTypeBankAccount is the asset type reported for bank balances
+const AssetTypeBankAccount = "bank_account"
+
+type NetWorthService struct {
+	assetRepo            AssetRepositoryInterface
+	bankAccountRepo      BankAccountRepositoryInterface
+	recurringPaymentRepo RecurringPaymentRepositoryInterface
+	netWorthSnapshotRepo NetWorthSnapshotRepositoryInterface
+	userRepo             UserRepositoryInterface
+	appSettingRepo       AppSettingRepositoryInterface
+	exchangeRateRepo     ExchangeRateRepositoryInterface
+}
+
+func NewNetWorthService(
+	assetRepo AssetRepositoryInterface,
+	bankAccountRepo BankAccountRepositoryInterface,
+	recurringPaymentRepo RecurringPaymentRepositoryInterface,
+	netWorthSnapshotRepo NetWorthSnapshotRepositoryInterface,
+	userRepo UserRepositoryInterface,
+	appSettingRepo AppSettingRepositoryInterface,
+	exchangeRateRepo ExchangeRateRepositoryInterface,
+) *NetWorthService {
+	return &NetWorthService{
+		assetRepo:            assetRepo,
+		bankAccountRepo:      bankAccountRepo,
+		recurringPaymentRepo: recurringPaymentRepo,
+		netWorthSnapshotRepo: netWorthSnapshotRepo,
+		userRepo:             userRepo,
+		appSettingRepo:       appSettingRepo,
+		exchangeRateRepo:     exchangeRateRepo,
+	}
+}
+
+// GetNetWorth returns the user's current assets, liabilities and net worth in the base currency
+func (s *NetWorthService) GetNetWorth(ctx context.Context, userID uuid.UUID) (*models.NetWorth, error) {
+	ctx, span := tracer.Start(ctx, "NetWorthService.GetNetWorth")
+	defer span.End()
+
+	return s.netWorthOn(ctx, userID, time.Now())
+}
+
+// GetNetWorthHistory returns the daily snapshots of the user's net worth over the last months,
+// oldest first. Snapshots are recorded by the net worth snapshot job.
+func (s *NetWorthService) GetNetWorthHistory(ctx context.Context, userID uuid.UUID, months int) ([]models.NetWorthSnapshot, error) {
+	ctx, span := tracer.Start(ctx, "NetWorthService.GetNetWorthHistory")
+	defer span.End()
+
+	from := time.Now().AddDate(0, -months, 0).Format("2006-01-02")
+	return s.netWorthSnapshotRepo.GetByUserID(ctx, userID, from)
+}
+
+// RecordNetWorthSnapshots records the net worth of every user on the day of now. A user whose net
+// worth cannot be calculated, e.g. for a missing exchange rate, does not stop the others.
+func (s *NetWorthService) RecordNetWorthSnapshots(ctx context.Context, now time.Time) (*models.NetWorthSnapshotReport, error) {
+	ctx, span := tracer.Start(ctx, "NetWorthService.RecordNetWorthSnapshots")
+	defer span.End()
+
+	userIDs, err := s.userRepo.GetAllIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.NetWorthSnapshotReport{}
+	var errs []error
+	for _, userID := range userIDs {
+		report.Users++
+
+		netWorth, err := s.netWorthOn(ctx, userID, now)
+		if err == nil {
+			err = s.netWorthSnapshotRepo.Upsert(ctx, &models.NetWorthSnapshot{
+				ID:               uuid.New(),
+				UserID:           userID,
+				SnapshotDate:     netWorth.Date,
+				Currency:         netWorth.Currency,
+				TotalAssets:      netWorth.TotalAssets,
+				TotalLiabilities: netWorth.TotalLiabilities,
+				NetWorth:         netWorth.NetWorth,
+				CreatedAt:        now,
+				UpdatedAt:        now,
+			})
+		}
+		if err != nil {
+			report.Failed++
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+			continue
+		}
+		report.Recorded++
+	}
+
+	return report, errors.Join(errs...)
+}
+
+// netWorthOn loads the user's accounts, assets and loans and returns the net worth on the given date
+func (s *NetWorthService) netWorthOn(ctx context.Context, userID uuid.UUID, date time.Time) (*models.NetWorth, error) {
+	converter, err := loadCurrencyConverter(ctx, s.appSettingRepo, s.exchangeRateRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.bankAccountRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := s.assetRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := s.recurringPaymentRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return netWorth(date, accounts, assets, payments, converter)
+}
+
+// netWorth returns the net worth on the given date. Active assets count with their latest valuation
+// and active loans with the principal left after the instalments due by the date.
+func netWorth(date time.Time, accounts []models.BankAccount, assets []models.Asset, payments []models.RecurringPayment, converter *currencyConverter) (*models.NetWorth, error) {
+	loans := make([]models.RecurringPayment, 0)
+	for _, payment := range payments {
+		if payment.IsActive && isLoan(payment) {
+			loans = append(loans, payment)
+		}
+	}
+	valued := make([]models.Asset, 0, len(assets))
+	for _, asset := range assets {
+		if asset.IsActive && asset.CurrentValue != nil {
+			valued = append(valued, asset)
+		}
+	}
+
+	codes := projectionCurrencies(accounts, nil, loans, nil)
+	for _, asset := range valued {
+		codes = append(codes, asset.Currency)
+	}
+	if err := converter.check(codes...); err != nil {
+		return nil, err
+	}
+
+	result := &models.NetWorth{
+		Date:        date.Format("2006-01-02"),
+		Currency:    converter.base,
+		Assets:      make([]models.AssetTypeTotal, 0, len(assetTypes)+1),
+		Liabilities: make([]models.LiabilityBalance, 0, len(loans)),
+	}
+
+	cash := int64(0)
+	for _, account := range accounts {
+		cash += converter.toBase(account.Balance, account.Currency, date)
+	}
+	result.Assets = append(result.Assets, models.AssetTypeTotal{AssetType: AssetTypeBankAccount, Amount: cash})
+
+	byType := make(map[string]int64, len(assetTypes))
+	for _, asset := range valued {
+		byType[asset.AssetType] += converter.toBase(*asset.CurrentValue, asset.Currency, date)
+	}
+	for _, assetType := range assetTypes {
+		if amount, ok := byType[assetType]; ok {
+			result.Assets = append(result.Assets, models.AssetTypeTotal{AssetType: assetType, Amount: amount})
+		}
+	}
+	for _, total := range result.Assets {
+		result.TotalAssets += total.Amount
+	}
+
+	for _, payment := range loans {
+		schedule, err := loanAmortization(payment, nil, date)
+		if err != nil || schedule.RemainingPrincipal <= 0 {
+			continue
+		}
+
+		liability := models.LiabilityBalance{
+			RecurringPaymentID: payment.ID,
+			Name:               payment.Name,
+			Amount:             converter.toBase(schedule.RemainingPrincipal, payment.Currency, date),
+		}
+		if code := converter.code(payment.Currency); code != converter.base {
+			remaining := schedule.RemainingPrincipal
+			liability.OriginalAmount = &remaining
+			liability.OriginalCurrency = code
+		}
+		result.Liabilities = append(result.Liabilities, liability)
+		result.TotalLiabilities += liability.Amount
+	}
+	// Largest loan first
+	slices.SortStableFunc(result.Liabilities, func(a, b models.LiabilityBalance) int {
+		return cmp.Compare(b.Amount, a.Amount)
+	})
+
+	result.NetWorth = result.TotalAssets - result.TotalLiabilities
+	return result, nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetWorth(t *testing.T) {
+	converter := newCurrencyConverter("JPY", []models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, RateDate: "2025-01-01"},
+	})
+	date := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	value := func(v int64) *int64 { return &v }
+
+	accounts := []models.BankAccount{
+		{ID: uuid.New(), Balance: 30000000, Currency: "JPY"},
+		{ID: uuid.New(), Balance: 100000, Currency: "USD"},
+	}
+	assets := []models.Asset{
+		{ID: uuid.New(), AssetType: AssetTypeNISA, Currency: "JPY", IsActive: true, CurrentValue: value(50000000)},
+		{ID: uuid.New(), AssetType: AssetTypeSecurities, Currency: "USD", IsActive: true, CurrentValue: value(200000)},
+		{ID: uuid.New(), AssetType: AssetTypeNISA, Currency: "JPY", IsActive: true, CurrentValue: value(10000000)},
+		{ID: uuid.New(), AssetType: AssetTypePension, Currency: "JPY", IsActive: true},                                 // Not valued yet
+		{ID: uuid.New(), AssetType: AssetTypeCrypto, Currency: "GBP", IsActive: false, CurrentValue: value(100000000)}, // Sold
+	}
+	carLoan := testLoanPayment("equal_principal")
+	dollarLoan := testLoanPayment("equal_principal")
+	dollarLoan.Name = "Dollar Loan"
+	dollarLoan.Currency = "USD"
+	finished := testLoanPayment("equal_principal")
+	finished.IsActive = false
+	payments := []models.RecurringPayment{
+		carLoan, dollarLoan, finished,
+		{ID: uuid.New(), Name: "Rent", Amount: 8000000, IsActive: true},
+	}
+
+	result, err := netWorth(date, accounts, assets, payments, converter)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2025-03-01", result.Date)
+	assert.Equal(t, "JPY", result.Currency)
+	assert.Equal(t, []models.AssetTypeTotal{
+		{AssetType: AssetTypeBankAccount, Amount: 45000000},
+		{AssetType: AssetTypeSecurities, Amount: 30000000},
+		{AssetType: AssetTypeNISA, Amount: 60000000},
+	}, result.Assets)
+	assert.Equal(t, int64(135000000), result.TotalAssets)
+
+	// Two of the twelve instalments are due by the date
+	require.Len(t, result.Liabilities, 2)
+	assert.Equal(t, "Dollar Loan", result.Liabilities[0].Name, "largest loan first")
+	assert.Equal(t, int64(150000000), result.Liabilities[0].Amount)
+	assert.Equal(t, int64(1000000), *result.Liabilities[0].OriginalAmount)
+	assert.Equal(t, "USD", result.Liabilities[0].OriginalCurrency)
+	assert.Equal(t, int64(1000000), result.Liabilities[1].Amount)
+	assert.Nil(t, result.Liabilities[1].OriginalAmount)
+	assert.Equal(t, int64(151000000), result.TotalLiabilities)
+
+	assert.Equal(t, int64(-16000000), result.NetWorth)
+}
+
+func TestNetWorth_missingExchangeRate(t *testing.T) {
+	converter := newCurrencyConverter("JPY", nil)
+	value := int64(100)
+	assets := []models.Asset{{AssetType: AssetTypeCrypto, Currency: "USD", IsActive: true, CurrentValue: &value}}
+
+	_, err := netWorth(time.Now(), nil, assets, nil, converter)
+
+	var validationErr *ValidationError
+	if assert.ErrorAs(t, err, &validationErr) {
+		assert.Equal(t, "no exchange rate from USD to JPY", validationErr.Message)
+	}
+}
+
+func TestNetWorthService_RecordNetWorthSnapshots(t *testing.T) {
+	assetRepo := &mocks.MockAssetRepository{}
+	bankAccountRepo := &mocks.MockBankAccountRepository{}
+	recurringPaymentRepo := &mocks.MockRecurringPaymentRepository{}
+	snapshotRepo := &mocks.MockNetWorthSnapshotRepository{}
+	userRepo := &mocks.MockUserRepository{}
+	appSettingRepo := &mocks.MockAppSettingRepository{}
+	exchangeRateRepo := &mocks.MockExchangeRateRepository{}
+	service := NewNetWorthService(assetRepo, bankAccountRepo, recurringPaymentRepo, snapshotRepo, userRepo, appSettingRepo, exchangeRateRepo)
+
+	okUser, failingUser := uuid.New(), uuid.New()
+	now := time.Date(2025, 3, 1, 23, 0, 0, 0, time.UTC)
+	dollars := int64(1000)
+
+	userRepo.On("GetAllIDs", mock.Anything).Return([]uuid.UUID{okUser, failingUser}, nil)
+	appSettingRepo.On("GetByKey", mock.Anything, mock.Anything, BaseCurrencySettingKey).Return(nil, assert.AnError)
+	exchangeRateRepo.On("GetAll", mock.Anything, mock.Anything).Return([]models.ExchangeRate{}, nil)
+	bankAccountRepo.On("GetAll", mock.Anything, okUser).Return([]models.BankAccount{{Balance: 500000, Currency: "JPY"}}, nil)
+	bankAccountRepo.On("GetAll", mock.Anything, failingUser).Return([]models.BankAccount{}, nil)
+	assetRepo.On("GetAll", mock.Anything, okUser).Return([]models.Asset{}, nil)
+	assetRepo.On("GetAll", mock.Anything, failingUser).Return([]models.Asset{{AssetType: AssetTypeCrypto, Currency: "USD", IsActive: true, CurrentValue: &dollars}}, nil)
+	recurringPaymentRepo.On("GetAll", mock.Anything, mock.Anything).Return([]models.RecurringPayment{}, nil)
+	snapshotRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(snapshot *models.NetWorthSnapshot) bool {
+		return snapshot.UserID == okUser && snapshot.SnapshotDate == "2025-03-01" && snapshot.Currency == "JPY" &&
+			snapshot.TotalAssets == 500000 && snapshot.NetWorth == 500000
+	})).Return(nil).Once()
+
+	report, err := service.RecordNetWorthSnapshots(context.Background(), now)
+
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), failingUser.String())
+	}
+	assert.Equal(t, &models.NetWorthSnapshotReport{Users: 2, Recorded: 1, Failed: 1}, report)
+	snapshotRepo.AssertExpectations(t)
+}
+
+func TestNetWorthService_GetNetWorthHistory(t *testing.T) {
+	snapshotRepo := &mocks.MockNetWorthSnapshotRepository{}
+	service := NewNetWorthService(nil, nil, nil, snapshotRepo, nil, nil, nil)
+	userID := uuid.New()
+	from := time.Now().AddDate(0, -12, 0).Format("2006-01-02")
+
+	snapshots := []models.NetWorthSnapshot{{UserID: userID, SnapshotDate: from, NetWorth: 100}}
+	snapshotRepo.On("GetByUserID", mock.Anything, userID, from).Return(snapshots, nil)
+
+	result, err := service.GetNetWorthHistory(context.Background(), userID, 12)
+
+	assert.NoError(t, err)
+	assert.Equal(t, snapshots, result)
+}
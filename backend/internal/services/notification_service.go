@@ -0,0 +1,743 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+	"github.com/Soli0222/flow-sight/backend/internal/metrics"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/notify"
+
+	"github.com/google/uuid"
+)
+
+// Setting keys of the notification preferences. NotificationEnabledSettingKey turns every
+// notification of the user off.
+const (
+	NotificationEmailEnabledSettingKey        = "notification_email_enabled"
+	NotificationEmailSettingKey               = "notification_email"
+	NotificationLocaleSettingKey              = "notification_locale"
+	NotificationEventsSettingKey              = "notification_events"
+	NotificationLargeDebitThresholdSettingKey = "notification_large_debit_threshold"
+	NotificationLargeDebitDaysSettingKey      = "notification_large_debit_days"
+	NotificationLowBalanceThresholdSettingKey = "notification_low_balance_threshold"
+	NotificationLowBalanceDaysSettingKey      = "notification_low_balance_days"
+	NotificationSlackWebhookURLSettingKey     = "notification_slack_webhook_url"
+	NotificationDiscordWebhookURLSettingKey   = "notification_discord_webhook_url"
+	NotificationLINETokenSettingKey           = "notification_line_token"
+)
+
+// Events a user can be notified of
+const (
+	NotificationEventLargeDebit      = "large_debit"
+	NotificationEventLowBalance      = "low_balance"
+	NotificationEventCardUnconfirmed = "card_unconfirmed"
+	NotificationEventWeeklyDigest    = "weekly_digest"
+	NotificationEventTest            = "test"
+)
+
+// Channels notifications are delivered on. Alerts on the webhook channel are published as
+// "alert.triggered" events to the user's webhooks.
+const (
+	NotificationChannelEmail   = "email"
+	NotificationChannelSlack   = "slack"
+	NotificationChannelDiscord = "discord"
+	NotificationChannelLINE    = "line"
+	NotificationChannelWebhook = "webhook"
+)
+
+// Statuses of a notification log
+const (
+	NotificationStatusSent   = "sent"
+	NotificationStatusFailed = "failed"
+)
+
+const (
+	// maxNotificationLargeDebitDays is the earliest a debit can be announced
+	maxNotificationLargeDebitDays = 30
+	// maxNotificationLowBalanceDays is how far ahead the balance can be watched
+	maxNotificationLowBalanceDays = 366
+	// weeklyDigestDays is the period a digest covers, starting on the day it is sent
+	weeklyDigestDays = 7
+	// weeklyDigestMaxItems is how many items a digest lists
+	weeklyDigestMaxItems = 10
+	// notificationLogLimit is how many log entries are returned
+	notificationLogLimit = 50
+)
+
+// notificationEvents are the events a user can subscribe to, in the order of the settings form
+var notificationEvents = []string{
+	NotificationEventLargeDebit,
+	NotificationEventLowBalance,
+	NotificationEventCardUnconfirmed,
+	NotificationEventWeeklyDigest,
+}
+
+// notificationChatChannels are the chat channels, in delivery order, with the setting holding
+// what their notifier addresses. A chat channel is on when its setting is not empty.
+var notificationChatChannels = []struct {
+	channel    string
+	settingKey string
+}{
+	{NotificationChannelSlack, NotificationSlackWebhookURLSettingKey},
+	{NotificationChannelDiscord, NotificationDiscordWebhookURLSettingKey},
+	{NotificationChannelLINE, NotificationLINETokenSettingKey},
+}
+
+// notificationLinks are the pages of the frontend the notification of an event links to
+var notificationLinks = map[string]string{
+	NotificationEventLargeDebit:      "/cashflow",
+	NotificationEventLowBalance:      "/cashflow",
+	NotificationEventCardUnconfirmed: "/card-monthly-totals",
+	NotificationEventWeeklyDigest:    "/cashflow",
+	NotificationEventTest:            "/",
+}
+
+// largeDebitTypes are the projection items that are announced as debits
+var largeDebitTypes = map[string]bool{
+	"recurring_payment": true,
+	"card_payment":      true,
+	"loan_prepayment":   true,
+}
+
+// NotificationService detects the events users asked to be notified of and delivers them by
+// e-mail, to chat services and to webhooks. Every event has a key and is sent once per channel;
+// failed deliveries are retried by the next run.
+type NotificationService struct {
+	notificationLogRepo  NotificationLogRepositoryInterface
+	userRepo             UserRepositoryInterface
+	appSettingRepo       AppSettingRepositoryInterface
+	creditCardRepo       CreditCardRepositoryInterface
+	cardMonthlyTotalRepo CardMonthlyTotalRepositoryInterface
+	cashflowProjector    CashflowProjectorInterface
+	notifiers            map[string]notify.Notifier // Transports by channel; e-mail is missing when no mail server is configured
+	publisher            EventPublisherInterface    // nil when alerts are not published to webhooks
+	appURL               string
+}
+
+func NewNotificationService(
+	notificationLogRepo NotificationLogRepositoryInterface,
+	userRepo UserRepositoryInterface,
+	appSettingRepo AppSettingRepositoryInterface,
+	creditCardRepo CreditCardRepositoryInterface,
+	cardMonthlyTotalRepo CardMonthlyTotalRepositoryInterface,
+	cashflowProjector CashflowProjectorInterface,
+	notifiers map[string]notify.Notifier,
+	publisher EventPublisherInterface,
+	appURL string,
+) *NotificationService {
+	return &NotificationService{
+		notificationLogRepo:  notificationLogRepo,
+		userRepo:             userRepo,
+		appSettingRepo:       appSettingRepo,
+		creditCardRepo:       creditCardRepo,
+		cardMonthlyTotalRepo: cardMonthlyTotalRepo,
+		cashflowProjector:    cashflowProjector,
+		notifiers:            notifiers,
+		publisher:            publisher,
+		appURL:               strings.TrimSuffix(appURL, "/"),
+	}
+}
+
+// notificationPreferences are a user's notification settings
+type notificationPreferences struct {
+	enabled             bool
+	emailEnabled        bool
+	email               string            // Empty for the address the user signed in with
+	chatRecipients      map[string]string // What the notifiers of the chat channels address, by channel
+	locale              string
+	events              map[string]bool
+	currency            string
+	largeDebitThreshold int64
+	largeDebitDays      int
+	lowBalanceThreshold int64
+	lowBalanceDays      int
+}
+
+// notificationChannel is a channel a user's notifications are delivered on
+type notificationChannel struct {
+	name      string
+	recipient string // What the notifier of the channel addresses; empty for webhooks
+}
+
+// pendingNotification is a detected event waiting for delivery
+type pendingNotification struct {
+	key          string
+	notification notify.Notification
+}
+
+// GetNotificationLogs returns the latest notifications sent to the user
+func (s *NotificationService) GetNotificationLogs(ctx context.Context, userID uuid.UUID) ([]models.NotificationLog, error) {
+	ctx, span := tracer.Start(ctx, "NotificationService.GetNotificationLogs")
+	defer span.End()
+
+	return s.notificationLogRepo.GetByUserID(ctx, userID, notificationLogLimit)
+}
+
+// SendNotifications sends every user the events of the day they subscribed to. A user whose
+// events cannot be detected is skipped and the error returned with the others.
+func (s *NotificationService) SendNotifications(ctx context.Context, now time.Time) (*models.NotificationRun, error) {
+	ctx, span := tracer.Start(ctx, "NotificationService.SendNotifications")
+	defer span.End()
+
+	userIDs, err := s.userRepo.GetAllIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &models.NotificationRun{}
+	var errs []error
+	for _, userID := range userIDs {
+		run.Users++
+
+		preferences, err := s.loadPreferences(ctx, userID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+			continue
+		}
+		channels, err := s.deliveryChannels(ctx, userID, preferences)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+			continue
+		}
+		if len(channels) == 0 {
+			run.Skipped++
+			continue
+		}
+
+		pending, err := s.detectNotifications(ctx, userID, preferences, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+			continue
+		}
+
+		for _, p := range pending {
+			for _, channel := range channels {
+				if channel.name == NotificationChannelWebhook && p.notification.Event == NotificationEventWeeklyDigest {
+					continue
+				}
+
+				sent, err := s.notificationLogRepo.WasSent(ctx, userID, channel.name, p.key)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+					continue
+				}
+				if sent {
+					continue
+				}
+
+				var log *models.NotificationLog
+				if channel.name == NotificationChannelWebhook {
+					log, err = s.publishAlert(ctx, userID, p, now)
+				} else {
+					log, err = s.deliver(ctx, userID, channel, p, now)
+				}
+				if err != nil {
+					errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+				}
+				if log != nil && log.Status == NotificationStatusSent {
+					run.Sent++
+				} else {
+					run.Failed++
+				}
+			}
+		}
+	}
+
+	return run, errors.Join(errs...)
+}
+
+// SendTestNotification sends the user a test notification on the channel, e-mail when none is
+// given, regardless of their preferences. A chat channel needs its webhook URL or token to be
+// set. A failed delivery is recorded in the returned log rather than returned as an error.
+func (s *NotificationService) SendTestNotification(ctx context.Context, userID uuid.UUID, channel string, now time.Time) (*models.NotificationLog, error) {
+	ctx, span := tracer.Start(ctx, "NotificationService.SendTestNotification")
+	defer span.End()
+
+	if channel == "" {
+		channel = NotificationChannelEmail
+	}
+	settingKey := ""
+	for _, chat := range notificationChatChannels {
+		if chat.channel == channel {
+			settingKey = chat.settingKey
+		}
+	}
+	if channel != NotificationChannelEmail && settingKey == "" {
+		return nil, NewValidationError("channel", "unknown channel %q", channel)
+	}
+	if s.notifiers[channel] == nil {
+		return nil, NewValidationError(channel, "notifications are not configured on this server")
+	}
+
+	preferences, err := s.loadPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	recipient := preferences.chatRecipients[channel]
+	if channel == NotificationChannelEmail {
+		recipient, err = s.recipient(ctx, userID, preferences)
+		if err != nil {
+			return nil, err
+		}
+	} else if recipient == "" {
+		return nil, NewValidationError(settingKey, "is not set")
+	}
+
+	notification, err := renderNotification(preferences.locale, NotificationEventTest, nil)
+	if err != nil {
+		return nil, err
+	}
+	log, err := s.deliver(ctx, userID, notificationChannel{name: channel, recipient: recipient}, pendingNotification{
+		key:          NotificationEventTest + ":" + now.UTC().Format(time.RFC3339Nano),
+		notification: notification,
+	}, now)
+	if log == nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// deliver sends a notification on a channel and records the attempt. The log is nil when the
+// attempt could not be recorded; a failed delivery is only returned as an error then.
+func (s *NotificationService) deliver(ctx context.Context, userID uuid.UUID, channel notificationChannel, p pendingNotification, now time.Time) (*models.NotificationLog, error) {
+	notification := p.notification
+	if link, ok := notificationLinks[notification.Event]; ok && s.appURL != "" {
+		notification.Link = s.appURL + link
+	}
+
+	log := &models.NotificationLog{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Channel:   channel.name,
+		EventType: notification.Event,
+		DedupeKey: p.key,
+		Recipient: logRecipient(channel),
+		Subject:   notification.Subject,
+		Status:    NotificationStatusSent,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	sendErr := s.notifiers[channel.name].Notify(ctx, channel.recipient, notification)
+	if sendErr != nil {
+		message := sendErr.Error()
+		log.Status = NotificationStatusFailed
+		log.Error = &message
+	}
+	metrics.CountNotification(log.Channel, log.EventType, log.Status)
+
+	if err := s.notificationLogRepo.Upsert(ctx, log); err != nil {
+		return nil, errors.Join(sendErr, err)
+	}
+	return log, sendErr
+}
+
+// logRecipient is the recipient recorded in the log. Webhook URLs and access tokens are
+// credentials of the user's chat, so only the host of a URL is recorded.
+func logRecipient(channel notificationChannel) string {
+	switch channel.name {
+	case NotificationChannelEmail:
+		return channel.recipient
+	case NotificationChannelSlack, NotificationChannelDiscord:
+		if address, err := url.Parse(channel.recipient); err == nil && address.Host != "" {
+			return address.Host
+		}
+	}
+	return channel.name
+}
+
+// deliveryChannels returns the channels the user's notifications are delivered on: e-mail when
+// it is turned on, every chat channel the user set up and webhooks
+func (s *NotificationService) deliveryChannels(ctx context.Context, userID uuid.UUID, preferences notificationPreferences) ([]notificationChannel, error) {
+	if !preferences.enabled {
+		return nil, nil
+	}
+
+	channels := make([]notificationChannel, 0, len(notificationChatChannels)+2)
+	if s.notifiers[NotificationChannelEmail] != nil && preferences.emailEnabled {
+		recipient, err := s.recipient(ctx, userID, preferences)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, notificationChannel{name: NotificationChannelEmail, recipient: recipient})
+	}
+	for _, chat := range notificationChatChannels {
+		recipient := preferences.chatRecipients[chat.channel]
+		if recipient != "" && s.notifiers[chat.channel] != nil {
+			channels = append(channels, notificationChannel{name: chat.channel, recipient: recipient})
+		}
+	}
+	if s.publisher != nil {
+		channels = append(channels, notificationChannel{name: NotificationChannelWebhook})
+	}
+	return channels, nil
+}
+
+// publishAlert publishes an alert to the user's webhooks and records it. Queued events are
+// sent by the webhook deliveries, which retry on their own; only an event that cannot be
+// queued is recorded as failed.
+func (s *NotificationService) publishAlert(ctx context.Context, userID uuid.UUID, p pendingNotification, now time.Time) (*models.NotificationLog, error) {
+	notification := p.notification
+	if link, ok := notificationLinks[notification.Event]; ok && s.appURL != "" {
+		notification.Link = s.appURL + link
+	}
+
+	fields := make(map[string]string, len(notification.Fields))
+	for _, field := range notification.Fields {
+		fields[field.Name] = field.Value
+	}
+	_, publishErr := s.publisher.Publish(ctx, userID, WebhookEventAlertTriggered, map[string]any{
+		"alert":   notification.Event,
+		"key":     p.key,
+		"subject": notification.Subject,
+		"body":    notification.Body,
+		"fields":  fields,
+		"link":    notification.Link,
+	})
+
+	log := &models.NotificationLog{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Channel:   NotificationChannelWebhook,
+		EventType: notification.Event,
+		DedupeKey: p.key,
+		Recipient: NotificationChannelWebhook,
+		Subject:   notification.Subject,
+		Status:    NotificationStatusSent,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if publishErr != nil {
+		message := publishErr.Error()
+		log.Status = NotificationStatusFailed
+		log.Error = &message
+	}
+	metrics.CountNotification(log.Channel, log.EventType, log.Status)
+
+	if err := s.notificationLogRepo.Upsert(ctx, log); err != nil {
+		return nil, errors.Join(publishErr, err)
+	}
+	return log, publishErr
+}
+
+// recipient returns the address notifications of the user go to
+func (s *NotificationService) recipient(ctx context.Context, userID uuid.UUID, preferences notificationPreferences) (string, error) {
+	if preferences.email != "" {
+		return preferences.email, nil
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.Email == "" {
+		return "", NewValidationError(NotificationEmailSettingKey, "no e-mail address to send notifications to")
+	}
+	return user.Email, nil
+}
+
+// loadPreferences reads the notification settings of the user. Settings the user did not store
+// take their registered default.
+func (s *NotificationService) loadPreferences(ctx context.Context, userID uuid.UUID) (notificationPreferences, error) {
+	settings, err := s.appSettingRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return notificationPreferences{}, err
+	}
+	values := make(map[string]string, len(settings))
+	for _, setting := range settings {
+		values[setting.Key] = setting.Value
+	}
+	value := func(key string) string {
+		if v, ok := values[key]; ok {
+			return v
+		}
+		definition, _ := lookupSetting(key)
+		return definition.Default
+	}
+
+	preferences := notificationPreferences{
+		email:          strings.TrimSpace(value(NotificationEmailSettingKey)),
+		chatRecipients: make(map[string]string, len(notificationChatChannels)),
+		locale:         value(NotificationLocaleSettingKey),
+		currency:       currency.Default,
+	}
+	for _, chat := range notificationChatChannels {
+		preferences.chatRecipients[chat.channel] = strings.TrimSpace(value(chat.settingKey))
+	}
+	preferences.enabled, _ = strconv.ParseBool(value(NotificationEnabledSettingKey))
+	preferences.emailEnabled, _ = strconv.ParseBool(value(NotificationEmailEnabledSettingKey))
+	preferences.events, _ = parseNotificationEvents(value(NotificationEventsSettingKey))
+	preferences.largeDebitThreshold, _ = strconv.ParseInt(value(NotificationLargeDebitThresholdSettingKey), 10, 64)
+	preferences.largeDebitDays, _ = strconv.Atoi(value(NotificationLargeDebitDaysSettingKey))
+	preferences.lowBalanceThreshold, _ = strconv.ParseInt(value(NotificationLowBalanceThresholdSettingKey), 10, 64)
+	preferences.lowBalanceDays, _ = strconv.Atoi(value(NotificationLowBalanceDaysSettingKey))
+	if code := values[BaseCurrencySettingKey]; code != "" {
+		if _, ok := currency.Lookup(code); ok {
+			preferences.currency = code
+		}
+	}
+	return preferences, nil
+}
+
+// parseNotificationEvents parses a comma separated list of events
+func parseNotificationEvents(value string) (map[string]bool, error) {
+	events := make(map[string]bool)
+	for _, event := range strings.Split(value, ",") {
+		event = strings.TrimSpace(event)
+		if event == "" {
+			continue
+		}
+		known := false
+		for _, e := range notificationEvents {
+			known = known || e == event
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown event %q; events are %s", event, strings.Join(notificationEvents, ", "))
+		}
+		events[event] = true
+	}
+	return events, nil
+}
+
+// detectNotifications finds the events of the day the user subscribed to
+func (s *NotificationService) detectNotifications(ctx context.Context, userID uuid.UUID, preferences notificationPreferences, now time.Time) ([]pendingNotification, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	events := preferences.events
+	pending := make([]pendingNotification, 0)
+
+	add := func(key, event string, data any) error {
+		notification, err := renderNotification(preferences.locale, event, data)
+		if err != nil {
+			return err
+		}
+		pending = append(pending, pendingNotification{key: key, notification: notification})
+		return nil
+	}
+
+	// Days of the projection the subscribed events look at
+	horizon := 0
+	if events[NotificationEventLargeDebit] {
+		horizon = max(horizon, preferences.largeDebitDays)
+	}
+	if events[NotificationEventLowBalance] {
+		horizon = max(horizon, preferences.lowBalanceDays)
+	}
+	if events[NotificationEventWeeklyDigest] && today.Weekday() == time.Monday {
+		horizon = max(horizon, weeklyDigestDays)
+	}
+
+	if horizon > 0 {
+		months := monthsBetween(today, today.AddDate(0, 0, horizon)) + 1
+		days, err := s.cashflowProjector.GetCashflowProjection(ctx, userID, months, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if events[NotificationEventLargeDebit] {
+			for _, debit := range findLargeDebits(days, today, preferences.largeDebitDays, preferences.largeDebitThreshold) {
+				data := largeDebitData{
+					Date:        debit.date.Format("2006-01-02"),
+					Description: debit.detail.Description,
+					Amount:      currency.Format(debit.detail.Amount, preferences.currency),
+					DaysLeft:    int(debit.date.Sub(today).Hours() / 24),
+				}
+				if err := add(largeDebitKey(data.Date, debit.detail), NotificationEventLargeDebit, data); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if events[NotificationEventLowBalance] {
+			if day := findLowBalance(days, today, preferences.lowBalanceDays, preferences.lowBalanceThreshold); day != nil {
+				data := lowBalanceData{
+					Date:      day.Date,
+					Balance:   currency.Format(day.Balance, preferences.currency),
+					Threshold: currency.Format(preferences.lowBalanceThreshold, preferences.currency),
+				}
+				if err := add(NotificationEventLowBalance+":"+day.Date, NotificationEventLowBalance, data); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if events[NotificationEventWeeklyDigest] && today.Weekday() == time.Monday {
+			year, week := today.ISOWeek()
+			key := fmt.Sprintf("%s:%d-W%02d", NotificationEventWeeklyDigest, year, week)
+			if err := add(key, NotificationEventWeeklyDigest, buildWeeklyDigest(days, today, preferences.currency)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if events[NotificationEventCardUnconfirmed] {
+		creditCards, err := s.creditCardRepo.GetAll(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, creditCard := range creditCards {
+			statementMonth, closingDate, ok := closedStatementMonth(creditCard, today)
+			if !ok || creditCard.CreatedAt.After(closingDate) {
+				continue
+			}
+
+			totals, err := s.cardMonthlyTotalRepo.GetByCreditCardID(ctx, creditCard.ID)
+			if err != nil {
+				return nil, err
+			}
+			var total *models.CardMonthlyTotal
+			for i := range totals {
+				if totals[i].YearMonth == statementMonth {
+					total = &totals[i]
+				}
+			}
+			if total != nil && total.IsConfirmed {
+				continue
+			}
+
+			data := cardUnconfirmedData{
+				Card:           creditCard.Name,
+				StatementMonth: statementMonth,
+				ClosingDate:    closingDate.Format("2006-01-02"),
+				Recorded:       total != nil,
+			}
+			key := NotificationEventCardUnconfirmed + ":" + creditCard.ID.String() + ":" + statementMonth
+			if err := add(key, NotificationEventCardUnconfirmed, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return pending, nil
+}
+
+// largeDebit is a projected debit on a day
+type largeDebit struct {
+	date   time.Time
+	detail models.CashflowProjectionDetail
+}
+
+// findLargeDebits returns the debits of at least threshold from today through the next days
+func findLargeDebits(days []models.CashflowProjection, today time.Time, within int, threshold int64) []largeDebit {
+	last := today.AddDate(0, 0, within)
+	debits := make([]largeDebit, 0)
+	for _, day := range days {
+		date, err := time.Parse("2006-01-02", day.Date)
+		if err != nil || date.Before(today) || date.After(last) {
+			continue
+		}
+		for _, detail := range day.Details {
+			if largeDebitTypes[detail.Type] && detail.Amount >= threshold {
+				debits = append(debits, largeDebit{date: date, detail: detail})
+			}
+		}
+	}
+	return debits
+}
+
+// largeDebitKey identifies a debit across runs
+func largeDebitKey(date string, detail models.CashflowProjectionDetail) string {
+	source := detail.Description
+	if detail.SourceID != nil {
+		source = detail.SourceID.String()
+	}
+	return NotificationEventLargeDebit + ":" + date + ":" + detail.Type + ":" + source
+}
+
+// findLowBalance returns the first day from today through the next days whose balance is
+// below threshold, nil when there is none
+func findLowBalance(days []models.CashflowProjection, today time.Time, within int, threshold int64) *models.CashflowProjection {
+	first, last := today.Format("2006-01-02"), today.AddDate(0, 0, within).Format("2006-01-02")
+	for i, day := range days {
+		if day.Date >= first && day.Date <= last && day.Balance < threshold {
+			return &days[i]
+		}
+	}
+	return nil
+}
+
+// closedStatementMonth returns the usage month of the card's latest closed statement and its
+// closing date. A statement closes at the end of its closing day, so on the closing day the
+// previous statement is the latest. Cards without a closing day have no statements to confirm.
+func closedStatementMonth(creditCard models.CreditCard, today time.Time) (string, time.Time, bool) {
+	if creditCard.ClosingDay == nil {
+		return "", time.Time{}, false
+	}
+
+	month := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	closingDate := clampedDate(month, *creditCard.ClosingDay)
+	if !today.After(closingDate) {
+		month = month.AddDate(0, -1, 0)
+		closingDate = clampedDate(month, *creditCard.ClosingDay)
+	}
+	return month.Format("2006-01"), closingDate, true
+}
+
+// clampedDate returns the day of the month, the last day when the month is shorter
+func clampedDate(month time.Time, day int) time.Time {
+	lastDay := month.AddDate(0, 1, -1).Day()
+	return time.Date(month.Year(), month.Month(), min(day, lastDay), 0, 0, 0, 0, time.UTC)
+}
+
+// buildWeeklyDigest sums up the projected days of the week starting today
+func buildWeeklyDigest(days []models.CashflowProjection, today time.Time, code string) weeklyDigestData {
+	from, to := today.Format("2006-01-02"), today.AddDate(0, 0, weeklyDigestDays-1).Format("2006-01-02")
+
+	// The week opens with the balance after the last projected day before it
+	var income, expense, balance int64
+	for i, day := range days {
+		if day.Date >= from {
+			if i > 0 {
+				balance = days[i-1].Balance
+			} else {
+				balance = day.Balance - day.Income + day.Expense
+			}
+			break
+		}
+		balance = day.Balance
+	}
+	lowest, lowestDate := balance, from
+
+	data := weeklyDigestData{From: from, To: to, Items: make([]weeklyDigestItem, 0)}
+	for _, day := range days {
+		if day.Date < from || day.Date > to {
+			continue
+		}
+		income += day.Income
+		expense += day.Expense
+		balance = day.Balance
+		if balance < lowest {
+			lowest, lowestDate = balance, day.Date
+		}
+
+		for _, detail := range day.Details {
+			if detail.Type == "transfer" {
+				continue
+			}
+			if len(data.Items) == weeklyDigestMaxItems {
+				data.More++
+				continue
+			}
+			sign := "-"
+			if detail.Type == "income" {
+				sign = "+"
+			}
+			data.Items = append(data.Items, weeklyDigestItem{
+				Date:        day.Date,
+				Description: detail.Description,
+				Amount:      sign + currency.Format(detail.Amount, code),
+			})
+		}
+	}
+
+	data.Income = currency.Format(income, code)
+	data.Expense = currency.Format(expense, code)
+	data.Balance = currency.Format(balance, code)
+	data.LowestBalance = currency.Format(lowest, code)
+	data.LowestDate = lowestDate
+	return data
+}
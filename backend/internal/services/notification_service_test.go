@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/notify"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNotifier keeps the notifications it is asked to deliver
+type recordingNotifier struct {
+	recipients    []string
+	notifications []notify.Notification
+	err           error
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, recipient string, notification notify.Notification) error {
+	n.recipients = append(n.recipients, recipient)
+	n.notifications = append(n.notifications, notification)
+	return n.err
+}
+
+func testNotificationProjection() []models.CashflowProjection {
+	rentID := uuid.New()
+	return []models.CashflowProjection{
+		{Date: "2025-01-17", Balance: 50000000},
+		{Date: "2025-01-21", Expense: 12000000, Balance: 38000000, Details: []models.CashflowProjectionDetail{
+			{Type: "recurring_payment", Description: "固定支出: 家賃", Amount: 12000000, SourceID: &rentID},
+			{Type: "transfer", Description: "振替: 貯蓄", Amount: 20000000},
+		}},
+		{Date: "2025-01-25", Income: 30000000, Expense: 5000000, Balance: 63000000, Details: []models.CashflowProjectionDetail{
+			{Type: "income", Description: "収入: 給与", Amount: 30000000},
+			{Type: "living_cost", Description: "生活費", Amount: 5000000},
+		}},
+		{Date: "2025-02-10", Expense: 70000000, Balance: -7000000, Details: []models.CashflowProjectionDetail{
+			{Type: "card_payment", Description: "カード支払い: Visa", Amount: 70000000},
+		}},
+	}
+}
+
+func TestFindLargeDebits(t *testing.T) {
+	days := testNotificationProjection()
+	today := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	debits := findLargeDebits(days, today, 3, 10000000)
+
+	require.Len(t, debits, 1, "transfers, income and later debits are left out")
+	assert.Equal(t, "固定支出: 家賃", debits[0].detail.Description)
+	assert.Empty(t, findLargeDebits(days, today, 3, 15000000))
+	assert.Len(t, findLargeDebits(days, today, 30, 10000000), 2)
+}
+
+func TestFindLowBalance(t *testing.T) {
+	days := testNotificationProjection()
+	today := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	day := findLowBalance(days, today, 30, 0)
+	require.NotNil(t, day)
+	assert.Equal(t, "2025-02-10", day.Date)
+
+	day = findLowBalance(days, today, 30, 40000000)
+	require.NotNil(t, day)
+	assert.Equal(t, "2025-01-21", day.Date, "the first day below the threshold")
+
+	assert.Nil(t, findLowBalance(days, today, 10, 0), "outside the watched period")
+}
+
+func TestClosedStatementMonth(t *testing.T) {
+	closingDay := 15
+	card := models.CreditCard{ClosingDay: &closingDay}
+
+	month, closingDate, ok := closedStatementMonth(card, time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, "2025-01", month)
+	assert.Equal(t, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), closingDate)
+
+	month, _, _ = closedStatementMonth(card, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2024-12", month, "the statement closes at the end of the closing day")
+
+	endOfMonth := 31
+	_, closingDate, _ = closedStatementMonth(models.CreditCard{ClosingDay: &endOfMonth}, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), closingDate)
+
+	_, _, ok = closedStatementMonth(models.CreditCard{}, time.Now())
+	assert.False(t, ok, "cards without a closing day")
+}
+
+func TestBuildWeeklyDigest(t *testing.T) {
+	days := testNotificationProjection()
+
+	digest := buildWeeklyDigest(days, time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), "JPY")
+
+	assert.Equal(t, "2025-01-20", digest.From)
+	assert.Equal(t, "2025-01-26", digest.To)
+	assert.Equal(t, "¥300,000", digest.Income)
+	assert.Equal(t, "¥170,000", digest.Expense)
+	assert.Equal(t, "¥630,000", digest.Balance)
+	assert.Equal(t, "¥380,000", digest.LowestBalance)
+	assert.Equal(t, "2025-01-21", digest.LowestDate)
+	assert.Equal(t, []weeklyDigestItem{
+		{Date: "2025-01-21", Description: "固定支出: 家賃", Amount: "-¥120,000"},
+		{Date: "2025-01-25", Description: "収入: 給与", Amount: "+¥300,000"},
+		{Date: "2025-01-25", Description: "生活費", Amount: "-¥50,000"},
+	}, digest.Items)
+
+	empty := buildWeeklyDigest(days, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), "JPY")
+	assert.Equal(t, "-¥70,000", empty.Balance, "the balance carries over from before the week")
+	assert.Empty(t, empty.Items)
+}
+
+func TestRenderNotification(t *testing.T) {
+	data := largeDebitData{Date: "2025-01-21", Description: "固定支出: 家賃", Amount: "¥120,000", DaysLeft: 1}
+
+	ja, err := renderNotification(NotificationLocaleJapanese, NotificationEventLargeDebit, data)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-21 に ¥120,000 の引き落とし予定があります", ja.Subject)
+	assert.Contains(t, ja.Body, "（1日後）")
+	assert.Equal(t, []notify.Field{
+		{Name: "内容", Value: "固定支出: 家賃"},
+		{Name: "金額", Value: "¥120,000"},
+		{Name: "引き落とし日", Value: "2025-01-21"},
+	}, ja.Fields)
+
+	en, err := renderNotification(NotificationLocaleEnglish, NotificationEventLargeDebit, data)
+	require.NoError(t, err)
+	assert.Equal(t, "¥120,000 will be debited on 2025-01-21", en.Subject)
+	assert.Contains(t, en.Body, "(tomorrow)")
+	assert.Equal(t, NotificationEventLargeDebit, en.Event)
+
+	for _, locale := range []string{NotificationLocaleJapanese, NotificationLocaleEnglish} {
+		for event, data := range map[string]any{
+			NotificationEventLowBalance:      lowBalanceData{},
+			NotificationEventCardUnconfirmed: cardUnconfirmedData{},
+			NotificationEventWeeklyDigest:    weeklyDigestData{Items: []weeklyDigestItem{{}}, More: 2},
+			NotificationEventTest:            nil,
+		} {
+			notification, err := renderNotification(locale, event, data)
+			require.NoError(t, err, locale+" "+event)
+			assert.NotEmpty(t, notification.Subject, locale+" "+event)
+		}
+	}
+}
+
+func TestNotificationService_SendNotifications(t *testing.T) {
+	disabled, enabled := uuid.New(), uuid.New()
+	closingDay := 15
+	card := models.CreditCard{ID: uuid.New(), Name: "Visa", ClosingDay: &closingDay, CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+
+	userRepo := new(mocks.MockUserRepository)
+	appSettingRepo := new(mocks.MockAppSettingRepository)
+	creditCardRepo := new(mocks.MockCreditCardRepository)
+	cardMonthlyTotalRepo := new(mocks.MockCardMonthlyTotalRepository)
+	cashflowProjector := new(mocks.MockCashflowService)
+	notificationLogRepo := new(mocks.MockNotificationLogRepository)
+
+	userRepo.On("GetAllIDs", mock.Anything).Return([]uuid.UUID{disabled, enabled}, nil)
+	appSettingRepo.On("GetByUserID", mock.Anything, disabled).Return([]models.AppSetting{}, nil)
+	appSettingRepo.On("GetByUserID", mock.Anything, enabled).Return([]models.AppSetting{
+		{Key: NotificationEmailEnabledSettingKey, Value: "true"},
+		{Key: NotificationEmailSettingKey, Value: "alerts@example.com"},
+		{Key: NotificationEventsSettingKey, Value: "large_debit,card_unconfirmed"},
+		{Key: BaseCurrencySettingKey, Value: "JPY"},
+	}, nil)
+	cashflowProjector.On("GetCashflowProjection", mock.Anything, enabled, 1, true).Return(testNotificationProjection(), nil)
+	creditCardRepo.On("GetAll", mock.Anything, enabled).Return([]models.CreditCard{card}, nil)
+	cardMonthlyTotalRepo.On("GetByCreditCardID", mock.Anything, card.ID).Return([]models.CardMonthlyTotal{
+		{YearMonth: "2024-12", IsConfirmed: true},
+		{YearMonth: "2025-01", IsConfirmed: false},
+	}, nil)
+	notificationLogRepo.On("WasSent", mock.Anything, enabled, NotificationChannelEmail, mock.MatchedBy(func(key string) bool {
+		return key == "card_unconfirmed:"+card.ID.String()+":2025-01"
+	})).Return(true, nil)
+	notificationLogRepo.On("WasSent", mock.Anything, enabled, NotificationChannelEmail, mock.Anything).Return(false, nil)
+	notificationLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(log *models.NotificationLog) bool {
+		return log.UserID == enabled && log.EventType == NotificationEventLargeDebit && log.Status == NotificationStatusSent &&
+			log.Recipient == "alerts@example.com" && log.CreatedAt.Equal(now)
+	})).Return(nil)
+
+	notifier := &recordingNotifier{}
+	service := NewNotificationService(notificationLogRepo, userRepo, appSettingRepo, creditCardRepo, cardMonthlyTotalRepo, cashflowProjector, map[string]notify.Notifier{NotificationChannelEmail: notifier}, nil, "https://flow.example.com/")
+	run, err := service.SendNotifications(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.NotificationRun{Users: 2, Sent: 1, Skipped: 1}, run)
+	require.Len(t, notifier.notifications, 1, "the unconfirmed statement was already sent")
+	assert.Equal(t, []string{"alerts@example.com"}, notifier.recipients)
+	assert.Equal(t, "2025-01-21 に ¥120,000 の引き落とし予定があります", notifier.notifications[0].Subject)
+	assert.Equal(t, "https://flow.example.com/cashflow", notifier.notifications[0].Link)
+	notificationLogRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_SendTestNotification(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+
+	_, err := NewNotificationService(nil, nil, nil, nil, nil, nil, nil, nil, "").SendTestNotification(context.Background(), userID, "", now)
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr, "no mail server")
+
+	userRepo := new(mocks.MockUserRepository)
+	appSettingRepo := new(mocks.MockAppSettingRepository)
+	notificationLogRepo := new(mocks.MockNotificationLogRepository)
+	appSettingRepo.On("GetByUserID", mock.Anything, userID).Return([]models.AppSetting{{Key: NotificationLocaleSettingKey, Value: "en"}}, nil)
+	userRepo.On("GetByID", mock.Anything, userID).Return(&models.User{ID: userID, Email: "user@example.com"}, nil)
+	notificationLogRepo.On("Upsert", mock.Anything, mock.AnythingOfType("*models.NotificationLog")).Return(nil)
+
+	notifier := &recordingNotifier{err: errors.New("connection refused")}
+	service := NewNotificationService(notificationLogRepo, userRepo, appSettingRepo, nil, nil, nil, map[string]notify.Notifier{NotificationChannelEmail: notifier}, nil, "")
+	log, err := service.SendTestNotification(context.Background(), userID, "", now)
+
+	require.NoError(t, err, "a failed delivery is recorded in the log")
+	assert.Equal(t, NotificationStatusFailed, log.Status)
+	assert.Equal(t, "connection refused", *log.Error)
+	assert.Equal(t, "user@example.com", log.Recipient)
+	assert.Equal(t, "Flow Sight test notification", log.Subject)
+	assert.Empty(t, notifier.notifications[0].Link)
+}
+
+func TestNotificationService_SendNotificationsToWebhooks(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+
+	userRepo := new(mocks.MockUserRepository)
+	appSettingRepo := new(mocks.MockAppSettingRepository)
+	cashflowProjector := new(mocks.MockCashflowService)
+	notificationLogRepo := new(mocks.MockNotificationLogRepository)
+	publisher := new(mocks.MockEventPublisher)
+
+	userRepo.On("GetAllIDs", mock.Anything).Return([]uuid.UUID{userID}, nil)
+	appSettingRepo.On("GetByUserID", mock.Anything, userID).Return([]models.AppSetting{
+		{Key: NotificationEventsSettingKey, Value: "large_debit"},
+		{Key: BaseCurrencySettingKey, Value: "JPY"},
+	}, nil)
+	cashflowProjector.On("GetCashflowProjection", mock.Anything, userID, 1, true).Return(testNotificationProjection(), nil)
+	notificationLogRepo.On("WasSent", mock.Anything, userID, NotificationChannelWebhook, mock.Anything).Return(false, nil)
+	notificationLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(log *models.NotificationLog) bool {
+		return log.Channel == NotificationChannelWebhook && log.EventType == NotificationEventLargeDebit && log.Status == NotificationStatusSent
+	})).Return(nil)
+	publisher.On("Publish", mock.Anything, userID, WebhookEventAlertTriggered, mock.MatchedBy(func(data map[string]any) bool {
+		return data["alert"] == NotificationEventLargeDebit && data["link"] == "https://flow.example.com/cashflow"
+	})).Return(1, nil)
+
+	service := NewNotificationService(notificationLogRepo, userRepo, appSettingRepo, nil, nil, cashflowProjector, nil, publisher, "https://flow.example.com")
+	run, err := service.SendNotifications(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.NotificationRun{Users: 1, Sent: 1}, run, "alerts are published without e-mail")
+	publisher.AssertExpectations(t)
+	notificationLogRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_SendNotificationsToChat(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+	slackURL := "https://hooks.slack.com/services/T000/B000/secret"
+
+	userRepo := new(mocks.MockUserRepository)
+	appSettingRepo := new(mocks.MockAppSettingRepository)
+	cashflowProjector := new(mocks.MockCashflowService)
+	notificationLogRepo := new(mocks.MockNotificationLogRepository)
+
+	userRepo.On("GetAllIDs", mock.Anything).Return([]uuid.UUID{userID}, nil)
+	appSettingRepo.On("GetByUserID", mock.Anything, userID).Return([]models.AppSetting{
+		{Key: NotificationEventsSettingKey, Value: "large_debit"},
+		{Key: NotificationSlackWebhookURLSettingKey, Value: " " + slackURL + " "},
+		{Key: NotificationLINETokenSettingKey, Value: "token-123"},
+		{Key: BaseCurrencySettingKey, Value: "JPY"},
+	}, nil)
+	cashflowProjector.On("GetCashflowProjection", mock.Anything, userID, 1, true).Return(testNotificationProjection(), nil)
+	notificationLogRepo.On("WasSent", mock.Anything, userID, NotificationChannelSlack, mock.Anything).Return(false, nil)
+	notificationLogRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(log *models.NotificationLog) bool {
+		return log.Channel == NotificationChannelSlack && log.Recipient == "hooks.slack.com" && log.Status == NotificationStatusSent
+	})).Return(nil)
+
+	slack := &recordingNotifier{}
+	service := NewNotificationService(notificationLogRepo, userRepo, appSettingRepo, nil, nil, cashflowProjector, map[string]notify.Notifier{NotificationChannelSlack: slack}, nil, "https://flow.example.com")
+	run, err := service.SendNotifications(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.NotificationRun{Users: 1, Sent: 1}, run, "LINE is skipped without a LINE notifier")
+	assert.Equal(t, []string{slackURL}, slack.recipients)
+	assert.Equal(t, "https://flow.example.com/cashflow", slack.notifications[0].Link)
+	notificationLogRepo.AssertExpectations(t)
+	userRepo.AssertNotCalled(t, "GetByID", mock.Anything, userID)
+}
+
+func TestNotificationService_SendTestNotificationChannels(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+
+	appSettingRepo := new(mocks.MockAppSettingRepository)
+	appSettingRepo.On("GetByUserID", mock.Anything, userID).Return([]models.AppSetting{}, nil)
+	notifiers := map[string]notify.Notifier{NotificationChannelDiscord: &recordingNotifier{}}
+	service := NewNotificationService(nil, nil, appSettingRepo, nil, nil, nil, notifiers, nil, "")
+
+	tests := []struct {
+		channel       string
+		expectedField string
+	}{
+		{channel: "sms", expectedField: "channel"},
+		{channel: NotificationChannelSlack, expectedField: NotificationChannelSlack},
+		{channel: NotificationChannelDiscord, expectedField: NotificationDiscordWebhookURLSettingKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			_, err := service.SendTestNotification(context.Background(), userID, tt.channel, now)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Soli0222/flow-sight/backend/internal/notify"
+)
+
+// Languages notifications are written in
+const (
+	NotificationLocaleJapanese = "ja"
+	NotificationLocaleEnglish  = "en"
+)
+
+// notificationTemplateText defines three templates per event and language: "<event>.subject",
+// "<event>.body" and "<event>.fields", whose lines are "name: value" pairs of the key figures
+var notificationTemplateText = map[string]string{
+	NotificationLocaleJapanese: `
+{{define "large_debit.subject"}}{{.Date}} に {{.Amount}} の引き落とし予定があります{{end}}
+{{define "large_debit.body"}}{{.Date}}（{{if eq .DaysLeft 0}}本日{{else}}{{.DaysLeft}}日後{{end}}）に次の引き落としが予定されています。
+
+{{.Description}}
+金額: {{.Amount}}
+
+引き落とし口座の残高をご確認ください。{{end}}
+{{define "large_debit.fields"}}内容: {{.Description}}
+金額: {{.Amount}}
+引き落とし日: {{.Date}}{{end}}
+
+{{define "low_balance.subject"}}{{.Date}} に残高が {{.Balance}} まで減る見込みです{{end}}
+{{define "low_balance.body"}}キャッシュフロー予測で、{{.Date}} に残高が {{.Balance}} となり、通知の基準額 {{.Threshold}} を下回る見込みです。
+
+入金予定の確認や支出の見直しをご検討ください。{{end}}
+{{define "low_balance.fields"}}日付: {{.Date}}
+予測残高: {{.Balance}}
+基準額: {{.Threshold}}{{end}}
+
+{{define "card_unconfirmed.subject"}}{{.Card}} の {{.StatementMonth}} 分の利用額が未確定です{{end}}
+{{define "card_unconfirmed.body"}}{{.Card}} は {{.ClosingDate}} に締め日を過ぎましたが、{{.StatementMonth}} 分の月次利用額が{{if .Recorded}}確定されていません{{else}}登録されていません{{end}}。
+
+請求額を確認して、カード月次利用額を登録・確定してください。{{end}}
+{{define "card_unconfirmed.fields"}}カード: {{.Card}}
+利用月: {{.StatementMonth}}
+締め日: {{.ClosingDate}}{{end}}
+
+{{define "weekly_digest.subject"}}今週の入出金予定（{{.From}} 〜 {{.To}}）{{end}}
+{{define "weekly_digest.body"}}{{.From}} 〜 {{.To}} の入出金予定です。
+
+収入: {{.Income}}
+支出: {{.Expense}}
+週末の残高: {{.Balance}}
+最低残高: {{.LowestBalance}}（{{.LowestDate}}）
+{{if .Items}}
+{{range .Items}}{{.Date}} {{.Description}} {{.Amount}}
+{{end}}{{if .More}}ほか {{.More}} 件
+{{end}}{{else}}
+今週の予定はありません。
+{{end}}{{end}}
+{{define "weekly_digest.fields"}}収入: {{.Income}}
+支出: {{.Expense}}
+週末の残高: {{.Balance}}
+最低残高: {{.LowestBalance}}{{end}}
+
+{{define "test.subject"}}Flow Sight のテスト通知{{end}}
+{{define "test.body"}}Flow Sight からのテスト通知です。このメッセージが届いていれば、通知の設定は完了しています。{{end}}
+{{define "test.fields"}}{{end}}
+`,
+	NotificationLocaleEnglish: `
+{{define "large_debit.subject"}}{{.Amount}} will be debited on {{.Date}}{{end}}
+{{define "large_debit.body"}}The following debit is scheduled for {{.Date}} ({{if eq .DaysLeft 0}}today{{else if eq .DaysLeft 1}}tomorrow{{else}}in {{.DaysLeft}} days{{end}}).
+
+{{.Description}}
+Amount: {{.Amount}}
+
+Please make sure the account has enough funds.{{end}}
+{{define "large_debit.fields"}}Item: {{.Description}}
+Amount: {{.Amount}}
+Date: {{.Date}}{{end}}
+
+{{define "low_balance.subject"}}Your balance is projected to fall to {{.Balance}} on {{.Date}}{{end}}
+{{define "low_balance.body"}}According to the cashflow projection, your balance will be {{.Balance}} on {{.Date}}, below your alert threshold of {{.Threshold}}.
+
+Consider checking upcoming income or reducing expenses.{{end}}
+{{define "low_balance.fields"}}Date: {{.Date}}
+Projected balance: {{.Balance}}
+Threshold: {{.Threshold}}{{end}}
+
+{{define "card_unconfirmed.subject"}}The {{.StatementMonth}} statement of {{.Card}} is not confirmed{{end}}
+{{define "card_unconfirmed.body"}}{{.Card}} closed on {{.ClosingDate}}, but the monthly total for {{.StatementMonth}} has not been {{if .Recorded}}confirmed{{else}}recorded{{end}}.
+
+Please check the statement and record and confirm the card's monthly total.{{end}}
+{{define "card_unconfirmed.fields"}}Card: {{.Card}}
+Statement month: {{.StatementMonth}}
+Closing date: {{.ClosingDate}}{{end}}
+
+{{define "weekly_digest.subject"}}Your week ahead ({{.From}} – {{.To}}){{end}}
+{{define "weekly_digest.body"}}Here is what is scheduled from {{.From}} to {{.To}}.
+
+Income: {{.Income}}
+Expenses: {{.Expense}}
+Balance at the end of the week: {{.Balance}}
+Lowest balance: {{.LowestBalance}} ({{.LowestDate}})
+{{if .Items}}
+{{range .Items}}{{.Date}} {{.Description}} {{.Amount}}
+{{end}}{{if .More}}and {{.More}} more
+{{end}}{{else}}
+Nothing is scheduled this week.
+{{end}}{{end}}
+{{define "weekly_digest.fields"}}Income: {{.Income}}
+Expenses: {{.Expense}}
+Balance at the end of the week: {{.Balance}}
+Lowest balance: {{.LowestBalance}}{{end}}
+
+{{define "test.subject"}}Flow Sight test notification{{end}}
+{{define "test.body"}}This is a test notification from Flow Sight. If you can read this, your notifications are set up.{{end}}
+{{define "test.fields"}}{{end}}
+`,
+}
+
+// notificationTemplates are the parsed templates by language
+var notificationTemplates = parseNotificationTemplates()
+
+func parseNotificationTemplates() map[string]*template.Template {
+	templates := make(map[string]*template.Template, len(notificationTemplateText))
+	for locale, text := range notificationTemplateText {
+		templates[locale] = template.Must(template.New(locale).Parse(text))
+	}
+	return templates
+}
+
+// Template data of the events
+type (
+	largeDebitData struct {
+		Date        string
+		Description string
+		Amount      string
+		DaysLeft    int
+	}
+	lowBalanceData struct {
+		Date      string
+		Balance   string
+		Threshold string
+	}
+	cardUnconfirmedData struct {
+		Card           string
+		StatementMonth string
+		ClosingDate    string
+		Recorded       bool // Whether a total was recorded but not confirmed
+	}
+	weeklyDigestData struct {
+		From          string
+		To            string
+		Income        string
+		Expense       string
+		Balance       string
+		LowestBalance string
+		LowestDate    string
+		Items         []weeklyDigestItem
+		More          int // Items left out of the list
+	}
+	weeklyDigestItem struct {
+		Date        string
+		Description string
+		Amount      string
+	}
+)
+
+// renderNotification writes the notification of an event in the language, English when the
+// language is unknown
+func renderNotification(locale, event string, data any) (notify.Notification, error) {
+	templates, ok := notificationTemplates[locale]
+	if !ok {
+		templates = notificationTemplates[NotificationLocaleEnglish]
+	}
+
+	execute := func(part string) (string, error) {
+		var b bytes.Buffer
+		if err := templates.ExecuteTemplate(&b, event+"."+part, data); err != nil {
+			return "", fmt.Errorf("render %s notification: %w", event, err)
+		}
+		return strings.TrimSpace(b.String()), nil
+	}
+
+	subject, err := execute("subject")
+	if err != nil {
+		return notify.Notification{}, err
+	}
+	body, err := execute("body")
+	if err != nil {
+		return notify.Notification{}, err
+	}
+	fieldLines, err := execute("fields")
+	if err != nil {
+		return notify.Notification{}, err
+	}
+
+	notification := notify.Notification{Event: event, Subject: subject, Body: body}
+	for _, line := range strings.Split(fieldLines, "\n") {
+		if name, value, found := strings.Cut(line, ": "); found {
+			notification.Fields = append(notification.Fields, notify.Field{Name: name, Value: value})
+		}
+	}
+	return notification, nil
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// validateBankAccountOwner checks that a referenced bank account exists and belongs to the user,
+// so items cannot point at the accounts of other users
+func validateBankAccountOwner(ctx context.Context, bankAccountRepo BankAccountRepositoryInterface, userID, accountID uuid.UUID, field string) error {
+	account, err := bankAccountRepo.GetByID(ctx, accountID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && account.UserID != userID) {
+		return NewValidationError(field, "is not one of your bank accounts")
+	}
+	return err
+}
+
+// validateCreditCardOwner checks that a referenced credit card exists and belongs to the user
+func validateCreditCardOwner(ctx context.Context, creditCardRepo CreditCardRepositoryInterface, userID, creditCardID uuid.UUID, field string) error {
+	creditCard, err := creditCardRepo.GetByID(ctx, creditCardID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && creditCard.UserID != userID) {
+		return NewValidationError(field, "is not one of your credit cards")
+	}
+	return err
+}
+
+// validateCategoryOwner checks that a referenced category exists and belongs to the user
+func validateCategoryOwner(ctx context.Context, categoryRepo CategoryRepositoryInterface, userID, categoryID uuid.UUID, field string) error {
+	category, err := categoryRepo.GetByID(ctx, categoryID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && category.UserID != userID) {
+		return NewValidationError(field, "is not one of your categories")
+	}
+	return err
+}
+
+// validateItemCategory checks the optional category of an income source, payment or card
+// against the categories of the user
+func validateItemCategory(ctx context.Context, categoryRepo CategoryRepositoryInterface, userID uuid.UUID, categoryID *uuid.UUID) error {
+	if categoryID == nil {
+		return nil
+	}
+	return validateCategoryOwner(ctx, categoryRepo, userID, *categoryID, "category_id")
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/recurrence"
+)
+
+// recurringPaymentRule returns the effective recurrence rule of a payment and the first date it can occur.
+// Payments without a rule repeat monthly on PaymentDay. PaymentDay fills in BYMONTHDAY for monthly and
+// yearly rules, and TotalPayments acts as COUNT when the rule does not limit itself.
+func recurringPaymentRule(payment models.RecurringPayment) (*recurrence.Rule, time.Time, error) {
+	startYear, startMonth, err := parseYearMonth(payment.StartYearMonth)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	rule := &recurrence.Rule{Freq: recurrence.Monthly, Interval: 1}
+	if payment.RecurrenceRule != nil && *payment.RecurrenceRule != "" {
+		rule, err = recurrence.Parse(*payment.RecurrenceRule)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	dtstart := time.Date(startYear, time.Month(startMonth), 1, 0, 0, 0, 0, time.UTC)
+
+	switch rule.Freq {
+	case recurrence.Monthly, recurrence.Yearly:
+		if len(rule.ByMonthDay) == 0 && payment.PaymentDay > 0 {
+			rule.ByMonthDay = []int{payment.PaymentDay}
+		}
+	default:
+		// Daily and weekly rules are anchored on the first payment date
+		day := payment.PaymentDay
+		if last := time.Date(startYear, time.Month(startMonth)+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+			day = last
+		}
+		if day > 0 {
+			dtstart = dtstart.AddDate(0, 0, day-1)
+		}
+	}
+
+	if rule.Count == 0 && rule.Until == nil && payment.TotalPayments != nil && *payment.TotalPayments > 0 {
+		rule.Count = *payment.TotalPayments
+	}
+
+	return rule, dtstart, nil
+}
+
+// recurringPaymentOccurrences returns the dates from "from" to "to" on which the payment is due
+func recurringPaymentOccurrences(payment models.RecurringPayment, from, to time.Time) []time.Time {
+	if !payment.IsActive {
+		return nil
+	}
+
+	rule, dtstart, err := recurringPaymentRule(payment)
+	if err != nil {
+		return nil
+	}
+
+	return rule.Between(dtstart, from, to)
+}
+
+// recurringPaymentSchedule maps each date ("2006-01-02") in the range to the payments due on it,
+// keeping the order of the given payments. Loan payments carry the amount of their amortized
+// instalment, with the simulated prepayments applied; the prepayments themselves are returned
+// in a separate map by date.
+func recurringPaymentSchedule(payments []models.RecurringPayment, prepayments []models.LoanPrepayment, from, to time.Time) (map[string][]models.RecurringPayment, map[string][]scheduledPrepayment, error) {
+	for _, prepayment := range prepayments {
+		if payment := findRecurringPayment(payments, prepayment.RecurringPaymentID); payment == nil || !payment.IsActive || !isLoan(*payment) {
+			return nil, nil, NewValidationError("prepayment", "recurring payment %s is not an active loan", prepayment.RecurringPaymentID)
+		}
+	}
+
+	schedule := make(map[string][]models.RecurringPayment)
+	prepaymentSchedule := make(map[string][]scheduledPrepayment)
+	for _, payment := range payments {
+		if payment.IsActive && isLoan(payment) {
+			installments, prepaid, err := loanPaymentSchedule(payment, prepayments, from, to)
+			if err != nil {
+				return nil, nil, err
+			}
+			for date, amount := range installments {
+				due := payment
+				due.Amount = amount
+				schedule[date] = append(schedule[date], due)
+			}
+			for date, amount := range prepaid {
+				prepaymentSchedule[date] = append(prepaymentSchedule[date], scheduledPrepayment{payment: payment, amount: amount})
+			}
+			continue
+		}
+
+		for _, date := range recurringPaymentOccurrences(payment, from, to) {
+			key := date.Format("2006-01-02")
+			schedule[key] = append(schedule[key], payment)
+		}
+	}
+	return schedule, prepaymentSchedule, nil
+}
+
+// recurringPaymentProgress returns how many payments are still due after today. Only payments
+// limited by COUNT or UNTIL have a remaining count; for the others ok is false. The result depends
+// only on the schedule and the date, so computing it again gives the same answer.
+func recurringPaymentProgress(payment models.RecurringPayment, today time.Time) (int, bool) {
+	rule, dtstart, err := recurringPaymentRule(payment)
+	if err != nil {
+		return 0, false
+	}
+
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case rule.Count > 0:
+		paid := len(rule.Between(dtstart, dtstart, today))
+		return rule.Count - paid, true
+	case rule.Until != nil:
+		return len(rule.Between(dtstart, today.AddDate(0, 0, 1), *rule.Until)), true
+	}
+	return 0, false
+}
+
+// normalizeRecurringPayment validates the recurrence settings of a payment and stores its rule in
+// canonical form. A payment without a rule gets the monthly rule equivalent to its legacy fields;
+// when a rule is given it takes precedence and PaymentDay/TotalPayments are kept in sync with it.
+func normalizeRecurringPayment(payment *models.RecurringPayment) error {
+	tags, err := normalizeTags(payment.Tags)
+	if err != nil {
+		return err
+	}
+	payment.Tags = tags
+
+	if err := normalizeCurrency("currency", &payment.Currency); err != nil {
+		return err
+	}
+	if payment.PaymentDay < 1 || payment.PaymentDay > 31 {
+		return NewValidationError("payment_day", "must be between 1 and 31")
+	}
+	if _, _, err := parseYearMonth(payment.StartYearMonth); err != nil {
+		return NewValidationError("start_year_month", "must be in YYYY-MM format")
+	}
+
+	var rule *recurrence.Rule
+	if payment.RecurrenceRule == nil || *payment.RecurrenceRule == "" {
+		rule = &recurrence.Rule{Freq: recurrence.Monthly, Interval: 1, ByMonthDay: []int{payment.PaymentDay}}
+		if payment.TotalPayments != nil && *payment.TotalPayments > 0 {
+			rule.Count = *payment.TotalPayments
+		}
+	} else {
+		parsed, err := recurrence.Parse(*payment.RecurrenceRule)
+		if err != nil {
+			return NewValidationError("recurrence_rule", "%s", err.Error())
+		}
+		rule = parsed
+
+		if rule.Count > 0 {
+			count := rule.Count
+			payment.TotalPayments = &count
+		} else if rule.Until == nil && payment.TotalPayments != nil && *payment.TotalPayments > 0 {
+			rule.Count = *payment.TotalPayments
+		}
+
+		if rule.Freq == recurrence.Monthly || rule.Freq == recurrence.Yearly {
+			if len(rule.ByMonthDay) == 0 {
+				rule.ByMonthDay = []int{payment.PaymentDay}
+			} else if rule.ByMonthDay[0] > 0 {
+				payment.PaymentDay = rule.ByMonthDay[0]
+			}
+		}
+	}
+
+	canonical := rule.String()
+	payment.RecurrenceRule = &canonical
+	return normalizeLoan(payment)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRecurringPayment(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name                  string
+		payment               models.RecurringPayment
+		expectedRule          string
+		expectedPaymentDay    int
+		expectedTotalPayments *int
+		expectedError         bool
+	}{
+		{
+			name:               "legacy monthly payment",
+			payment:            models.RecurringPayment{PaymentDay: 27, StartYearMonth: "2024-01"},
+			expectedRule:       "FREQ=MONTHLY;BYMONTHDAY=27",
+			expectedPaymentDay: 27,
+		},
+		{
+			name:                  "legacy loan",
+			payment:               models.RecurringPayment{PaymentDay: 5, StartYearMonth: "2024-01", TotalPayments: intPtr(36)},
+			expectedRule:          "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=36",
+			expectedPaymentDay:    5,
+			expectedTotalPayments: intPtr(36),
+		},
+		{
+			name:               "yearly rule sets payment day",
+			payment:            models.RecurringPayment{PaymentDay: 1, StartYearMonth: "2024-01", RecurrenceRule: strPtr("freq=yearly;bymonth=5;bymonthday=31")},
+			expectedRule:       "FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=31",
+			expectedPaymentDay: 31,
+		},
+		{
+			name:               "rule without month day uses payment day",
+			payment:            models.RecurringPayment{PaymentDay: 10, StartYearMonth: "2024-01", RecurrenceRule: strPtr("FREQ=MONTHLY;INTERVAL=3")},
+			expectedRule:       "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=10",
+			expectedPaymentDay: 10,
+		},
+		{
+			name:                  "rule count sets total payments",
+			payment:               models.RecurringPayment{PaymentDay: 10, StartYearMonth: "2024-01", RecurrenceRule: strPtr("FREQ=WEEKLY;COUNT=20"), TotalPayments: intPtr(5)},
+			expectedRule:          "FREQ=WEEKLY;COUNT=20",
+			expectedPaymentDay:    10,
+			expectedTotalPayments: intPtr(20),
+		},
+		{
+			name:          "invalid rule",
+			payment:       models.RecurringPayment{PaymentDay: 10, StartYearMonth: "2024-01", RecurrenceRule: strPtr("FREQ=SOMETIMES")},
+			expectedError: true,
+		},
+		{
+			name:          "invalid payment day",
+			payment:       models.RecurringPayment{PaymentDay: 0, StartYearMonth: "2024-01"},
+			expectedError: true,
+		},
+		{
+			name:          "invalid start year month",
+			payment:       models.RecurringPayment{PaymentDay: 10, StartYearMonth: "2024/01"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment := tt.payment
+			err := normalizeRecurringPayment(&payment)
+
+			if tt.expectedError {
+				var validationErr *ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, payment.RecurrenceRule)
+			assert.Equal(t, tt.expectedRule, *payment.RecurrenceRule)
+			assert.Equal(t, tt.expectedPaymentDay, payment.PaymentDay)
+			assert.Equal(t, tt.expectedTotalPayments, payment.TotalPayments)
+		})
+	}
+}
+
+func TestRecurringPaymentSchedule(t *testing.T) {
+	weekly := "FREQ=WEEKLY"
+	yearly := "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=20"
+	payments := []models.RecurringPayment{
+		{Name: "Childcare", PaymentDay: 3, StartYearMonth: "2025-03", RecurrenceRule: &weekly, IsActive: true},
+		{Name: "Insurance", PaymentDay: 20, StartYearMonth: "2025-01", RecurrenceRule: &yearly, IsActive: true},
+		{Name: "Stopped", PaymentDay: 20, StartYearMonth: "2025-01", IsActive: false},
+	}
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	schedule, prepayments, err := recurringPaymentSchedule(payments, nil, from, to)
+	require.NoError(t, err)
+	assert.Empty(t, prepayments)
+
+	dates := make([]string, 0)
+	for date, due := range schedule {
+		for _, payment := range due {
+			dates = append(dates, date+" "+payment.Name)
+		}
+	}
+
+	assert.ElementsMatch(t, []string{
+		"2025-03-03 Childcare",
+		"2025-03-10 Childcare",
+		"2025-03-17 Childcare",
+		"2025-03-24 Childcare",
+		"2025-03-31 Childcare",
+		"2025-03-20 Insurance",
+	}, dates)
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"time"
 
@@ -9,35 +10,200 @@ import (
 
 type RecurringPaymentService struct {
 	recurringPaymentRepo RecurringPaymentRepositoryInterface
+	categoryRepo         CategoryRepositoryInterface
+	publisher            EventPublisherInterface // nil when changes are not published
 }
 
-func NewRecurringPaymentService(recurringPaymentRepo RecurringPaymentRepositoryInterface) *RecurringPaymentService {
+func NewRecurringPaymentService(recurringPaymentRepo RecurringPaymentRepositoryInterface, categoryRepo CategoryRepositoryInterface, publisher EventPublisherInterface) *RecurringPaymentService {
 	return &RecurringPaymentService{
 		recurringPaymentRepo: recurringPaymentRepo,
+		categoryRepo:         categoryRepo,
+		publisher:            publisher,
 	}
 }
 
-func (s *RecurringPaymentService) GetRecurringPayments(userID uuid.UUID) ([]models.RecurringPayment, error) {
-	return s.recurringPaymentRepo.GetAll(userID)
+func (s *RecurringPaymentService) GetRecurringPayments(ctx context.Context, userID uuid.UUID) ([]models.RecurringPayment, error) {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.GetRecurringPayments")
+	defer span.End()
+
+	payments, err := s.recurringPaymentRepo.GetAll(ctx, userID)
+	if err != nil {
+		return payments, err
+	}
+
+	now := time.Now()
+	for i := range payments {
+		withRemainingPrincipal(&payments[i], now)
+	}
+	return payments, nil
 }
 
-func (s *RecurringPaymentService) GetRecurringPayment(id uuid.UUID) (*models.RecurringPayment, error) {
-	return s.recurringPaymentRepo.GetByID(id)
+func (s *RecurringPaymentService) GetRecurringPayment(ctx context.Context, id uuid.UUID) (*models.RecurringPayment, error) {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.GetRecurringPayment")
+	defer span.End()
+
+	payment, err := s.recurringPaymentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	withRemainingPrincipal(payment, time.Now())
+	return payment, nil
 }
 
-func (s *RecurringPaymentService) CreateRecurringPayment(payment *models.RecurringPayment) error {
+// GetAmortizationSchedule returns the repayment schedule of a loan, optionally with a simulated prepayment
+func (s *RecurringPaymentService) GetAmortizationSchedule(ctx context.Context, id uuid.UUID, prepayment *models.LoanPrepayment) (*models.AmortizationSchedule, error) {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.GetAmortizationSchedule")
+	defer span.End()
+
+	payment, err := s.recurringPaymentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isLoan(*payment) {
+		return nil, NewValidationError("loan_principal", "recurring payment is not a loan")
+	}
+
+	var prepayments []models.LoanPrepayment
+	if prepayment != nil {
+		prepayment.RecurringPaymentID = payment.ID
+		prepayments = append(prepayments, *prepayment)
+	}
+
+	return loanAmortization(*payment, prepayments, time.Now())
+}
+
+func (s *RecurringPaymentService) CreateRecurringPayment(ctx context.Context, payment *models.RecurringPayment) error {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.CreateRecurringPayment")
+	defer span.End()
+
+	if err := normalizeRecurringPayment(payment); err != nil {
+		return err
+	}
+	if err := validateItemCategory(ctx, s.categoryRepo, payment.UserID, payment.CategoryID); err != nil {
+		return err
+	}
+	if remaining, ok := recurringPaymentProgress(*payment, time.Now()); ok {
+		payment.RemainingPayments = &remaining
+	}
+
 	payment.ID = uuid.New()
 	payment.CreatedAt = time.Now()
 	payment.UpdatedAt = time.Now()
 
-	return s.recurringPaymentRepo.Create(payment)
+	if err := s.recurringPaymentRepo.Create(ctx, payment); err != nil {
+		return err
+	}
+	publishEvent(ctx, s.publisher, payment.UserID, entityEvent(EntityRecurringPayment, EntityCreated), payment)
+	return nil
 }
 
-func (s *RecurringPaymentService) UpdateRecurringPayment(payment *models.RecurringPayment) error {
+func (s *RecurringPaymentService) UpdateRecurringPayment(ctx context.Context, payment *models.RecurringPayment) error {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.UpdateRecurringPayment")
+	defer span.End()
+
+	if err := normalizeRecurringPayment(payment); err != nil {
+		return err
+	}
+	if payment.CategoryID != nil {
+		existing, err := s.recurringPaymentRepo.GetByID(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		if err := validateItemCategory(ctx, s.categoryRepo, existing.UserID, payment.CategoryID); err != nil {
+			return err
+		}
+	}
+	if remaining, ok := recurringPaymentProgress(*payment, time.Now()); ok {
+		payment.RemainingPayments = &remaining
+	}
+
 	payment.UpdatedAt = time.Now()
-	return s.recurringPaymentRepo.Update(payment)
+	if err := s.recurringPaymentRepo.Update(ctx, payment); err != nil {
+		return err
+	}
+	if stored := s.storedForEvent(ctx, payment.ID); stored != nil {
+		publishEvent(ctx, s.publisher, stored.UserID, entityEvent(EntityRecurringPayment, EntityUpdated), stored)
+	}
+	return nil
 }
 
-func (s *RecurringPaymentService) DeleteRecurringPayment(id uuid.UUID) error {
-	return s.recurringPaymentRepo.Delete(id)
+// AdvanceProgress brings the remaining payment count of every active payment up to date with the
+// payment dates that have passed, and deactivates payments that have made their last payment.
+// Remaining counts are recalculated from the schedule rather than decremented, so running it
+// more than once for the same day changes nothing.
+func (s *RecurringPaymentService) AdvanceProgress(ctx context.Context, now time.Time) (*models.PaymentProgressReport, error) {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.AdvanceProgress")
+	defer span.End()
+
+	payments, err := s.recurringPaymentRepo.GetAllActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.PaymentProgressReport{Changes: make([]models.PaymentProgressChange, 0)}
+	for _, payment := range payments {
+		report.Checked++
+
+		remaining, ok := recurringPaymentProgress(payment, now)
+		if !ok {
+			continue
+		}
+		finished := remaining <= 0
+		if finished {
+			remaining = 0
+		}
+		if !finished && payment.RemainingPayments != nil && *payment.RemainingPayments == remaining {
+			continue
+		}
+
+		change := models.PaymentProgressChange{
+			RecurringPaymentID: payment.ID,
+			Name:               payment.Name,
+			RemainingBefore:    payment.RemainingPayments,
+			RemainingAfter:     &remaining,
+			Deactivated:        finished,
+		}
+
+		payment.RemainingPayments = &remaining
+		payment.IsActive = !finished
+		payment.UpdatedAt = now
+		if err := s.recurringPaymentRepo.UpdateProgress(ctx, &payment); err != nil {
+			return report, err
+		}
+
+		report.Updated++
+		if finished {
+			report.Completed++
+		}
+		report.Changes = append(report.Changes, change)
+	}
+
+	return report, nil
+}
+
+func (s *RecurringPaymentService) DeleteRecurringPayment(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "RecurringPaymentService.DeleteRecurringPayment")
+	defer span.End()
+
+	deleted := s.storedForEvent(ctx, id)
+	if err := s.recurringPaymentRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if deleted != nil {
+		publishEvent(ctx, s.publisher, deleted.UserID, entityEvent(EntityRecurringPayment, EntityDeleted), deleted)
+	}
+	return nil
+}
+
+// storedForEvent returns the stored recurring payment an event is about, nil without a publisher
+func (s *RecurringPaymentService) storedForEvent(ctx context.Context, id uuid.UUID) *models.RecurringPayment {
+	if s.publisher == nil {
+		return nil
+	}
+	payment, err := s.recurringPaymentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return payment
 }
@@ -1,10 +1,12 @@
 package services
 
 import (
+	"context"
 	"github.com/Soli0222/flow-sight/backend/internal/models"
 	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
 	"github.com/Soli0222/flow-sight/backend/test/helpers"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -13,7 +15,7 @@ import (
 
 func TestRecurringPaymentService_GetRecurringPayments(t *testing.T) {
 	mockRepo := &mocks.MockRecurringPaymentRepository{}
-	service := NewRecurringPaymentService(mockRepo)
+	service := NewRecurringPaymentService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	userID := uuid.New()
 	bankAccountID := uuid.New()
 
@@ -32,7 +34,7 @@ func TestRecurringPaymentService_GetRecurringPayments(t *testing.T) {
 					*helpers.CreateTestRecurringPayment(userID, bankAccountID),
 					*helpers.CreateTestRecurringPayment(userID, bankAccountID),
 				}
-				m.On("GetAll", userID).Return(payments, nil)
+				m.On("GetAll", mock.Anything, userID).Return(payments, nil)
 			},
 			expectedCount: 2,
 			expectedError: false,
@@ -41,7 +43,7 @@ func TestRecurringPaymentService_GetRecurringPayments(t *testing.T) {
 			name:   "empty result",
 			userID: userID,
 			setupMock: func(m *mocks.MockRecurringPaymentRepository) {
-				m.On("GetAll", userID).Return([]models.RecurringPayment{}, nil)
+				m.On("GetAll", mock.Anything, userID).Return([]models.RecurringPayment{}, nil)
 			},
 			expectedCount: 0,
 			expectedError: false,
@@ -50,7 +52,7 @@ func TestRecurringPaymentService_GetRecurringPayments(t *testing.T) {
 			name:   "repository error",
 			userID: userID,
 			setupMock: func(m *mocks.MockRecurringPaymentRepository) {
-				m.On("GetAll", userID).Return([]models.RecurringPayment{}, assert.AnError)
+				m.On("GetAll", mock.Anything, userID).Return([]models.RecurringPayment{}, assert.AnError)
 			},
 			expectedCount: 0,
 			expectedError: true,
@@ -62,7 +64,7 @@ func TestRecurringPaymentService_GetRecurringPayments(t *testing.T) {
 			mockRepo.ExpectedCalls = nil
 			tt.setupMock(mockRepo)
 
-			result, err := service.GetRecurringPayments(tt.userID)
+			result, err := service.GetRecurringPayments(context.Background(), tt.userID)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -78,7 +80,7 @@ func TestRecurringPaymentService_GetRecurringPayments(t *testing.T) {
 
 func TestRecurringPaymentService_CreateRecurringPayment(t *testing.T) {
 	mockRepo := &mocks.MockRecurringPaymentRepository{}
-	service := NewRecurringPaymentService(mockRepo)
+	service := NewRecurringPaymentService(mockRepo, &mocks.MockCategoryRepository{}, nil)
 	userID := uuid.New()
 	bankAccountID := uuid.New()
 
@@ -92,7 +94,7 @@ func TestRecurringPaymentService_CreateRecurringPayment(t *testing.T) {
 			name:    "successful creation",
 			payment: helpers.CreateTestRecurringPayment(userID, bankAccountID),
 			setupMock: func(m *mocks.MockRecurringPaymentRepository, rp *models.RecurringPayment) {
-				m.On("Create", mock.MatchedBy(func(payment *models.RecurringPayment) bool {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(payment *models.RecurringPayment) bool {
 					return payment.UserID == userID && payment.Name == rp.Name
 				})).Return(nil)
 			},
@@ -102,7 +104,7 @@ func TestRecurringPaymentService_CreateRecurringPayment(t *testing.T) {
 			name:    "repository error",
 			payment: helpers.CreateTestRecurringPayment(userID, bankAccountID),
 			setupMock: func(m *mocks.MockRecurringPaymentRepository, rp *models.RecurringPayment) {
-				m.On("Create", mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
+				m.On("Create", mock.Anything, mock.AnythingOfType("*models.RecurringPayment")).Return(assert.AnError)
 			},
 			expectedError: true,
 		},
@@ -114,7 +116,7 @@ func TestRecurringPaymentService_CreateRecurringPayment(t *testing.T) {
 			originalID := tt.payment.ID
 			tt.setupMock(mockRepo, tt.payment)
 
-			err := service.CreateRecurringPayment(tt.payment)
+			err := service.CreateRecurringPayment(context.Background(), tt.payment)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -132,3 +134,81 @@ func TestRecurringPaymentService_CreateRecurringPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurringPaymentService_AdvanceProgress(t *testing.T) {
+	now := time.Date(2025, 3, 28, 3, 0, 0, 0, time.UTC)
+	intPtr := func(v int) *int { return &v }
+	strPtr := func(v string) *string { return &v }
+
+	instalments := models.RecurringPayment{
+		ID: uuid.New(), Name: "Phone", Amount: 5000, PaymentDay: 27, StartYearMonth: "2025-01",
+		RecurrenceRule: strPtr("FREQ=MONTHLY;BYMONTHDAY=27;COUNT=6"), TotalPayments: intPtr(6), RemainingPayments: intPtr(4), IsActive: true,
+	}
+	finished := models.RecurringPayment{
+		ID: uuid.New(), Name: "Course", Amount: 10000, PaymentDay: 27, StartYearMonth: "2025-01",
+		RecurrenceRule: strPtr("FREQ=MONTHLY;BYMONTHDAY=27;COUNT=3"), TotalPayments: intPtr(3), RemainingPayments: intPtr(1), IsActive: true,
+	}
+	upToDate := models.RecurringPayment{
+		ID: uuid.New(), Name: "Gym", Amount: 8000, PaymentDay: 1, StartYearMonth: "2025-01",
+		RecurrenceRule: strPtr("FREQ=MONTHLY;BYMONTHDAY=1;COUNT=12"), TotalPayments: intPtr(12), RemainingPayments: intPtr(9), IsActive: true,
+	}
+	open := models.RecurringPayment{
+		ID: uuid.New(), Name: "Rent", Amount: 80000, PaymentDay: 27, StartYearMonth: "2025-01",
+		RecurrenceRule: strPtr("FREQ=MONTHLY;BYMONTHDAY=27"), IsActive: true,
+	}
+
+	t.Run("advances and completes payments", func(t *testing.T) {
+		mockRepo := &mocks.MockRecurringPaymentRepository{}
+		service := NewRecurringPaymentService(mockRepo, &mocks.MockCategoryRepository{}, nil)
+
+		mockRepo.On("GetAllActive", mock.Anything).Return([]models.RecurringPayment{instalments, finished, upToDate, open}, nil)
+		mockRepo.On("UpdateProgress", mock.Anything, mock.MatchedBy(func(p *models.RecurringPayment) bool {
+			return p.ID == instalments.ID && *p.RemainingPayments == 3 && p.IsActive
+		})).Return(nil)
+		mockRepo.On("UpdateProgress", mock.Anything, mock.MatchedBy(func(p *models.RecurringPayment) bool {
+			return p.ID == finished.ID && *p.RemainingPayments == 0 && !p.IsActive
+		})).Return(nil)
+
+		report, err := service.AdvanceProgress(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, report.Checked)
+		assert.Equal(t, 2, report.Updated)
+		assert.Equal(t, 1, report.Completed)
+		if assert.Len(t, report.Changes, 2) {
+			assert.Equal(t, 4, *report.Changes[0].RemainingBefore)
+			assert.Equal(t, 3, *report.Changes[0].RemainingAfter)
+			assert.True(t, report.Changes[1].Deactivated)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("running again changes nothing", func(t *testing.T) {
+		mockRepo := &mocks.MockRecurringPaymentRepository{}
+		service := NewRecurringPaymentService(mockRepo, &mocks.MockCategoryRepository{}, nil)
+
+		advanced := instalments
+		advanced.RemainingPayments = intPtr(3)
+		mockRepo.On("GetAllActive", mock.Anything).Return([]models.RecurringPayment{advanced, upToDate, open}, nil)
+
+		report, err := service.AdvanceProgress(context.Background(), now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Checked)
+		assert.Equal(t, 0, report.Updated)
+		assert.Empty(t, report.Changes)
+		mockRepo.AssertNotCalled(t, "UpdateProgress", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := &mocks.MockRecurringPaymentRepository{}
+		service := NewRecurringPaymentService(mockRepo, &mocks.MockCategoryRepository{}, nil)
+
+		mockRepo.On("GetAllActive", mock.Anything).Return([]models.RecurringPayment{}, assert.AnError)
+
+		report, err := service.AdvanceProgress(context.Background(), now)
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecurringTransferService struct {
+	recurringTransferRepo RecurringTransferRepositoryInterface
+	bankAccountRepo       BankAccountRepositoryInterface
+}
+
+func NewRecurringTransferService(recurringTransferRepo RecurringTransferRepositoryInterface, bankAccountRepo BankAccountRepositoryInterface) *RecurringTransferService {
+	return &RecurringTransferService{
+		recurringTransferRepo: recurringTransferRepo,
+		bankAccountRepo:       bankAccountRepo,
+	}
+}
+
+func (s *RecurringTransferService) GetRecurringTransfers(ctx context.Context, userID uuid.UUID) ([]models.RecurringTransfer, error) {
+	ctx, span := tracer.Start(ctx, "RecurringTransferService.GetRecurringTransfers")
+	defer span.End()
+
+	return s.recurringTransferRepo.GetAll(ctx, userID)
+}
+
+// GetRecurringTransfer returns the user's transfer, sql.ErrNoRows when the user has no such transfer
+func (s *RecurringTransferService) GetRecurringTransfer(ctx context.Context, userID, id uuid.UUID) (*models.RecurringTransfer, error) {
+	ctx, span := tracer.Start(ctx, "RecurringTransferService.GetRecurringTransfer")
+	defer span.End()
+
+	transfer, err := s.recurringTransferRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return transfer, nil
+}
+
+func (s *RecurringTransferService) CreateRecurringTransfer(ctx context.Context, transfer *models.RecurringTransfer) error {
+	ctx, span := tracer.Start(ctx, "RecurringTransferService.CreateRecurringTransfer")
+	defer span.End()
+
+	if err := normalizeRecurringTransfer(transfer); err != nil {
+		return err
+	}
+	if err := s.validateAccounts(ctx, transfer); err != nil {
+		return err
+	}
+
+	transfer.ID = uuid.New()
+	transfer.CreatedAt = time.Now()
+	transfer.UpdatedAt = time.Now()
+
+	return s.recurringTransferRepo.Create(ctx, transfer)
+}
+
+func (s *RecurringTransferService) UpdateRecurringTransfer(ctx context.Context, userID uuid.UUID, transfer *models.RecurringTransfer) error {
+	ctx, span := tracer.Start(ctx, "RecurringTransferService.UpdateRecurringTransfer")
+	defer span.End()
+
+	if err := normalizeRecurringTransfer(transfer); err != nil {
+		return err
+	}
+
+	existing, err := s.GetRecurringTransfer(ctx, userID, transfer.ID)
+	if err != nil {
+		return err
+	}
+	transfer.UserID = existing.UserID
+
+	if err := s.validateAccounts(ctx, transfer); err != nil {
+		return err
+	}
+
+	transfer.UpdatedAt = time.Now()
+	return s.recurringTransferRepo.Update(ctx, transfer)
+}
+
+// validateAccounts checks that both accounts of a transfer belong to its user
+func (s *RecurringTransferService) validateAccounts(ctx context.Context, transfer *models.RecurringTransfer) error {
+	if err := validateBankAccountOwner(ctx, s.bankAccountRepo, transfer.UserID, transfer.FromAccount, "from_account"); err != nil {
+		return err
+	}
+	return validateBankAccountOwner(ctx, s.bankAccountRepo, transfer.UserID, transfer.ToAccount, "to_account")
+}
+
+// DeleteRecurringTransfer removes the user's transfer
+func (s *RecurringTransferService) DeleteRecurringTransfer(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "RecurringTransferService.DeleteRecurringTransfer")
+	defer span.End()
+
+	if _, err := s.GetRecurringTransfer(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.recurringTransferRepo.Delete(ctx, id)
+}
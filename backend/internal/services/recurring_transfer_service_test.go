@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringTransferService_CreateRecurringTransfer(t *testing.T) {
+	amount := int64(50000)
+	day := 25
+	userID, fromAccount, toAccount, foreignAccount := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name          string
+		transfer      models.RecurringTransfer
+		setupMock     func(*mocks.MockRecurringTransferRepository)
+		expectedError bool
+	}{
+		{
+			name:     "successful creation",
+			transfer: models.RecurringTransfer{UserID: userID, Name: "Savings", FromAccount: fromAccount, ToAccount: toAccount, TransferType: "fixed", Amount: &amount, TransferDay: &day},
+			setupMock: func(m *mocks.MockRecurringTransferRepository) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(transfer *models.RecurringTransfer) bool {
+					return transfer.ID != uuid.Nil && !transfer.CreatedAt.IsZero()
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "invalid transfer is not saved",
+			transfer:      models.RecurringTransfer{UserID: userID, Name: "Savings", FromAccount: fromAccount, ToAccount: toAccount, TransferType: "fixed"},
+			setupMock:     func(m *mocks.MockRecurringTransferRepository) {},
+			expectedError: true,
+		},
+		{
+			name:          "account of another user",
+			transfer:      models.RecurringTransfer{UserID: userID, Name: "Savings", FromAccount: fromAccount, ToAccount: foreignAccount, TransferType: "fixed", Amount: &amount, TransferDay: &day},
+			setupMock:     func(m *mocks.MockRecurringTransferRepository) {},
+			expectedError: true,
+		},
+		{
+			name:          "unknown account",
+			transfer:      models.RecurringTransfer{UserID: userID, Name: "Savings", FromAccount: uuid.New(), ToAccount: toAccount, TransferType: "fixed", Amount: &amount, TransferDay: &day},
+			setupMock:     func(m *mocks.MockRecurringTransferRepository) {},
+			expectedError: true,
+		},
+		{
+			name:     "repository error",
+			transfer: models.RecurringTransfer{UserID: userID, Name: "Savings", FromAccount: fromAccount, ToAccount: toAccount, TransferType: "fixed", Amount: &amount, TransferDay: &day},
+			setupMock: func(m *mocks.MockRecurringTransferRepository) {
+				m.On("Create", mock.Anything, mock.AnythingOfType("*models.RecurringTransfer")).Return(assert.AnError)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockRecurringTransferRepository{}
+			accountRepo := &mocks.MockBankAccountRepository{}
+			accountRepo.On("GetByID", mock.Anything, fromAccount).Return(&models.BankAccount{ID: fromAccount, UserID: userID}, nil).Maybe()
+			accountRepo.On("GetByID", mock.Anything, toAccount).Return(&models.BankAccount{ID: toAccount, UserID: userID}, nil).Maybe()
+			accountRepo.On("GetByID", mock.Anything, foreignAccount).Return(&models.BankAccount{ID: foreignAccount, UserID: uuid.New()}, nil).Maybe()
+			accountRepo.On("GetByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Maybe()
+			service := NewRecurringTransferService(mockRepo, accountRepo)
+			tt.setupMock(mockRepo)
+
+			err := service.CreateRecurringTransfer(context.Background(), &tt.transfer)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRecurringTransferService_UpdateRecurringTransfer(t *testing.T) {
+	mockRepo := &mocks.MockRecurringTransferRepository{}
+	accountRepo := &mocks.MockBankAccountRepository{}
+	service := NewRecurringTransferService(mockRepo, accountRepo)
+	userID := uuid.New()
+	target := int64(100000)
+	transfer := &models.RecurringTransfer{ID: uuid.New(), Name: "Card account", FromAccount: uuid.New(), ToAccount: uuid.New(), TransferType: "top_up", TargetBalance: &target}
+
+	mockRepo.On("GetByID", mock.Anything, transfer.ID).Return(&models.RecurringTransfer{ID: transfer.ID, UserID: userID}, nil)
+	accountRepo.On("GetByID", mock.Anything, transfer.FromAccount).Return(&models.BankAccount{ID: transfer.FromAccount, UserID: userID}, nil)
+	accountRepo.On("GetByID", mock.Anything, transfer.ToAccount).Return(&models.BankAccount{ID: transfer.ToAccount, UserID: userID}, nil)
+	mockRepo.On("Update", mock.Anything, transfer).Return(nil)
+
+	assert.NoError(t, service.UpdateRecurringTransfer(context.Background(), userID, transfer))
+	assert.Equal(t, userID, transfer.UserID)
+	assert.False(t, transfer.UpdatedAt.IsZero())
+	mockRepo.AssertExpectations(t)
+	accountRepo.AssertExpectations(t)
+
+	t.Run("account of another user", func(t *testing.T) {
+		foreign := *transfer
+		foreign.ToAccount = uuid.New()
+		accountRepo.On("GetByID", mock.Anything, foreign.ToAccount).Return(&models.BankAccount{ID: foreign.ToAccount, UserID: uuid.New()}, nil)
+
+		err := service.UpdateRecurringTransfer(context.Background(), userID, &foreign)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "to_account", validationErr.Field)
+	})
+
+	t.Run("transfer of another user", func(t *testing.T) {
+		foreign := *transfer
+		assert.ErrorIs(t, service.UpdateRecurringTransfer(context.Background(), uuid.New(), &foreign), sql.ErrNoRows)
+	})
+}
+
+func TestRecurringTransferService_DeleteRecurringTransfer(t *testing.T) {
+	mockRepo := &mocks.MockRecurringTransferRepository{}
+	service := NewRecurringTransferService(mockRepo, &mocks.MockBankAccountRepository{})
+	userID := uuid.New()
+	transferID := uuid.New()
+
+	mockRepo.On("GetByID", mock.Anything, transferID).Return(&models.RecurringTransfer{ID: transferID, UserID: userID}, nil)
+	mockRepo.On("Delete", mock.Anything, transferID).Return(nil)
+
+	assert.ErrorIs(t, service.DeleteRecurringTransfer(context.Background(), uuid.New(), transferID), sql.ErrNoRows)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, transferID)
+
+	assert.NoError(t, service.DeleteRecurringTransfer(context.Background(), userID, transferID))
+	mockRepo.AssertExpectations(t)
+}
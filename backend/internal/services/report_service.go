@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/pdf"
+
+	"github.com/google/uuid"
+)
+
+// MonthlyReportEnabledSettingKey turns the scheduled monthly report of a user on
+const MonthlyReportEnabledSettingKey = "monthly_report_enabled"
+
+// monthlyReportOutlookMonths is how many months after the reported month the balance chart covers
+const monthlyReportOutlookMonths = 12
+
+var errReportFontUnavailable = errors.New("report font is not available")
+
+type ReportService struct {
+	monthlyReportRepo MonthlyReportRepositoryInterface
+	userRepo          UserRepositoryInterface
+	appSettingRepo    AppSettingRepositoryInterface
+	cashflowService   *CashflowService
+	reportFont        *pdf.Font
+}
+
+func NewReportService(
+	monthlyReportRepo MonthlyReportRepositoryInterface,
+	userRepo UserRepositoryInterface,
+	appSettingRepo AppSettingRepositoryInterface,
+	cashflowService *CashflowService,
+	reportFont *pdf.Font,
+) *ReportService {
+	return &ReportService{
+		monthlyReportRepo: monthlyReportRepo,
+		userRepo:          userRepo,
+		appSettingRepo:    appSettingRepo,
+		cashflowService:   cashflowService,
+		reportFont:        reportFont,
+	}
+}
+
+// GetMonthlyReport returns the statement of the month ("2024-01"), which must not be after the
+// month of now. Balances follow the cashflow projection, which starts the current month from the
+// current account balances; the balances of earlier months are worked back from there.
+func (s *ReportService) GetMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string, now time.Time) (*models.MonthlyReport, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetMonthlyReport")
+	defer span.End()
+
+	month, err := time.Parse("2006-01", yearMonth)
+	if err != nil {
+		return nil, NewValidationError("year_month", "must be in YYYY-MM format")
+	}
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if month.After(currentMonth) {
+		return nil, NewValidationError("year_month", "must not be after the current month")
+	}
+
+	inputs, err := s.cashflowService.loadProjectionInputs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Project from the reported month through the outlook, and at least through the current month
+	// whose opening balance the balances are anchored to
+	months := max(1+monthlyReportOutlookMonths, monthsBetween(month, currentMonth)+1)
+	days, err := s.cashflowService.projectMonths(ctx, userID, inputs, month, months, now, ProjectionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	balance := int64(0)
+	for _, account := range inputs.bankAccounts {
+		balance += inputs.converter.toBase(account.Balance, account.Currency, now)
+	}
+	rebaseProjection(days, currentMonth.Format("2006-01-02"), balance)
+
+	return buildMonthlyReport(days, yearMonth, inputs.converter.base, inputs.creditCards), nil
+}
+
+// RenderMonthlyReport returns the statement of the month as a PDF file. It fails when the
+// server has no report font.
+func (s *ReportService) RenderMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string, now time.Time) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.RenderMonthlyReport")
+	defer span.End()
+
+	if s.reportFont == nil {
+		return nil, errReportFontUnavailable
+	}
+	report, err := s.GetMonthlyReport(ctx, userID, yearMonth, now)
+	if err != nil {
+		return nil, err
+	}
+	return renderMonthlyReport(report, now, s.reportFont)
+}
+
+// GetStoredMonthlyReports returns the reports the scheduled job produced for the user, latest first
+func (s *ReportService) GetStoredMonthlyReports(ctx context.Context, userID uuid.UUID) ([]models.StoredMonthlyReport, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetStoredMonthlyReports")
+	defer span.End()
+
+	return s.monthlyReportRepo.GetByUserID(ctx, userID)
+}
+
+// GetStoredMonthlyReport returns the report the scheduled job produced for the month,
+// sql.ErrNoRows when there is none
+func (s *ReportService) GetStoredMonthlyReport(ctx context.Context, userID uuid.UUID, yearMonth string) (*models.StoredMonthlyReport, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetStoredMonthlyReport")
+	defer span.End()
+
+	return s.monthlyReportRepo.GetByUserIDAndYearMonth(ctx, userID, yearMonth)
+}
+
+// GenerateMonthlyReports stores the report of the month before now for every user who turned
+// scheduled reports on. A user whose report fails is counted and skipped.
+func (s *ReportService) GenerateMonthlyReports(ctx context.Context, now time.Time) (*models.MonthlyReportRun, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GenerateMonthlyReports")
+	defer span.End()
+
+	yearMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0).Format("2006-01")
+
+	userIDs, err := s.userRepo.GetAllIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &models.MonthlyReportRun{YearMonth: yearMonth}
+	var errs []error
+	for _, userID := range userIDs {
+		run.Users++
+
+		if !s.scheduledReportEnabled(ctx, userID) {
+			run.Skipped++
+			continue
+		}
+
+		content, err := s.RenderMonthlyReport(ctx, userID, yearMonth, now)
+		if err == nil {
+			err = s.monthlyReportRepo.Upsert(ctx, &models.StoredMonthlyReport{
+				ID:        uuid.New(),
+				UserID:    userID,
+				YearMonth: yearMonth,
+				Content:   content,
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+		}
+		if err != nil {
+			run.Failed++
+			errs = append(errs, fmt.Errorf("user %s: %w", userID, err))
+			continue
+		}
+		run.Generated++
+	}
+
+	return run, errors.Join(errs...)
+}
+
+// scheduledReportEnabled reports whether the user turned scheduled reports on
+func (s *ReportService) scheduledReportEnabled(ctx context.Context, userID uuid.UUID) bool {
+	setting, err := s.appSettingRepo.GetByKey(ctx, userID, MonthlyReportEnabledSettingKey)
+	if err != nil {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(setting.Value)
+	return enabled
+}
+
+// monthsBetween returns how many months to is after from
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+}
+
+// rebaseProjection shifts the balances of projected days so that the day of date opens with
+// balance. Days are left as they are when date is not projected.
+func rebaseProjection(days []models.CashflowProjection, date string, balance int64) {
+	for _, day := range days {
+		if day.Date != date {
+			continue
+		}
+		offset := balance - (day.Balance - day.Income + day.Expense)
+		for i := range days {
+			days[i].Balance += offset
+		}
+		return
+	}
+}
+
+// buildMonthlyReport sums projected days up into the statement of the month and the closing
+// balances of the months after it
+func buildMonthlyReport(days []models.CashflowProjection, yearMonth, code string, creditCards []models.CreditCard) *models.MonthlyReport {
+	report := &models.MonthlyReport{
+		YearMonth:      yearMonth,
+		Currency:       code,
+		Inflows:        make([]models.MonthlyReportItem, 0),
+		Outflows:       make([]models.MonthlyReportItem, 0),
+		CardStatements: make([]models.MonthlyReportCardStatement, 0),
+		Variances:      make([]models.MonthlyReportVariance, 0),
+		Outlook:        make([]models.MonthlyBalance, 0),
+	}
+
+	cards := make(map[uuid.UUID]models.CreditCard, len(creditCards))
+	for _, creditCard := range creditCards {
+		cards[creditCard.ID] = creditCard
+	}
+
+	started := false
+	for _, day := range days {
+		dayMonth := day.Date[:7]
+		if dayMonth < yearMonth {
+			continue
+		}
+
+		if dayMonth > yearMonth {
+			// Months after the reported one only contribute their closing balance
+			last := len(report.Outlook) - 1
+			if last >= 0 && report.Outlook[last].YearMonth == dayMonth {
+				report.Outlook[last].Balance = day.Balance
+			} else if len(report.Outlook) < monthlyReportOutlookMonths {
+				report.Outlook = append(report.Outlook, models.MonthlyBalance{YearMonth: dayMonth, Balance: day.Balance})
+			}
+			continue
+		}
+
+		if !started {
+			report.OpeningBalance = day.Balance - day.Income + day.Expense
+			started = true
+		}
+		report.ClosingBalance = day.Balance
+		report.Income += day.Income
+		report.Expense += day.Expense
+
+		for _, detail := range day.Details {
+			switch detail.Type {
+			case "transfer":
+				continue
+			case "income":
+				report.Inflows = append(report.Inflows, models.MonthlyReportItem{Date: day.Date, CashflowProjectionDetail: detail})
+			default:
+				report.Outflows = append(report.Outflows, models.MonthlyReportItem{Date: day.Date, CashflowProjectionDetail: detail})
+			}
+
+			if detail.Type == "card_payment" && detail.SourceID != nil {
+				creditCard := cards[*detail.SourceID]
+				statementMonth, _ := cardStatementYearMonth(creditCard, yearMonth)
+				name := creditCard.Name
+				if name == "" {
+					name = strings.TrimPrefix(detail.Description, "カード支払い: ")
+				}
+				report.CardStatements = append(report.CardStatements, models.MonthlyReportCardStatement{
+					CreditCardID:   *detail.SourceID,
+					Name:           name,
+					StatementMonth: statementMonth,
+					PaymentDate:    day.Date,
+					Amount:         detail.Amount,
+					IsEstimated:    detail.IsEstimated,
+				})
+			}
+
+			if detail.PlannedAmount != nil && *detail.PlannedAmount != detail.Amount {
+				report.Variances = append(report.Variances, models.MonthlyReportVariance{
+					Date:        day.Date,
+					Type:        detail.Type,
+					Description: detail.Description,
+					Planned:     *detail.PlannedAmount,
+					Actual:      detail.Amount,
+					Difference:  detail.Amount - *detail.PlannedAmount,
+				})
+			}
+		}
+	}
+
+	return report
+}
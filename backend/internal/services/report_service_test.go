@@ -0,0 +1,169 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/pdf"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"github.com/Soli0222/flow-sight/backend/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testReportProjection() ([]models.CashflowProjection, models.CreditCard) {
+	closingDay := 15
+	card := models.CreditCard{ID: uuid.New(), Name: "Visa", ClosingDay: &closingDay, PaymentDay: 10}
+	salaryID := uuid.New()
+	planned := int64(30000000)
+	plannedCard := int64(5000000)
+
+	days := []models.CashflowProjection{
+		{Date: "2024-12-31", Balance: 100000000},
+		{Date: "2025-01-10", Expense: 6000000, Balance: 94000000, Details: []models.CashflowProjectionDetail{
+			{Type: "card_payment", Description: "カード支払い: Visa", Amount: 6000000, SourceID: &card.ID, PlannedAmount: &plannedCard},
+		}},
+		{Date: "2025-01-25", Income: 32000000, Expense: 8000000, Balance: 118000000, Details: []models.CashflowProjectionDetail{
+			{Type: "income", Description: "収入: 給与", Amount: 32000000, SourceID: &salaryID, PlannedAmount: &planned},
+			{Type: "recurring_payment", Description: "固定支出: 家賃", Amount: 8000000},
+			{Type: "transfer", Description: "振替: 貯蓄", Amount: 1000000},
+		}},
+		{Date: "2025-01-31", Expense: 1000000, Balance: 117000000, Details: []models.CashflowProjectionDetail{
+			{Type: "living_cost", Description: "生活費", Amount: 1000000},
+		}},
+		{Date: "2025-02-10", Balance: 110000000},
+		{Date: "2025-02-28", Balance: 112000000},
+		{Date: "2025-03-31", Balance: -5000000},
+	}
+	return days, card
+}
+
+func TestBuildMonthlyReport(t *testing.T) {
+	days, card := testReportProjection()
+
+	report := buildMonthlyReport(days, "2025-01", "JPY", []models.CreditCard{card})
+
+	assert.Equal(t, "2025-01", report.YearMonth)
+	assert.Equal(t, "JPY", report.Currency)
+	assert.Equal(t, int64(100000000), report.OpeningBalance)
+	assert.Equal(t, int64(117000000), report.ClosingBalance)
+	assert.Equal(t, int64(32000000), report.Income)
+	assert.Equal(t, int64(15000000), report.Expense)
+
+	require.Len(t, report.Inflows, 1)
+	assert.Equal(t, "2025-01-25", report.Inflows[0].Date)
+	require.Len(t, report.Outflows, 3, "transfers are left out")
+	assert.Equal(t, "card_payment", report.Outflows[0].Type)
+
+	assert.Equal(t, []models.MonthlyReportCardStatement{{
+		CreditCardID:   card.ID,
+		Name:           "Visa",
+		StatementMonth: "2024-12",
+		PaymentDate:    "2025-01-10",
+		Amount:         6000000,
+	}}, report.CardStatements)
+
+	require.Len(t, report.Variances, 2)
+	assert.Equal(t, int64(1000000), report.Variances[0].Difference, "card statement above its estimate")
+	assert.Equal(t, int64(2000000), report.Variances[1].Difference, "income above the schedule")
+
+	assert.Equal(t, []models.MonthlyBalance{
+		{YearMonth: "2025-02", Balance: 112000000},
+		{YearMonth: "2025-03", Balance: -5000000},
+	}, report.Outlook)
+}
+
+func TestRebaseProjection(t *testing.T) {
+	days := []models.CashflowProjection{
+		{Date: "2025-01-31", Balance: 900},
+		{Date: "2025-02-01", Income: 100, Expense: 50, Balance: 1050},
+		{Date: "2025-02-02", Balance: 1050},
+	}
+
+	rebaseProjection(days, "2025-02-01", 2000)
+
+	assert.Equal(t, int64(1900), days[0].Balance)
+	assert.Equal(t, int64(2050), days[1].Balance, "the day opens with the balance")
+	assert.Equal(t, int64(2050), days[2].Balance)
+
+	rebaseProjection(days, "2030-01-01", 0)
+	assert.Equal(t, int64(1900), days[0].Balance, "unknown dates leave the balances")
+}
+
+func testReportFont(t *testing.T) *pdf.Font {
+	font, err := pdf.ParseFont(helpers.CreateTestFont())
+	require.NoError(t, err)
+	return font
+}
+
+func TestRenderMonthlyReport(t *testing.T) {
+	days, card := testReportProjection()
+	report := buildMonthlyReport(days, "2025-01", "JPY", []models.CreditCard{card})
+	for i := 0; i < 80; i++ {
+		report.Outflows = append(report.Outflows, report.Outflows[0]) // Enough rows for a second page
+	}
+
+	content, err := renderMonthlyReport(report, time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC), testReportFont(t))
+
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+	assert.Contains(t, string(content), "/Count 3")
+}
+
+func TestChartStep(t *testing.T) {
+	assert.Equal(t, int64(200000), chartStep(1000000))
+	assert.Equal(t, int64(5000000), chartStep(12000000))
+	assert.Equal(t, int64(100), chartStep(0))
+}
+
+func TestFitText(t *testing.T) {
+	font := testReportFont(t)
+
+	assert.Equal(t, "家賃", fitText(font, "家賃", 100, 10))
+	assert.Equal(t, "固定支…", fitText(font, "固定支出: 家賃", 40, 10))
+}
+
+func TestReportService_RenderMonthlyReport_noFont(t *testing.T) {
+	service := NewReportService(nil, nil, nil, nil, nil)
+
+	_, err := service.RenderMonthlyReport(context.Background(), uuid.New(), "2025-01", time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC))
+
+	assert.EqualError(t, err, "report font is not available")
+}
+
+func TestReportService_GetMonthlyReport_invalidMonth(t *testing.T) {
+	service := NewReportService(nil, nil, nil, nil, nil)
+	now := time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)
+
+	for _, month := range []string{"2025/01", "2025-03"} {
+		_, err := service.GetMonthlyReport(context.Background(), uuid.New(), month, now)
+
+		var validationErr *ValidationError
+		assert.ErrorAs(t, err, &validationErr, month)
+	}
+}
+
+func TestReportService_GenerateMonthlyReports(t *testing.T) {
+	disabled, failing := uuid.New(), uuid.New()
+	userRepo := new(mocks.MockUserRepository)
+	appSettingRepo := new(mocks.MockAppSettingRepository)
+	monthlyReportRepo := new(mocks.MockMonthlyReportRepository)
+
+	userRepo.On("GetAllIDs", mock.Anything).Return([]uuid.UUID{disabled, failing}, nil)
+	appSettingRepo.On("GetByKey", mock.Anything, disabled, MonthlyReportEnabledSettingKey).Return(&models.AppSetting{Value: "false"}, nil)
+	appSettingRepo.On("GetByKey", mock.Anything, failing, MonthlyReportEnabledSettingKey).Return(nil, errors.New("connection lost"))
+
+	service := NewReportService(monthlyReportRepo, userRepo, appSettingRepo, nil, nil)
+	run, err := service.GenerateMonthlyReports(context.Background(), time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.MonthlyReportRun{YearMonth: "2024-12", Users: 2, Skipped: 2}, run)
+	monthlyReportRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"math"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// savingsGoalProjectionMonths is how far ahead the projection is searched for the day a goal is reached
+const savingsGoalProjectionMonths = 60
+
+// normalizeSavingsGoal validates a savings goal
+func normalizeSavingsGoal(goal *models.SavingsGoal) error {
+	if goal.Name == "" {
+		return NewValidationError("name", "is required")
+	}
+	if goal.TargetAmount <= 0 {
+		return NewValidationError("target_amount", "must be positive")
+	}
+	if goal.BankAccount == uuid.Nil {
+		return NewValidationError("bank_account", "is required")
+	}
+	if goal.TargetYearMonth != nil {
+		if _, err := time.Parse("2006-01", *goal.TargetYearMonth); err != nil {
+			return NewValidationError("target_year_month", "must be in YYYY-MM format")
+		}
+	}
+	if goal.AllocatedAmount != nil && *goal.AllocatedAmount < 0 {
+		return NewValidationError("allocated_amount", "must not be negative")
+	}
+	return nil
+}
+
+// goalAllocation is the share of each account balance that counts towards each goal
+type goalAllocation struct {
+	amounts     map[uuid.UUID]int64 // By goal ID
+	unallocated map[uuid.UUID]int64 // By account ID, after the ring-fenced portions
+}
+
+// allocateGoals splits account balances between goals. Goals with a ring-fenced portion claim
+// their share first, in the order of the goals, and goals without one count whatever is left.
+func allocateGoals(goals []models.SavingsGoal, balances map[uuid.UUID]int64, claim func(models.SavingsGoal) int64) goalAllocation {
+	allocation := goalAllocation{
+		amounts:     make(map[uuid.UUID]int64, len(goals)),
+		unallocated: make(map[uuid.UUID]int64, len(balances)),
+	}
+	for accountID, balance := range balances {
+		allocation.unallocated[accountID] = balance
+	}
+
+	for _, goal := range goals {
+		if goal.AllocatedAmount == nil {
+			continue
+		}
+		amount := min(claim(goal), max(allocation.unallocated[goal.BankAccount], 0))
+		allocation.amounts[goal.ID] = amount
+		allocation.unallocated[goal.BankAccount] -= amount
+	}
+	for _, goal := range goals {
+		if goal.AllocatedAmount == nil {
+			allocation.amounts[goal.ID] = max(allocation.unallocated[goal.BankAccount], 0)
+		}
+	}
+	return allocation
+}
+
+// allocatedClaim is the share of its account a goal holds today
+func allocatedClaim(goal models.SavingsGoal) int64 {
+	return *goal.AllocatedAmount
+}
+
+// targetClaim is the share of its account a goal can grow into as the account balance grows
+func targetClaim(goal models.SavingsGoal) int64 {
+	return goal.TargetAmount
+}
+
+// accountBalances returns the balance of each bank account
+func accountBalances(accounts []models.BankAccount) map[uuid.UUID]int64 {
+	balances := make(map[uuid.UUID]int64, len(accounts))
+	for _, account := range accounts {
+		balances[account.ID] = account.Balance
+	}
+	return balances
+}
+
+// reservedGoalAmount returns how much of the account balances is set aside by reserving goals.
+// Money counted by several goals without a ring-fenced portion is only reserved once.
+func reservedGoalAmount(goals []models.SavingsGoal, balances map[uuid.UUID]int64) int64 {
+	allocation := allocateGoals(goals, balances, allocatedClaim)
+
+	reserved := int64(0)
+	wholeAccounts := make(map[uuid.UUID]bool)
+	for _, goal := range goals {
+		if !goal.IsReserved {
+			continue
+		}
+		if goal.AllocatedAmount == nil {
+			wholeAccounts[goal.BankAccount] = true
+			continue
+		}
+		reserved += allocation.amounts[goal.ID]
+	}
+	for accountID := range wholeAccounts {
+		reserved += max(allocation.unallocated[accountID], 0)
+	}
+	return reserved
+}
+
+// savingsGoalProgress computes the progress of each goal from the current account balances and
+// the first projected day on which the goal's account can hold its whole target
+func savingsGoalProgress(goals []models.SavingsGoal, accounts []models.BankAccount, projections []models.CashflowProjection) []models.SavingsGoalProgress {
+	current := allocateGoals(goals, accountBalances(accounts), allocatedClaim)
+
+	projectedDates := make(map[uuid.UUID]string, len(goals))
+	for _, projection := range projections {
+		if len(projectedDates) == len(goals) {
+			break
+		}
+
+		balances := make(map[uuid.UUID]int64, len(projection.AccountBalances))
+		for _, accountBalance := range projection.AccountBalances {
+			balances[accountBalance.BankAccountID] = accountBalance.Balance
+		}
+
+		projected := allocateGoals(goals, balances, targetClaim)
+		for _, goal := range goals {
+			if _, found := projectedDates[goal.ID]; !found && projected.amounts[goal.ID] >= goal.TargetAmount {
+				projectedDates[goal.ID] = projection.Date
+			}
+		}
+	}
+
+	progress := make([]models.SavingsGoalProgress, 0, len(goals))
+	for _, goal := range goals {
+		amount := current.amounts[goal.ID]
+		entry := models.SavingsGoalProgress{
+			SavingsGoalID:   goal.ID,
+			Name:            goal.Name,
+			BankAccount:     goal.BankAccount,
+			TargetAmount:    goal.TargetAmount,
+			TargetYearMonth: goal.TargetYearMonth,
+			CurrentAmount:   amount,
+			RemainingAmount: max(goal.TargetAmount-amount, 0),
+			ProgressRate:    math.Min(math.Round(float64(amount)/float64(goal.TargetAmount)*1000)/10, 100),
+			Achieved:        amount >= goal.TargetAmount,
+		}
+		if goal.IsReserved {
+			entry.ReservedAmount = amount
+		}
+		if date, found := projectedDates[goal.ID]; found && !entry.Achieved {
+			entry.ProjectedDate = &date
+		}
+		if goal.TargetYearMonth != nil {
+			onTrack := entry.Achieved || (entry.ProjectedDate != nil && (*entry.ProjectedDate)[:7] <= *goal.TargetYearMonth)
+			entry.OnTrack = &onTrack
+		}
+		progress = append(progress, entry)
+	}
+	return progress
+}
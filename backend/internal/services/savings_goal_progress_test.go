@@ -0,0 +1,114 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSavingsGoal(t *testing.T) {
+	valid := func() models.SavingsGoal {
+		month := "2027-03"
+		return models.SavingsGoal{Name: "Emergency fund", TargetAmount: 1000000, TargetYearMonth: &month, BankAccount: uuid.New()}
+	}
+
+	goal := valid()
+	require.NoError(t, normalizeSavingsGoal(&goal))
+
+	invalid := []struct {
+		name   string
+		modify func(*models.SavingsGoal)
+		field  string
+	}{
+		{name: "no name", modify: func(g *models.SavingsGoal) { g.Name = "" }, field: "name"},
+		{name: "no target", modify: func(g *models.SavingsGoal) { g.TargetAmount = 0 }, field: "target_amount"},
+		{name: "no account", modify: func(g *models.SavingsGoal) { g.BankAccount = uuid.Nil }, field: "bank_account"},
+		{name: "bad target month", modify: func(g *models.SavingsGoal) { m := "2027/03"; g.TargetYearMonth = &m }, field: "target_year_month"},
+		{name: "negative allocation", modify: func(g *models.SavingsGoal) { v := int64(-1); g.AllocatedAmount = &v }, field: "allocated_amount"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := valid()
+			tt.modify(&goal)
+
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, normalizeSavingsGoal(&goal), &validationErr) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestReservedGoalAmount(t *testing.T) {
+	savings, main := uuid.New(), uuid.New()
+	trip, car := int64(200000), int64(150000)
+	balances := map[uuid.UUID]int64{savings: 300000, main: 500000}
+
+	goals := []models.SavingsGoal{
+		{ID: uuid.New(), BankAccount: main, TargetAmount: 300000, AllocatedAmount: &trip, IsReserved: true},
+		{ID: uuid.New(), BankAccount: main, TargetAmount: 300000, AllocatedAmount: &car},
+		{ID: uuid.New(), BankAccount: savings, TargetAmount: 1000000, IsReserved: true},
+		{ID: uuid.New(), BankAccount: savings, TargetAmount: 500000, IsReserved: true},
+	}
+
+	// The ring-fenced trip money and the savings account, which is counted once
+	assert.Equal(t, int64(500000), reservedGoalAmount(goals, balances))
+
+	// A short account funds the earlier goals first
+	balances[main] = 250000
+	assert.Equal(t, int64(500000), reservedGoalAmount(goals, balances))
+	balances[main] = 100000
+	assert.Equal(t, int64(400000), reservedGoalAmount(goals, balances))
+
+	assert.Equal(t, int64(0), reservedGoalAmount(nil, balances))
+}
+
+func TestSavingsGoalProgress(t *testing.T) {
+	savings, main := uuid.New(), uuid.New()
+	accounts := []models.BankAccount{{ID: savings, Balance: 400000}, {ID: main, Balance: 200000}}
+	trip := int64(100000)
+	deadline := "2025-02"
+
+	emergency := models.SavingsGoal{ID: uuid.New(), Name: "Emergency fund", BankAccount: savings, TargetAmount: 1000000, TargetYearMonth: &deadline, IsReserved: true}
+	travel := models.SavingsGoal{ID: uuid.New(), Name: "Trip", BankAccount: main, TargetAmount: 300000, AllocatedAmount: &trip}
+	done := models.SavingsGoal{ID: uuid.New(), Name: "Laptop", BankAccount: savings, TargetAmount: 200000}
+
+	projections := []models.CashflowProjection{
+		{Date: "2025-01-25", AccountBalances: []models.AccountBalance{{BankAccountID: savings, Balance: 700000}, {BankAccountID: main, Balance: 350000}}},
+		{Date: "2025-02-25", AccountBalances: []models.AccountBalance{{BankAccountID: savings, Balance: 1000000}, {BankAccountID: main, Balance: 250000}}},
+		{Date: "2025-03-25", AccountBalances: []models.AccountBalance{{BankAccountID: savings, Balance: 1300000}, {BankAccountID: main, Balance: 500000}}},
+	}
+
+	progress := savingsGoalProgress([]models.SavingsGoal{emergency, travel, done}, accounts, projections)
+	require.Len(t, progress, 3)
+
+	assert.Equal(t, int64(400000), progress[0].CurrentAmount)
+	assert.Equal(t, int64(600000), progress[0].RemainingAmount)
+	assert.Equal(t, 40.0, progress[0].ProgressRate)
+	assert.Equal(t, int64(400000), progress[0].ReservedAmount)
+	assert.False(t, progress[0].Achieved)
+	require.NotNil(t, progress[0].ProjectedDate)
+	assert.Equal(t, "2025-02-25", *progress[0].ProjectedDate)
+	require.NotNil(t, progress[0].OnTrack)
+	assert.True(t, *progress[0].OnTrack)
+
+	// A ring-fenced goal counts its allocation today and can grow into the account balance
+	assert.Equal(t, int64(100000), progress[1].CurrentAmount)
+	assert.Equal(t, int64(0), progress[1].ReservedAmount)
+	require.NotNil(t, progress[1].ProjectedDate)
+	assert.Equal(t, "2025-01-25", *progress[1].ProjectedDate)
+	assert.Nil(t, progress[1].OnTrack)
+
+	assert.True(t, progress[2].Achieved)
+	assert.Equal(t, 100.0, progress[2].ProgressRate)
+	assert.Nil(t, progress[2].ProjectedDate)
+
+	// Not reached within the projection
+	progress = savingsGoalProgress([]models.SavingsGoal{emergency}, accounts, projections[:1])
+	assert.Nil(t, progress[0].ProjectedDate)
+	require.NotNil(t, progress[0].OnTrack)
+	assert.False(t, *progress[0].OnTrack)
+}
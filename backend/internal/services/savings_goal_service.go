@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SavingsGoalService struct {
+	savingsGoalRepo   SavingsGoalRepositoryInterface
+	bankAccountRepo   BankAccountRepositoryInterface
+	cashflowProjector CashflowProjectorInterface
+}
+
+func NewSavingsGoalService(
+	savingsGoalRepo SavingsGoalRepositoryInterface,
+	bankAccountRepo BankAccountRepositoryInterface,
+	cashflowProjector CashflowProjectorInterface,
+) *SavingsGoalService {
+	return &SavingsGoalService{
+		savingsGoalRepo:   savingsGoalRepo,
+		bankAccountRepo:   bankAccountRepo,
+		cashflowProjector: cashflowProjector,
+	}
+}
+
+func (s *SavingsGoalService) GetSavingsGoals(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoal, error) {
+	ctx, span := tracer.Start(ctx, "SavingsGoalService.GetSavingsGoals")
+	defer span.End()
+
+	return s.savingsGoalRepo.GetAll(ctx, userID)
+}
+
+// GetSavingsGoal returns the user's goal, sql.ErrNoRows when the user has no such goal
+func (s *SavingsGoalService) GetSavingsGoal(ctx context.Context, userID, id uuid.UUID) (*models.SavingsGoal, error) {
+	ctx, span := tracer.Start(ctx, "SavingsGoalService.GetSavingsGoal")
+	defer span.End()
+
+	goal, err := s.savingsGoalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if goal.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return goal, nil
+}
+
+// GetSavingsGoalProgress returns the progress of the user's active goals. The projected date of a
+// goal that is not reached yet is searched for in the cashflow projection.
+func (s *SavingsGoalService) GetSavingsGoalProgress(ctx context.Context, userID uuid.UUID) ([]models.SavingsGoalProgress, error) {
+	ctx, span := tracer.Start(ctx, "SavingsGoalService.GetSavingsGoalProgress")
+	defer span.End()
+
+	goals, err := s.savingsGoalRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(goals) == 0 {
+		return []models.SavingsGoalProgress{}, nil
+	}
+
+	accounts, err := s.bankAccountRepo.GetAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projections, err := s.cashflowProjector.GetCashflowProjection(ctx, userID, savingsGoalProjectionMonths, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return savingsGoalProgress(goals, accounts, projections), nil
+}
+
+func (s *SavingsGoalService) CreateSavingsGoal(ctx context.Context, goal *models.SavingsGoal) error {
+	ctx, span := tracer.Start(ctx, "SavingsGoalService.CreateSavingsGoal")
+	defer span.End()
+
+	if err := normalizeSavingsGoal(goal); err != nil {
+		return err
+	}
+	if err := validateBankAccountOwner(ctx, s.bankAccountRepo, goal.UserID, goal.BankAccount, "bank_account"); err != nil {
+		return err
+	}
+
+	goal.ID = uuid.New()
+	goal.CreatedAt = time.Now()
+	goal.UpdatedAt = time.Now()
+
+	return s.savingsGoalRepo.Create(ctx, goal)
+}
+
+func (s *SavingsGoalService) UpdateSavingsGoal(ctx context.Context, userID uuid.UUID, goal *models.SavingsGoal) error {
+	ctx, span := tracer.Start(ctx, "SavingsGoalService.UpdateSavingsGoal")
+	defer span.End()
+
+	if err := normalizeSavingsGoal(goal); err != nil {
+		return err
+	}
+
+	existing, err := s.GetSavingsGoal(ctx, userID, goal.ID)
+	if err != nil {
+		return err
+	}
+	goal.UserID = existing.UserID
+
+	if err := validateBankAccountOwner(ctx, s.bankAccountRepo, goal.UserID, goal.BankAccount, "bank_account"); err != nil {
+		return err
+	}
+
+	goal.UpdatedAt = time.Now()
+	return s.savingsGoalRepo.Update(ctx, goal)
+}
+
+// DeleteSavingsGoal removes the user's goal
+func (s *SavingsGoalService) DeleteSavingsGoal(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "SavingsGoalService.DeleteSavingsGoal")
+	defer span.End()
+
+	if _, err := s.GetSavingsGoal(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.savingsGoalRepo.Delete(ctx, id)
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSavingsGoalService_CreateSavingsGoal(t *testing.T) {
+	userID, accountID, foreignAccount := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name          string
+		goal          models.SavingsGoal
+		setupMock     func(*mocks.MockSavingsGoalRepository)
+		expectedError bool
+	}{
+		{
+			name: "successful creation",
+			goal: models.SavingsGoal{UserID: userID, Name: "Trip", TargetAmount: 300000, BankAccount: accountID, IsActive: true},
+			setupMock: func(m *mocks.MockSavingsGoalRepository) {
+				m.On("Create", mock.Anything, mock.MatchedBy(func(goal *models.SavingsGoal) bool {
+					return goal.ID != uuid.Nil && !goal.CreatedAt.IsZero()
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "invalid goal is not saved",
+			goal:          models.SavingsGoal{UserID: userID, Name: "Trip", BankAccount: accountID},
+			setupMock:     func(m *mocks.MockSavingsGoalRepository) {},
+			expectedError: true,
+		},
+		{
+			name:          "account of another user",
+			goal:          models.SavingsGoal{UserID: userID, Name: "Trip", TargetAmount: 300000, BankAccount: foreignAccount},
+			setupMock:     func(m *mocks.MockSavingsGoalRepository) {},
+			expectedError: true,
+		},
+		{
+			name: "repository error",
+			goal: models.SavingsGoal{UserID: userID, Name: "Trip", TargetAmount: 300000, BankAccount: accountID},
+			setupMock: func(m *mocks.MockSavingsGoalRepository) {
+				m.On("Create", mock.Anything, mock.AnythingOfType("*models.SavingsGoal")).Return(assert.AnError)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockSavingsGoalRepository{}
+			accountRepo := &mocks.MockBankAccountRepository{}
+			accountRepo.On("GetByID", mock.Anything, accountID).Return(&models.BankAccount{ID: accountID, UserID: userID}, nil).Maybe()
+			accountRepo.On("GetByID", mock.Anything, foreignAccount).Return(&models.BankAccount{ID: foreignAccount, UserID: uuid.New()}, nil).Maybe()
+			service := NewSavingsGoalService(mockRepo, accountRepo, &mocks.MockCashflowService{})
+			tt.setupMock(mockRepo)
+
+			err := service.CreateSavingsGoal(context.Background(), &tt.goal)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSavingsGoalService_UpdateSavingsGoal(t *testing.T) {
+	mockRepo := &mocks.MockSavingsGoalRepository{}
+	accountRepo := &mocks.MockBankAccountRepository{}
+	service := NewSavingsGoalService(mockRepo, accountRepo, &mocks.MockCashflowService{})
+	userID, foreignAccount := uuid.New(), uuid.New()
+	goal := &models.SavingsGoal{ID: uuid.New(), Name: "Trip", TargetAmount: 300000, BankAccount: foreignAccount}
+
+	mockRepo.On("GetByID", mock.Anything, goal.ID).Return(&models.SavingsGoal{ID: goal.ID, UserID: userID}, nil)
+	accountRepo.On("GetByID", mock.Anything, foreignAccount).Return(&models.BankAccount{ID: foreignAccount, UserID: uuid.New()}, nil)
+
+	err := service.UpdateSavingsGoal(context.Background(), userID, goal)
+
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr, "the account belongs to another user")
+
+	err = service.UpdateSavingsGoal(context.Background(), uuid.New(), goal)
+	assert.ErrorIs(t, err, sql.ErrNoRows, "the goal belongs to another user")
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSavingsGoalService_DeleteSavingsGoal(t *testing.T) {
+	mockRepo := &mocks.MockSavingsGoalRepository{}
+	service := NewSavingsGoalService(mockRepo, &mocks.MockBankAccountRepository{}, &mocks.MockCashflowService{})
+	userID, goalID := uuid.New(), uuid.New()
+
+	mockRepo.On("GetByID", mock.Anything, goalID).Return(&models.SavingsGoal{ID: goalID, UserID: userID}, nil)
+	mockRepo.On("Delete", mock.Anything, goalID).Return(nil)
+
+	assert.ErrorIs(t, service.DeleteSavingsGoal(context.Background(), uuid.New(), goalID), sql.ErrNoRows)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, goalID)
+
+	assert.NoError(t, service.DeleteSavingsGoal(context.Background(), userID, goalID))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSavingsGoalService_GetSavingsGoalProgress(t *testing.T) {
+	userID := uuid.New()
+	accountID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*mocks.MockSavingsGoalRepository, *mocks.MockBankAccountRepository, *mocks.MockCashflowService)
+		expectedCount int
+		expectedError bool
+	}{
+		{
+			name: "progress with projected date",
+			setupMocks: func(goals *mocks.MockSavingsGoalRepository, accounts *mocks.MockBankAccountRepository, cashflow *mocks.MockCashflowService) {
+				goals.On("GetActiveByUserID", mock.Anything, userID).Return([]models.SavingsGoal{
+					{ID: uuid.New(), Name: "Trip", TargetAmount: 300000, BankAccount: accountID, IsActive: true},
+				}, nil)
+				accounts.On("GetAll", mock.Anything, userID).Return([]models.BankAccount{{ID: accountID, Balance: 100000}}, nil)
+				cashflow.On("GetCashflowProjection", mock.Anything, userID, savingsGoalProjectionMonths, true).Return([]models.CashflowProjection{
+					{Date: "2025-03-25", AccountBalances: []models.AccountBalance{{BankAccountID: accountID, Balance: 300000}}},
+				}, nil)
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "no goals skips the projection",
+			setupMocks: func(goals *mocks.MockSavingsGoalRepository, accounts *mocks.MockBankAccountRepository, cashflow *mocks.MockCashflowService) {
+				goals.On("GetActiveByUserID", mock.Anything, userID).Return([]models.SavingsGoal{}, nil)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "projection error",
+			setupMocks: func(goals *mocks.MockSavingsGoalRepository, accounts *mocks.MockBankAccountRepository, cashflow *mocks.MockCashflowService) {
+				goals.On("GetActiveByUserID", mock.Anything, userID).Return([]models.SavingsGoal{
+					{ID: uuid.New(), Name: "Trip", TargetAmount: 300000, BankAccount: accountID, IsActive: true},
+				}, nil)
+				accounts.On("GetAll", mock.Anything, userID).Return([]models.BankAccount{{ID: accountID, Balance: 100000}}, nil)
+				cashflow.On("GetCashflowProjection", mock.Anything, userID, savingsGoalProjectionMonths, true).Return([]models.CashflowProjection{}, assert.AnError)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGoalRepo := &mocks.MockSavingsGoalRepository{}
+			mockAccountRepo := &mocks.MockBankAccountRepository{}
+			mockCashflow := &mocks.MockCashflowService{}
+			service := NewSavingsGoalService(mockGoalRepo, mockAccountRepo, mockCashflow)
+			tt.setupMocks(mockGoalRepo, mockAccountRepo, mockCashflow)
+
+			progress, err := service.GetSavingsGoalProgress(context.Background(), userID)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, progress, tt.expectedCount)
+				if tt.expectedCount > 0 {
+					assert.Equal(t, "2025-03-25", *progress[0].ProjectedDate)
+				}
+			}
+			mockGoalRepo.AssertExpectations(t)
+			mockAccountRepo.AssertExpectations(t)
+			mockCashflow.AssertExpectations(t)
+		})
+	}
+}
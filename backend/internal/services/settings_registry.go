@@ -0,0 +1,366 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// Types of application settings
+const (
+	SettingTypeInteger = "integer"
+	SettingTypeNumber  = "number"
+	SettingTypeBoolean = "boolean"
+	SettingTypeEnum    = "enum"
+	SettingTypeString  = "string"
+)
+
+// Setting keys of the preferences used by the frontend
+const (
+	NotificationEnabledSettingKey = "notification_enabled"
+	ThemeSettingKey               = "theme"
+)
+
+// cardEstimatorSettingKeyTemplate stands for every per-card estimator key in the schema
+const cardEstimatorSettingKeyTemplate = CardEstimatorSettingKey + ":<credit_card_id>"
+
+// settingDefinition is a registered setting. validate checks what the type, range and options
+// cannot express and may be nil.
+type settingDefinition struct {
+	models.SettingDefinition
+	validate func(value string) error
+}
+
+// settingRegistry declares every setting a user can store, in the order of the settings form
+var settingRegistry = []settingDefinition{
+	{SettingDefinition: models.SettingDefinition{
+		Key: BaseCurrencySettingKey, Type: SettingTypeEnum, Default: currency.Default,
+		Options:     currency.Codes(),
+		Label:       "基準通貨",
+		Description: "キャッシュフロー予測・ダッシュボードの合計と生活費・予算の通貨。他の通貨の金額は為替レートで換算",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: LivingCostAmountSettingKey, Type: SettingTypeInteger, Default: "0", Unit: "cents", Minimum: settingBound(0),
+		Label:       "月の生活費",
+		Description: "固定支出・カードとして登録されない日々の支出の月額。0の場合は計上しない",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: LivingCostSourceSettingKey, Type: SettingTypeEnum, Default: LivingCostSourceSetting,
+		Options:     []string{LivingCostSourceSetting, LivingCostSourceBudgets},
+		Label:       "生活費の算出元",
+		Description: "setting: 月の生活費の設定値、budgets: その月のカテゴリ予算の合計",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: LivingCostModeSettingKey, Type: SettingTypeEnum, Default: LivingCostModeShortfall,
+		Options:     []string{LivingCostModeShortfall, LivingCostModeAdditional},
+		Label:       "生活費の計上方式",
+		Description: "shortfall: 固定支出・カード支払いの月合計で賄えない差額のみ計上、additional: 全額を上乗せ",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: LivingCostStartOffsetSettingKey, Type: SettingTypeInteger, Default: strconv.Itoa(defaultLivingCostStartOffset), Unit: "months",
+		Minimum: settingBound(0), Maximum: settingBound(maxLivingCostStartOffset),
+		Label:       "生活費の計上開始月",
+		Description: "当月から何ヶ月後から生活費を計上するか。0の場合は当月から",
+	}},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: LivingCostBookingSettingKey, Type: SettingTypeString, Default: livingCostBookingDayPrefix + strconv.Itoa(defaultLivingCostBookingDay),
+			Format:      "spread | day:N",
+			Label:       "生活費の計上日",
+			Description: "day:N はN日に一括計上（月末を超える日は月末）、spread は月の日数で均等に分割",
+		},
+		validate: func(value string) error {
+			_, err := parseLivingCostBooking(value)
+			return err
+		},
+	},
+	{SettingDefinition: models.SettingDefinition{
+		Key: LivingCostInflationRateSettingKey, Type: SettingTypeNumber, Default: "0", Unit: "percent",
+		Minimum: settingBound(0), Maximum: settingBound(maxLivingCostInflationRate),
+		Label:       "生活費のインフレ率",
+		Description: "生活費の年間上昇率。当月からの経過月数に応じて月次複利で増額",
+	}},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: LivingCostOverridesSettingKey, Type: SettingTypeString, Default: "",
+			Format:      "YYYY-MM:amount,...",
+			Label:       "月別の生活費",
+			Description: "指定月の生活費を置き換える金額（銭単位）。開始月より前でも適用し、インフレ率は適用しない",
+		},
+		validate: func(value string) error {
+			_, err := parseLivingCostOverrides(value)
+			return err
+		},
+	},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: CardEstimatorSettingKey, Type: SettingTypeString, Default: CardEstimatorNone,
+			Format:      "none | trailing_average[:N] | same_month_last_year | fixed_budget:amount",
+			Label:       "カード利用額の見込み方式",
+			Description: "月次利用額が未登録の月のカード支払いを推定する方式（全カード共通）",
+		},
+		validate: validateCardEstimatorSetting,
+	},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: cardEstimatorSettingKeyTemplate, Type: SettingTypeString, Default: "",
+			Format:      "none | trailing_average[:N] | same_month_last_year | fixed_budget:amount",
+			Label:       "カード利用額の見込み方式（カード個別）",
+			Description: "指定したカードだけ見込み方式を上書き。未設定の場合は全カード共通の設定を使用",
+		},
+		validate: validateCardEstimatorSetting,
+	},
+	{SettingDefinition: models.SettingDefinition{
+		Key: CalendarAlarmDaysSettingKey, Type: SettingTypeInteger, Default: "0", Unit: "days",
+		Minimum: settingBound(0), Maximum: settingBound(maxCalendarAlarmDays),
+		Label:       "カレンダーのリマインダー",
+		Description: "カレンダーフィードで、大きな引き落としの何日前にリマインダーを鳴らすか。0の場合はリマインダーなし",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: CalendarAlarmThresholdSettingKey, Type: SettingTypeInteger, Default: "0", Unit: "cents", Minimum: settingBound(0),
+		Label:       "リマインダーの対象金額",
+		Description: "この金額（基準通貨）以上の固定支出・カード支払いにリマインダーを付ける",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: MonthlyReportEnabledSettingKey, Type: SettingTypeBoolean, Default: "false",
+		Label:       "月次レポートの自動作成",
+		Description: "毎月1日に前月の月次レポート（PDF）を作成して保存するかどうか",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationEnabledSettingKey, Type: SettingTypeBoolean, Default: "true",
+		Label:       "通知",
+		Description: "通知を送信するかどうか",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationEmailEnabledSettingKey, Type: SettingTypeBoolean, Default: "false",
+		Label:       "メール通知",
+		Description: "通知をメールで送信するかどうか。サーバーにメールサーバーが設定されている場合のみ送信",
+	}},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: NotificationEmailSettingKey, Type: SettingTypeString, Default: "",
+			Format:      "address@example.com",
+			Label:       "通知先メールアドレス",
+			Description: "通知を送るメールアドレス。空の場合はログインに使用しているアドレス",
+		},
+		validate: func(value string) error {
+			if value == "" {
+				return nil
+			}
+			_, err := mail.ParseAddress(value)
+			return err
+		},
+	},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: NotificationSlackWebhookURLSettingKey, Type: SettingTypeString, Default: "",
+			Format:      "https://hooks.slack.com/services/...",
+			Label:       "Slack通知",
+			Description: "通知を送るSlackのIncoming WebhookのURL。Slack互換の中継サーバーのURLも可（ローカルネットワークのアドレスは不可）。空の場合は送信しない",
+		},
+		validate: validateNotificationWebhookURL,
+	},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: NotificationDiscordWebhookURLSettingKey, Type: SettingTypeString, Default: "",
+			Format:      "https://discord.com/api/webhooks/...",
+			Label:       "Discord通知",
+			Description: "通知を送るDiscordのWebhookのURL。空の場合は送信しない",
+		},
+		validate: validateNotificationWebhookURL,
+	},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationLINETokenSettingKey, Type: SettingTypeString, Default: "",
+		Label:       "LINE通知",
+		Description: "LINE Notify形式の通知先のアクセストークン。空の場合は送信しない",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationLocaleSettingKey, Type: SettingTypeEnum, Default: NotificationLocaleJapanese,
+		Options:     []string{NotificationLocaleJapanese, NotificationLocaleEnglish},
+		Label:       "通知の言語",
+		Description: "ja: 日本語、en: 英語",
+	}},
+	{
+		SettingDefinition: models.SettingDefinition{
+			Key: NotificationEventsSettingKey, Type: SettingTypeString, Default: strings.Join(notificationEvents, ","),
+			Format:      "large_debit,low_balance,card_unconfirmed,weekly_digest",
+			Label:       "通知するイベント",
+			Description: "large_debit: 大きな引き落とし、low_balance: 残高不足の見込み、card_unconfirmed: 締め日後も未確定のカード利用額、weekly_digest: 毎週月曜の週間予定",
+		},
+		validate: func(value string) error {
+			_, err := parseNotificationEvents(value)
+			return err
+		},
+	},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationLargeDebitThresholdSettingKey, Type: SettingTypeInteger, Default: "10000000", Unit: "cents", Minimum: settingBound(0),
+		Label:       "大きな引き落としの金額",
+		Description: "この金額（基準通貨）以上の固定支出・カード支払いを事前に通知",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationLargeDebitDaysSettingKey, Type: SettingTypeInteger, Default: "3", Unit: "days",
+		Minimum: settingBound(1), Maximum: settingBound(maxNotificationLargeDebitDays),
+		Label:       "大きな引き落としの通知日",
+		Description: "引き落とし日の何日前から通知するか",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationLowBalanceThresholdSettingKey, Type: SettingTypeInteger, Default: "0", Unit: "cents",
+		Label:       "残高不足の基準額",
+		Description: "予測残高がこの金額（基準通貨）を下回る見込みになったら通知",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: NotificationLowBalanceDaysSettingKey, Type: SettingTypeInteger, Default: "30", Unit: "days",
+		Minimum: settingBound(1), Maximum: settingBound(maxNotificationLowBalanceDays),
+		Label:       "残高不足の監視期間",
+		Description: "今日から何日先までの予測残高を監視するか",
+	}},
+	{SettingDefinition: models.SettingDefinition{
+		Key: ThemeSettingKey, Type: SettingTypeEnum, Default: "light",
+		Options:     []string{"light", "dark", "system"},
+		Label:       "テーマ",
+		Description: "画面のカラーテーマ",
+	}},
+}
+
+// SettingSchema returns the definitions of every known setting
+func SettingSchema() []models.SettingDefinition {
+	schema := make([]models.SettingDefinition, len(settingRegistry))
+	for i, definition := range settingRegistry {
+		schema[i] = definition.SettingDefinition
+	}
+	return schema
+}
+
+// ValidateSetting checks a setting against the registry and returns the value to store.
+// Unknown keys and values that do not match the definition are rejected with a ValidationError.
+func ValidateSetting(key, value string) (string, error) {
+	definition, found := lookupSetting(key)
+	if !found {
+		return "", NewValidationError(key, "is not a known setting")
+	}
+
+	value = strings.TrimSpace(value)
+	switch definition.Type {
+	case SettingTypeInteger:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", NewValidationError(key, "must be an integer")
+		}
+		if err := checkSettingRange(key, definition, float64(n)); err != nil {
+			return "", err
+		}
+	case SettingTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return "", NewValidationError(key, "must be a number")
+		}
+		if err := checkSettingRange(key, definition, n); err != nil {
+			return "", err
+		}
+	case SettingTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", NewValidationError(key, "must be true or false")
+		}
+		value = strconv.FormatBool(b)
+	case SettingTypeEnum:
+		valid := false
+		for _, option := range definition.Options {
+			valid = valid || value == option
+		}
+		if !valid {
+			return "", NewValidationError(key, "must be one of %s", strings.Join(definition.Options, ", "))
+		}
+	}
+
+	if definition.validate != nil {
+		if err := definition.validate(value); err != nil {
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				err = NewValidationError(key, "%s", err)
+			}
+			return "", err
+		}
+	}
+	return value, nil
+}
+
+// ValidateSettings checks every setting of an update in key order and returns the values to store
+func ValidateSettings(settings map[string]string) (map[string]string, error) {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	validated := make(map[string]string, len(settings))
+	for _, key := range keys {
+		value, err := ValidateSetting(key, settings[key])
+		if err != nil {
+			return nil, err
+		}
+		validated[key] = value
+	}
+	return validated, nil
+}
+
+// lookupSetting finds the definition of a key. Per-card estimator keys share one definition.
+func lookupSetting(key string) (settingDefinition, bool) {
+	templateKey := key
+	if cardID, found := strings.CutPrefix(key, CardEstimatorSettingKey+":"); found {
+		if _, err := uuid.Parse(cardID); err != nil {
+			return settingDefinition{}, false
+		}
+		templateKey = cardEstimatorSettingKeyTemplate
+	}
+
+	for _, definition := range settingRegistry {
+		if definition.Key == templateKey {
+			return definition, true
+		}
+	}
+	return settingDefinition{}, false
+}
+
+// checkSettingRange checks a numeric setting against its minimum and maximum
+func checkSettingRange(key string, definition settingDefinition, n float64) error {
+	if definition.Minimum != nil && n < *definition.Minimum {
+		return NewValidationError(key, "must be at least %g", *definition.Minimum)
+	}
+	if definition.Maximum != nil && n > *definition.Maximum {
+		return NewValidationError(key, "must be at most %g", *definition.Maximum)
+	}
+	return nil
+}
+
+// validateCardEstimatorSetting checks that a card estimator setting can be parsed
+func validateCardEstimatorSetting(value string) error {
+	_, err := ParseCardSpendEstimator(value)
+	return err
+}
+
+// validateNotificationWebhookURL checks that a chat webhook setting is empty or an http or https URL
+func validateNotificationWebhookURL(value string) error {
+	if value == "" {
+		return nil
+	}
+	address, err := url.Parse(value)
+	if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+		return errors.New("must be an http or https URL")
+	}
+	return nil
+}
+
+// settingBound returns a pointer for the minimum or maximum of a setting
+func settingBound(n float64) *float64 {
+	return &n
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSetting(t *testing.T) {
+	cardKey := CardEstimatorSettingKeyFor(uuid.New().String())
+
+	tests := []struct {
+		key      string
+		value    string
+		valid    bool
+		expected string
+	}{
+		{key: BaseCurrencySettingKey, value: "USD", valid: true, expected: "USD"},
+		{key: BaseCurrencySettingKey, value: "XXX"},
+		{key: LivingCostAmountSettingKey, value: " 150000 ", valid: true, expected: "150000"},
+		{key: LivingCostAmountSettingKey, value: "-1"},
+		{key: LivingCostAmountSettingKey, value: "a lot"},
+		{key: LivingCostSourceSettingKey, value: LivingCostSourceBudgets, valid: true, expected: LivingCostSourceBudgets},
+		{key: LivingCostSourceSettingKey, value: "salary"},
+		{key: LivingCostModeSettingKey, value: LivingCostModeAdditional, valid: true, expected: LivingCostModeAdditional},
+		{key: LivingCostModeSettingKey, value: "sometimes"},
+		{key: LivingCostStartOffsetSettingKey, value: "0", valid: true, expected: "0"},
+		{key: LivingCostStartOffsetSettingKey, value: "121"},
+		{key: LivingCostBookingSettingKey, value: "spread", valid: true, expected: "spread"},
+		{key: LivingCostBookingSettingKey, value: "day:31", valid: true, expected: "day:31"},
+		{key: LivingCostBookingSettingKey, value: "day:0"},
+		{key: LivingCostBookingSettingKey, value: "26"},
+		{key: LivingCostInflationRateSettingKey, value: "2.5", valid: true, expected: "2.5"},
+		{key: LivingCostInflationRateSettingKey, value: "-1"},
+		{key: LivingCostInflationRateSettingKey, value: "NaN"},
+		{key: LivingCostOverridesSettingKey, value: "", valid: true, expected: ""},
+		{key: LivingCostOverridesSettingKey, value: "2024-08:400000, 2024-12:0", valid: true, expected: "2024-08:400000, 2024-12:0"},
+		{key: LivingCostOverridesSettingKey, value: "2024-13:400000"},
+		{key: LivingCostOverridesSettingKey, value: "2024-08"},
+		{key: LivingCostOverridesSettingKey, value: "2024-08:1,2024-08:2"},
+		{key: CardEstimatorSettingKey, value: "trailing_average:6", valid: true, expected: "trailing_average:6"},
+		{key: CardEstimatorSettingKey, value: "guess"},
+		{key: cardKey, value: "fixed_budget:50000", valid: true, expected: "fixed_budget:50000"},
+		{key: cardKey, value: "fixed_budget"},
+		{key: CardEstimatorSettingKey + ":not-a-card", value: "none"},
+		{key: NotificationEnabledSettingKey, value: "1", valid: true, expected: "true"},
+		{key: NotificationEnabledSettingKey, value: "yes"},
+		{key: ThemeSettingKey, value: "dark", valid: true, expected: "dark"},
+		{key: ThemeSettingKey, value: "blue"},
+		{key: "currency", value: "JPY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			value, err := ValidateSetting(tt.key, tt.value)
+			if tt.valid {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, value)
+			} else {
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.key, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestValidateSettings(t *testing.T) {
+	validated, err := ValidateSettings(map[string]string{
+		ThemeSettingKey:               "system",
+		NotificationEnabledSettingKey: "FALSE",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{ThemeSettingKey: "system", NotificationEnabledSettingKey: "false"}, validated)
+
+	// The first invalid key in key order is reported
+	_, err = ValidateSettings(map[string]string{
+		ThemeSettingKey:            "blue",
+		LivingCostAmountSettingKey: "-1",
+	})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, LivingCostAmountSettingKey, validationErr.Field)
+}
+
+func TestSettingSchema(t *testing.T) {
+	schema := SettingSchema()
+	require.Len(t, schema, len(settingRegistry))
+
+	keys := make(map[string]bool)
+	for _, definition := range schema {
+		assert.False(t, keys[definition.Key], "duplicate key %s", definition.Key)
+		keys[definition.Key] = true
+		assert.NotEmpty(t, definition.Label, definition.Key)
+		assert.NotEmpty(t, definition.Description, definition.Key)
+
+		// Every default must pass its own validation; per-card keys are checked with a real card ID
+		key := definition.Key
+		if key == cardEstimatorSettingKeyTemplate {
+			key = CardEstimatorSettingKeyFor(uuid.New().String())
+		}
+		_, err := ValidateSetting(key, definition.Default)
+		assert.NoError(t, err, definition.Key)
+	}
+
+	// Every living-cost setting is registered
+	for _, key := range livingCostSettingKeys {
+		assert.True(t, keys[key], key)
+	}
+}
@@ -0,0 +1,6 @@
+package services
+
+import "go.opentelemetry.io/otel"
+
+// tracer starts a span for each service operation, between the request span and the SQL spans
+var tracer = otel.Tracer("github.com/Soli0222/flow-sight/backend/internal/services")
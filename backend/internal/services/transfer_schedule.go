@@ -0,0 +1,213 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/currency"
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// Transfer types
+const (
+	TransferTypeFixed = "fixed"
+	TransferTypeTopUp = "top_up"
+)
+
+// normalizeRecurringTransfer validates a transfer. A fixed transfer needs an amount and a day; a
+// top-up needs a target balance and may limit the amount moved and the day it happens.
+func normalizeRecurringTransfer(transfer *models.RecurringTransfer) error {
+	if transfer.FromAccount == uuid.Nil {
+		return NewValidationError("from_account", "is required")
+	}
+	if transfer.ToAccount == uuid.Nil {
+		return NewValidationError("to_account", "is required")
+	}
+	if transfer.FromAccount == transfer.ToAccount {
+		return NewValidationError("to_account", "must be different from from_account")
+	}
+	if transfer.Amount != nil && *transfer.Amount <= 0 {
+		return NewValidationError("amount", "must be positive")
+	}
+	if transfer.TransferDay != nil && (*transfer.TransferDay < 1 || *transfer.TransferDay > 31) {
+		return NewValidationError("transfer_day", "must be between 1 and 31")
+	}
+
+	switch transfer.TransferType {
+	case TransferTypeFixed:
+		if transfer.Amount == nil {
+			return NewValidationError("amount", "is required for a fixed transfer")
+		}
+		if transfer.TransferDay == nil {
+			return NewValidationError("transfer_day", "is required for a fixed transfer")
+		}
+		if transfer.TargetBalance != nil {
+			return NewValidationError("target_balance", "is only used by top_up transfers")
+		}
+	case TransferTypeTopUp:
+		if transfer.TargetBalance == nil {
+			return NewValidationError("target_balance", "is required for a top_up transfer")
+		}
+	default:
+		return NewValidationError("transfer_type", "must be fixed or top_up")
+	}
+	return nil
+}
+
+// accountLedger tracks the projected balance of each bank account in the account's currency.
+// Amounts that are not tied to an account, such as living costs, are booked to the primary
+// account, which is the user's oldest account.
+type accountLedger struct {
+	accounts   []uuid.UUID
+	balances   map[uuid.UUID]int64
+	currencies map[uuid.UUID]string
+	primary    *uuid.UUID
+	converter  *currencyConverter
+}
+
+func newAccountLedger(bankAccounts []models.BankAccount, converter *currencyConverter) *accountLedger {
+	sorted := append([]models.BankAccount(nil), bankAccounts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	ledger := &accountLedger{
+		accounts:   make([]uuid.UUID, len(sorted)),
+		balances:   make(map[uuid.UUID]int64, len(sorted)),
+		currencies: make(map[uuid.UUID]string, len(sorted)),
+		converter:  converter,
+	}
+	for i, account := range sorted {
+		ledger.accounts[i] = account.ID
+		ledger.balances[account.ID] = account.Balance
+		ledger.currencies[account.ID] = converter.code(account.Currency)
+	}
+	if len(sorted) > 0 {
+		ledger.primary = &ledger.accounts[0]
+	}
+	return ledger
+}
+
+// book applies a projection detail to the account it belongs to, converting the amount from the
+// currency of the detail to the currency of the account. Transfers are applied by transfer and
+// are ignored here.
+func (l *accountLedger) book(detail models.CashflowProjectionDetail, date time.Time) {
+	account := detail.BankAccountID
+	if account == nil {
+		account = l.primary
+	}
+	if account == nil {
+		return
+	}
+
+	amount, code := detail.Amount, ""
+	if detail.OriginalAmount != nil {
+		amount, code = *detail.OriginalAmount, detail.OriginalCurrency
+	}
+	amount = l.converter.convert(amount, code, l.currencies[*account], date)
+
+	switch detail.Type {
+	case "transfer":
+	case "income":
+		l.balances[*account] += amount
+	default:
+		l.balances[*account] -= amount
+	}
+}
+
+// has reports whether the account is tracked by the ledger
+func (l *accountLedger) has(account uuid.UUID) bool {
+	_, ok := l.balances[account]
+	return ok
+}
+
+// transfer moves an amount in the currency of the source account. The destination account
+// receives it converted to its own currency.
+func (l *accountLedger) transfer(from, to uuid.UUID, amount int64, date time.Time) {
+	l.balances[from] -= amount
+	l.balances[to] += l.converter.convert(amount, l.currencies[from], l.currencies[to], date)
+}
+
+// snapshot returns the current balance of every account, oldest account first
+func (l *accountLedger) snapshot() []models.AccountBalance {
+	balances := make([]models.AccountBalance, len(l.accounts))
+	for i, account := range l.accounts {
+		balances[i] = models.AccountBalance{BankAccountID: account, Balance: l.balances[account], Currency: l.currencies[account]}
+	}
+	return balances
+}
+
+// transferDay returns the day of the month a transfer happens, clamped to the end of the month
+func transferDay(transfer models.RecurringTransfer, daysInMonth int) int {
+	if *transfer.TransferDay > daysInMonth {
+		return daysInMonth
+	}
+	return *transfer.TransferDay
+}
+
+// applyTransfers moves money between accounts for the transfers due on the given date and
+// returns a detail for each. It runs after the other flows of the day have been booked, fixed
+// transfers first, so top-ups cover whatever the day left the destination account short of.
+// The amount of a transfer is in the currency of the source account and its target balance in
+// the currency of the destination account; the detail reports the amount in the base currency.
+// Transfers involving an account the ledger does not track are skipped, since the money would
+// leave the balances the projection reports.
+func applyTransfers(ledger *accountLedger, transfers []models.RecurringTransfer, date time.Time) []models.CashflowProjectionDetail {
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	details := make([]models.CashflowProjectionDetail, 0)
+
+	apply := func(transfer models.RecurringTransfer, amount int64) {
+		ledger.transfer(transfer.FromAccount, transfer.ToAccount, amount, date)
+
+		id, from, to := transfer.ID, transfer.FromAccount, transfer.ToAccount
+		detail := models.CashflowProjectionDetail{
+			Type:            "transfer",
+			Description:     fmt.Sprintf("振替: %s", transfer.Name),
+			Amount:          amount,
+			SourceID:        &id,
+			BankAccountID:   &from,
+			ToBankAccountID: &to,
+		}
+		ledger.converter.setBaseAmount(&detail, ledger.currencies[from], date)
+		details = append(details, detail)
+	}
+
+	for _, transfer := range transfers {
+		if !ledger.has(transfer.FromAccount) || !ledger.has(transfer.ToAccount) {
+			continue
+		}
+		if transfer.TransferType == TransferTypeFixed && transferDay(transfer, daysInMonth) == date.Day() {
+			apply(transfer, *transfer.Amount)
+		}
+	}
+
+	for _, transfer := range transfers {
+		if transfer.TransferType != TransferTypeTopUp || !ledger.has(transfer.FromAccount) || !ledger.has(transfer.ToAccount) {
+			continue
+		}
+		if transfer.TransferDay != nil && transferDay(transfer, daysInMonth) != date.Day() {
+			continue
+		}
+
+		shortfall := *transfer.TargetBalance - ledger.balances[transfer.ToAccount]
+		if shortfall <= 0 {
+			continue
+		}
+
+		// Convert the shortfall to the currency of the source account, rounding up so that the
+		// converted transfer does not leave the destination short by a fraction
+		amount := ledger.converter.convert(shortfall, ledger.currencies[transfer.ToAccount], ledger.currencies[transfer.FromAccount], date)
+		if ledger.converter.convert(amount, ledger.currencies[transfer.FromAccount], ledger.currencies[transfer.ToAccount], date) < shortfall {
+			amount += currency.MinorUnit(ledger.currencies[transfer.FromAccount])
+		}
+		if transfer.Amount != nil && amount > *transfer.Amount {
+			amount = *transfer.Amount
+		}
+		apply(transfer, amount)
+	}
+
+	return details
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeRecurringTransfer(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	intPtr := func(v int) *int { return &v }
+	from, to := uuid.New(), uuid.New()
+
+	valid := []models.RecurringTransfer{
+		{FromAccount: from, ToAccount: to, TransferType: "fixed", Amount: int64Ptr(50000), TransferDay: intPtr(25)},
+		{FromAccount: from, ToAccount: to, TransferType: "top_up", TargetBalance: int64Ptr(100000)},
+		{FromAccount: from, ToAccount: to, TransferType: "top_up", TargetBalance: int64Ptr(0), Amount: int64Ptr(30000), TransferDay: intPtr(26)},
+	}
+	for _, transfer := range valid {
+		assert.NoError(t, normalizeRecurringTransfer(&transfer), transfer)
+	}
+
+	invalid := []struct {
+		name     string
+		transfer models.RecurringTransfer
+		field    string
+	}{
+		{name: "missing source", transfer: models.RecurringTransfer{ToAccount: to, TransferType: "fixed"}, field: "from_account"},
+		{name: "missing destination", transfer: models.RecurringTransfer{FromAccount: from, TransferType: "fixed"}, field: "to_account"},
+		{name: "same account", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: from, TransferType: "fixed"}, field: "to_account"},
+		{name: "unknown type", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "sweep"}, field: "transfer_type"},
+		{name: "fixed without amount", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "fixed", TransferDay: intPtr(1)}, field: "amount"},
+		{name: "fixed without day", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "fixed", Amount: int64Ptr(1)}, field: "transfer_day"},
+		{name: "fixed with target", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "fixed", Amount: int64Ptr(1), TransferDay: intPtr(1), TargetBalance: int64Ptr(1)}, field: "target_balance"},
+		{name: "top-up without target", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "top_up"}, field: "target_balance"},
+		{name: "negative amount", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "top_up", TargetBalance: int64Ptr(1), Amount: int64Ptr(-1)}, field: "amount"},
+		{name: "day out of range", transfer: models.RecurringTransfer{FromAccount: from, ToAccount: to, TransferType: "top_up", TargetBalance: int64Ptr(1), TransferDay: intPtr(32)}, field: "transfer_day"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, normalizeRecurringTransfer(&tt.transfer), &validationErr) {
+				assert.Equal(t, tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestAccountLedger(t *testing.T) {
+	now := time.Now()
+	salary := models.BankAccount{ID: uuid.New(), Balance: 300000, CreatedAt: now.AddDate(-2, 0, 0)}
+	card := models.BankAccount{ID: uuid.New(), Balance: 20000, CreatedAt: now}
+
+	// Accounts come newest first from the repository; the oldest is the primary account
+	ledger := newAccountLedger([]models.BankAccount{card, salary}, newCurrencyConverter("JPY", nil))
+	require.NotNil(t, ledger.primary)
+	assert.Equal(t, salary.ID, *ledger.primary)
+
+	ledger.book(models.CashflowProjectionDetail{Type: "income", Amount: 250000, BankAccountID: &salary.ID}, now)
+	ledger.book(models.CashflowProjectionDetail{Type: "card_payment", Amount: 60000, BankAccountID: &card.ID}, now)
+	ledger.book(models.CashflowProjectionDetail{Type: "recurring_payment", Amount: 10000}, now)
+
+	assert.Equal(t, []models.AccountBalance{
+		{BankAccountID: salary.ID, Balance: 540000, Currency: "JPY"},
+		{BankAccountID: card.ID, Balance: -40000, Currency: "JPY"},
+	}, ledger.snapshot())
+
+	assert.Nil(t, newAccountLedger(nil, newCurrencyConverter("JPY", nil)).primary)
+}
+
+func TestAccountLedger_foreignCurrency(t *testing.T) {
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	converter := newCurrencyConverter("JPY", []models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, RateDate: "2024-01-01"},
+	})
+	yen := models.BankAccount{ID: uuid.New(), Balance: 10000000, CreatedAt: date.AddDate(-1, 0, 0)}
+	dollars := models.BankAccount{ID: uuid.New(), Balance: 100000, Currency: "USD", CreatedAt: date}
+	ledger := newAccountLedger([]models.BankAccount{yen, dollars}, converter)
+
+	// A dollar income to the dollar account is booked as is
+	income := models.CashflowProjectionDetail{Type: "income", Amount: 50000, BankAccountID: &dollars.ID}
+	converter.setBaseAmount(&income, "USD", date)
+	assert.Equal(t, int64(7500000), income.Amount)
+	ledger.book(income, date)
+	// A yen payment from the dollar account is converted to dollars
+	ledger.book(models.CashflowProjectionDetail{Type: "recurring_payment", Amount: 1500000, BankAccountID: &dollars.ID}, date)
+	// Living costs in the base currency go to the yen account
+	ledger.book(models.CashflowProjectionDetail{Type: "living_cost", Amount: 2000000}, date)
+
+	assert.Equal(t, []models.AccountBalance{
+		{BankAccountID: yen.ID, Balance: 8000000, Currency: "JPY"},
+		{BankAccountID: dollars.ID, Balance: 140000, Currency: "USD"},
+	}, ledger.snapshot())
+}
+
+func TestApplyTransfers(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	intPtr := func(v int) *int { return &v }
+
+	salary := models.BankAccount{ID: uuid.New(), Balance: 500000}
+	savings := models.BankAccount{ID: uuid.New(), Balance: 0, CreatedAt: time.Now()}
+	card := models.BankAccount{ID: uuid.New(), Balance: 30000, CreatedAt: time.Now()}
+
+	toSavings := models.RecurringTransfer{ID: uuid.New(), Name: "Savings", FromAccount: salary.ID, ToAccount: savings.ID, TransferType: "fixed", Amount: int64Ptr(50000), TransferDay: intPtr(31)}
+	cardTopUp := models.RecurringTransfer{ID: uuid.New(), Name: "Card account", FromAccount: salary.ID, ToAccount: card.ID, TransferType: "top_up", TargetBalance: int64Ptr(100000)}
+	cappedTopUp := models.RecurringTransfer{ID: uuid.New(), Name: "Capped", FromAccount: savings.ID, ToAccount: card.ID, TransferType: "top_up", TargetBalance: int64Ptr(200000), Amount: int64Ptr(10000), TransferDay: intPtr(10)}
+	transfers := []models.RecurringTransfer{cardTopUp, toSavings, cappedTopUp}
+
+	t.Run("fixed transfer on the last day of a short month", func(t *testing.T) {
+		ledger := newAccountLedger([]models.BankAccount{salary, savings, card}, newCurrencyConverter("JPY", nil))
+
+		details := applyTransfers(ledger, transfers, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC))
+
+		// The fixed transfer runs first, then the top-up fills the card account to its target
+		require.Len(t, details, 2)
+		assert.Equal(t, "transfer", details[0].Type)
+		assert.Equal(t, "振替: Savings", details[0].Description)
+		assert.Equal(t, int64(50000), details[0].Amount)
+		assert.Equal(t, salary.ID, *details[0].BankAccountID)
+		assert.Equal(t, savings.ID, *details[0].ToBankAccountID)
+		assert.Equal(t, int64(70000), details[1].Amount)
+
+		assert.Equal(t, int64(380000), ledger.balances[salary.ID])
+		assert.Equal(t, int64(50000), ledger.balances[savings.ID])
+		assert.Equal(t, int64(100000), ledger.balances[card.ID])
+	})
+
+	t.Run("top-up only when below target", func(t *testing.T) {
+		ledger := newAccountLedger([]models.BankAccount{salary, savings, card}, newCurrencyConverter("JPY", nil))
+		ledger.balances[card.ID] = 150000
+
+		details := applyTransfers(ledger, transfers, time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC))
+		assert.Empty(t, details)
+	})
+
+	t.Run("top-up on its day is capped by the amount", func(t *testing.T) {
+		ledger := newAccountLedger([]models.BankAccount{salary, savings, card}, newCurrencyConverter("JPY", nil))
+		ledger.balances[card.ID] = 150000
+
+		details := applyTransfers(ledger, transfers, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC))
+		require.Len(t, details, 1)
+		assert.Equal(t, cappedTopUp.ID, *details[0].SourceID)
+		assert.Equal(t, int64(10000), details[0].Amount)
+		assert.Equal(t, int64(-10000), ledger.balances[savings.ID])
+	})
+
+	t.Run("transfers to accounts outside the ledger are skipped", func(t *testing.T) {
+		ledger := newAccountLedger([]models.BankAccount{salary, savings}, newCurrencyConverter("JPY", nil))
+		elsewhere := models.RecurringTransfer{ID: uuid.New(), Name: "Elsewhere", FromAccount: salary.ID, ToAccount: uuid.New(), TransferType: "fixed", Amount: int64Ptr(50000), TransferDay: intPtr(28)}
+
+		details := applyTransfers(ledger, []models.RecurringTransfer{elsewhere, cardTopUp}, time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC))
+
+		assert.Empty(t, details)
+		assert.Equal(t, int64(500000), ledger.balances[salary.ID])
+		assert.Len(t, ledger.balances, 2)
+	})
+}
+
+func TestApplyTransfers_foreignCurrency(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	intPtr := func(v int) *int { return &v }
+
+	converter := newCurrencyConverter("JPY", []models.ExchangeRate{
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, RateDate: "2024-01-01"},
+	})
+	yen := models.BankAccount{ID: uuid.New(), Balance: 100000000}
+	dollars := models.BankAccount{ID: uuid.New(), Balance: 10000, Currency: "USD", CreatedAt: time.Now()}
+	date := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("fixed transfer in the source currency", func(t *testing.T) {
+		ledger := newAccountLedger([]models.BankAccount{yen, dollars}, converter)
+		fromDollars := models.RecurringTransfer{ID: uuid.New(), Name: "Repatriate", FromAccount: dollars.ID, ToAccount: yen.ID, TransferType: "fixed", Amount: int64Ptr(5000), TransferDay: intPtr(10)}
+
+		details := applyTransfers(ledger, []models.RecurringTransfer{fromDollars}, date)
+
+		require.Len(t, details, 1)
+		assert.Equal(t, int64(750000), details[0].Amount)
+		assert.Equal(t, int64(5000), *details[0].OriginalAmount)
+		assert.Equal(t, "USD", details[0].OriginalCurrency)
+		assert.Equal(t, int64(5000), ledger.balances[dollars.ID])
+		assert.Equal(t, int64(100750000), ledger.balances[yen.ID])
+	})
+
+	t.Run("top-up to a target in the destination currency", func(t *testing.T) {
+		ledger := newAccountLedger([]models.BankAccount{yen, dollars}, converter)
+		topUp := models.RecurringTransfer{ID: uuid.New(), Name: "Dollar account", FromAccount: yen.ID, ToAccount: dollars.ID, TransferType: "top_up", TargetBalance: int64Ptr(20001)}
+
+		details := applyTransfers(ledger, []models.RecurringTransfer{topUp}, date)
+
+		// $100.01 is ¥15,001.50; whole yen are moved, rounded up so the target is reached
+		require.Len(t, details, 1)
+		assert.Equal(t, int64(1500200), details[0].Amount)
+		assert.Nil(t, details[0].OriginalAmount)
+		assert.Equal(t, int64(98499800), ledger.balances[yen.ID])
+		assert.Equal(t, int64(20001), ledger.balances[dollars.ID])
+	})
+}
@@ -0,0 +1,451 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/netguard"
+	"github.com/Soli0222/flow-sight/backend/internal/webhook"
+
+	"github.com/google/uuid"
+)
+
+// Types of webhook events besides the changes of entities
+const (
+	WebhookEventAlertTriggered     = "alert.triggered"
+	WebhookEventCardTotalConfirmed = "card_monthly_total.confirmed"
+	WebhookEventTest               = "test"
+)
+
+// Actions of entity events, published as "<entity>.<action>"
+const (
+	EntityCreated = "created"
+	EntityUpdated = "updated"
+	EntityDeleted = "deleted"
+)
+
+// Entities whose changes are published
+const (
+	EntityBankAccount      = "bank_account"
+	EntityCreditCard       = "credit_card"
+	EntityIncomeSource     = "income_source"
+	EntityRecurringPayment = "recurring_payment"
+	EntityCardMonthlyTotal = "card_monthly_total"
+)
+
+// Statuses of a webhook delivery
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+const (
+	// webhookSecretBytes is the length of a generated secret before hex encoding
+	webhookSecretBytes = 32
+	// webhookDeliveryLogLimit is how many deliveries of a webhook are returned
+	webhookDeliveryLogLimit = 50
+	// webhookDeliveryBatch is how many due deliveries a run sends at most
+	webhookDeliveryBatch = 100
+)
+
+// webhookRetryDelays are the waits before the retries of a failed delivery. A delivery that
+// fails its last retry is given up.
+var webhookRetryDelays = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	12 * time.Hour,
+}
+
+// webhookEntities are the entities whose changes webhooks can subscribe to
+var webhookEntities = []string{
+	EntityBankAccount,
+	EntityCreditCard,
+	EntityIncomeSource,
+	EntityRecurringPayment,
+	EntityCardMonthlyTotal,
+}
+
+// entityEvent returns the event type of a change of an entity
+func entityEvent(entity, action string) string {
+	return entity + "." + action
+}
+
+// webhookEventTypes returns every event type a webhook can subscribe to
+func webhookEventTypes() []string {
+	types := make([]string, 0, len(webhookEntities)*3+2)
+	for _, entity := range webhookEntities {
+		for _, action := range []string{EntityCreated, EntityUpdated, EntityDeleted} {
+			types = append(types, entityEvent(entity, action))
+		}
+	}
+	return append(types, WebhookEventAlertTriggered, WebhookEventCardTotalConfirmed)
+}
+
+// WebhookService manages the users' webhooks and posts their events to them. Events are queued
+// as deliveries and sent by DeliverWebhooks, which retries failed deliveries with backoff.
+// Webhooks may not point to the server's own network unless the operator allows it.
+type WebhookService struct {
+	webhookRepo         WebhookRepositoryInterface
+	webhookDeliveryRepo WebhookDeliveryRepositoryInterface
+	sender              WebhookSenderInterface
+	hostGuard           HostGuardInterface
+}
+
+func NewWebhookService(webhookRepo WebhookRepositoryInterface, webhookDeliveryRepo WebhookDeliveryRepositoryInterface, sender WebhookSenderInterface, hostGuard HostGuardInterface) *WebhookService {
+	return &WebhookService{
+		webhookRepo:         webhookRepo,
+		webhookDeliveryRepo: webhookDeliveryRepo,
+		sender:              sender,
+		hostGuard:           hostGuard,
+	}
+}
+
+func (s *WebhookService) GetWebhooks(ctx context.Context, userID uuid.UUID) ([]models.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.GetWebhooks")
+	defer span.End()
+
+	return s.webhookRepo.GetAll(ctx, userID)
+}
+
+// GetWebhook returns the user's webhook, sql.ErrNoRows when the user has no such webhook
+func (s *WebhookService) GetWebhook(ctx context.Context, userID, id uuid.UUID) (*models.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.GetWebhook")
+	defer span.End()
+
+	hook, err := s.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hook.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	return hook, nil
+}
+
+// CreateWebhook stores a new, active webhook. A secret is generated when none is given.
+func (s *WebhookService) CreateWebhook(ctx context.Context, hook *models.Webhook) error {
+	ctx, span := tracer.Start(ctx, "WebhookService.CreateWebhook")
+	defer span.End()
+
+	if err := normalizeWebhook(hook); err != nil {
+		return err
+	}
+	if err := checkURLHost(ctx, s.hostGuard, "url", hook.URL); err != nil {
+		return err
+	}
+	if hook.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return err
+		}
+		hook.Secret = secret
+	}
+
+	hook.ID = uuid.New()
+	hook.IsActive = true
+	hook.CreatedAt = time.Now()
+	hook.UpdatedAt = time.Now()
+
+	return s.webhookRepo.Create(ctx, hook)
+}
+
+// UpdateWebhook changes the user's webhook. The secret is kept when none is given.
+func (s *WebhookService) UpdateWebhook(ctx context.Context, userID uuid.UUID, hook *models.Webhook) error {
+	ctx, span := tracer.Start(ctx, "WebhookService.UpdateWebhook")
+	defer span.End()
+
+	existing, err := s.GetWebhook(ctx, userID, hook.ID)
+	if err != nil {
+		return err
+	}
+	if err := normalizeWebhook(hook); err != nil {
+		return err
+	}
+	if err := checkURLHost(ctx, s.hostGuard, "url", hook.URL); err != nil {
+		return err
+	}
+	if hook.Secret == "" {
+		hook.Secret = existing.Secret
+	}
+
+	hook.UserID = existing.UserID
+	hook.CreatedAt = existing.CreatedAt
+	hook.UpdatedAt = time.Now()
+	return s.webhookRepo.Update(ctx, hook)
+}
+
+// DeleteWebhook removes the user's webhook with its deliveries
+func (s *WebhookService) DeleteWebhook(ctx context.Context, userID, id uuid.UUID) error {
+	ctx, span := tracer.Start(ctx, "WebhookService.DeleteWebhook")
+	defer span.End()
+
+	if _, err := s.GetWebhook(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.webhookRepo.Delete(ctx, id)
+}
+
+// GetWebhookDeliveries returns the latest deliveries of the user's webhook, newest first
+func (s *WebhookService) GetWebhookDeliveries(ctx context.Context, userID, webhookID uuid.UUID) ([]models.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.GetWebhookDeliveries")
+	defer span.End()
+
+	if _, err := s.GetWebhook(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+	return s.webhookDeliveryRepo.GetByWebhookID(ctx, webhookID, webhookDeliveryLogLimit)
+}
+
+// SendTestEvent posts a test event to the user's webhook right away, whether or not it is
+// active. The attempt is recorded in the delivery log and not retried.
+func (s *WebhookService) SendTestEvent(ctx context.Context, userID, webhookID uuid.UUID, now time.Time) (*models.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.SendTestEvent")
+	defer span.End()
+
+	hook, err := s.GetWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := newWebhookDelivery(hook.ID, uuid.New(), WebhookEventTest, map[string]string{
+		"webhook_id": hook.ID.String(),
+		"message":    "Flow Sight test event",
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+	s.send(ctx, hook, delivery, now, false)
+
+	if err := s.webhookDeliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// Publish queues the event for every active webhook of the user that subscribes to it
+func (s *WebhookService) Publish(ctx context.Context, userID uuid.UUID, eventType string, data any) (int, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.Publish")
+	defer span.End()
+
+	hooks, err := s.webhookRepo.GetAll(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	eventID := uuid.New()
+	queued := 0
+	for _, hook := range hooks {
+		if !hook.IsActive || !webhookSubscribes(hook, eventType) {
+			continue
+		}
+
+		delivery, err := newWebhookDelivery(hook.ID, eventID, eventType, data, now)
+		if err != nil {
+			return queued, err
+		}
+		if err := s.webhookDeliveryRepo.Create(ctx, delivery); err != nil {
+			return queued, err
+		}
+		queued++
+	}
+	return queued, nil
+}
+
+// DeliverWebhooks sends the deliveries that are due. Deliveries of deleted or inactive webhooks
+// are given up.
+func (s *WebhookService) DeliverWebhooks(ctx context.Context, now time.Time) (*models.WebhookDeliveryRun, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.DeliverWebhooks")
+	defer span.End()
+
+	deliveries, err := s.webhookDeliveryRepo.GetDue(ctx, now, webhookDeliveryBatch)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &models.WebhookDeliveryRun{}
+	hooks := make(map[uuid.UUID]*models.Webhook)
+	var errs []error
+	for i := range deliveries {
+		delivery := &deliveries[i]
+
+		hook, found := hooks[delivery.WebhookID]
+		if !found {
+			hook, err = s.webhookRepo.GetByID(ctx, delivery.WebhookID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				errs = append(errs, fmt.Errorf("delivery %s: %w", delivery.ID, err))
+				continue
+			}
+			hooks[delivery.WebhookID] = hook
+		}
+
+		if hook == nil || !hook.IsActive {
+			message := "webhook is inactive"
+			delivery.Status, delivery.Error, delivery.NextAttemptAt, delivery.UpdatedAt = WebhookDeliveryFailed, &message, nil, now
+		} else {
+			run.Attempted++
+			s.send(ctx, hook, delivery, now, true)
+		}
+
+		switch delivery.Status {
+		case WebhookDeliverySucceeded:
+			run.Succeeded++
+		case WebhookDeliveryPending:
+			run.Retrying++
+		default:
+			run.Failed++
+		}
+		if err := s.webhookDeliveryRepo.Update(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("delivery %s: %w", delivery.ID, err))
+		}
+	}
+
+	return run, errors.Join(errs...)
+}
+
+// send makes one attempt of the delivery and records its result. With retry, a failed attempt
+// is scheduled again after the next backoff delay while there are retries left.
+func (s *WebhookService) send(ctx context.Context, hook *models.Webhook, delivery *models.WebhookDelivery, now time.Time, retry bool) {
+	status, err := s.sender.Send(ctx, webhook.Request{
+		URL:        hook.URL,
+		Secret:     hook.Secret,
+		EventType:  delivery.EventType,
+		DeliveryID: delivery.ID.String(),
+		Payload:    delivery.Payload,
+	})
+
+	delivery.Attempts++
+	delivery.UpdatedAt = now
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	if err == nil {
+		delivery.Status, delivery.Error, delivery.NextAttemptAt = WebhookDeliverySucceeded, nil, nil
+		return
+	}
+
+	message := err.Error()
+	delivery.Error = &message
+	if retry && delivery.Attempts <= len(webhookRetryDelays) {
+		next := now.Add(webhookRetryDelays[delivery.Attempts-1])
+		delivery.Status, delivery.NextAttemptAt = WebhookDeliveryPending, &next
+		return
+	}
+	delivery.Status, delivery.NextAttemptAt = WebhookDeliveryFailed, nil
+}
+
+// newWebhookDelivery returns a pending delivery of the event to a webhook, due now
+func newWebhookDelivery(webhookID, eventID uuid.UUID, eventType string, data any, now time.Time) (*models.WebhookDelivery, error) {
+	payload, err := json.Marshal(models.WebhookEvent{ID: eventID, Type: eventType, CreatedAt: now, Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     webhookID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// webhookSubscribes reports whether the webhook receives events of the type
+func webhookSubscribes(hook models.Webhook, eventType string) bool {
+	if len(hook.Events) == 0 {
+		return true
+	}
+	for _, event := range hook.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeWebhook checks the name, address and events of a webhook
+func normalizeWebhook(hook *models.Webhook) error {
+	hook.Name = strings.TrimSpace(hook.Name)
+	if hook.Name == "" {
+		return NewValidationError("name", "is required")
+	}
+
+	hook.URL = strings.TrimSpace(hook.URL)
+	address, err := url.Parse(hook.URL)
+	if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+		return NewValidationError("url", "must be an http or https URL")
+	}
+
+	known := webhookEventTypes()
+	events := make(models.TagList, 0, len(hook.Events))
+	for _, event := range hook.Events {
+		event = strings.TrimSpace(event)
+		valid := false
+		for _, k := range known {
+			valid = valid || event == k
+		}
+		if !valid {
+			return NewValidationError("events", "unknown event %q", event)
+		}
+		events = append(events, event)
+	}
+	hook.Events = events
+	if len(hook.Events) == 0 {
+		hook.Events = nil
+	}
+	return nil
+}
+
+// checkURLHost refuses a URL whose host the guard does not permit, such as an address of the
+// server's own network or a name that does not resolve
+func checkURLHost(ctx context.Context, guard HostGuardInterface, field, rawURL string) error {
+	address, err := url.Parse(rawURL)
+	if err != nil {
+		return NewValidationError(field, "must be an http or https URL")
+	}
+
+	err = guard.CheckHost(ctx, address.Hostname())
+	switch {
+	case errors.Is(err, netguard.ErrBlocked):
+		return NewValidationError(field, "must not point to a local or private network address")
+	case errors.Is(err, netguard.ErrUnresolvable):
+		return NewValidationError(field, "host cannot be resolved")
+	}
+	return err
+}
+
+// generateWebhookSecret returns a random key for signing payloads
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// publishEvent hands an event to the publisher when there is one. The change the event is
+// about is already stored, so an event that cannot be queued does not fail the change.
+func publishEvent(ctx context.Context, publisher EventPublisherInterface, userID uuid.UUID, eventType string, data any) {
+	if publisher == nil {
+		return
+	}
+	_, _ = publisher.Publish(ctx, userID, eventType, data)
+}
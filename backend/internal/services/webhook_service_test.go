@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/models"
+	"github.com/Soli0222/flow-sight/backend/internal/netguard"
+	"github.com/Soli0222/flow-sight/backend/internal/services/mocks"
+	"github.com/Soli0222/flow-sight/backend/internal/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSender keeps the requests it is asked to post and answers with a fixed result
+type recordingSender struct {
+	requests []webhook.Request
+	status   int
+	err      error
+}
+
+func (s *recordingSender) Send(ctx context.Context, request webhook.Request) (int, error) {
+	s.requests = append(s.requests, request)
+	return s.status, s.err
+}
+
+// fakeHostGuard fails the checks of the hosts it has an error for
+type fakeHostGuard map[string]error
+
+func (g fakeHostGuard) CheckHost(ctx context.Context, host string) error {
+	return g[host]
+}
+
+// testHostGuard refuses the cloud metadata address and knows no "missing.example.com"
+var testHostGuard = fakeHostGuard{"169.254.169.254": netguard.ErrBlocked, "missing.example.com": netguard.ErrUnresolvable}
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name        string
+		webhook     models.Webhook
+		expectedErr string
+	}{
+		{name: "valid", webhook: models.Webhook{Name: " Home Assistant ", URL: "https://ha.example.com/api/webhook/flow", Events: models.TagList{"alert.triggered", "credit_card.updated"}}},
+		{name: "every event", webhook: models.Webhook{Name: "Relay", URL: "http://relay.local/hook", Secret: "shared"}},
+		{name: "missing name", webhook: models.Webhook{URL: "https://example.com"}, expectedErr: "name"},
+		{name: "not http", webhook: models.Webhook{Name: "FTP", URL: "ftp://example.com/hook"}, expectedErr: "url"},
+		{name: "unknown event", webhook: models.Webhook{Name: "Relay", URL: "https://example.com", Events: models.TagList{"budget.exceeded"}}, expectedErr: "events"},
+		{name: "local address", webhook: models.Webhook{Name: "Metadata", URL: "http://169.254.169.254/latest/meta-data"}, expectedErr: "url"},
+		{name: "unknown host", webhook: models.Webhook{Name: "Typo", URL: "https://missing.example.com/hook"}, expectedErr: "url"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookRepo := new(mocks.MockWebhookRepository)
+			if tt.expectedErr == "" {
+				webhookRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Webhook")).Return(nil)
+			}
+			service := NewWebhookService(webhookRepo, nil, nil, testHostGuard)
+
+			hook := tt.webhook
+			err := service.CreateWebhook(context.Background(), &hook)
+
+			if tt.expectedErr != "" {
+				var validationErr *ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.expectedErr, validationErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEqual(t, uuid.Nil, hook.ID)
+			assert.True(t, hook.IsActive)
+			assert.Equal(t, strings.TrimSpace(tt.webhook.Name), hook.Name)
+			if tt.webhook.Secret != "" {
+				assert.Equal(t, tt.webhook.Secret, hook.Secret, "a given secret is kept")
+			} else {
+				assert.Len(t, hook.Secret, webhookSecretBytes*2, "a secret is generated")
+			}
+			webhookRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebhookService_UpdateWebhook(t *testing.T) {
+	userID, otherUserID := uuid.New(), uuid.New()
+	existing := &models.Webhook{ID: uuid.New(), UserID: userID, Name: "Relay", URL: "https://example.com", Secret: "kept", IsActive: true}
+
+	webhookRepo := new(mocks.MockWebhookRepository)
+	webhookRepo.On("GetByID", mock.Anything, existing.ID).Return(existing, nil)
+	webhookRepo.On("Update", mock.Anything, mock.MatchedBy(func(hook *models.Webhook) bool {
+		return hook.Secret == "kept" && hook.UserID == userID && !hook.IsActive
+	})).Return(nil)
+	service := NewWebhookService(webhookRepo, nil, nil, testHostGuard)
+
+	err := service.UpdateWebhook(context.Background(), otherUserID, &models.Webhook{ID: existing.ID, Name: "Mine", URL: "https://example.com"})
+	assert.ErrorIs(t, err, sql.ErrNoRows, "the webhook of another user")
+
+	err = service.UpdateWebhook(context.Background(), userID, &models.Webhook{ID: existing.ID, Name: "Relay", URL: "http://169.254.169.254/"})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr, "a local address")
+	assert.Equal(t, "url", validationErr.Field)
+
+	err = service.UpdateWebhook(context.Background(), userID, &models.Webhook{ID: existing.ID, Name: "Relay", URL: "https://example.com", IsActive: false})
+	require.NoError(t, err)
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	userID := uuid.New()
+	all := models.Webhook{ID: uuid.New(), UserID: userID, IsActive: true}
+	alerts := models.Webhook{ID: uuid.New(), UserID: userID, IsActive: true, Events: models.TagList{WebhookEventAlertTriggered}}
+	inactive := models.Webhook{ID: uuid.New(), UserID: userID, IsActive: false}
+
+	webhookRepo := new(mocks.MockWebhookRepository)
+	webhookDeliveryRepo := new(mocks.MockWebhookDeliveryRepository)
+	webhookRepo.On("GetAll", mock.Anything, userID).Return([]models.Webhook{all, alerts, inactive}, nil)
+
+	var created []*models.WebhookDelivery
+	webhookDeliveryRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.WebhookDelivery")).Run(func(args mock.Arguments) {
+		created = append(created, args.Get(1).(*models.WebhookDelivery))
+	}).Return(nil)
+	service := NewWebhookService(webhookRepo, webhookDeliveryRepo, nil, testHostGuard)
+
+	queued, err := service.Publish(context.Background(), userID, "credit_card.updated", map[string]string{"name": "Visa"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, queued, "only the webhook subscribed to every event")
+	require.Len(t, created, 1)
+	assert.Equal(t, all.ID, created[0].WebhookID)
+	assert.Equal(t, WebhookDeliveryPending, created[0].Status)
+	require.NotNil(t, created[0].NextAttemptAt)
+
+	var event models.WebhookEvent
+	require.NoError(t, json.Unmarshal(created[0].Payload, &event))
+	assert.Equal(t, created[0].EventID, event.ID)
+	assert.Equal(t, "credit_card.updated", event.Type)
+	assert.Equal(t, map[string]any{"name": "Visa"}, event.Data)
+
+	queued, err = service.Publish(context.Background(), userID, WebhookEventAlertTriggered, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, queued)
+	assert.Equal(t, created[1].EventID, created[2].EventID, "one event ID for every webhook")
+}
+
+func TestWebhookService_DeliverWebhooks(t *testing.T) {
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+	active := &models.Webhook{ID: uuid.New(), URL: "https://example.com/hook", Secret: "s3cret", IsActive: true}
+	inactive := &models.Webhook{ID: uuid.New(), URL: "https://example.com/off", IsActive: false}
+	deletedID := uuid.New()
+
+	first := models.WebhookDelivery{ID: uuid.New(), WebhookID: active.ID, EventType: "bank_account.created", Payload: []byte(`{}`), Status: WebhookDeliveryPending}
+	last := models.WebhookDelivery{ID: uuid.New(), WebhookID: active.ID, EventType: "bank_account.deleted", Payload: []byte(`{}`), Status: WebhookDeliveryPending, Attempts: len(webhookRetryDelays)}
+	off := models.WebhookDelivery{ID: uuid.New(), WebhookID: inactive.ID, Status: WebhookDeliveryPending}
+	orphan := models.WebhookDelivery{ID: uuid.New(), WebhookID: deletedID, Status: WebhookDeliveryPending}
+
+	webhookRepo := new(mocks.MockWebhookRepository)
+	webhookDeliveryRepo := new(mocks.MockWebhookDeliveryRepository)
+	webhookDeliveryRepo.On("GetDue", mock.Anything, now, webhookDeliveryBatch).Return([]models.WebhookDelivery{first, last, off, orphan}, nil)
+	webhookRepo.On("GetByID", mock.Anything, active.ID).Return(active, nil).Once()
+	webhookRepo.On("GetByID", mock.Anything, inactive.ID).Return(inactive, nil).Once()
+	webhookRepo.On("GetByID", mock.Anything, deletedID).Return(nil, sql.ErrNoRows).Once()
+
+	updated := make(map[uuid.UUID]models.WebhookDelivery)
+	webhookDeliveryRepo.On("Update", mock.Anything, mock.AnythingOfType("*models.WebhookDelivery")).Run(func(args mock.Arguments) {
+		delivery := args.Get(1).(*models.WebhookDelivery)
+		updated[delivery.ID] = *delivery
+	}).Return(nil)
+
+	sender := &recordingSender{status: 503, err: errors.New("unexpected status 503 Service Unavailable")}
+	service := NewWebhookService(webhookRepo, webhookDeliveryRepo, sender, testHostGuard)
+
+	run, err := service.DeliverWebhooks(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.WebhookDeliveryRun{Attempted: 2, Retrying: 1, Failed: 3}, run)
+	require.Len(t, sender.requests, 2, "inactive and deleted webhooks are not called")
+	assert.Equal(t, "s3cret", sender.requests[0].Secret)
+	assert.Equal(t, first.ID.String(), sender.requests[0].DeliveryID)
+
+	retried := updated[first.ID]
+	assert.Equal(t, WebhookDeliveryPending, retried.Status)
+	assert.Equal(t, 1, retried.Attempts)
+	require.NotNil(t, retried.ResponseStatus)
+	assert.Equal(t, 503, *retried.ResponseStatus)
+	require.NotNil(t, retried.NextAttemptAt)
+	assert.Equal(t, now.Add(webhookRetryDelays[0]), *retried.NextAttemptAt)
+
+	assert.Equal(t, WebhookDeliveryFailed, updated[last.ID].Status, "no retries left")
+	assert.Nil(t, updated[last.ID].NextAttemptAt)
+	assert.Equal(t, WebhookDeliveryFailed, updated[off.ID].Status)
+	assert.Equal(t, WebhookDeliveryFailed, updated[orphan.ID].Status)
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestWebhookService_SendTestEvent(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2025, 1, 20, 8, 0, 0, 0, time.UTC)
+	hook := &models.Webhook{ID: uuid.New(), UserID: userID, URL: "https://example.com/hook", Secret: "s3cret", IsActive: false}
+
+	webhookRepo := new(mocks.MockWebhookRepository)
+	webhookDeliveryRepo := new(mocks.MockWebhookDeliveryRepository)
+	webhookRepo.On("GetByID", mock.Anything, hook.ID).Return(hook, nil)
+	webhookDeliveryRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.WebhookDelivery")).Return(nil)
+
+	sender := &recordingSender{err: errors.New("connection refused")}
+	service := NewWebhookService(webhookRepo, webhookDeliveryRepo, sender, testHostGuard)
+
+	delivery, err := service.SendTestEvent(context.Background(), userID, hook.ID, now)
+
+	require.NoError(t, err, "a failed attempt is recorded in the delivery")
+	require.Len(t, sender.requests, 1, "inactive webhooks can be tested")
+	assert.Equal(t, WebhookEventTest, sender.requests[0].EventType)
+	assert.Equal(t, WebhookDeliveryFailed, delivery.Status, "test events are not retried")
+	assert.Nil(t, delivery.ResponseStatus)
+	require.NotNil(t, delivery.Error)
+	assert.Equal(t, "connection refused", *delivery.Error)
+
+	_, err = service.SendTestEvent(context.Background(), uuid.New(), hook.ID, now)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCreditCardService_PublishesChanges(t *testing.T) {
+	userID := uuid.New()
+	card := &models.CreditCard{ID: uuid.New(), UserID: userID, Name: "Visa"}
+
+	creditCardRepo := new(mocks.MockCreditCardRepository)
+	publisher := new(mocks.MockEventPublisher)
+	creditCardRepo.On("Update", mock.Anything, mock.AnythingOfType("*models.CreditCard")).Return(nil)
+	creditCardRepo.On("Delete", mock.Anything, card.ID).Return(nil)
+	creditCardRepo.On("GetByID", mock.Anything, card.ID).Return(card, nil)
+	publisher.On("Publish", mock.Anything, userID, "credit_card.updated", card).Return(1, nil)
+	publisher.On("Publish", mock.Anything, userID, "credit_card.deleted", card).Return(0, errors.New("db down"))
+	service := NewCreditCardService(creditCardRepo, &mocks.MockCategoryRepository{}, publisher)
+
+	require.NoError(t, service.UpdateCreditCard(context.Background(), &models.CreditCard{ID: card.ID, Name: "Visa"}))
+	require.NoError(t, service.DeleteCreditCard(context.Background(), card.ID), "an event that cannot be queued does not fail the change")
+	publisher.AssertExpectations(t)
+}
@@ -0,0 +1,68 @@
+// Package tracing sets up OpenTelemetry tracing for the backend.
+//
+// Spans are started at the HTTP, service and SQL layers through the global tracer provider.
+// Without an export endpoint the provider stays the no-op default, so spans cost nothing, but
+// trace context sent by callers is still passed on.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Soli0222/flow-sight/backend/internal/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Setup installs the propagator and, with an endpoint, a tracer provider exporting over
+// OTLP/HTTP. The returned function flushes the spans still buffered and must be called
+// before exiting.
+func Setup(ctx context.Context, cfg config.TracingConfig, serviceVersion string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	ratio, err := strconv.ParseFloat(cfg.SampleRatio, 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return nil, fmt.Errorf("invalid trace sample ratio %q: must be between 0 and 1", cfg.SampleRatio)
+	}
+
+	// Headers, TLS and timeouts of the exporter follow the standard OTEL_EXPORTER_OTLP_*
+	// variables
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.ServiceName),
+			semconv.ServiceVersion(serviceVersion),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
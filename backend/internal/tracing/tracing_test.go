@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Soli0222/flow-sight/backend/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetup_WithoutEndpoint(t *testing.T) {
+	shutdown, err := Setup(context.Background(), config.TracingConfig{ServiceName: "flow-sight-backend", SampleRatio: "1"}, "test")
+
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.Contains(t, otel.GetTextMapPropagator().Fields(), "traceparent", "trace context is passed on")
+}
+
+func TestSetup_InvalidSampleRatio(t *testing.T) {
+	for _, ratio := range []string{"", "half", "-0.1", "1.5"} {
+		_, err := Setup(context.Background(), config.TracingConfig{Endpoint: "http://localhost:4318/v1/traces", SampleRatio: ratio}, "test")
+		assert.Error(t, err, ratio)
+	}
+}
+
+func TestSetup_ExportsSpans(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		paths    []string
+		received int
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		received += len(body)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	shutdown, err := Setup(context.Background(), config.TracingConfig{
+		Endpoint:    collector.URL + "/v1/traces",
+		ServiceName: "flow-sight-backend",
+		SampleRatio: "1",
+	}, "test")
+	require.NoError(t, err)
+
+	carrier := propagation.MapCarrier{}
+	ctx, span := otel.Tracer("test").Start(context.Background(), "CreditCardService.GetCreditCards")
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	span.End()
+
+	require.NoError(t, shutdown(context.Background()), "shutdown flushes the buffered spans")
+
+	assert.Contains(t, carrier.Get("traceparent"), span.SpanContext().TraceID().String())
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"/v1/traces"}, paths)
+	assert.Positive(t, received)
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Soli0222/flow-sight/backend/internal/netguard"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client allowed to reach the test servers on the loopback address
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	guard, err := netguard.New([]string{"127.0.0.1"})
+	require.NoError(t, err)
+	return NewClient(guard)
+}
+
+func TestClient_Send(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t)
+	client.now = func() time.Time { return time.Unix(1737360000, 0) }
+	payload := []byte(`{"type":"test"}`)
+
+	status, err := client.Send(context.Background(), Request{
+		URL:        server.URL,
+		Secret:     "s3cret",
+		EventType:  "test",
+		DeliveryID: "delivery-1",
+		Payload:    payload,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, ContentType, received.Header.Get("Content-Type"))
+	assert.Equal(t, "test", received.Header.Get(EventHeader))
+	assert.Equal(t, "delivery-1", received.Header.Get(DeliveryHeader))
+	assert.Equal(t, "t=1737360000,v1="+Sign("s3cret", 1737360000, payload), received.Header.Get(SignatureHeader))
+	assert.Equal(t, payload, body)
+}
+
+func TestClient_SendErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	status, err := newTestClient(t).Send(context.Background(), Request{URL: server.URL})
+
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.ErrorContains(t, err, "503")
+}
+
+func TestClient_SendUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	status, err := newTestClient(t).Send(context.Background(), Request{URL: server.URL})
+
+	assert.Zero(t, status)
+	assert.Equal(t, netguard.ErrRequestFailed, err, "the reason is not passed on")
+}
+
+func TestClient_SendRedirect(t *testing.T) {
+	var redirected bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			redirected = true
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	status, err := newTestClient(t).Send(context.Background(), Request{URL: server.URL})
+
+	assert.Equal(t, http.StatusTemporaryRedirect, status)
+	assert.ErrorContains(t, err, "307")
+	assert.False(t, redirected, "redirects are not followed")
+}
+
+func TestClient_SendBlocked(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	guard, err := netguard.New(nil)
+	require.NoError(t, err)
+
+	status, err := NewClient(guard).Send(context.Background(), Request{URL: server.URL})
+
+	assert.Zero(t, status)
+	assert.Equal(t, netguard.ErrBlocked, err, "loopback needs to be allowed")
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t, "9d713ed406bb7076d4123f0dc2c39d2df5c654ed4b0cd56b52c8b4c940bd63ae", Sign("key", 1700000000, []byte("{}")))
+}
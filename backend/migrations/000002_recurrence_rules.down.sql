@@ -0,0 +1 @@
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS recurrence_rule;
@@ -0,0 +1,12 @@
+-- Recurrence rules (RRULE subset) for recurring payments
+
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS recurrence_rule VARCHAR(255);
+
+-- Existing payments repeat monthly on their payment day, limited by total_payments for loans
+UPDATE recurring_payments
+SET recurrence_rule = 'FREQ=MONTHLY;BYMONTHDAY=' || payment_day ||
+    CASE WHEN total_payments IS NOT NULL AND total_payments > 0
+         THEN ';COUNT=' || total_payments
+         ELSE ''
+    END
+WHERE recurrence_rule IS NULL;
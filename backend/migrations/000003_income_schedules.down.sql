@@ -0,0 +1,11 @@
+-- Income sources using the new types cannot be represented by the old constraint
+DELETE FROM income_sources WHERE income_type IN ('semi_annual', 'custom_months', 'annual');
+
+ALTER TABLE income_sources DROP CONSTRAINT IF EXISTS income_sources_raise_rate_check;
+ALTER TABLE income_sources DROP COLUMN IF EXISTS base_year;
+ALTER TABLE income_sources DROP COLUMN IF EXISTS raise_rate;
+ALTER TABLE income_sources DROP COLUMN IF EXISTS payment_months;
+
+ALTER TABLE income_sources DROP CONSTRAINT IF EXISTS income_sources_income_type_check;
+ALTER TABLE income_sources ADD CONSTRAINT income_sources_income_type_check
+    CHECK (income_type IN ('monthly_fixed', 'one_time'));
@@ -0,0 +1,14 @@
+-- Bonus and multi-schedule income types
+
+ALTER TABLE income_sources DROP CONSTRAINT IF EXISTS income_sources_income_type_check;
+ALTER TABLE income_sources ADD CONSTRAINT income_sources_income_type_check
+    CHECK (income_type IN ('monthly_fixed', 'one_time', 'semi_annual', 'custom_months', 'annual'));
+
+-- Comma separated months (1-12) the income is paid in, e.g. '6,12'
+ALTER TABLE income_sources ADD COLUMN IF NOT EXISTS payment_months VARCHAR(64);
+-- Yearly raise in percent, compounded from base_year
+ALTER TABLE income_sources ADD COLUMN IF NOT EXISTS raise_rate NUMERIC(6, 3);
+ALTER TABLE income_sources ADD COLUMN IF NOT EXISTS base_year INTEGER;
+
+ALTER TABLE income_sources ADD CONSTRAINT income_sources_raise_rate_check
+    CHECK (raise_rate IS NULL OR (raise_rate > -100 AND raise_rate <= 100));
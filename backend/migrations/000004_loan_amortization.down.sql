@@ -0,0 +1,4 @@
+ALTER TABLE recurring_payments DROP CONSTRAINT IF EXISTS recurring_payments_loan_check;
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS loan_repayment_method;
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS loan_annual_rate;
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS loan_principal;
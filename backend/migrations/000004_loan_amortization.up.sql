@@ -0,0 +1,14 @@
+-- Loan terms for amortized recurring payments; total_payments is the number of instalments
+
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS loan_principal BIGINT;
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS loan_annual_rate NUMERIC(7, 4);
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS loan_repayment_method VARCHAR(20);
+
+ALTER TABLE recurring_payments ADD CONSTRAINT recurring_payments_loan_check CHECK (
+    loan_principal IS NULL OR (
+        loan_principal > 0
+        AND total_payments IS NOT NULL
+        AND loan_repayment_method IN ('equal_payment', 'equal_principal')
+        AND (loan_annual_rate IS NULL OR (loan_annual_rate >= 0 AND loan_annual_rate <= 100))
+    )
+);
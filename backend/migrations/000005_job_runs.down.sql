@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_job_runs_job_name_started_at;
+DROP TABLE IF EXISTS job_runs;
@@ -0,0 +1,7 @@
+-- Leases that let only one replica run each scheduled job run
+CREATE TABLE IF NOT EXISTS job_locks (
+    job_name VARCHAR(100) PRIMARY KEY,
+    locked_by VARCHAR(255) NOT NULL,
+    locked_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
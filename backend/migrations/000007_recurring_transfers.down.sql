@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_recurring_transfers_updated_at ON recurring_transfers;
+DROP INDEX IF EXISTS idx_recurring_transfers_user_id;
+DROP TABLE IF EXISTS recurring_transfers;
@@ -0,0 +1,30 @@
+-- Recurring transfers between a user's own bank accounts
+
+CREATE TABLE IF NOT EXISTS recurring_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    from_account UUID NOT NULL REFERENCES bank_accounts(id),
+    to_account UUID NOT NULL REFERENCES bank_accounts(id),
+    transfer_type VARCHAR(20) NOT NULL CHECK (transfer_type IN ('fixed', 'top_up')),
+    amount BIGINT, -- Amount moved by a fixed transfer, or the maximum moved by a top-up
+    transfer_day INTEGER, -- Day of the month (1-31); optional for top-ups
+    target_balance BIGINT, -- Balance a top-up brings the destination account back to
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_transfer_accounts CHECK (from_account <> to_account),
+    CONSTRAINT check_transfer_day CHECK (transfer_day IS NULL OR (transfer_day >= 1 AND transfer_day <= 31)),
+    CONSTRAINT check_transfer_amount CHECK (amount IS NULL OR amount > 0),
+    CONSTRAINT check_transfer_rule CHECK (
+        (transfer_type = 'fixed' AND amount IS NOT NULL AND transfer_day IS NOT NULL)
+        OR (transfer_type = 'top_up' AND target_balance IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_transfers_user_id ON recurring_transfers(user_id);
+
+CREATE TRIGGER update_recurring_transfers_updated_at BEFORE UPDATE ON recurring_transfers
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
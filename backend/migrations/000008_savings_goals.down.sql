@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_savings_goals_updated_at ON savings_goals;
+DROP INDEX IF EXISTS idx_savings_goals_user_id;
+DROP TABLE IF EXISTS savings_goals;
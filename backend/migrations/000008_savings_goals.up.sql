@@ -0,0 +1,24 @@
+-- Savings goals funded from a bank account
+
+CREATE TABLE IF NOT EXISTS savings_goals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    target_amount BIGINT NOT NULL,
+    target_year_month VARCHAR(7), -- YYYY-MM format; optional deadline
+    bank_account UUID NOT NULL REFERENCES bank_accounts(id) ON DELETE CASCADE,
+    allocated_amount BIGINT, -- Ring-fenced portion of the account; NULL when the whole balance counts
+    is_reserved BOOLEAN NOT NULL DEFAULT false, -- Excluded from the available balance
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_savings_goal_target_amount CHECK (target_amount > 0),
+    CONSTRAINT check_savings_goal_allocated_amount CHECK (allocated_amount IS NULL OR allocated_amount >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_savings_goals_user_id ON savings_goals(user_id);
+
+CREATE TRIGGER update_savings_goals_updated_at BEFORE UPDATE ON savings_goals
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
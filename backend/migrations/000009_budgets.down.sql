@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS update_transactions_updated_at ON transactions;
+DROP TRIGGER IF EXISTS update_budgets_updated_at ON budgets;
+DROP TRIGGER IF EXISTS update_categories_updated_at ON categories;
+DROP INDEX IF EXISTS idx_transactions_user_id_date;
+DROP INDEX IF EXISTS idx_budgets_user_id;
+DROP INDEX IF EXISTS idx_budgets_category_year_month;
+DROP INDEX IF EXISTS idx_budgets_category_default;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS budgets;
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,53 @@
+-- Spending categories, monthly budgets per category and actual transactions
+
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS budgets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL,
+    year_month VARCHAR(7), -- YYYY-MM format; NULL for the budget of every month without an override
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_budget_amount CHECK (amount >= 0)
+);
+
+-- One default budget and at most one override per month for each category
+CREATE UNIQUE INDEX IF NOT EXISTS idx_budgets_category_default ON budgets(category_id) WHERE year_month IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_budgets_category_year_month ON budgets(category_id, year_month) WHERE year_month IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_budgets_user_id ON budgets(user_id);
+
+CREATE TABLE IF NOT EXISTS transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    transaction_date DATE NOT NULL,
+    amount BIGINT NOT NULL, -- Spending is positive, refunds are negative
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    bank_account UUID REFERENCES bank_accounts(id) ON DELETE SET NULL,
+    credit_card UUID REFERENCES credit_cards(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_transaction_payment_source CHECK (bank_account IS NULL OR credit_card IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_id_date ON transactions(user_id, transaction_date);
+
+CREATE TRIGGER update_categories_updated_at BEFORE UPDATE ON categories
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_budgets_updated_at BEFORE UPDATE ON budgets
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_transactions_updated_at BEFORE UPDATE ON transactions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
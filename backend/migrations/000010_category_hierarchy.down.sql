@@ -0,0 +1,11 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS tags;
+ALTER TABLE credit_cards DROP COLUMN IF EXISTS tags;
+ALTER TABLE credit_cards DROP COLUMN IF EXISTS category_id;
+ALTER TABLE income_sources DROP COLUMN IF EXISTS tags;
+ALTER TABLE income_sources DROP COLUMN IF EXISTS category_id;
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS tags;
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS category_id;
+DROP INDEX IF EXISTS idx_categories_parent_name;
+DROP INDEX IF EXISTS idx_categories_root_name;
+ALTER TABLE categories ADD CONSTRAINT categories_user_id_name_key UNIQUE (user_id, name);
+ALTER TABLE categories DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,19 @@
+-- Nested categories and categories/tags on every cash-flow item. Tags are stored as a comma separated list.
+
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES categories(id) ON DELETE SET NULL;
+
+-- Category names only need to be unique among siblings
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_user_id_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_root_name ON categories(user_id, name) WHERE parent_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_parent_name ON categories(parent_id, name) WHERE parent_id IS NOT NULL;
+
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories(id) ON DELETE SET NULL;
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS tags TEXT;
+
+ALTER TABLE income_sources ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories(id) ON DELETE SET NULL;
+ALTER TABLE income_sources ADD COLUMN IF NOT EXISTS tags TEXT;
+
+ALTER TABLE credit_cards ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories(id) ON DELETE SET NULL;
+ALTER TABLE credit_cards ADD COLUMN IF NOT EXISTS tags TEXT;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS tags TEXT;
@@ -0,0 +1,2 @@
+UPDATE app_settings SET key = 'minimum_monthly_expense_source' WHERE key = 'living_cost_source';
+UPDATE app_settings SET key = 'minimum_monthly_expense' WHERE key = 'living_cost_amount';
@@ -0,0 +1,3 @@
+-- The minimum monthly expense settings became the living-cost model
+UPDATE app_settings SET key = 'living_cost_amount' WHERE key = 'minimum_monthly_expense';
+UPDATE app_settings SET key = 'living_cost_source' WHERE key = 'minimum_monthly_expense_source';
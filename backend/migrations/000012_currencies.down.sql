@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_exchange_rates_updated_at ON exchange_rates;
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE credit_cards DROP COLUMN IF EXISTS currency;
+ALTER TABLE recurring_payments DROP COLUMN IF EXISTS currency;
+ALTER TABLE income_sources DROP COLUMN IF EXISTS currency;
+ALTER TABLE bank_accounts DROP COLUMN IF EXISTS currency;
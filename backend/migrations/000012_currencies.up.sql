@@ -0,0 +1,26 @@
+-- Currency of accounts and cash-flow items (ISO 4217). Existing data is in yen.
+
+ALTER TABLE bank_accounts ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+ALTER TABLE income_sources ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+ALTER TABLE recurring_payments ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+ALTER TABLE credit_cards ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'JPY';
+
+-- Exchange rates entered by hand or imported from CSV: one unit of from_currency is worth rate
+-- units of to_currency on rate_date
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    from_currency VARCHAR(3) NOT NULL,
+    to_currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL,
+    rate_date DATE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_exchange_rate_positive CHECK (rate > 0),
+    CONSTRAINT check_exchange_rate_currencies CHECK (from_currency <> to_currency),
+    UNIQUE(user_id, from_currency, to_currency, rate_date)
+);
+
+CREATE TRIGGER update_exchange_rates_updated_at BEFORE UPDATE ON exchange_rates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_net_worth_snapshots_updated_at ON net_worth_snapshots;
+DROP TRIGGER IF EXISTS update_asset_valuations_updated_at ON asset_valuations;
+DROP TRIGGER IF EXISTS update_assets_updated_at ON assets;
+
+DROP TABLE IF EXISTS net_worth_snapshots;
+DROP TABLE IF EXISTS asset_valuations;
+DROP TABLE IF EXISTS assets;
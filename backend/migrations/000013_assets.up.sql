@@ -0,0 +1,52 @@
+-- Investments and other non-cash assets, their valuations over time and daily net worth snapshots
+
+CREATE TABLE IF NOT EXISTS assets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    asset_type VARCHAR(20) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_asset_type CHECK (asset_type IN ('securities', 'nisa', 'pension', 'property', 'crypto', 'other')),
+    UNIQUE(user_id, name)
+);
+
+-- The value of an asset on a date, in the currency of the asset
+CREATE TABLE IF NOT EXISTS asset_valuations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    valuation_date DATE NOT NULL,
+    value BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_asset_valuation_value CHECK (value >= 0),
+    UNIQUE(asset_id, valuation_date)
+);
+
+-- Net worth of a user at the end of a day, in the base currency of that day
+CREATE TABLE IF NOT EXISTS net_worth_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    snapshot_date DATE NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    total_assets BIGINT NOT NULL,
+    total_liabilities BIGINT NOT NULL,
+    net_worth BIGINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, snapshot_date)
+);
+
+CREATE TRIGGER update_assets_updated_at BEFORE UPDATE ON assets
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_asset_valuations_updated_at BEFORE UPDATE ON asset_valuations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_net_worth_snapshots_updated_at BEFORE UPDATE ON net_worth_snapshots
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
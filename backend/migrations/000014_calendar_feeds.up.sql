@@ -0,0 +1,10 @@
+-- Secret tokens of the users' iCalendar feeds; a new token replaces the old one
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_calendar_feeds_updated_at BEFORE UPDATE ON calendar_feeds
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
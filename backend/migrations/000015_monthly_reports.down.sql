@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_monthly_reports_updated_at ON monthly_reports;
+
+DROP TABLE IF EXISTS monthly_reports;
@@ -0,0 +1,13 @@
+-- Monthly report PDFs produced by the scheduled job; a new run replaces the month's report
+CREATE TABLE IF NOT EXISTS monthly_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    year_month VARCHAR(7) NOT NULL,
+    content BYTEA NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, year_month)
+);
+
+CREATE TRIGGER update_monthly_reports_updated_at BEFORE UPDATE ON monthly_reports
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
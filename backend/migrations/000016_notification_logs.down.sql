@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_notification_logs_updated_at ON notification_logs;
+
+DROP TABLE IF EXISTS notification_logs;
@@ -0,0 +1,21 @@
+-- Notifications sent to users. The dedupe key identifies the event, so an event is sent once per
+-- channel; a failed delivery is retried by the next run.
+CREATE TABLE IF NOT EXISTS notification_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    dedupe_key VARCHAR(255) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('sent', 'failed')),
+    error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, channel, dedupe_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_logs_user_id ON notification_logs(user_id, updated_at DESC);
+
+CREATE TRIGGER update_notification_logs_updated_at BEFORE UPDATE ON notification_logs
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -25,15 +25,20 @@ func TeardownMockDB(db *sql.DB) {
 // ExpectBankAccountRows creates expected rows for bank account queries
 func ExpectBankAccountRows(mock sqlmock.Sqlmock, accounts []MockBankAccountData) *sqlmock.Rows {
 	rows := sqlmock.NewRows([]string{
-		"id", "user_id", "name", "balance", "created_at", "updated_at",
+		"id", "user_id", "name", "balance", "currency", "created_at", "updated_at",
 	})
 
 	for _, account := range accounts {
+		currency := account.Currency
+		if currency == "" {
+			currency = "JPY"
+		}
 		rows.AddRow(
 			account.ID,
 			account.UserID,
 			account.Name,
 			account.Balance,
+			currency,
 			account.CreatedAt,
 			account.UpdatedAt,
 		)
@@ -66,7 +71,8 @@ func ExpectCreditCardRows(mock sqlmock.Sqlmock, cards []MockCreditCardData) *sql
 func ExpectIncomeSourceRows(mock sqlmock.Sqlmock, sources []MockIncomeSourceData) *sqlmock.Rows {
 	rows := sqlmock.NewRows([]string{
 		"id", "user_id", "name", "income_type", "base_amount", "bank_account",
-		"payment_day", "scheduled_date", "scheduled_year_month", "is_active", "created_at", "updated_at",
+		"payment_day", "payment_months", "raise_rate", "base_year", "scheduled_date", "scheduled_year_month",
+		"is_active", "created_at", "updated_at",
 	})
 
 	for _, source := range sources {
@@ -78,6 +84,9 @@ func ExpectIncomeSourceRows(mock sqlmock.Sqlmock, sources []MockIncomeSourceData
 			source.BaseAmount,
 			source.BankAccount,
 			source.PaymentDay,
+			source.PaymentMonths,
+			source.RaiseRate,
+			source.BaseYear,
 			source.ScheduledDate,
 			source.ScheduledYearMonth,
 			source.IsActive,
@@ -94,6 +103,7 @@ type MockBankAccountData struct {
 	UserID    uuid.UUID
 	Name      string
 	Balance   int64
+	Currency  string // JPY when empty
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -119,6 +129,9 @@ type MockIncomeSourceData struct {
 	BaseAmount         int64
 	BankAccount        uuid.UUID
 	PaymentDay         *int
+	PaymentMonths      *string
+	RaiseRate          *float64
+	BaseYear           *int
 	ScheduledDate      *string
 	ScheduledYearMonth *string
 	IsActive           bool
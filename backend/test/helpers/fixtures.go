@@ -26,6 +26,7 @@ func CreateTestBankAccount(userID uuid.UUID) *models.BankAccount {
 		UserID:    userID,
 		Name:      "Test Bank Account",
 		Balance:   100000, // 1000.00 in cents
+		Currency:  "JPY",
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
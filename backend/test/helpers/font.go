@@ -0,0 +1,177 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"unicode/utf16"
+)
+
+// Glyphs of the test font, in the order of their glyph IDs after .notdef
+var testFontRanges = []struct {
+	first, last rune
+	advance     uint16
+}{
+	{0x20, 0x7e, 1024},     // ASCII, half width
+	{0xa5, 0xa5, 1024},     // ¥
+	{0x2026, 0x2026, 2048}, // …
+	{0x3000, 0x30ff, 2048}, // Punctuation, hiragana and katakana
+	{0x4e00, 0x9fff, 2048}, // Kanji
+}
+
+// TestFontPostScriptName is the PostScript name of the test font
+const TestFontPostScriptName = "FlowSightTest-Regular"
+
+// CreateTestFont returns a TrueType font for tests of PDF documents. ASCII and ¥ are half as
+// wide as the size and Japanese characters as wide as the size. Only .notdef, "." and "…", which
+// is made of three ".", have outlines.
+func CreateTestFont() []byte {
+	const unitsPerEm = 2048
+
+	advances := []uint16{unitsPerEm}
+	var groups [][3]uint32
+	glyphIDs := make(map[rune]int)
+	for _, r := range testFontRanges {
+		groups = append(groups, [3]uint32{uint32(r.first), uint32(r.last), uint32(len(advances))})
+		for c := r.first; c <= r.last; c++ {
+			glyphIDs[c] = len(advances)
+			advances = append(advances, r.advance)
+		}
+	}
+
+	box := testFontSimpleGlyph(100, 0, 900, 1400)
+	dot := testFontSimpleGlyph(400, 0, 624, 224)
+	ellipsis := testFontCompositeGlyph(glyphIDs['.'], []int16{0, 683, 1366})
+	outlines := map[int][]byte{0: box, glyphIDs['.']: dot, glyphIDs['…']: ellipsis}
+
+	var glyf bytes.Buffer
+	loca := make([]byte, 2*(len(advances)+1))
+	hmtx := make([]byte, 4*len(advances))
+	for gid, advance := range advances {
+		binary.BigEndian.PutUint16(loca[2*gid:], uint16(glyf.Len()/2))
+		glyf.Write(outlines[gid])
+		binary.BigEndian.PutUint16(hmtx[4*gid:], advance)
+	}
+	binary.BigEndian.PutUint16(loca[2*len(advances):], uint16(glyf.Len()/2))
+
+	head := make([]byte, 54)
+	binary.BigEndian.PutUint32(head, 0x00010000)
+	binary.BigEndian.PutUint32(head[12:], 0x5f0f3cf5)
+	binary.BigEndian.PutUint16(head[18:], unitsPerEm)
+	testFontPutInt16s(head[36:], 0, -446, 2048, 1802) // Bounding box
+	// The offsets of the glyphs are in the short format
+
+	hhea := make([]byte, 36)
+	binary.BigEndian.PutUint32(hhea, 0x00010000)
+	testFontPutInt16s(hhea[4:], 1802, -446) // Ascender and descender
+	binary.BigEndian.PutUint16(hhea[34:], uint16(len(advances)))
+
+	maxp := make([]byte, 32)
+	binary.BigEndian.PutUint32(maxp, 0x00010000)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(len(advances)))
+
+	// A full Unicode character map of the ranges
+	cmap := make([]byte, 12+16+12*len(groups))
+	binary.BigEndian.PutUint16(cmap[2:], 1)
+	binary.BigEndian.PutUint16(cmap[4:], 3)
+	binary.BigEndian.PutUint16(cmap[6:], 10)
+	binary.BigEndian.PutUint32(cmap[8:], 12)
+	binary.BigEndian.PutUint16(cmap[12:], 12)
+	binary.BigEndian.PutUint32(cmap[16:], uint32(len(cmap)-12))
+	binary.BigEndian.PutUint32(cmap[24:], uint32(len(groups)))
+	for i, group := range groups {
+		for j, value := range group {
+			binary.BigEndian.PutUint32(cmap[28+12*i+4*j:], value)
+		}
+	}
+
+	var postScriptName []byte
+	for _, unit := range utf16.Encode([]rune(TestFontPostScriptName)) {
+		postScriptName = binary.BigEndian.AppendUint16(postScriptName, unit)
+	}
+	name := make([]byte, 18)
+	binary.BigEndian.PutUint16(name[2:], 1)
+	binary.BigEndian.PutUint16(name[4:], 18)
+	binary.BigEndian.PutUint16(name[6:], 3)
+	binary.BigEndian.PutUint16(name[8:], 1)
+	binary.BigEndian.PutUint16(name[10:], 0x409)
+	binary.BigEndian.PutUint16(name[12:], 6)
+	binary.BigEndian.PutUint16(name[14:], uint16(len(postScriptName)))
+	name = append(name, postScriptName...)
+
+	return testFontFile(map[string][]byte{
+		"cmap": cmap,
+		"glyf": glyf.Bytes(),
+		"head": head,
+		"hhea": hhea,
+		"hmtx": hmtx,
+		"loca": loca,
+		"maxp": maxp,
+		"name": name,
+	})
+}
+
+// testFontSimpleGlyph returns a glyph of a rectangle
+func testFontSimpleGlyph(xMin, yMin, xMax, yMax int16) []byte {
+	glyph := make([]byte, 10)
+	testFontPutInt16s(glyph, 1, xMin, yMin, xMax, yMax)
+	glyph = binary.BigEndian.AppendUint16(glyph, 3) // Last point of the contour
+	glyph = binary.BigEndian.AppendUint16(glyph, 0) // No instructions
+	glyph = append(glyph, 1, 1, 1, 1)               // On-curve points with word coordinates
+	for _, dx := range []int16{xMin, xMax - xMin, 0, xMin - xMax} {
+		glyph = binary.BigEndian.AppendUint16(glyph, uint16(dx))
+	}
+	for _, dy := range []int16{yMin, 0, yMax - yMin, 0} {
+		glyph = binary.BigEndian.AppendUint16(glyph, uint16(dy))
+	}
+	return glyph
+}
+
+// testFontCompositeGlyph returns a glyph of copies of another glyph moved right by the offsets
+func testFontCompositeGlyph(component int, offsets []int16) []byte {
+	glyph := make([]byte, 10)
+	testFontPutInt16s(glyph, -1, 400, 0, 624+offsets[len(offsets)-1], 224)
+	for i, offset := range offsets {
+		flags := uint16(0x0001 | 0x0002) // Word arguments that are offsets
+		if i < len(offsets)-1 {
+			flags |= 0x0020 // More components follow
+		}
+		glyph = binary.BigEndian.AppendUint16(glyph, flags)
+		glyph = binary.BigEndian.AppendUint16(glyph, uint16(component))
+		glyph = binary.BigEndian.AppendUint16(glyph, uint16(offset))
+		glyph = binary.BigEndian.AppendUint16(glyph, 0)
+	}
+	return glyph
+}
+
+func testFontPutInt16s(b []byte, values ...int16) {
+	for i, value := range values {
+		binary.BigEndian.PutUint16(b[2*i:], uint16(value))
+	}
+}
+
+// testFontFile writes the tables as a font file. The checksums are left at zero.
+func testFontFile(tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	header := make([]byte, 12+16*len(tags))
+	binary.BigEndian.PutUint32(header, 0x00010000)
+	binary.BigEndian.PutUint16(header[4:], uint16(len(tags)))
+
+	var data bytes.Buffer
+	for i, tag := range tags {
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[8:], uint32(len(header)+data.Len()))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(tables[tag])))
+		data.Write(tables[tag])
+		for data.Len()%4 != 0 {
+			data.WriteByte(0)
+		}
+	}
+	return append(header, data.Bytes()...)
+}
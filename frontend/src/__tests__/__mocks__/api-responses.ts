@@ -39,6 +39,8 @@ export const mockDashboardSummary: DashboardSummary = {
   monthly_income: 250000,
   monthly_expense: 150000,
   total_assets: 500000,
+  total_liabilities: 200000,
+  net_worth: 300000,
   recent_activities: [mockCashflowProjection],
 }
 
@@ -126,15 +126,15 @@ export default function DashboardPage() {
 
           <Card>
             <CardHeader className="flex flex-row items-center justify-between space-y-0 pb-2">
-              <CardTitle className="text-sm font-medium">管理資産数</CardTitle>
+              <CardTitle className="text-sm font-medium">純資産</CardTitle>
               <CreditCardIcon className="h-4 w-4 text-muted-foreground" />
             </CardHeader>
             <CardContent>
               <div className="text-2xl font-bold">
-                {dashboardData.total_assets}
+                {formatCurrency(dashboardData.net_worth)}
               </div>
               <p className="text-xs text-muted-foreground">
-                口座 + カード
+                総資産 {formatCurrency(dashboardData.total_assets)} − 負債 {formatCurrency(dashboardData.total_liabilities)}
               </p>
             </CardContent>
           </Card>
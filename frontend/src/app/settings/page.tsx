@@ -16,7 +16,7 @@ import { FRONTEND_VERSION } from '@/lib/version';
 export default function SettingsPage() {
   const apiClient = useApi();
   const [settings, setSettings] = useState<Record<string, string>>({
-    minimum_monthly_expense: '0',
+    living_cost_amount: '0',
     notification_enabled: 'true',
     theme: 'light',
   });
@@ -47,8 +47,8 @@ export default function SettingsPage() {
       }, {} as Record<string, string>);
       
       setSettings({
-        minimum_monthly_expense: settingsMap.minimum_monthly_expense 
-          ? String(Math.round(Number(settingsMap.minimum_monthly_expense) / 100))
+        living_cost_amount: settingsMap.living_cost_amount
+          ? String(Math.round(Number(settingsMap.living_cost_amount) / 100))
           : '0', // Convert cents to yen for display
         notification_enabled: settingsMap.notification_enabled || 'true',
         theme: settingsMap.theme || 'light',
@@ -87,7 +87,7 @@ export default function SettingsPage() {
       // Convert yen to cents before saving
       const settingsToSave = {
         ...settings,
-        minimum_monthly_expense: String(Number(settings.minimum_monthly_expense) * 100)
+        living_cost_amount: String(Math.round(Number(settings.living_cost_amount) * 100))
       };
       await apiClient.updateSettings({ settings: settingsToSave });
       toast.success('設定を保存しました');
@@ -130,16 +130,16 @@ export default function SettingsPage() {
             </CardHeader>
             <CardContent className="space-y-4">
               <div className="space-y-2">
-                <Label htmlFor="minimum_monthly_expense">最低月支出 (円)</Label>
+                <Label htmlFor="living_cost_amount">月の生活費 (円)</Label>
                 <Input
-                  id="minimum_monthly_expense"
+                  id="living_cost_amount"
                   type="number"
-                  value={settings.minimum_monthly_expense}
-                  onChange={(e) => updateSetting('minimum_monthly_expense', e.target.value)}
+                  value={settings.living_cost_amount}
+                  onChange={(e) => updateSetting('living_cost_amount', e.target.value)}
                   placeholder="0"
                 />
                 <p className="text-sm text-muted-foreground">
-                  キャッシュフロー予測で使用される最低月支出額
+                  キャッシュフロー予測で、固定支出・カード支払いで賄えない分を生活費として計上する月額
                 </p>
               </div>
             </CardContent>
@@ -80,38 +80,18 @@ export function BalanceTrendChart() {
       try {
         setIsLoading(true)
         
-        // 前後1年（24ヶ月）のキャッシュフロー予測を取得
-        const projections = await apiClient.getCashflowProjection(24, false)
-        
-        // 月末残高データを作成（月次集計）
-        const monthlyData = new Map<string, { balance: number; lastDate: string }>()
-        
-        projections.forEach((projection) => {
-          const date = new Date(projection.date)
-          const yearMonth = `${date.getFullYear()}-${String(date.getMonth() + 1).padStart(2, '0')}`
-          
-          // 各月の最後の日の残高を記録
-          const existing = monthlyData.get(yearMonth)
-          if (!existing || new Date(projection.date) > new Date(existing.lastDate)) {
-            monthlyData.set(yearMonth, {
-              balance: projection.balance,
-              lastDate: projection.date
-            })
+        // 24ヶ月分の月次集計を取得し、月末残高をプロット
+        const periods = await apiClient.getCashflowPeriods(24, 'month')
+
+        const chartPoints: ChartDataPoint[] = periods.map((period) => {
+          const [year, month] = period.start_date.split('-')
+          return {
+            date: `${year}-${month}`,
+            balance: period.closing_balance,
+            formattedDate: `${year}年${parseInt(month)}月`
           }
         })
 
-        // チャート用のデータ形式に変換
-        const chartPoints: ChartDataPoint[] = Array.from(monthlyData.entries())
-          .map(([yearMonth, data]) => {
-            const [year, month] = yearMonth.split('-')
-            return {
-              date: yearMonth,
-              balance: data.balance,
-              formattedDate: `${year}年${parseInt(month)}月`
-            }
-          })
-          .sort((a, b) => a.date.localeCompare(b.date))
-
         setChartData(chartPoints)
       } catch (error) {
         console.error('Failed to load chart data:', error)
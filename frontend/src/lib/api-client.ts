@@ -6,9 +6,29 @@ import {
   MonthlyIncomeRecord,
   RecurringPayment,
   CashflowProjection,
+  CashflowPeriod,
+  CashflowGranularity,
+  ExportOptions,
+  ExportList,
+  CalendarFeed,
+  StoredMonthlyReport,
+  NotificationChannel,
+  NotificationLog,
+  Webhook,
+  WebhookInput,
+  WebhookDelivery,
   DashboardSummary,
+  DashboardOverview,
+  ExchangeRate,
+  ExchangeRateImportResult,
+  Asset,
+  AssetValuation,
+  AssetValuationImportResult,
+  NetWorth,
+  NetWorthSnapshot,
   AppSetting,
   UpdateSettingsRequest,
+  SettingDefinition,
   VersionInfo,
   UserInfo,
 } from '@/types/api';
@@ -250,16 +270,233 @@ class ApiClient {
     return this.request<CashflowProjection[]>(`/cashflow-projection?months=${months}&onlyChanges=${onlyChanges}`);
   }
 
+  async getCashflowPeriods(months: number = 36, granularity: 'week' | 'month' = 'month'): Promise<CashflowPeriod[]> {
+    return this.request<CashflowPeriod[]>(`/cashflow-projection?months=${months}&granularity=${granularity}`);
+  }
+
+  // Export API
+  async exportCashflowProjection(months: number = 36, options: ExportOptions & { granularity?: CashflowGranularity } = {}): Promise<Blob> {
+    const { granularity = 'day', ...exportOptions } = options;
+    return this.download(`/cashflow-projection?months=${months}&granularity=${granularity}&${this.exportQuery(exportOptions)}`);
+  }
+
+  async exportList(list: ExportList, options: ExportOptions = {}): Promise<Blob> {
+    return this.download(`/${list}/export?${this.exportQuery(options)}`);
+  }
+
+  private exportQuery({ format = 'csv', amountUnit = 'yen' }: ExportOptions): string {
+    return `format=${format}&amount_unit=${amountUnit}`;
+  }
+
+  private async download(endpoint: string): Promise<Blob> {
+    const response = await fetch(`${this.baseURL}${endpoint}`, {
+      headers: this.getAuthHeaders(),
+    });
+
+    if (!response.ok) {
+      if (response.status === 401) {
+        Cookies.remove('auth_token');
+        window.location.href = '/login';
+        return Promise.reject(new Error('Unauthorized'));
+      }
+      throw new Error(`HTTP error! status: ${response.status}`);
+    }
+    return response.blob();
+  }
+
+  // Calendar Feed API
+  async getCalendarFeed(): Promise<CalendarFeed> {
+    return this.request<CalendarFeed>('/calendar-feed');
+  }
+
+  async createCalendarFeed(): Promise<CalendarFeed> {
+    return this.request<CalendarFeed>('/calendar-feed', {
+      method: 'POST',
+    });
+  }
+
+  async deleteCalendarFeed(): Promise<void> {
+    return this.request<void>('/calendar-feed', {
+      method: 'DELETE',
+    });
+  }
+
+  // Report API
+  async getMonthlyReport(yearMonth?: string): Promise<Blob> {
+    const query = yearMonth ? `?year_month=${yearMonth}` : '';
+    return this.download(`/reports/monthly${query}`);
+  }
+
+  async getStoredMonthlyReports(): Promise<StoredMonthlyReport[]> {
+    return this.request<StoredMonthlyReport[]>('/reports/monthly/archive');
+  }
+
+  async getStoredMonthlyReport(yearMonth: string): Promise<Blob> {
+    return this.download(`/reports/monthly/archive/${yearMonth}`);
+  }
+
+  // Notifications API
+  async getNotificationLogs(): Promise<NotificationLog[]> {
+    return this.request<NotificationLog[]>('/notifications');
+  }
+
+  async sendTestNotification(channel: NotificationChannel = 'email'): Promise<NotificationLog> {
+    return this.request<NotificationLog>(`/notifications/test?channel=${channel}`, {
+      method: 'POST',
+    });
+  }
+
+  // Webhooks API
+  async getWebhooks(): Promise<Webhook[]> {
+    return this.request<Webhook[]>('/webhooks');
+  }
+
+  async getWebhook(id: string): Promise<Webhook> {
+    return this.request<Webhook>(`/webhooks/${id}`);
+  }
+
+  async createWebhook(webhook: WebhookInput): Promise<Webhook> {
+    return this.request<Webhook>('/webhooks', {
+      method: 'POST',
+      body: JSON.stringify(webhook),
+    });
+  }
+
+  async updateWebhook(id: string, webhook: WebhookInput): Promise<Webhook> {
+    return this.request<Webhook>(`/webhooks/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(webhook),
+    });
+  }
+
+  async deleteWebhook(id: string): Promise<void> {
+    await this.request<void>(`/webhooks/${id}`, {
+      method: 'DELETE',
+    });
+  }
+
+  async getWebhookDeliveries(id: string): Promise<WebhookDelivery[]> {
+    return this.request<WebhookDelivery[]>(`/webhooks/${id}/deliveries`);
+  }
+
+  async sendTestWebhookEvent(id: string): Promise<WebhookDelivery> {
+    return this.request<WebhookDelivery>(`/webhooks/${id}/test`, {
+      method: 'POST',
+    });
+  }
+
   // Dashboard API
   async getDashboardSummary(): Promise<DashboardSummary> {
     return this.request<DashboardSummary>('/dashboard/summary');
   }
 
+  async getDashboardOverview(upcomingDays: number = 30): Promise<DashboardOverview> {
+    return this.request<DashboardOverview>(`/dashboard/overview?upcoming_days=${upcomingDays}`);
+  }
+
+  // Exchange Rates API
+  async getExchangeRates(): Promise<ExchangeRate[]> {
+    return this.request<ExchangeRate[]>('/exchange-rates');
+  }
+
+  async createExchangeRate(rate: Omit<ExchangeRate, 'id' | 'created_at' | 'updated_at' | 'user_id'>): Promise<ExchangeRate> {
+    return this.request<ExchangeRate>('/exchange-rates', {
+      method: 'POST',
+      body: JSON.stringify(rate),
+    });
+  }
+
+  async updateExchangeRate(id: string, rate: Omit<ExchangeRate, 'id' | 'created_at' | 'updated_at' | 'user_id'>): Promise<ExchangeRate> {
+    return this.request<ExchangeRate>(`/exchange-rates/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(rate),
+    });
+  }
+
+  async deleteExchangeRate(id: string): Promise<void> {
+    await this.request<void>(`/exchange-rates/${id}`, {
+      method: 'DELETE',
+    });
+  }
+
+  // CSV with the columns date, from, to and rate
+  async importExchangeRates(csv: string): Promise<ExchangeRateImportResult> {
+    return this.request<ExchangeRateImportResult>('/exchange-rates/import', {
+      method: 'POST',
+      headers: { 'Content-Type': 'text/csv' },
+      body: csv,
+    });
+  }
+
+  // Assets API
+  async getAssets(): Promise<Asset[]> {
+    return this.request<Asset[]>('/assets');
+  }
+
+  async createAsset(asset: Omit<Asset, 'id' | 'created_at' | 'updated_at' | 'user_id' | 'current_value' | 'valued_on'>): Promise<Asset> {
+    return this.request<Asset>('/assets', {
+      method: 'POST',
+      body: JSON.stringify(asset),
+    });
+  }
+
+  async updateAsset(id: string, asset: Omit<Asset, 'id' | 'created_at' | 'updated_at' | 'user_id' | 'current_value' | 'valued_on'>): Promise<Asset> {
+    return this.request<Asset>(`/assets/${id}`, {
+      method: 'PUT',
+      body: JSON.stringify(asset),
+    });
+  }
+
+  async deleteAsset(id: string): Promise<void> {
+    await this.request<void>(`/assets/${id}`, {
+      method: 'DELETE',
+    });
+  }
+
+  async getAssetValuations(assetId: string): Promise<AssetValuation[]> {
+    return this.request<AssetValuation[]>(`/assets/${assetId}/valuations`);
+  }
+
+  async createAssetValuation(assetId: string, valuation: Pick<AssetValuation, 'valuation_date' | 'value'>): Promise<AssetValuation> {
+    return this.request<AssetValuation>(`/assets/${assetId}/valuations`, {
+      method: 'POST',
+      body: JSON.stringify(valuation),
+    });
+  }
+
+  async deleteAssetValuation(assetId: string, valuationId: string): Promise<void> {
+    await this.request<void>(`/assets/${assetId}/valuations/${valuationId}`, {
+      method: 'DELETE',
+    });
+  }
+
+  // CSV with the columns date, asset (name or ID) and value
+  async importAssetValuations(csv: string): Promise<AssetValuationImportResult> {
+    return this.request<AssetValuationImportResult>('/assets/valuations/import', {
+      method: 'POST',
+      headers: { 'Content-Type': 'text/csv' },
+      body: csv,
+    });
+  }
+
+  // Net Worth API
+  async getNetWorth(): Promise<NetWorth> {
+    return this.request<NetWorth>('/net-worth');
+  }
+
+  async getNetWorthHistory(months: number = 12): Promise<NetWorthSnapshot[]> {
+    return this.request<NetWorthSnapshot[]>(`/net-worth/history?months=${months}`);
+  }
+
   // Settings API
   async getSettings(): Promise<AppSetting[]> {
     return this.request<AppSetting[]>('/settings');
   }
 
+  async getSettingsSchema(): Promise<SettingDefinition[]> {
+    return this.request<SettingDefinition[]>('/settings/schema');
+  }
+
   async updateSettings(settings: UpdateSettingsRequest): Promise<Record<string, string>> {
     return this.request<Record<string, string>>('/settings', {
       method: 'PUT',
@@ -1,8 +1,10 @@
-export const formatCurrency = (amountInCents: number): string => {
+// Amounts are stored in hundredths of the currency unit for every currency; the number of
+// decimals shown follows the currency (none for JPY, two for USD)
+export const formatCurrency = (amountInCents: number, currency: string = 'JPY'): string => {
   const amount = amountInCents / 100;
   return new Intl.NumberFormat('ja-JP', {
     style: 'currency',
-    currency: 'JPY',
+    currency,
   }).format(amount);
 };
 
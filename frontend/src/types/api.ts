@@ -7,6 +7,7 @@ export interface CreditCard {
   bank_account: string;
   closing_day?: number; // Closing day of the month
   payment_day: number;
+  currency?: string; // ISO 4217 code, "JPY" when omitted
   created_at: string;
   updated_at: string;
 }
@@ -15,7 +16,8 @@ export interface BankAccount {
   id: string;
   user_id: string;
   name: string;
-  balance: number; // Amount in cents
+  balance: number; // Amount in hundredths of the currency unit
+  currency?: string; // ISO 4217 code, "JPY" when omitted
   created_at: string;
   updated_at: string;
 }
@@ -41,6 +43,7 @@ export interface IncomeSource {
   payment_day?: number; // For monthly_fixed income (1-31)
   scheduled_date?: string; // For one_time income (ISO date string)
   scheduled_year_month?: string; // For one-time income (backward compatibility)
+  currency?: string; // ISO 4217 code, "JPY" when omitted
   created_at: string;
   updated_at: string;
 }
@@ -68,6 +71,7 @@ export interface RecurringPayment {
   remaining_payments?: number;
   is_active: boolean;
   note?: string;
+  currency?: string; // ISO 4217 code, "JPY" when omitted
   created_at: string;
   updated_at: string;
 }
@@ -75,25 +79,229 @@ export interface RecurringPayment {
 export interface CashflowProjectionDetail {
   type: 'income' | 'recurring_payment' | 'card_payment';
   description: string;
-  amount: number;
+  amount: number; // In the base currency
+  planned_amount?: number; // Amount the schedule or card estimate predicted, when a recorded amount replaced it
+  original_amount?: number; // Amount in original_currency when it is not the base currency
+  original_currency?: string;
+}
+
+export interface AccountBalance {
+  bank_account_id: string;
+  balance: number;
+  currency: string; // Currency of the account
 }
 
 export interface CashflowProjection {
   date: string;
   income: number;
   expense: number;
-  balance: number;
+  balance: number; // In the base currency
+  account_balances?: AccountBalance[];
   details: CashflowProjectionDetail[];
 }
 
+export type CashflowGranularity = 'day' | 'week' | 'month';
+
+export interface CashflowPeriod {
+  start_date: string;
+  end_date: string;
+  opening_balance: number; // In the base currency
+  closing_balance: number;
+  income: number;
+  expense: number;
+  subtotals: Record<string, number>; // Amounts by detail type, transfers left out
+  account_balances?: AccountBalance[]; // Balance of each bank account at the end of the period
+}
+
+export interface ExportOptions {
+  format?: 'csv' | 'xlsx';
+  amountUnit?: 'yen' | 'minor'; // Whole currency units or stored hundredths
+}
+
+export type ExportList = 'bank-accounts' | 'credit-cards' | 'card-monthly-totals' | 'income-sources' | 'recurring-payments';
+
+export interface CalendarFeed {
+  user_id: string;
+  token: string;
+  url: string; // Address to subscribe to in a calendar app
+  created_at: string;
+  updated_at: string;
+}
+
+export interface StoredMonthlyReport {
+  id: string;
+  user_id: string;
+  year_month: string; // Format: "2024-01"
+  size: number; // Size of the PDF in bytes
+  created_at: string;
+  updated_at: string;
+}
+
+export type NotificationChannel = 'email' | 'slack' | 'discord' | 'line';
+
+export interface NotificationLog {
+  id: string;
+  user_id: string;
+  channel: NotificationChannel | 'webhook';
+  event_type: 'large_debit' | 'low_balance' | 'card_unconfirmed' | 'weekly_digest' | 'test';
+  dedupe_key: string; // Identifies the event; an event is sent once per channel
+  recipient: string; // Address for e-mail, host or channel name for chat services
+  subject: string;
+  status: 'sent' | 'failed';
+  error?: string;
+  created_at: string;
+  updated_at: string; // Time of the latest attempt
+}
+
+export type WebhookEntity = 'bank_account' | 'credit_card' | 'income_source' | 'recurring_payment' | 'card_monthly_total';
+
+export type WebhookEventType =
+  | `${WebhookEntity}.${'created' | 'updated' | 'deleted'}`
+  | 'alert.triggered'
+  | 'card_monthly_total.confirmed';
+
+export interface Webhook {
+  id: string;
+  user_id: string;
+  name: string;
+  url: string;
+  secret: string; // Key of the X-Flow-Sight-Signature HMAC-SHA256 signatures
+  events?: WebhookEventType[]; // Empty for every event
+  is_active: boolean;
+  created_at: string;
+  updated_at: string;
+}
+
+// The secret is generated on creation and kept on update when empty
+export type WebhookInput = Omit<Webhook, 'id' | 'user_id' | 'secret' | 'created_at' | 'updated_at'> & { secret?: string };
+
+export interface WebhookDelivery {
+  id: string;
+  webhook_id: string;
+  event_id: string; // The same for the deliveries of one event to several webhooks
+  event_type: WebhookEventType | 'test';
+  payload: { id: string; type: string; created_at: string; data: unknown };
+  status: 'pending' | 'succeeded' | 'failed';
+  attempts: number;
+  response_status?: number; // HTTP status of the latest attempt
+  error?: string;
+  next_attempt_at?: string; // Set while pending
+  created_at: string;
+  updated_at: string;
+}
+
 export interface DashboardSummary {
+  currency: string; // Base currency of the amounts
   total_balance: number;
   monthly_income: number;
   monthly_expense: number;
-  total_assets: number;
+  total_assets: number; // Bank balances plus the latest asset valuations
+  total_liabilities: number; // Remaining principal of loans
+  net_worth: number;
   recent_activities: CashflowProjection[];
 }
 
+export interface MonthlyTrend {
+  year_month: string; // Format: "2024-01"
+  income: number;
+  expense: number;
+  net: number;
+  income_change: number | null; // Percent change from the previous month
+  expense_change: number | null; // Percent change from the previous month
+}
+
+export interface UpcomingItem extends CashflowProjectionDetail {
+  date: string; // Format: "2024-01-31"
+}
+
+export interface DashboardOverview {
+  currency: string; // Base currency of the amounts
+  trends: MonthlyTrend[]; // Last 12 months ending with the current month
+  upcoming: UpcomingItem[];
+  lowest_balance: number;
+  lowest_balance_date: string;
+  runway_months: number | null; // Months the balance covers the average expense without income
+}
+
+export type AssetType = 'securities' | 'nisa' | 'pension' | 'property' | 'crypto' | 'other';
+
+export interface Asset {
+  id: string;
+  user_id: string;
+  name: string;
+  asset_type: AssetType;
+  currency?: string; // ISO 4217 code, "JPY" when omitted
+  is_active: boolean;
+  note?: string;
+  current_value?: number; // Latest valuation, absent before the first one
+  valued_on?: string; // Date of the latest valuation, e.g. "2024-01-31"
+  created_at: string;
+  updated_at: string;
+}
+
+export interface AssetValuation {
+  id: string;
+  asset_id: string;
+  valuation_date: string; // Format: "2024-01-31"
+  value: number; // In the currency of the asset
+  created_at: string;
+  updated_at: string;
+}
+
+export interface AssetValuationImportResult {
+  imported: number;
+}
+
+export interface AssetTypeTotal {
+  asset_type: AssetType | 'bank_account';
+  amount: number;
+}
+
+export interface LiabilityBalance {
+  recurring_payment_id: string;
+  name: string;
+  amount: number; // In the base currency
+  original_amount?: number; // Amount in original_currency when it is not the base currency
+  original_currency?: string;
+}
+
+export interface NetWorth {
+  date: string;
+  currency: string; // Base currency of the amounts
+  total_assets: number;
+  total_liabilities: number;
+  net_worth: number;
+  assets: AssetTypeTotal[];
+  liabilities: LiabilityBalance[];
+}
+
+export interface NetWorthSnapshot {
+  id: string;
+  user_id: string;
+  snapshot_date: string; // Format: "2024-01-31"
+  currency: string;
+  total_assets: number;
+  total_liabilities: number;
+  net_worth: number;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ExchangeRate {
+  id: string;
+  user_id: string;
+  from_currency: string; // ISO 4217 code, e.g. "USD"
+  to_currency: string; // ISO 4217 code, e.g. "JPY"
+  rate: number; // Units of to_currency per unit of from_currency
+  rate_date: string; // Format: "2024-01-15"
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ExchangeRateImportResult {
+  imported: number;
+}
+
 export interface AppSetting {
   id: string;
   user_id: string;
@@ -107,6 +315,19 @@ export interface UpdateSettingsRequest {
   settings: Record<string, string>;
 }
 
+export interface SettingDefinition {
+  key: string;
+  type: 'integer' | 'number' | 'boolean' | 'enum' | 'string';
+  default: string;
+  label: string;
+  description: string;
+  unit?: string;
+  minimum?: number;
+  maximum?: number;
+  options?: string[];
+  format?: string;
+}
+
 export interface VersionInfo {
   version: string;
 }